@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/daemon"
+	"github.com/clawscli/claws/internal/log"
+)
+
+type daemonOptions struct {
+	help       bool
+	configFile string
+	logFile    string
+}
+
+// parseDaemonArgs parses the arguments after `claws daemon` (testable)
+func parseDaemonArgs(args []string) (daemonOptions, error) {
+	opts := daemonOptions{}
+
+	for i := 0; i < len(args); i++ {
+		needsValue := func() (string, error) {
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("%s requires a value", args[i])
+			}
+			i++
+			return args[i], nil
+		}
+
+		var err error
+		switch args[i] {
+		case "-c", "--config":
+			opts.configFile, err = needsValue()
+		case "-l", "--log-file":
+			opts.logFile, err = needsValue()
+		case "-h", "--help":
+			opts.help = true
+		default:
+			err = fmt.Errorf("unknown option %q", args[i])
+		}
+		if err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
+}
+
+// runDaemon implements `claws daemon` and returns the process exit code
+func runDaemon(args []string) int {
+	opts, err := parseDaemonArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintln(os.Stderr, "Run 'claws daemon --help' for usage")
+		return 2
+	}
+	if opts.help {
+		printDaemonUsage()
+		return 0
+	}
+
+	if opts.configFile == "" {
+		opts.configFile = strings.TrimSpace(os.Getenv("CLAWS_CONFIG"))
+	}
+	if opts.configFile != "" {
+		if err := config.SetConfigPath(opts.configFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+	if opts.logFile != "" {
+		if err := log.EnableFile(opts.logFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not open log file %s: %v\n", opts.logFile, err)
+		}
+	}
+
+	propagateAllProxy()
+
+	path, err := daemon.SocketPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	l, err := daemon.Listen(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "claws daemon %s listening on %s (Ctrl+C to stop)\n", version, path)
+	log.Info("claws daemon started", "socket", path)
+	if err := daemon.NewServer(version).Serve(ctx, l); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// connectDaemon makes the credentials and listings of a running `claws daemon`
+// available to the AWS clients. Without a daemon, they are resolved in-process.
+func connectDaemon(ctx context.Context) {
+	path, err := daemon.SocketPath()
+	if err != nil {
+		return
+	}
+	client, err := daemon.Connect(ctx, path)
+	if err != nil {
+		log.Debug("claws daemon not running", "socket", path, "error", err)
+		return
+	}
+	// The socket protocol gains ops between versions, so an older or newer
+	// daemon may not serve every request
+	if client.Version() != version {
+		log.Warn("claws daemon version differs, not using it; restart the daemon", "daemon", client.Version(), "claws", version)
+		return
+	}
+	aws.SetWarmSource(client)
+	log.Info("using claws daemon", "socket", path)
+}
+
+func printDaemonUsage() {
+	fmt.Println("claws daemon - Keep AWS clients, credentials and listings warm for fast launches")
+	fmt.Println()
+	fmt.Println("Usage: claws daemon [options]")
+	fmt.Println()
+	fmt.Println("Runs in the foreground until interrupted. While it runs, claws gets the")
+	fmt.Println("credentials, account IDs and region lists of named profiles and SSO roles,")
+	fmt.Println("and the accounts and roles of SSO sessions, from it over a socket under the")
+	fmt.Println("claws config directory, instead of exchanging SSO tokens, assuming roles and")
+	fmt.Println("listing them on every launch. The daemon reuses its SDK clients, refreshes")
+	fmt.Println("credentials and SSO tokens before they expire, and refetches region lists")
+	fmt.Println("(hourly) and SSO roles (every 10 minutes) in the background. Resource data")
+	fmt.Println("is not cached. Profiles are resolved with the daemon's environment; SDK")
+	fmt.Println("default and --env credentials are always resolved by claws.")
+	fmt.Println()
+	fmt.Println("claws uses the daemon only when started with --daemon or when")
+	fmt.Println("daemon.enabled is true in the config file, and only if the daemon runs")
+	fmt.Println("the same claws version; restart it after upgrading.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -c, --config <path>")
+	fmt.Println("        Use custom config file instead of ~/.config/claws/config.yaml")
+	fmt.Println("        (the socket is placed next to it)")
+	fmt.Println("  -l, --log-file <path>")
+	fmt.Println("        Enable debug logging to specified file")
+	fmt.Println("  -h, --help")
+	fmt.Println("        Show this help message")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  claws daemon & claws --daemon")
+	fmt.Println("  claws daemon -l /tmp/claws-daemon.log")
+}
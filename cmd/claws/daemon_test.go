@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestParseDaemonArgs(t *testing.T) {
+	opts, err := parseDaemonArgs([]string{"-c", "/tmp/claws.yaml", "--log-file", "/tmp/daemon.log"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.configFile != "/tmp/claws.yaml" || opts.logFile != "/tmp/daemon.log" || opts.help {
+		t.Errorf("opts = %+v", opts)
+	}
+
+	for name, args := range map[string][]string{
+		"missing value": {"--config"},
+		"unknown flag":  {"--profile", "prod"},
+	} {
+		if _, err := parseDaemonArgs(args); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
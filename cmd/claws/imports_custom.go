@@ -12,9 +12,16 @@ import (
 	_ "github.com/clawscli/claws/custom/accessanalyzer/analyzers"
 	_ "github.com/clawscli/claws/custom/accessanalyzer/findings"
 
+	// Account
+	_ "github.com/clawscli/claws/custom/account/contacts"
+	_ "github.com/clawscli/claws/custom/account/information"
+
 	// ACM
 	_ "github.com/clawscli/claws/custom/acm/certificates"
 
+	// ACM PCA
+	_ "github.com/clawscli/claws/custom/acmpca/certificate-authorities"
+
 	// API Gateway
 	_ "github.com/clawscli/claws/custom/apigateway/http-apis"
 	_ "github.com/clawscli/claws/custom/apigateway/rest-apis"
@@ -36,6 +43,7 @@ import (
 	// Auto Scaling
 	_ "github.com/clawscli/claws/custom/autoscaling/activities"
 	_ "github.com/clawscli/claws/custom/autoscaling/groups"
+	_ "github.com/clawscli/claws/custom/autoscaling/instance-refreshes"
 
 	// AWS Backup
 	_ "github.com/clawscli/claws/custom/backup/backup-jobs"
@@ -57,6 +65,9 @@ import (
 	_ "github.com/clawscli/claws/custom/bedrock/foundation-models"
 	_ "github.com/clawscli/claws/custom/bedrock/guardrails"
 	_ "github.com/clawscli/claws/custom/bedrock/inference-profiles"
+	_ "github.com/clawscli/claws/custom/bedrock/invocation-logging"
+	_ "github.com/clawscli/claws/custom/bedrock/model-access"
+	_ "github.com/clawscli/claws/custom/bedrock/provisioned-throughputs"
 
 	// Bedrock Agent
 	_ "github.com/clawscli/claws/custom/bedrock-agent/agents"
@@ -87,6 +98,7 @@ import (
 
 	// CloudFront
 	_ "github.com/clawscli/claws/custom/cloudfront/distributions"
+	_ "github.com/clawscli/claws/custom/cloudfront/functions"
 
 	// CloudTrail
 	_ "github.com/clawscli/claws/custom/cloudtrail/events"
@@ -96,6 +108,7 @@ import (
 	_ "github.com/clawscli/claws/custom/cloudwatch/alarms"
 	_ "github.com/clawscli/claws/custom/cloudwatch/log-groups"
 	_ "github.com/clawscli/claws/custom/cloudwatch/log-streams"
+	_ "github.com/clawscli/claws/custom/cloudwatch/metrics"
 
 	// CodeBuild
 	_ "github.com/clawscli/claws/custom/codebuild/builds"
@@ -135,20 +148,28 @@ import (
 	// EC2
 	_ "github.com/clawscli/claws/custom/ec2/capacity-reservations"
 	_ "github.com/clawscli/claws/custom/ec2/elastic-ips"
+	_ "github.com/clawscli/claws/custom/ec2/encryption-audit"
 	_ "github.com/clawscli/claws/custom/ec2/images"
 	_ "github.com/clawscli/claws/custom/ec2/instances"
 	_ "github.com/clawscli/claws/custom/ec2/key-pairs"
+	_ "github.com/clawscli/claws/custom/ec2/launch-template-versions"
 	_ "github.com/clawscli/claws/custom/ec2/launch-templates"
+	_ "github.com/clawscli/claws/custom/ec2/prefix-list-entries"
+	_ "github.com/clawscli/claws/custom/ec2/prefix-lists"
+	_ "github.com/clawscli/claws/custom/ec2/regions"
 	_ "github.com/clawscli/claws/custom/ec2/security-groups"
 	_ "github.com/clawscli/claws/custom/ec2/snapshots"
 	_ "github.com/clawscli/claws/custom/ec2/volumes"
 
 	// ECR
 	_ "github.com/clawscli/claws/custom/ecr/images"
+	_ "github.com/clawscli/claws/custom/ecr/registry-settings"
 	_ "github.com/clawscli/claws/custom/ecr/repositories"
 
 	// ECS
 	_ "github.com/clawscli/claws/custom/ecs/clusters"
+	_ "github.com/clawscli/claws/custom/ecs/performance"
+	_ "github.com/clawscli/claws/custom/ecs/service-connect"
 	_ "github.com/clawscli/claws/custom/ecs/services"
 	_ "github.com/clawscli/claws/custom/ecs/task-definitions"
 	_ "github.com/clawscli/claws/custom/ecs/tasks"
@@ -159,9 +180,12 @@ import (
 	_ "github.com/clawscli/claws/custom/eks/clusters"
 	_ "github.com/clawscli/claws/custom/eks/fargate-profiles"
 	_ "github.com/clawscli/claws/custom/eks/node-groups"
+	_ "github.com/clawscli/claws/custom/eks/performance"
+	_ "github.com/clawscli/claws/custom/eks/updates"
 
 	// ElastiCache
 	_ "github.com/clawscli/claws/custom/elasticache/clusters"
+	_ "github.com/clawscli/claws/custom/elasticache/events"
 
 	// Elastic Load Balancing
 	_ "github.com/clawscli/claws/custom/elbv2/load-balancers"
@@ -192,6 +216,7 @@ import (
 	_ "github.com/clawscli/claws/custom/glue/databases"
 	_ "github.com/clawscli/claws/custom/glue/job-runs"
 	_ "github.com/clawscli/claws/custom/glue/jobs"
+	_ "github.com/clawscli/claws/custom/glue/partitions"
 	_ "github.com/clawscli/claws/custom/glue/tables"
 
 	// GuardDuty
@@ -200,6 +225,7 @@ import (
 
 	// Health
 	_ "github.com/clawscli/claws/custom/health/events"
+	_ "github.com/clawscli/claws/custom/health/history"
 
 	// IAM
 	_ "github.com/clawscli/claws/custom/iam/groups"
@@ -244,19 +270,29 @@ import (
 	_ "github.com/clawscli/claws/custom/organizations/policies"
 	_ "github.com/clawscli/claws/custom/organizations/roots"
 
+	// EventBridge Pipes
+	_ "github.com/clawscli/claws/custom/pipes/pipes"
+
 	// RDS
+	_ "github.com/clawscli/claws/custom/rds/clusters"
 	_ "github.com/clawscli/claws/custom/rds/instances"
+	_ "github.com/clawscli/claws/custom/rds/parameter-groups"
 	_ "github.com/clawscli/claws/custom/rds/snapshots"
 
 	// Redshift
 	_ "github.com/clawscli/claws/custom/redshift/clusters"
 	_ "github.com/clawscli/claws/custom/redshift/snapshots"
 
+	// Resource Groups
+	_ "github.com/clawscli/claws/custom/resource-groups/groups"
+	_ "github.com/clawscli/claws/custom/resource-groups/members"
+
 	// RI/SP
 	_ "github.com/clawscli/claws/custom/risp/reserved-instances"
 	_ "github.com/clawscli/claws/custom/risp/savings-plans"
 
 	// Route 53
+	_ "github.com/clawscli/claws/custom/route53/health-checks"
 	_ "github.com/clawscli/claws/custom/route53/hosted-zones"
 	_ "github.com/clawscli/claws/custom/route53/record-sets"
 
@@ -283,6 +319,9 @@ import (
 	_ "github.com/clawscli/claws/custom/service-quotas/quotas"
 	_ "github.com/clawscli/claws/custom/service-quotas/services"
 
+	// Service Catalog
+	_ "github.com/clawscli/claws/custom/servicecatalog/provisioned-products"
+
 	// SNS
 	_ "github.com/clawscli/claws/custom/sns/subscriptions"
 	_ "github.com/clawscli/claws/custom/sns/topics"
@@ -291,6 +330,9 @@ import (
 	_ "github.com/clawscli/claws/custom/sqs/queues"
 
 	// Systems Manager
+	_ "github.com/clawscli/claws/custom/ssm/automation-executions"
+	_ "github.com/clawscli/claws/custom/ssm/automation-steps"
+	_ "github.com/clawscli/claws/custom/ssm/documents"
 	_ "github.com/clawscli/claws/custom/ssm/parameters"
 
 	// Step Functions
@@ -320,6 +362,11 @@ import (
 	// WAF
 	_ "github.com/clawscli/claws/custom/wafv2/web-acls"
 
+	// Well-Architected
+	_ "github.com/clawscli/claws/custom/wellarchitected/improvements"
+	_ "github.com/clawscli/claws/custom/wellarchitected/lens-reviews"
+	_ "github.com/clawscli/claws/custom/wellarchitected/workloads"
+
 	// X-Ray
 	_ "github.com/clawscli/claws/custom/xray/groups"
 )
@@ -3,7 +3,9 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"slices"
@@ -14,6 +16,7 @@ import (
 	"github.com/clawscli/claws/internal/app"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/recording"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
 )
@@ -22,8 +25,19 @@ import (
 var version = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		os.Exit(runReport(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "daemon" {
+		os.Exit(runDaemon(os.Args[2:]))
+	}
+
 	opts := parseFlags()
 
+	if opts.replayFile != "" {
+		os.Exit(runReplay(opts.replayFile))
+	}
+
 	propagateAllProxy()
 
 	// Set custom config path (CLI flag > env var > default)
@@ -51,6 +65,23 @@ func main() {
 			opts.readOnly = true
 		}
 	}
+	if !opts.sandbox {
+		if v := os.Getenv("CLAWS_SANDBOX"); v == "1" || v == "true" {
+			opts.sandbox = true
+		}
+	}
+	if opts.sandbox || opts.sandboxPolicy != "" {
+		policy, err := loadSandboxPolicy(opts.sandboxPolicy)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		cfg.EnableSandbox(policy)
+		// The default sandbox is read-only, so hide actions that would fail
+		if policy == "" {
+			opts.readOnly = true
+		}
+	}
 	cfg.SetReadOnly(opts.readOnly)
 
 	var compactHeader bool
@@ -119,31 +150,85 @@ func main() {
 		if err := log.EnableFile(opts.logFile); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: could not open log file %s: %v\n", opts.logFile, err)
 		} else {
-			log.Info("claws started", "profiles", opts.profiles, "regions", opts.regions, "readOnly", opts.readOnly)
+			log.Info("claws started", "profiles", opts.profiles, "regions", opts.regions, "readOnly", opts.readOnly, "sandbox", cfg.Sandbox())
 		}
 	}
 
 	ctx := context.Background()
+	if opts.daemon || fileCfg.DaemonEnabled() {
+		connectDaemon(ctx)
+	}
 
 	application := app.New(ctx, registry.Global, startupPath)
 
+	var recorder *recording.Recorder
+	if opts.recordFile != "" {
+		recorder = recording.NewRecorder(opts.recordFile, version)
+		application.SetRecorder(recorder)
+	}
+
 	// Run the TUI
 	// Note: In v2, AltScreen and MouseMode are set via the View struct
 	// v2 has better ESC key handling via x/input package
 	p := tea.NewProgram(application)
 
-	if _, err := p.Run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	_, runErr := p.Run()
+
+	if recorder != nil {
+		if err := recorder.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		} else {
+			fmt.Printf("Recorded %d steps to %s (replay with: claws --replay %s)\n", recorder.Len(), recorder.Path(), recorder.Path())
+		}
+	}
+
+	if runErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", runErr)
 		os.Exit(1)
 	}
 }
 
+// loadSandboxPolicy reads and compacts the session policy document at path.
+// An empty path selects the default ReadOnlyAccess policy.
+func loadSandboxPolicy(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read sandbox policy: %w", err)
+	}
+	// Session policies count packed size against a 2048 character limit
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return "", fmt.Errorf("sandbox policy %s is not valid JSON: %w", path, err)
+	}
+	return buf.String(), nil
+}
+
+// runReplay steps through a recorded session without touching AWS
+func runReplay(path string) int {
+	rec, err := recording.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if _, err := tea.NewProgram(app.NewReplay(rec)).Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
 type cliOptions struct {
 	profiles      []string
 	regions       []string
 	readOnly      bool
+	sandbox       bool
+	sandboxPolicy string
 	envCreds      bool
 	autosave      *bool
+	daemon        bool
 	logFile       string
 	configFile    string
 	service       string
@@ -152,6 +237,8 @@ type cliOptions struct {
 	tag           string
 	theme         string
 	compactHeader *bool
+	recordFile    string
+	replayFile    string
 }
 
 // parseFlags parses command line flags and returns options
@@ -187,6 +274,13 @@ func parseFlagsFromArgs(args []string) cliOptions {
 			}
 		case "-ro", "--read-only":
 			opts.readOnly = true
+		case "--sandbox":
+			opts.sandbox = true
+		case "--sandbox-policy":
+			if i+1 < len(args) {
+				i++
+				opts.sandboxPolicy = args[i]
+			}
 		case "-e", "--env":
 			opts.envCreds = true
 		case "--autosave":
@@ -195,6 +289,8 @@ func parseFlagsFromArgs(args []string) cliOptions {
 		case "--no-autosave":
 			f := false
 			opts.autosave = &f
+		case "--daemon":
+			opts.daemon = true
 		case "-l", "--log-file":
 			if i+1 < len(args) {
 				i++
@@ -230,6 +326,16 @@ func parseFlagsFromArgs(args []string) cliOptions {
 				i++
 				opts.theme = args[i]
 			}
+		case "--record":
+			if i+1 < len(args) {
+				i++
+				opts.recordFile = args[i]
+			}
+		case "--replay":
+			if i+1 < len(args) {
+				i++
+				opts.replayFile = args[i]
+			}
 		case "--compact":
 			t := true
 			opts.compactHeader = &t
@@ -260,6 +366,8 @@ func printUsage() {
 	fmt.Println("claws - A terminal UI for AWS resource management")
 	fmt.Println()
 	fmt.Println("Usage: claws [options]")
+	fmt.Println("       claws report --template <name> [options]  (see claws report --help)")
+	fmt.Println("       claws daemon [options]                    (see claws daemon --help)")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -p, --profile <name>[,name2,...]")
@@ -281,16 +389,28 @@ func printUsage() {
 	fmt.Println("        Useful for instance profiles, ECS task roles, Lambda, etc.")
 	fmt.Println("  -ro, --read-only")
 	fmt.Println("        Run in read-only mode (disable dangerous actions)")
+	fmt.Println("  --sandbox")
+	fmt.Println("        Re-assume the current role with the ReadOnlyAccess session policy")
+	fmt.Println("        so AWS rejects writes (implies --read-only)")
+	fmt.Println("  --sandbox-policy <path>")
+	fmt.Println("        Re-assume the current role with the session policy in <path>")
 	fmt.Println("  --autosave")
 	fmt.Println("        Enable saving region/profile/theme to config file")
 	fmt.Println("  --no-autosave")
 	fmt.Println("        Disable saving region/profile/theme to config file")
+	fmt.Println("  --daemon")
+	fmt.Println("        Use the warm clients and caches of a running `claws daemon`")
 	fmt.Println("  -c, --config <path>")
 	fmt.Println("        Use custom config file instead of ~/.config/claws/config.yaml")
 	fmt.Println("  -l, --log-file <path>")
 	fmt.Println("        Enable debug logging to specified file")
 	fmt.Println("  -t, --theme <name>")
 	fmt.Println("        Color theme: dark, light, nord, dracula, gruvbox, catppuccin")
+	fmt.Println("  --record <path>")
+	fmt.Println("        Record visited views to a sanitized file for sharing")
+	fmt.Println("        (secrets removed, account IDs and IP addresses masked)")
+	fmt.Println("  --replay <path>")
+	fmt.Println("        Step through a recorded session read-only (no AWS access)")
 	fmt.Println("  --compact")
 	fmt.Println("        Start with compact header mode (toggle with Ctrl+E)")
 	fmt.Println("  --no-compact")
@@ -312,10 +432,15 @@ func printUsage() {
 	fmt.Println("  claws -s ec2 --tag Role=bastion   Open EC2 instances filtered by tag Role=bastion")
 	fmt.Println("  claws -p dev,prod                 Query multiple profiles")
 	fmt.Println("  claws -r us-east-1,ap-northeast-1 Query multiple regions")
+	fmt.Println("  claws --sandbox -p prod           Browse prod with writes denied by AWS")
+	fmt.Println("  claws --record handoff.json       Record the session for a teammate")
+	fmt.Println("  claws --replay handoff.json       Step through a recorded session")
+	fmt.Println("  claws report -t cost-weekly -o report.md  Write the weekly cost report")
 	fmt.Println()
 	fmt.Println("Environment Variables:")
 	fmt.Println("  CLAWS_CONFIG=<path>      Use custom config file")
 	fmt.Println("  CLAWS_READ_ONLY=1|true   Enable read-only mode")
+	fmt.Println("  CLAWS_SANDBOX=1|true     Enable sandbox mode")
 	fmt.Println("  ALL_PROXY                Propagated to HTTP_PROXY/HTTPS_PROXY if not set")
 }
 
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"slices"
 	"testing"
 
@@ -149,6 +151,70 @@ func TestParseFlags_ConfigFile(t *testing.T) {
 	}
 }
 
+func TestParseFlags_RecordReplay(t *testing.T) {
+	opts := parseFlagsFromArgs([]string{"--record", "out.json", "-p", "dev"})
+	if opts.recordFile != "out.json" || opts.replayFile != "" {
+		t.Errorf("recordFile = %q, replayFile = %q", opts.recordFile, opts.replayFile)
+	}
+
+	opts = parseFlagsFromArgs([]string{"--replay", "in.json"})
+	if opts.replayFile != "in.json" || opts.recordFile != "" {
+		t.Errorf("recordFile = %q, replayFile = %q", opts.recordFile, opts.replayFile)
+	}
+}
+
+func TestParseFlags_Sandbox(t *testing.T) {
+	opts := parseFlagsFromArgs([]string{"--sandbox", "-p", "prod"})
+	if !opts.sandbox || opts.sandboxPolicy != "" {
+		t.Errorf("sandbox = %v, sandboxPolicy = %q", opts.sandbox, opts.sandboxPolicy)
+	}
+
+	opts = parseFlagsFromArgs([]string{"--sandbox-policy", "policy.json"})
+	if opts.sandbox || opts.sandboxPolicy != "policy.json" {
+		t.Errorf("sandbox = %v, sandboxPolicy = %q", opts.sandbox, opts.sandboxPolicy)
+	}
+}
+
+func TestParseFlags_Daemon(t *testing.T) {
+	if opts := parseFlagsFromArgs(nil); opts.daemon {
+		t.Error("daemon should be off by default")
+	}
+	if opts := parseFlagsFromArgs([]string{"--daemon"}); !opts.daemon {
+		t.Error("--daemon should enable the daemon")
+	}
+}
+
+func TestLoadSandboxPolicy(t *testing.T) {
+	if policy, err := loadSandboxPolicy(""); err != nil || policy != "" {
+		t.Errorf("loadSandboxPolicy(\"\") = %q, %v, want default", policy, err)
+	}
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	doc := "{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": []\n}\n"
+	if err := os.WriteFile(valid, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := loadSandboxPolicy(valid)
+	if err != nil {
+		t.Fatalf("loadSandboxPolicy() error = %v", err)
+	}
+	if want := `{"Version":"2012-10-17","Statement":[]}`; policy != want {
+		t.Errorf("loadSandboxPolicy() = %q, want compacted %q", policy, want)
+	}
+
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalid, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadSandboxPolicy(invalid); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+	if _, err := loadSandboxPolicy(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
 func TestParseFlags_EnvCreds(t *testing.T) {
 	tests := []struct {
 		name string
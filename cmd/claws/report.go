@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/report"
+)
+
+type reportOptions struct {
+	template   string
+	output     string
+	format     string
+	list       bool
+	help       bool
+	profiles   []string
+	regions    []string
+	envCreds   bool
+	configFile string
+	logFile    string
+}
+
+// parseReportArgs parses the arguments after `claws report` (testable)
+func parseReportArgs(args []string) (reportOptions, error) {
+	opts := reportOptions{}
+
+	for i := 0; i < len(args); i++ {
+		needsValue := func() (string, error) {
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("%s requires a value", args[i])
+			}
+			i++
+			return args[i], nil
+		}
+
+		var err error
+		switch args[i] {
+		case "-t", "--template":
+			opts.template, err = needsValue()
+		case "-o", "--output":
+			opts.output, err = needsValue()
+		case "--format":
+			opts.format, err = needsValue()
+		case "--list":
+			opts.list = true
+		case "-p", "--profile":
+			var v string
+			if v, err = needsValue(); err == nil {
+				for _, p := range strings.Split(v, ",") {
+					if p = strings.TrimSpace(p); p != "" && !slices.Contains(opts.profiles, p) {
+						opts.profiles = append(opts.profiles, p)
+					}
+				}
+			}
+		case "-r", "--region":
+			var v string
+			if v, err = needsValue(); err == nil {
+				for _, r := range strings.Split(v, ",") {
+					if r = strings.TrimSpace(r); r != "" && !slices.Contains(opts.regions, r) {
+						opts.regions = append(opts.regions, r)
+					}
+				}
+			}
+		case "-e", "--env":
+			opts.envCreds = true
+		case "-c", "--config":
+			opts.configFile, err = needsValue()
+		case "-l", "--log-file":
+			opts.logFile, err = needsValue()
+		case "-h", "--help":
+			opts.help = true
+		default:
+			err = fmt.Errorf("unknown option %q", args[i])
+		}
+		if err != nil {
+			return opts, err
+		}
+	}
+
+	if len(opts.profiles) > 1 {
+		return opts, fmt.Errorf("reports support a single profile, got %d", len(opts.profiles))
+	}
+	for _, r := range opts.regions {
+		if !config.IsValidRegion(r) {
+			return opts, fmt.Errorf("invalid region format: %s", r)
+		}
+	}
+	for _, p := range opts.profiles {
+		if !config.IsValidProfileName(p) {
+			return opts, fmt.Errorf("invalid profile name: %s", p)
+		}
+	}
+	return opts, nil
+}
+
+// runReport implements `claws report` and returns the process exit code
+func runReport(args []string) int {
+	opts, err := parseReportArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintln(os.Stderr, "Run 'claws report --help' for usage")
+		return 2
+	}
+	if opts.help {
+		printReportUsage()
+		return 0
+	}
+	if opts.list {
+		for _, t := range report.Templates() {
+			fmt.Printf("%-20s %s\n", t.Name, t.Description)
+		}
+		return 0
+	}
+
+	tmpl, ok := report.Lookup(opts.template)
+	if !ok {
+		if opts.template == "" {
+			fmt.Fprintln(os.Stderr, "Error: --template is required")
+		} else {
+			fmt.Fprintf(os.Stderr, "Error: unknown template %q\n", opts.template)
+		}
+		fmt.Fprintln(os.Stderr, "Run 'claws report --list' to see available templates")
+		return 2
+	}
+
+	format := report.FormatForPath(opts.output)
+	if opts.format != "" {
+		if format, err = report.ParseFormat(opts.format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+	}
+
+	if opts.configFile == "" {
+		opts.configFile = strings.TrimSpace(os.Getenv("CLAWS_CONFIG"))
+	}
+	if opts.configFile != "" {
+		if err := config.SetConfigPath(opts.configFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+	if opts.logFile != "" {
+		if err := log.EnableFile(opts.logFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not open log file %s: %v\n", opts.logFile, err)
+		}
+	}
+
+	propagateAllProxy()
+
+	fileCfg := config.File()
+	cfg := config.Global()
+	applyStartupConfig(cliOptions{profiles: opts.profiles, regions: opts.regions, envCreds: opts.envCreds}, fileCfg, cfg)
+	if sels := cfg.Selections(); len(sels) > 1 {
+		// Saved startup profiles may list several; reports cover one account
+		cfg.SetSelections(sels[:1])
+	}
+
+	ctx := context.Background()
+	connectDaemon(ctx)
+	initCtx, cancel := context.WithTimeout(ctx, fileCfg.AWSInitTimeout())
+	err = aws.InitContext(initCtx)
+	cancel()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	rep := report.Generate(ctx, registry.Global, tmpl)
+
+	var w io.Writer = os.Stdout
+	if opts.output != "" && opts.output != "-" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := report.Write(w, rep, format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	for _, s := range rep.Sections {
+		if s.Err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: section %q failed: %v\n", s.Title, s.Err)
+		}
+	}
+	if rep.Failed() {
+		return 1
+	}
+	return 0
+}
+
+func printReportUsage() {
+	fmt.Println("claws report - Render a predefined report to markdown or HTML")
+	fmt.Println()
+	fmt.Println("Usage: claws report --template <name> [options]")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -t, --template <name>")
+	fmt.Println("        Report template (see --list)")
+	fmt.Println("  -o, --output <path>")
+	fmt.Println("        Write to a file instead of stdout")
+	fmt.Println("  --format <md|html>")
+	fmt.Println("        Output format (default: from --output extension, else md)")
+	fmt.Println("  --list")
+	fmt.Println("        List available templates")
+	fmt.Println("  -p, --profile <name>")
+	fmt.Println("        AWS profile to use")
+	fmt.Println("  -r, --region <region>[,region2,...]")
+	fmt.Println("        AWS region(s) to include")
+	fmt.Println("  -e, --env")
+	fmt.Println("        Use environment credentials (ignore ~/.aws config)")
+	fmt.Println("  -c, --config <path>")
+	fmt.Println("        Use custom config file instead of ~/.config/claws/config.yaml")
+	fmt.Println("  -l, --log-file <path>")
+	fmt.Println("        Enable debug logging to specified file")
+	fmt.Println("  -h, --help")
+	fmt.Println("        Show this help message")
+	fmt.Println()
+	fmt.Println("Templates:")
+	for _, t := range report.Templates() {
+		fmt.Printf("  %-20s %s\n", t.Name, t.Description)
+	}
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  claws report -t cost-weekly -o report.md")
+	fmt.Println("  claws report -t security-findings -p prod -r us-east-1,eu-west-1 -o findings.html")
+	fmt.Println()
+	fmt.Println("Exits 1 if every section failed; failed sections are noted in the report.")
+}
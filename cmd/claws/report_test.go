@@ -0,0 +1,70 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/report"
+)
+
+func TestParseReportArgs(t *testing.T) {
+	opts, err := parseReportArgs([]string{"-t", "cost-weekly", "-o", "out.html", "-p", "prod", "-r", "us-east-1,eu-west-1", "--format", "md"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.template != "cost-weekly" || opts.output != "out.html" || opts.format != "md" {
+		t.Errorf("opts = %+v", opts)
+	}
+	if !slices.Equal(opts.profiles, []string{"prod"}) {
+		t.Errorf("profiles = %v", opts.profiles)
+	}
+	if !slices.Equal(opts.regions, []string{"us-east-1", "eu-west-1"}) {
+		t.Errorf("regions = %v", opts.regions)
+	}
+}
+
+func TestParseReportArgs_Errors(t *testing.T) {
+	tests := map[string][]string{
+		"missing value":     {"--template"},
+		"unknown flag":      {"--bogus"},
+		"multiple profiles": {"-p", "dev,prod"},
+		"invalid region":    {"-r", "nowhere"},
+	}
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseReportArgs(args); err == nil {
+				t.Errorf("parseReportArgs(%v) succeeded, want error", args)
+			}
+		})
+	}
+}
+
+func TestReportTemplatesUseRegisteredResources(t *testing.T) {
+	for _, tmpl := range report.Templates() {
+		for _, s := range tmpl.Sections {
+			if s.Fetch != nil {
+				continue
+			}
+			renderer, err := registry.Global.GetRenderer(s.Service, s.Resource)
+			if err != nil {
+				t.Errorf("%s/%s: %v", tmpl.Name, s.Title, err)
+				continue
+			}
+			var names []string
+			for _, c := range renderer.Columns() {
+				names = append(names, c.Name)
+			}
+			for _, col := range s.Columns {
+				if !slices.Contains(names, col) {
+					t.Errorf("%s/%s: column %q not in %s/%s renderer", tmpl.Name, s.Title, col, s.Service, s.Resource)
+				}
+			}
+			if s.Parent != nil {
+				if _, ok := registry.Global.Get(s.Parent.Service, s.Parent.Resource); !ok {
+					t.Errorf("%s/%s: parent %s/%s not registered", tmpl.Name, s.Title, s.Parent.Service, s.Parent.Resource)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an Account Management client configured for the current
+// context. The API is global, so the current region only selects the endpoint.
+func GetClient(ctx context.Context) (*account.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return account.NewFromConfig(cfg), nil
+}
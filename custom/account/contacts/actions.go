@@ -0,0 +1,206 @@
+package contacts
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	accountClient "github.com/clawscli/claws/custom/account"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("account", "contacts", []action.Action{
+		{
+			Name:      "Edit Contact",
+			Shortcut:  "e",
+			Type:      action.ActionTypeAPI,
+			Operation: "PutAlternateContact",
+			Confirm:   action.ConfirmSimple,
+			Filter:    isAlternate,
+			Prompts: []action.Prompt{
+				{Label: "Name", Default: func(r dao.Resource) string { return r.GetName() }, Validate: maxLength("name", 64)},
+				{Label: "Title", Default: func(r dao.Resource) string { return contactField(r, (*ContactResource).Title) }, Validate: maxLength("title", 50)},
+				{Label: "Email address", Default: func(r dao.Resource) string { return contactField(r, (*ContactResource).Email) }, Validate: validateEmail},
+				{Label: "Phone number", Default: func(r dao.Resource) string { return contactField(r, (*ContactResource).Phone) }, Validate: validatePhone},
+			},
+			Preview: previewContactEdit,
+		},
+		{
+			Name:      "Delete Contact",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteAlternateContact",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				c, ok := r.(*ContactResource)
+				return ok && c.IsAlternate() && c.IsSet()
+			},
+		},
+	})
+
+	action.RegisterExecutor("account", "contacts", executeContactAction)
+}
+
+func executeContactAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "PutAlternateContact":
+		return executePutAlternateContact(ctx, resource, act.Inputs)
+	case "DeleteAlternateContact":
+		return executeDeleteAlternateContact(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// isAlternate limits edits to alternate contacts; the primary contact holds
+// the billing address and is edited in the console
+func isAlternate(r dao.Resource) bool {
+	c, ok := r.(*ContactResource)
+	return ok && c.IsAlternate()
+}
+
+func contactField(r dao.Resource, get func(*ContactResource) string) string {
+	if c, ok := r.(*ContactResource); ok {
+		return get(c)
+	}
+	return ""
+}
+
+// maxLength validates a required value of at most n characters
+func maxLength(label string, n int) func(string) error {
+	return func(value string) error {
+		if l := len(strings.TrimSpace(value)); l == 0 || l > n {
+			return fmt.Errorf("%s must be 1-%d characters", label, n)
+		}
+		return nil
+	}
+}
+
+func validateEmail(value string) error {
+	if _, err := mail.ParseAddress(value); err != nil {
+		return fmt.Errorf("invalid email address")
+	}
+	return nil
+}
+
+// validatePhone checks the format accepted by PutAlternateContact
+func validatePhone(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" || len(value) > 25 || strings.Trim(value, "0123456789+-() ") != "" {
+		return fmt.Errorf("phone number may only contain digits, spaces, +, -, ( and )")
+	}
+	return nil
+}
+
+// contactChange is a single before/after value in a contact edit
+type contactChange struct {
+	Label string
+	Old   string
+	New   string
+}
+
+// planContactEdit compares the prompted values with the current contact.
+// Inputs: name, title, email, phone.
+func planContactEdit(c *ContactResource, inputs []string) ([]contactChange, error) {
+	if len(inputs) != 4 {
+		return nil, action.ErrMissingInput
+	}
+
+	current := []string{c.GetName(), c.Title(), c.Email(), c.Phone()}
+	var changes []contactChange
+	for i, label := range []string{"Name", "Title", "Email", "Phone"} {
+		if value := strings.TrimSpace(inputs[i]); value != current[i] {
+			old := current[i]
+			if old == "" {
+				old = "-"
+			}
+			changes = append(changes, contactChange{Label: label, Old: old, New: value})
+		}
+	}
+	return changes, nil
+}
+
+// previewContactEdit renders the before/after diff shown in the confirmation
+func previewContactEdit(resource dao.Resource, inputs []string) string {
+	c, ok := resource.(*ContactResource)
+	if !ok {
+		return ""
+	}
+	changes, err := planContactEdit(c, inputs)
+	if err != nil {
+		return "Error: " + err.Error()
+	}
+	if len(changes) == 0 {
+		return "No changes"
+	}
+
+	lines := make([]string, len(changes))
+	for i, ch := range changes {
+		lines[i] = fmt.Sprintf("%s: %s → %s", ch.Label, ch.Old, ch.New)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func executePutAlternateContact(ctx context.Context, resource dao.Resource, inputs []string) action.ActionResult {
+	c, ok := resource.(*ContactResource)
+	if !ok || !c.IsAlternate() {
+		return action.InvalidResourceResult()
+	}
+
+	changes, err := planContactEdit(c, inputs)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if len(changes) == 0 {
+		return action.SuccessResult(fmt.Sprintf("No changes to the %s contact", strings.ToLower(c.ContactType)))
+	}
+
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := strings.TrimSpace(inputs[0])
+	title := strings.TrimSpace(inputs[1])
+	email := strings.TrimSpace(inputs[2])
+	phone := strings.TrimSpace(inputs[3])
+	_, err = client.PutAlternateContact(ctx, &account.PutAlternateContactInput{
+		AlternateContactType: types.AlternateContactType(c.ContactType),
+		Name:                 &name,
+		Title:                &title,
+		EmailAddress:         &email,
+		PhoneNumber:          &phone,
+	})
+	if err != nil {
+		return action.FailResultf(err, "put %s alternate contact", strings.ToLower(c.ContactType))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Updated the %s contact", strings.ToLower(c.ContactType)))
+}
+
+func executeDeleteAlternateContact(ctx context.Context, resource dao.Resource) action.ActionResult {
+	c, ok := resource.(*ContactResource)
+	if !ok || !c.IsAlternate() {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.DeleteAlternateContact(ctx, &account.DeleteAlternateContactInput{
+		AlternateContactType: types.AlternateContactType(c.ContactType),
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete %s alternate contact", strings.ToLower(c.ContactType))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted the %s contact", strings.ToLower(c.ContactType)))
+}
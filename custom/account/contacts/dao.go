@@ -0,0 +1,190 @@
+package contacts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	accountClient "github.com/clawscli/claws/custom/account"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ContactTypePrimary identifies the account's primary contact row
+const ContactTypePrimary = "PRIMARY"
+
+// alternateContactTypes are listed after the primary contact, in this order
+var alternateContactTypes = []types.AlternateContactType{
+	types.AlternateContactTypeBilling,
+	types.AlternateContactTypeOperations,
+	types.AlternateContactTypeSecurity,
+}
+
+// ContactDAO provides data access for the account's primary and alternate contacts
+type ContactDAO struct {
+	dao.BaseDAO
+	client *account.Client
+}
+
+// NewContactDAO creates a new ContactDAO
+func NewContactDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ContactDAO{
+		BaseDAO: dao.NewBaseDAO("account", "contacts"),
+		client:  client,
+	}, nil
+}
+
+// List returns the primary contact followed by the billing, operations and
+// security contacts. Alternate contacts that are not set are still listed so
+// they can be filled in.
+func (d *ContactDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	primary, err := d.getPrimary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []dao.Resource{primary}
+	for _, t := range alternateContactTypes {
+		contact, err := d.getAlternate(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, contact)
+	}
+	return resources, nil
+}
+
+// Get returns a contact by type (PRIMARY, BILLING, OPERATIONS or SECURITY)
+func (d *ContactDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	if id == ContactTypePrimary {
+		return d.getPrimary(ctx)
+	}
+	for _, t := range alternateContactTypes {
+		if string(t) == id {
+			return d.getAlternate(ctx, t)
+		}
+	}
+	return nil, fmt.Errorf("unknown contact type: %s", id)
+}
+
+func (d *ContactDAO) getPrimary(ctx context.Context) (*ContactResource, error) {
+	output, err := d.client.GetContactInformation(ctx, &account.GetContactInformationInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get contact information")
+	}
+	return NewPrimaryContactResource(output.ContactInformation), nil
+}
+
+func (d *ContactDAO) getAlternate(ctx context.Context, t types.AlternateContactType) (*ContactResource, error) {
+	output, err := d.client.GetAlternateContact(ctx, &account.GetAlternateContactInput{
+		AlternateContactType: t,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return NewAlternateContactResource(t, nil), nil
+		}
+		return nil, apperrors.Wrapf(err, "get %s alternate contact", strings.ToLower(string(t)))
+	}
+	return NewAlternateContactResource(t, output.AlternateContact), nil
+}
+
+func (d *ContactDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for contacts")
+}
+
+// Supports returns supported operations
+func (d *ContactDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// ContactResource wraps the primary contact or one alternate contact.
+// Exactly one of Primary and Alternate is set, unless the alternate contact
+// has not been configured.
+type ContactResource struct {
+	dao.BaseResource
+	ContactType string
+	Primary     *types.ContactInformation
+	Alternate   *types.AlternateContact
+}
+
+// NewPrimaryContactResource creates a ContactResource for the primary contact
+func NewPrimaryContactResource(info *types.ContactInformation) *ContactResource {
+	r := &ContactResource{
+		BaseResource: dao.BaseResource{
+			ID:   ContactTypePrimary,
+			Data: info,
+		},
+		ContactType: ContactTypePrimary,
+		Primary:     info,
+	}
+	if info != nil {
+		r.Name = appaws.Str(info.FullName)
+	}
+	return r
+}
+
+// NewAlternateContactResource creates a ContactResource for an alternate
+// contact. contact is nil when the contact is not set.
+func NewAlternateContactResource(t types.AlternateContactType, contact *types.AlternateContact) *ContactResource {
+	r := &ContactResource{
+		BaseResource: dao.BaseResource{
+			ID:   string(t),
+			Data: contact,
+		},
+		ContactType: string(t),
+		Alternate:   contact,
+	}
+	if contact != nil {
+		r.Name = appaws.Str(contact.Name)
+	}
+	return r
+}
+
+// IsAlternate reports whether this is an alternate (billing, operations or security) contact
+func (r *ContactResource) IsAlternate() bool {
+	return r.ContactType != ContactTypePrimary
+}
+
+// IsSet reports whether the contact has been configured
+func (r *ContactResource) IsSet() bool {
+	return r.Primary != nil || r.Alternate != nil
+}
+
+// Title returns the contact's title (alternate contacts) or company (primary contact)
+func (r *ContactResource) Title() string {
+	switch {
+	case r.Alternate != nil:
+		return appaws.Str(r.Alternate.Title)
+	case r.Primary != nil:
+		return appaws.Str(r.Primary.CompanyName)
+	}
+	return ""
+}
+
+// Email returns the alternate contact's email address. The primary contact
+// has no email in the contact information; it is the root user's address.
+func (r *ContactResource) Email() string {
+	if r.Alternate != nil {
+		return appaws.Str(r.Alternate.EmailAddress)
+	}
+	return ""
+}
+
+// Phone returns the contact's phone number
+func (r *ContactResource) Phone() string {
+	switch {
+	case r.Alternate != nil:
+		return appaws.Str(r.Alternate.PhoneNumber)
+	case r.Primary != nil:
+		return appaws.Str(r.Primary.PhoneNumber)
+	}
+	return ""
+}
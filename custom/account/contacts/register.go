@@ -0,0 +1,20 @@
+package contacts
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("account", "contacts", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewContactDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewContactRenderer()
+		},
+	})
+}
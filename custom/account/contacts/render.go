@@ -0,0 +1,138 @@
+package contacts
+
+import (
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// notSet is shown for alternate contacts that have not been configured
+const notSet = "(not set)"
+
+// ContactRenderer renders account contacts
+type ContactRenderer struct {
+	render.BaseRenderer
+}
+
+// NewContactRenderer creates a new ContactRenderer
+func NewContactRenderer() render.Renderer {
+	return &ContactRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "account",
+			Resource: "contacts",
+			Cols: []render.Column{
+				{Name: "TYPE", Width: 12, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "NAME", Width: 28, Getter: getName, Priority: 1},
+				{Name: "TITLE", Width: 24, Getter: getTitle, Priority: 4},
+				{Name: "EMAIL", Width: 32, Getter: getEmail, Priority: 2},
+				{Name: "PHONE", Width: 18, Getter: getPhone, Priority: 3},
+			},
+		},
+	}
+}
+
+func getName(r dao.Resource) string {
+	if c, ok := r.(*ContactResource); ok && !c.IsSet() {
+		return notSet
+	}
+	return r.GetName()
+}
+
+func getTitle(r dao.Resource) string {
+	if c, ok := r.(*ContactResource); ok {
+		return c.Title()
+	}
+	return ""
+}
+
+func getEmail(r dao.Resource) string {
+	if c, ok := r.(*ContactResource); ok {
+		return c.Email()
+	}
+	return ""
+}
+
+func getPhone(r dao.Resource) string {
+	if c, ok := r.(*ContactResource); ok {
+		return c.Phone()
+	}
+	return ""
+}
+
+// RenderDetail renders the contact's details (the mailing address for the primary contact)
+func (r *ContactRenderer) RenderDetail(resource dao.Resource) string {
+	c, ok := resource.(*ContactResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Account Contact", c.ContactType)
+
+	d.Section("Contact")
+	d.Field("Type", c.ContactType)
+	if !c.IsSet() {
+		d.FieldStyled("Status", "Not set", ui.WarningStyle())
+		return d.String()
+	}
+
+	if p := c.Primary; p != nil {
+		d.FieldIf("Full Name", p.FullName)
+		d.FieldIf("Company", p.CompanyName)
+		d.FieldIf("Phone", p.PhoneNumber)
+		d.FieldIf("Website", p.WebsiteUrl)
+
+		d.Section("Address")
+		var lines []string
+		for _, line := range []*string{p.AddressLine1, p.AddressLine2, p.AddressLine3} {
+			if line != nil && *line != "" {
+				lines = append(lines, *line)
+			}
+		}
+		d.Field("Street", strings.Join(lines, ", "))
+		d.FieldIf("City", p.City)
+		d.FieldIf("District", p.DistrictOrCounty)
+		d.FieldIf("State / Region", p.StateOrRegion)
+		d.FieldIf("Postal Code", p.PostalCode)
+		d.FieldIf("Country", p.CountryCode)
+	}
+
+	if a := c.Alternate; a != nil {
+		d.FieldIf("Name", a.Name)
+		d.FieldIf("Title", a.Title)
+		d.FieldIf("Email", a.EmailAddress)
+		d.FieldIf("Phone", a.PhoneNumber)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ContactRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	c, ok := resource.(*ContactResource)
+	if !ok {
+		return nil
+	}
+
+	if !c.IsSet() {
+		return []render.SummaryField{
+			{Label: "Type", Value: c.ContactType},
+			{Label: "Status", Value: "Not set", Style: ui.WarningStyle()},
+		}
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Type", Value: c.ContactType},
+		{Label: "Name", Value: c.GetName()},
+	}
+	if email := c.Email(); email != "" {
+		fields = append(fields, render.SummaryField{Label: "Email", Value: email})
+	}
+	if phone := c.Phone(); phone != "" {
+		fields = append(fields, render.SummaryField{Label: "Phone", Value: phone})
+	}
+	return fields
+}
@@ -0,0 +1,77 @@
+package contacts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+)
+
+func TestContactResource(t *testing.T) {
+	primary := NewPrimaryContactResource(&types.ContactInformation{
+		FullName:    aws.String("Jane Doe"),
+		CompanyName: aws.String("Example Corp"),
+		PhoneNumber: aws.String("+1-555-0100"),
+	})
+	if primary.IsAlternate() || !primary.IsSet() {
+		t.Errorf("primary: IsAlternate=%v IsSet=%v", primary.IsAlternate(), primary.IsSet())
+	}
+	if isAlternate(primary) {
+		t.Error("edit action should not be offered for the primary contact")
+	}
+	if primary.Title() != "Example Corp" || primary.Phone() != "+1-555-0100" || primary.Email() != "" {
+		t.Errorf("primary fields = %q %q %q", primary.Title(), primary.Phone(), primary.Email())
+	}
+
+	unset := NewAlternateContactResource(types.AlternateContactTypeSecurity, nil)
+	if !unset.IsAlternate() || unset.IsSet() {
+		t.Errorf("unset: IsAlternate=%v IsSet=%v", unset.IsAlternate(), unset.IsSet())
+	}
+	if unset.GetID() != "SECURITY" || getName(unset) != notSet {
+		t.Errorf("unset: ID=%q name=%q", unset.GetID(), getName(unset))
+	}
+}
+
+func TestPlanContactEdit(t *testing.T) {
+	c := NewAlternateContactResource(types.AlternateContactTypeBilling, &types.AlternateContact{
+		AlternateContactType: types.AlternateContactTypeBilling,
+		Name:                 aws.String("Ann"),
+		Title:                aws.String("Finance"),
+		EmailAddress:         aws.String("ann@example.com"),
+		PhoneNumber:          aws.String("+1 555 0101"),
+	})
+
+	changes, err := planContactEdit(c, []string{"Ann", "CFO", "ann@example.com", " +1 555 0101 "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Label != "Title" || changes[0].Old != "Finance" || changes[0].New != "CFO" {
+		t.Errorf("changes = %+v, want only the title", changes)
+	}
+
+	unset := NewAlternateContactResource(types.AlternateContactTypeSecurity, nil)
+	changes, err = planContactEdit(unset, []string{"Sec", "CISO", "sec@example.com", "+1 555 0102"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 4 || changes[0].Old != "-" {
+		t.Errorf("changes = %+v, want all four fields set from -", changes)
+	}
+
+	if _, err := planContactEdit(c, []string{"Ann"}); err == nil {
+		t.Error("expected error for missing inputs")
+	}
+}
+
+func TestValidatePhone(t *testing.T) {
+	for _, v := range []string{"+1 (555) 010-0100", "0312345678"} {
+		if err := validatePhone(v); err != nil {
+			t.Errorf("validatePhone(%q) = %v", v, err)
+		}
+	}
+	for _, v := range []string{"", "ext. 12", "+1 555 0100 0100 0100 0100 0100"} {
+		if err := validatePhone(v); err == nil {
+			t.Errorf("validatePhone(%q) = nil, want error", v)
+		}
+	}
+}
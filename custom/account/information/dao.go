@@ -0,0 +1,137 @@
+package information
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	accountClient "github.com/clawscli/claws/custom/account"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// InformationDAO provides data access for the current account's attributes
+type InformationDAO struct {
+	dao.BaseDAO
+	client *account.Client
+}
+
+// NewInformationDAO creates a new InformationDAO
+func NewInformationDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &InformationDAO{
+		BaseDAO: dao.NewBaseDAO("account", "information"),
+		client:  client,
+	}, nil
+}
+
+// List returns a single row for the current account
+func (d *InformationDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	info, err := d.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []dao.Resource{info}, nil
+}
+
+func (d *InformationDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return d.get(ctx)
+}
+
+func (d *InformationDAO) get(ctx context.Context) (*InformationResource, error) {
+	output, err := d.client.GetAccountInformation(ctx, &account.GetAccountInformationInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get account information")
+	}
+
+	regions, err := appaws.Paginate(ctx, func(token *string) ([]types.Region, *string, error) {
+		out, err := d.client.ListRegions(ctx, &account.ListRegionsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list regions")
+		}
+		return out.Regions, out.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewInformationResource(output, regions), nil
+}
+
+func (d *InformationDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for account information")
+}
+
+// Supports returns supported operations
+func (d *InformationDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// InformationResource wraps the account's name, creation date and regions
+type InformationResource struct {
+	dao.BaseResource
+	Item    *account.GetAccountInformationOutput
+	Regions []types.Region
+}
+
+// NewInformationResource creates a new InformationResource
+func NewInformationResource(info *account.GetAccountInformationOutput, regions []types.Region) *InformationResource {
+	return &InformationResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(info.AccountId),
+			Name: appaws.Str(info.AccountName),
+			Data: info,
+		},
+		Item:    info,
+		Regions: regions,
+	}
+}
+
+// EnabledRegions returns the names of the regions enabled for the account,
+// including regions enabled by default
+func (r *InformationResource) EnabledRegions() []string {
+	var names []string
+	for _, region := range r.Regions {
+		switch region.RegionOptStatus {
+		case types.RegionOptStatusEnabled, types.RegionOptStatusEnabledByDefault:
+			names = append(names, appaws.Str(region.RegionName))
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// OptInRegions returns the opt-in regions that are enabled (not enabled by default)
+func (r *InformationResource) OptInRegions() []string {
+	var names []string
+	for _, region := range r.Regions {
+		if region.RegionOptStatus == types.RegionOptStatusEnabled {
+			names = append(names, appaws.Str(region.RegionName))
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// PendingRegions returns regions that are being enabled or disabled, with
+// their status (e.g. "ap-east-2 (ENABLING)")
+func (r *InformationResource) PendingRegions() []string {
+	var pending []string
+	for _, region := range r.Regions {
+		switch region.RegionOptStatus {
+		case types.RegionOptStatusEnabling, types.RegionOptStatusDisabling:
+			pending = append(pending, fmt.Sprintf("%s (%s)", appaws.Str(region.RegionName), region.RegionOptStatus))
+		}
+	}
+	slices.Sort(pending)
+	return pending
+}
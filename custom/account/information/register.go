@@ -0,0 +1,20 @@
+package information
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("account", "information", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewInformationDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewInformationRenderer()
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package information
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure InformationRenderer implements render.Navigator
+var _ render.Navigator = (*InformationRenderer)(nil)
+
+// InformationRenderer renders the current account's attributes
+type InformationRenderer struct {
+	render.BaseRenderer
+}
+
+// NewInformationRenderer creates a new InformationRenderer
+func NewInformationRenderer() render.Renderer {
+	return &InformationRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "account",
+			Resource: "information",
+			Cols: []render.Column{
+				{Name: "ACCOUNT ID", Width: 14, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 1},
+				{Name: "REGIONS", Width: 8, Getter: getRegionCount, Priority: 2},
+				{Name: "OPT-IN", Width: 8, Getter: getOptInCount, Priority: 3},
+				{Name: "CREATED", Width: 12, Getter: getCreated, Priority: 4},
+			},
+		},
+	}
+}
+
+func getRegionCount(r dao.Resource) string {
+	if info, ok := r.(*InformationResource); ok {
+		return fmt.Sprintf("%d", len(info.EnabledRegions()))
+	}
+	return ""
+}
+
+func getOptInCount(r dao.Resource) string {
+	if info, ok := r.(*InformationResource); ok {
+		return fmt.Sprintf("%d", len(info.OptInRegions()))
+	}
+	return ""
+}
+
+func getCreated(r dao.Resource) string {
+	if info, ok := r.(*InformationResource); ok && info.Item.AccountCreatedDate != nil {
+		return info.Item.AccountCreatedDate.Format("2006-01-02")
+	}
+	return ""
+}
+
+// RenderDetail renders the account's attributes and enabled regions
+func (r *InformationRenderer) RenderDetail(resource dao.Resource) string {
+	info, ok := resource.(*InformationResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Account", info.GetName())
+
+	d.Section("Account Attributes")
+	d.Field("Account ID", info.GetID())
+	d.Field("Account Name", info.GetName())
+	if info.Item.AccountCreatedDate != nil {
+		d.Field("Created", info.Item.AccountCreatedDate.Format("2006-01-02 15:04:05"))
+		d.Field("Age", render.FormatAge(*info.Item.AccountCreatedDate))
+	}
+
+	d.Section("Regions")
+	enabled := info.EnabledRegions()
+	d.Field("Enabled", fmt.Sprintf("%d", len(enabled)))
+	if optIn := info.OptInRegions(); len(optIn) > 0 {
+		d.Field("Opt-in Enabled", strings.Join(optIn, ", "))
+	} else {
+		d.Field("Opt-in Enabled", "None")
+	}
+	if pending := info.PendingRegions(); len(pending) > 0 {
+		d.Field("Pending", strings.Join(pending, ", "))
+	}
+	d.Field("Enabled Regions", strings.Join(enabled, ", "))
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *InformationRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	info, ok := resource.(*InformationResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.SummaryField{
+		{Label: "Account ID", Value: info.GetID()},
+		{Label: "Name", Value: info.GetName()},
+		{Label: "Regions", Value: fmt.Sprintf("%d enabled (%d opt-in)", len(info.EnabledRegions()), len(info.OptInRegions()))},
+	}
+}
+
+// Navigations links to the contacts and to the region list, where opt-in
+// regions can be enabled or disabled
+func (r *InformationRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	if _, ok := resource.(*InformationResource); !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "c", Label: "Contacts", Service: "account", Resource: "contacts"},
+		{Key: "r", Label: "Regions", Service: "ec2", Resource: "regions"},
+	}
+}
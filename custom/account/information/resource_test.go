@@ -0,0 +1,35 @@
+package information
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+)
+
+func TestInformationRegions(t *testing.T) {
+	info := NewInformationResource(&account.GetAccountInformationOutput{
+		AccountId:   aws.String("123456789012"),
+		AccountName: aws.String("prod"),
+	}, []types.Region{
+		{RegionName: aws.String("us-east-1"), RegionOptStatus: types.RegionOptStatusEnabledByDefault},
+		{RegionName: aws.String("ap-east-1"), RegionOptStatus: types.RegionOptStatusEnabled},
+		{RegionName: aws.String("me-south-1"), RegionOptStatus: types.RegionOptStatusDisabled},
+		{RegionName: aws.String("af-south-1"), RegionOptStatus: types.RegionOptStatusEnabling},
+	})
+
+	if info.GetID() != "123456789012" || info.GetName() != "prod" {
+		t.Errorf("unexpected ID %q / name %q", info.GetID(), info.GetName())
+	}
+	if got, want := info.EnabledRegions(), []string{"ap-east-1", "us-east-1"}; !slices.Equal(got, want) {
+		t.Errorf("EnabledRegions() = %v, want %v", got, want)
+	}
+	if got, want := info.OptInRegions(), []string{"ap-east-1"}; !slices.Equal(got, want) {
+		t.Errorf("OptInRegions() = %v, want %v", got, want)
+	}
+	if got, want := info.PendingRegions(), []string{"af-south-1 (ENABLING)"}; !slices.Equal(got, want) {
+		t.Errorf("PendingRegions() = %v, want %v", got, want)
+	}
+}
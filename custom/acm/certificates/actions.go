@@ -0,0 +1,97 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	acmClient "github.com/clawscli/claws/custom/acm"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("acm", "certificates", []action.Action{
+		{
+			Name:       "Request Certificate",
+			Shortcut:   "r",
+			Type:       action.ActionTypeAPI,
+			Operation:  "RequestCertificate",
+			Confirm:    action.ConfirmSimple,
+			Standalone: true,
+			Prompts:    acmClient.RequestPrompts(certificateDomain, certificateSANs),
+		},
+		{
+			Name:      "Create DNS Validation Records",
+			Shortcut:  "v",
+			Type:      action.ActionTypeAPI,
+			Operation: "CreateValidationRecords",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				cert, ok := r.(*CertificateResource)
+				return ok && cert.Status() == string(types.CertificateStatusPendingValidation)
+			},
+		},
+	})
+
+	action.RegisterExecutor("acm", "certificates", executeCertificateAction)
+}
+
+func executeCertificateAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RequestCertificate":
+		return acmClient.ExecuteRequestCertificate(ctx, act)
+	case "CreateValidationRecords":
+		return executeCreateValidationRecords(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// certificateDomain pre-fills a new request with the selected certificate's domain
+func certificateDomain(r dao.Resource) string {
+	if cert, ok := r.(*CertificateResource); ok {
+		return cert.DomainName()
+	}
+	return ""
+}
+
+// certificateSANs pre-fills a new request with the selected certificate's other names
+func certificateSANs(r dao.Resource) string {
+	cert, ok := r.(*CertificateResource)
+	if !ok {
+		return ""
+	}
+	return strings.Join(acmClient.ParseSANs(strings.Join(cert.SubjectAlternativeNames(), ","), cert.DomainName()), ", ")
+}
+
+func executeCreateValidationRecords(ctx context.Context, resource dao.Resource) action.ActionResult {
+	cert, ok := resource.(*CertificateResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := acmClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	created, skipped, err := acmClient.CreateValidationRecords(ctx, client, route53.NewFromConfig(cfg), cert.GetARN())
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	msg := fmt.Sprintf("Created %d validation record(s) for %s", len(created), cert.DomainName())
+	if len(skipped) > 0 {
+		msg += fmt.Sprintf("; no Route53 zone for %s", strings.Join(skipped, ", "))
+	}
+	return action.SuccessResult(msg)
+}
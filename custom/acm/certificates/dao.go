@@ -2,6 +2,7 @@ package certificates
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
@@ -45,16 +46,53 @@ func (d *CertificateDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		return nil, err
 	}
 
+	// Filter by domain (for navigation from route53/hosted-zones), by ARN
+	// (to follow a newly requested certificate), or by issuing private CA
+	// (for navigation from acmpca/certificate-authorities)
+	domain := dao.GetFilterFromContext(ctx, "DomainName")
+	certArn := dao.GetFilterFromContext(ctx, "CertificateArn")
+	if caArn := dao.GetFilterFromContext(ctx, "CertificateAuthorityArn"); caArn != "" {
+		return d.listIssuedBy(ctx, summaries, caArn)
+	}
+
 	// CertificateSummary contains all fields needed for list view
 	// No need for N+1 DescribeCertificate calls
 	resources := make([]dao.Resource, 0, len(summaries))
 	for _, cert := range summaries {
-		resources = append(resources, NewCertificateResourceFromSummary(cert))
+		r := NewCertificateResourceFromSummary(cert)
+		if domain != "" && !r.CoversDomain(domain) {
+			continue
+		}
+		if certArn != "" && r.GetARN() != certArn {
+			continue
+		}
+		resources = append(resources, r)
 	}
 
 	return resources, nil
 }
 
+// listIssuedBy returns the private certificates issued by caArn. Summaries do
+// not name the issuing CA, so only private certificates are described.
+func (d *CertificateDAO) listIssuedBy(ctx context.Context, summaries []types.CertificateSummary, caArn string) ([]dao.Resource, error) {
+	var resources []dao.Resource
+	for _, cert := range summaries {
+		if cert.Type != types.CertificateTypePrivate {
+			continue
+		}
+		output, err := d.client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+			CertificateArn: cert.CertificateArn,
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe certificate %s", appaws.Str(cert.CertificateArn))
+		}
+		if appaws.Str(output.Certificate.CertificateAuthorityArn) == caArn {
+			resources = append(resources, NewCertificateResource(output.Certificate))
+		}
+	}
+	return resources, nil
+}
+
 func (d *CertificateDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	input := &acm.DescribeCertificateInput{
 		CertificateArn: &id,
@@ -143,6 +181,20 @@ func (r *CertificateResource) DomainName() string {
 	return ""
 }
 
+// CoversDomain returns true if the certificate's domain or any SAN is the given
+// domain or one of its subdomains (including wildcards).
+func (r *CertificateResource) CoversDomain(domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	names := append([]string{r.DomainName()}, r.SubjectAlternativeNames()...)
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimPrefix(name, "*."))
+		if name == domain || strings.HasSuffix(name, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
 // Status returns the certificate status
 func (r *CertificateResource) Status() string {
 	if r.Item != nil {
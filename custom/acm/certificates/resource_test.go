@@ -192,3 +192,31 @@ func TestCertificateResource_NilFields(t *testing.T) {
 		t.Errorf("InUseBy() = %v, want nil", resource.InUseBy())
 	}
 }
+
+func TestCertificateResource_CoversDomain(t *testing.T) {
+	resource := NewCertificateResourceFromSummary(types.CertificateSummary{
+		CertificateArn:                  aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc123"),
+		DomainName:                      aws.String("app.example.com"),
+		SubjectAlternativeNameSummaries: []string{"app.example.com", "*.api.example.net"},
+	})
+
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{"example.com", true},
+		{"app.example.com", true},
+		{"example.net.", true},
+		{"api.example.net", true},
+		{"other.com", false},
+		{"ample.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			if got := resource.CoversDomain(tt.domain); got != tt.want {
+				t.Errorf("CoversDomain(%q) = %v, want %v", tt.domain, got, tt.want)
+			}
+		})
+	}
+}
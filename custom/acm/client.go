@@ -0,0 +1,18 @@
+package acm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an ACM client configured for the current context
+func GetClient(ctx context.Context) (*acm.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return acm.NewFromConfig(cfg), nil
+}
@@ -0,0 +1,125 @@
+package acm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	navmsg "github.com/clawscli/claws/internal/msg"
+)
+
+// RequestPrompts asks for the domain and alternative names of a new certificate.
+// defaultDomain and defaultSANs pre-fill the inputs from the selected resource.
+func RequestPrompts(defaultDomain, defaultSANs func(dao.Resource) string) []action.Prompt {
+	return []action.Prompt{
+		{Label: "Domain name", Default: defaultDomain, Validate: validateDomainName},
+		{Label: "Alternative names (comma-separated, optional)", Default: defaultSANs, Validate: func(v string) error {
+			for _, san := range ParseSANs(v, "") {
+				if err := validateDomainName(san); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+	}
+}
+
+// ParseSANs splits comma- or space-separated alternative names, dropping
+// duplicates and the primary domain itself
+func ParseSANs(value, domain string) []string {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	var sans []string
+	for _, f := range fields {
+		if f != domain && !slices.Contains(sans, f) {
+			sans = append(sans, f)
+		}
+	}
+	return sans
+}
+
+func validateDomainName(v string) error {
+	switch {
+	case v == "":
+		return errors.New("domain name is required")
+	case strings.ContainsAny(v, " \t,"):
+		return fmt.Errorf("invalid domain name %q", v)
+	case !strings.Contains(strings.TrimPrefix(v, "*."), "."):
+		return fmt.Errorf("%q is not a fully qualified domain name", v)
+	}
+	return nil
+}
+
+// ExecuteRequestCertificate requests a DNS-validated certificate for the
+// domain and alternative names entered in act's prompts, then writes the
+// validation records into the matching public hosted zones. ACM issues the
+// certificate asynchronously, so the result opens the new certificate and
+// polls its status until it is issued or fails.
+func ExecuteRequestCertificate(ctx context.Context, act action.Action) action.ActionResult {
+	domain := act.Input(0)
+	if domain == "" {
+		return action.FailResult(errors.New("domain name is required"))
+	}
+	sans := ParseSANs(act.Input(1), domain)
+
+	client, err := GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	certArn, err := RequestDNSCertificate(ctx, client, domain, sans)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	created, skipped, err := CreateValidationRecords(ctx, client, route53.NewFromConfig(cfg), certArn)
+	if err != nil {
+		return action.FailResultf(err, "certificate %s requested but validation records were not created", certArn)
+	}
+
+	msg := fmt.Sprintf("Requested certificate for %s and created %d validation record(s)", domain, len(created))
+	if len(skipped) > 0 {
+		msg += fmt.Sprintf("; no Route53 zone for %s", strings.Join(skipped, ", "))
+	}
+	return action.SuccessResultWithFollowUp(msg, navmsg.FollowResourcesMsg{
+		Service:      "acm",
+		ResourceType: "certificates",
+		FilterField:  "CertificateArn",
+		FilterValue:  certArn,
+		Done:         CertificatesSettled,
+	})
+}
+
+// CertificatesSettled reports whether every listed certificate reached a
+// final status, so following a new certificate can stop polling
+func CertificatesSettled(resources []dao.Resource) bool {
+	type statusProvider interface{ Status() string }
+	if len(resources) == 0 {
+		return false // ListCertificates may not return a new certificate yet
+	}
+	for _, r := range resources {
+		sp, ok := dao.UnwrapResource(r).(statusProvider)
+		if !ok {
+			return false
+		}
+		switch acmtypes.CertificateStatus(sp.Status()) {
+		case acmtypes.CertificateStatusIssued, acmtypes.CertificateStatusFailed, acmtypes.CertificateStatusValidationTimedOut:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package acm
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestParseSANs(t *testing.T) {
+	tests := []struct {
+		value  string
+		domain string
+		want   []string
+	}{
+		{"", "example.com", nil},
+		{"*.example.com", "example.com", []string{"*.example.com"}},
+		{"www.example.com, api.example.com", "example.com", []string{"www.example.com", "api.example.com"}},
+		{"example.com,www.example.com www.example.com", "example.com", []string{"www.example.com"}},
+	}
+	for _, tt := range tests {
+		if got := ParseSANs(tt.value, tt.domain); !slices.Equal(got, tt.want) {
+			t.Errorf("ParseSANs(%q, %q) = %v, want %v", tt.value, tt.domain, got, tt.want)
+		}
+	}
+}
+
+func TestValidateDomainName(t *testing.T) {
+	valid := []string{"example.com", "*.example.com", "a.b.example.co.uk"}
+	for _, v := range valid {
+		if err := validateDomainName(v); err != nil {
+			t.Errorf("validateDomainName(%q) = %v, want nil", v, err)
+		}
+	}
+	invalid := []string{"", "localhost", "*.com", "exa mple.com"}
+	for _, v := range invalid {
+		if err := validateDomainName(v); err == nil {
+			t.Errorf("validateDomainName(%q) = nil, want error", v)
+		}
+	}
+}
+
+type statusResource struct {
+	dao.BaseResource
+	status string
+}
+
+func (r *statusResource) Status() string { return r.status }
+
+func TestCertificatesSettled(t *testing.T) {
+	cert := func(status string) dao.Resource { return &statusResource{status: status} }
+	tests := []struct {
+		name      string
+		resources []dao.Resource
+		want      bool
+	}{
+		{"not listed yet", nil, false},
+		{"pending", []dao.Resource{cert("PENDING_VALIDATION")}, false},
+		{"issued", []dao.Resource{cert("ISSUED")}, true},
+		{"failed", []dao.Resource{cert("FAILED")}, true},
+		{"issued in region", []dao.Resource{dao.WrapWithRegion(cert("ISSUED"), "us-east-1")}, true},
+	}
+	for _, tt := range tests {
+		if got := CertificatesSettled(tt.resources); got != tt.want {
+			t.Errorf("%s: CertificatesSettled() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
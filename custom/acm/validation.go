@@ -0,0 +1,181 @@
+package acm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	r53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ACM generates DNS validation records asynchronously after RequestCertificate.
+// They normally appear within a few seconds; wait at most this long for them.
+const (
+	validationRecordAttempts = 5
+	validationRecordInterval = 2 * time.Second
+	validationRecordTTL      = 300
+)
+
+// RequestDNSCertificate requests a public certificate for domain and its SANs
+// using DNS validation. Returns the new certificate ARN.
+func RequestDNSCertificate(ctx context.Context, client *acm.Client, domain string, sans []string) (string, error) {
+	output, err := client.RequestCertificate(ctx, &acm.RequestCertificateInput{
+		DomainName:              &domain,
+		SubjectAlternativeNames: sans,
+		ValidationMethod:        acmtypes.ValidationMethodDns,
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "request certificate for %s", domain)
+	}
+	return appaws.Str(output.CertificateArn), nil
+}
+
+// CreateValidationRecords UPSERTs the DNS validation CNAMEs for a certificate into
+// the matching public Route53 hosted zones. Records whose domain has no hosted zone
+// in this account are reported as skipped. Returns created and skipped record names.
+func CreateValidationRecords(ctx context.Context, client *acm.Client, r53 *route53.Client, certArn string) (created, skipped []string, err error) {
+	records, err := waitForValidationRecords(ctx, client, certArn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zones, err := listPublicZones(ctx, r53)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := make(map[string][]r53types.Change) // key: hosted zone ID
+	for _, rec := range records {
+		name := appaws.Str(rec.Name)
+		zoneID := MatchHostedZone(name, zones)
+		if zoneID == "" {
+			skipped = append(skipped, name)
+			continue
+		}
+		changes[zoneID] = append(changes[zoneID], r53types.Change{
+			Action: r53types.ChangeActionUpsert,
+			ResourceRecordSet: &r53types.ResourceRecordSet{
+				Name:            rec.Name,
+				Type:            r53types.RRType(rec.Type),
+				TTL:             appaws.Int64Ptr(validationRecordTTL),
+				ResourceRecords: []r53types.ResourceRecord{{Value: rec.Value}},
+			},
+		})
+		created = append(created, name)
+	}
+
+	for zoneID, zoneChanges := range changes {
+		_, err := r53.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: &zoneID,
+			ChangeBatch: &r53types.ChangeBatch{
+				Comment: appaws.StringPtr("ACM DNS validation for " + certArn),
+				Changes: zoneChanges,
+			},
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "create validation records in zone %s", zoneID)
+		}
+	}
+
+	return created, skipped, nil
+}
+
+// waitForValidationRecords returns the distinct pending DNS validation records,
+// retrying briefly while ACM is still generating them.
+func waitForValidationRecords(ctx context.Context, client *acm.Client, certArn string) ([]acmtypes.ResourceRecord, error) {
+	for attempt := range validationRecordAttempts {
+		output, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+			CertificateArn: &certArn,
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe certificate %s", certArn)
+		}
+		if output.Certificate == nil {
+			return nil, fmt.Errorf("certificate not found: %s", certArn)
+		}
+
+		records, ready := pendingValidationRecords(output.Certificate.DomainValidationOptions)
+		if ready {
+			return records, nil
+		}
+		if attempt == validationRecordAttempts-1 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(validationRecordInterval):
+		}
+	}
+	return nil, fmt.Errorf("validation records for %s are not available yet, try again shortly", certArn)
+}
+
+// pendingValidationRecords collects the distinct DNS records of domains that are
+// still pending validation. ready is false if ACM has not generated every record yet.
+func pendingValidationRecords(options []acmtypes.DomainValidation) (records []acmtypes.ResourceRecord, ready bool) {
+	seen := make(map[string]bool)
+	for _, opt := range options {
+		if opt.ValidationStatus == acmtypes.DomainStatusSuccess {
+			continue
+		}
+		if opt.ResourceRecord == nil {
+			return nil, false
+		}
+		name := appaws.Str(opt.ResourceRecord.Name)
+		if seen[name] {
+			continue // apex and wildcard names share one record
+		}
+		seen[name] = true
+		records = append(records, *opt.ResourceRecord)
+	}
+	return records, true
+}
+
+// listPublicZones returns public hosted zones keyed by ID, valued by domain name without trailing dot.
+func listPublicZones(ctx context.Context, r53 *route53.Client) (map[string]string, error) {
+	zones, err := appaws.Paginate(ctx, func(marker *string) ([]r53types.HostedZone, *string, error) {
+		output, err := r53.ListHostedZones(ctx, &route53.ListHostedZonesInput{Marker: marker})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list hosted zones")
+		}
+		return output.HostedZones, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(zones))
+	for _, z := range zones {
+		if z.Config != nil && z.Config.PrivateZone {
+			continue
+		}
+		id := strings.TrimPrefix(appaws.Str(z.Id), "/hostedzone/")
+		result[id] = strings.TrimSuffix(appaws.Str(z.Name), ".")
+	}
+	return result, nil
+}
+
+// MatchHostedZone returns the ID of the zone with the longest domain suffix
+// matching recordName, or "" if no zone matches.
+func MatchHostedZone(recordName string, zones map[string]string) string {
+	name := strings.ToLower(strings.TrimSuffix(recordName, "."))
+	bestID, bestLen := "", 0
+	for id, domain := range zones {
+		domain = strings.ToLower(domain)
+		if name != domain && !strings.HasSuffix(name, "."+domain) {
+			continue
+		}
+		if len(domain) > bestLen {
+			bestID, bestLen = id, len(domain)
+		}
+	}
+	return bestID
+}
@@ -0,0 +1,68 @@
+package acm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+)
+
+func TestMatchHostedZone(t *testing.T) {
+	zones := map[string]string{
+		"Z1": "example.com",
+		"Z2": "sub.example.com",
+		"Z3": "other.org",
+	}
+
+	tests := []struct {
+		record string
+		want   string
+	}{
+		{"_abc.example.com.", "Z1"},
+		{"_abc.sub.example.com.", "Z2"},
+		{"_abc.deep.sub.example.com", "Z2"},
+		{"_abc.Other.ORG.", "Z3"},
+		{"_abc.notexample.com.", ""},
+		{"_abc.unknown.net.", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.record, func(t *testing.T) {
+			if got := MatchHostedZone(tt.record, zones); got != tt.want {
+				t.Errorf("MatchHostedZone(%q) = %q, want %q", tt.record, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPendingValidationRecords(t *testing.T) {
+	rec := &types.ResourceRecord{Name: aws.String("_x.example.com."), Type: types.RecordTypeCname, Value: aws.String("_y.acm-validations.aws.")}
+
+	t.Run("dedupes apex and wildcard", func(t *testing.T) {
+		records, ready := pendingValidationRecords([]types.DomainValidation{
+			{DomainName: aws.String("example.com"), ResourceRecord: rec, ValidationStatus: types.DomainStatusPendingValidation},
+			{DomainName: aws.String("*.example.com"), ResourceRecord: rec, ValidationStatus: types.DomainStatusPendingValidation},
+		})
+		if !ready || len(records) != 1 {
+			t.Errorf("got ready=%v records=%d, want ready=true records=1", ready, len(records))
+		}
+	})
+
+	t.Run("not ready without record", func(t *testing.T) {
+		_, ready := pendingValidationRecords([]types.DomainValidation{
+			{DomainName: aws.String("example.com"), ValidationStatus: types.DomainStatusPendingValidation},
+		})
+		if ready {
+			t.Error("expected not ready when ResourceRecord is missing")
+		}
+	})
+
+	t.Run("skips validated domains", func(t *testing.T) {
+		records, ready := pendingValidationRecords([]types.DomainValidation{
+			{DomainName: aws.String("example.com"), ValidationStatus: types.DomainStatusSuccess},
+		})
+		if !ready || len(records) != 0 {
+			t.Errorf("got ready=%v records=%d, want ready=true records=0", ready, len(records))
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package certificateauthorities
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// expiryWarning is how long before NotAfter a CA is flagged as expiring
+const expiryWarning = 30 * 24 * time.Hour
+
+// CertificateAuthorityDAO provides data access for ACM private certificate authorities
+type CertificateAuthorityDAO struct {
+	dao.BaseDAO
+	client *acmpca.Client
+}
+
+// NewCertificateAuthorityDAO creates a new CertificateAuthorityDAO
+func NewCertificateAuthorityDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &CertificateAuthorityDAO{
+		BaseDAO: dao.NewBaseDAO("acmpca", "certificate-authorities"),
+		client:  acmpca.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the private CAs owned by the account
+func (d *CertificateAuthorityDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	cas, err := appaws.Paginate(ctx, func(token *string) ([]types.CertificateAuthority, *string, error) {
+		output, err := d.client.ListCertificateAuthorities(ctx, &acmpca.ListCertificateAuthoritiesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list certificate authorities")
+		}
+		return output.CertificateAuthorities, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(cas))
+	for _, ca := range cas {
+		resources = append(resources, NewCertificateAuthorityResource(ca))
+	}
+	return resources, nil
+}
+
+func (d *CertificateAuthorityDAO) Get(ctx context.Context, arn string) (dao.Resource, error) {
+	output, err := d.client.DescribeCertificateAuthority(ctx, &acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: &arn,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe certificate authority %s", arn)
+	}
+	if output.CertificateAuthority == nil {
+		return nil, fmt.Errorf("certificate authority not found: %s", arn)
+	}
+	return NewCertificateAuthorityResource(*output.CertificateAuthority), nil
+}
+
+func (d *CertificateAuthorityDAO) Delete(ctx context.Context, arn string) error {
+	return fmt.Errorf("delete not supported for certificate authorities")
+}
+
+// Supports returns supported operations
+func (d *CertificateAuthorityDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// CertificateAuthorityResource wraps an ACM private CA
+type CertificateAuthorityResource struct {
+	dao.BaseResource
+	Item types.CertificateAuthority
+}
+
+// NewCertificateAuthorityResource creates a new CertificateAuthorityResource
+func NewCertificateAuthorityResource(ca types.CertificateAuthority) *CertificateAuthorityResource {
+	arn := appaws.Str(ca.Arn)
+	res := &CertificateAuthorityResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			ARN:  arn,
+			Data: ca,
+		},
+		Item: ca,
+	}
+	res.Name = res.CommonName()
+	if res.Name == "" {
+		res.Name = appaws.ParseARN(arn).ShortID()
+	}
+	return res
+}
+
+// CommonName returns the CN of the CA subject
+func (r *CertificateAuthorityResource) CommonName() string {
+	if cfg := r.Item.CertificateAuthorityConfiguration; cfg != nil && cfg.Subject != nil {
+		return appaws.Str(cfg.Subject.CommonName)
+	}
+	return ""
+}
+
+// Status returns the CA status
+func (r *CertificateAuthorityResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// Type returns ROOT or SUBORDINATE
+func (r *CertificateAuthorityResource) Type() string {
+	return string(r.Item.Type)
+}
+
+// UsageMode returns GENERAL_PURPOSE or SHORT_LIVED_CERTIFICATE
+func (r *CertificateAuthorityResource) UsageMode() string {
+	return string(r.Item.UsageMode)
+}
+
+// KeyAlgorithm returns the key algorithm of the CA
+func (r *CertificateAuthorityResource) KeyAlgorithm() string {
+	if cfg := r.Item.CertificateAuthorityConfiguration; cfg != nil {
+		return string(cfg.KeyAlgorithm)
+	}
+	return ""
+}
+
+// SigningAlgorithm returns the algorithm the CA signs certificates with
+func (r *CertificateAuthorityResource) SigningAlgorithm() string {
+	if cfg := r.Item.CertificateAuthorityConfiguration; cfg != nil {
+		return string(cfg.SigningAlgorithm)
+	}
+	return ""
+}
+
+// NotAfter returns when the CA certificate expires (zero until it is installed)
+func (r *CertificateAuthorityResource) NotAfter() time.Time {
+	return appaws.Time(r.Item.NotAfter)
+}
+
+// Expiry returns the time left before the CA certificate expires, as shown
+// in the list: "" without a certificate, "expired", or e.g. "120d"
+func (r *CertificateAuthorityResource) Expiry(now time.Time) string {
+	notAfter := r.NotAfter()
+	if notAfter.IsZero() {
+		return ""
+	}
+	left := notAfter.Sub(now)
+	if left <= 0 {
+		return "expired"
+	}
+	if left < 24*time.Hour {
+		return fmt.Sprintf("%dh", int(left.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(left.Hours()/24))
+}
+
+// ExpiresSoon reports whether the CA certificate expires within expiryWarning
+func (r *CertificateAuthorityResource) ExpiresSoon(now time.Time) bool {
+	notAfter := r.NotAfter()
+	return !notAfter.IsZero() && notAfter.Sub(now) < expiryWarning
+}
@@ -0,0 +1,20 @@
+package certificateauthorities
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("acmpca", "certificate-authorities", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewCertificateAuthorityDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewCertificateAuthorityRenderer()
+		},
+	})
+}
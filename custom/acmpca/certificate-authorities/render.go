@@ -0,0 +1,200 @@
+package certificateauthorities
+
+import (
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure CertificateAuthorityRenderer implements render.Navigator
+var _ render.Navigator = (*CertificateAuthorityRenderer)(nil)
+
+// CertificateAuthorityRenderer renders ACM private CAs
+type CertificateAuthorityRenderer struct {
+	render.BaseRenderer
+}
+
+// NewCertificateAuthorityRenderer creates a new CertificateAuthorityRenderer
+func NewCertificateAuthorityRenderer() render.Renderer {
+	return &CertificateAuthorityRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "acmpca",
+			Resource: "certificate-authorities",
+			Cols: []render.Column{
+				{Name: "COMMON NAME", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "STATUS", Width: 20, Getter: getStatus, Priority: 1},
+				{Name: "TYPE", Width: 12, Getter: getType, Priority: 2},
+				{Name: "EXPIRES", Width: 12, Getter: getExpires, Priority: 3},
+				{Name: "LEFT", Width: 8, Getter: getLeft, Priority: 4},
+				{Name: "KEY", Width: 12, Getter: getKeyAlgorithm, Priority: 5},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if ca, ok := r.(*CertificateAuthorityResource); ok {
+		return ca.Status()
+	}
+	return ""
+}
+
+func getType(r dao.Resource) string {
+	if ca, ok := r.(*CertificateAuthorityResource); ok {
+		return ca.Type()
+	}
+	return ""
+}
+
+func getExpires(r dao.Resource) string {
+	if ca, ok := r.(*CertificateAuthorityResource); ok && !ca.NotAfter().IsZero() {
+		return ca.NotAfter().Format("2006-01-02")
+	}
+	return "-"
+}
+
+func getLeft(r dao.Resource) string {
+	if ca, ok := r.(*CertificateAuthorityResource); ok {
+		if left := ca.Expiry(time.Now()); left != "" {
+			return left
+		}
+	}
+	return "-"
+}
+
+func getKeyAlgorithm(r dao.Resource) string {
+	if ca, ok := r.(*CertificateAuthorityResource); ok {
+		return ca.KeyAlgorithm()
+	}
+	return ""
+}
+
+// expiryStyle highlights CAs whose certificate expires soon
+func expiryStyle(ca *CertificateAuthorityResource) lipgloss.Style {
+	if ca.ExpiresSoon(time.Now()) {
+		return ui.WarningStyle()
+	}
+	return ui.NoStyle()
+}
+
+// RenderDetail renders detailed CA information
+func (r *CertificateAuthorityRenderer) RenderDetail(resource dao.Resource) string {
+	ca, ok := resource.(*CertificateAuthorityResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Private Certificate Authority", ca.GetName())
+
+	d.Section("Basic Information")
+	d.Field("ARN", ca.GetARN())
+	d.FieldStyled("Status", ca.Status(), render.StateColorer()(strings.ToLower(ca.Status())))
+	if reason := string(ca.Item.FailureReason); reason != "" {
+		d.Field("Failure Reason", reason)
+	}
+	d.Field("Type", ca.Type())
+	if mode := ca.UsageMode(); mode != "" {
+		d.Field("Usage Mode", mode)
+	}
+	d.FieldIf("Owner Account", ca.Item.OwnerAccount)
+
+	if cfg := ca.Item.CertificateAuthorityConfiguration; cfg != nil && cfg.Subject != nil {
+		s := cfg.Subject
+		d.Section("Subject")
+		d.FieldIf("Common Name", s.CommonName)
+		d.FieldIf("Organization", s.Organization)
+		d.FieldIf("Organizational Unit", s.OrganizationalUnit)
+		d.FieldIf("Country", s.Country)
+		d.FieldIf("State", s.State)
+		d.FieldIf("Locality", s.Locality)
+	}
+
+	d.Section("Certificate")
+	d.Field("Key Algorithm", ca.KeyAlgorithm())
+	d.Field("Signing Algorithm", ca.SigningAlgorithm())
+	if ca.Item.KeyStorageSecurityStandard != "" {
+		d.Field("Key Storage", string(ca.Item.KeyStorageSecurityStandard))
+	}
+	d.FieldIf("Serial", ca.Item.Serial)
+	if ca.Item.NotBefore != nil {
+		d.Field("Not Before", ca.Item.NotBefore.Format("2006-01-02 15:04:05"))
+	}
+	if notAfter := ca.NotAfter(); !notAfter.IsZero() {
+		d.FieldStyled("Not After", notAfter.Format("2006-01-02 15:04:05"), expiryStyle(ca))
+		d.Field("Time Left", ca.Expiry(time.Now()))
+	} else {
+		d.Field("Not After", "No CA certificate installed")
+	}
+
+	if rc := ca.Item.RevocationConfiguration; rc != nil {
+		d.Section("Revocation")
+		if crl := rc.CrlConfiguration; crl != nil && appaws.Bool(crl.Enabled) {
+			d.Field("CRL", "Enabled")
+			d.FieldIf("CRL Bucket", crl.S3BucketName)
+		} else {
+			d.Field("CRL", "Disabled")
+		}
+		if ocsp := rc.OcspConfiguration; ocsp != nil && appaws.Bool(ocsp.Enabled) {
+			d.Field("OCSP", "Enabled")
+		} else {
+			d.Field("OCSP", "Disabled")
+		}
+	}
+
+	d.Section("Timestamps")
+	if ca.Item.CreatedAt != nil {
+		d.Field("Created", ca.Item.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	if ca.Item.LastStateChangeAt != nil {
+		d.Field("Last State Change", ca.Item.LastStateChangeAt.Format("2006-01-02 15:04:05"))
+	}
+	if ca.Item.RestorableUntil != nil {
+		d.Field("Restorable Until", ca.Item.RestorableUntil.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *CertificateAuthorityRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ca, ok := resource.(*CertificateAuthorityResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Common Name", Value: ca.GetName()},
+		{Label: "Status", Value: ca.Status(), Style: render.StateColorer()(strings.ToLower(ca.Status()))},
+		{Label: "Type", Value: ca.Type()},
+	}
+	if !ca.NotAfter().IsZero() {
+		fields = append(fields, render.SummaryField{
+			Label: "Expires",
+			Value: ca.NotAfter().Format("2006-01-02") + " (" + ca.Expiry(time.Now()) + ")",
+			Style: expiryStyle(ca),
+		})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts for CAs
+func (r *CertificateAuthorityRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	ca, ok := resource.(*CertificateAuthorityResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "c", Label: "Issued Certificates", Service: "acm", Resource: "certificates",
+			FilterField: "CertificateAuthorityArn", FilterValue: ca.GetARN(),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package certificateauthorities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+)
+
+const testCAArn = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/11111111-2222-3333-4444-555555555555"
+
+func TestCertificateAuthorityName(t *testing.T) {
+	named := NewCertificateAuthorityResource(types.CertificateAuthority{
+		Arn: aws.String(testCAArn),
+		CertificateAuthorityConfiguration: &types.CertificateAuthorityConfiguration{
+			Subject: &types.ASN1Subject{CommonName: aws.String("Example Root CA")},
+		},
+	})
+	if named.GetName() != "Example Root CA" {
+		t.Errorf("GetName() = %q, want common name", named.GetName())
+	}
+
+	unnamed := NewCertificateAuthorityResource(types.CertificateAuthority{Arn: aws.String(testCAArn)})
+	if unnamed.GetName() != "11111111-2222-3333-4444-555555555555" {
+		t.Errorf("GetName() = %q, want CA ID", unnamed.GetName())
+	}
+}
+
+func TestCertificateAuthorityExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		notAfter *time.Time
+		want     string
+		soon     bool
+	}{
+		{"no certificate", nil, "", false},
+		{"expired", aws.Time(now.Add(-time.Hour)), "expired", true},
+		{"hours left", aws.Time(now.Add(5 * time.Hour)), "5h", true},
+		{"within warning", aws.Time(now.Add(10 * 24 * time.Hour)), "10d", true},
+		{"far", aws.Time(now.Add(400 * 24 * time.Hour)), "400d", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca := NewCertificateAuthorityResource(types.CertificateAuthority{
+				Arn:      aws.String(testCAArn),
+				NotAfter: tt.notAfter,
+			})
+			if got := ca.Expiry(now); got != tt.want {
+				t.Errorf("Expiry() = %q, want %q", got, tt.want)
+			}
+			if got := ca.ExpiresSoon(now); got != tt.soon {
+				t.Errorf("ExpiresSoon() = %v, want %v", got, tt.soon)
+			}
+		})
+	}
+}
@@ -218,6 +218,15 @@ func (r *StageV2Resource) HasAccessLogs() bool {
 	return r.Item.AccessLogSettings != nil && r.Item.AccessLogSettings.DestinationArn != nil
 }
 
+// AccessLogGroup returns the access log group name, or "" if access logs
+// are not configured or go to Firehose
+func (r *StageV2Resource) AccessLogGroup() string {
+	if a := appaws.ParseARN(r.AccessLogDestination()); a != nil && a.Service == "logs" {
+		return appaws.LogGroupNameFromARN(a.Raw)
+	}
+	return ""
+}
+
 // AccessLogDestination returns the access log destination ARN
 func (r *StageV2Resource) AccessLogDestination() string {
 	if r.Item.AccessLogSettings != nil && r.Item.AccessLogSettings.DestinationArn != nil {
@@ -7,6 +7,8 @@ import (
 	"github.com/clawscli/claws/internal/render"
 )
 
+var _ render.LogSource = (*StageV2Renderer)(nil)
+
 // StageV2Renderer renders API Gateway HTTP/WebSocket API stages (v2)
 type StageV2Renderer struct {
 	render.BaseRenderer
@@ -178,3 +180,15 @@ func (r *StageV2Renderer) RenderSummary(resource dao.Resource) []render.SummaryF
 		{Label: "Logs", Value: logsStatus},
 	}
 }
+
+// LogTargets returns the stage's CloudWatch log groups
+func (r *StageV2Renderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	rr, ok := resource.(*StageV2Resource)
+	if !ok {
+		return nil
+	}
+	if group := rr.AccessLogGroup(); group != "" {
+		return []render.LogTarget{{Group: group}}
+	}
+	return nil
+}
@@ -232,6 +232,26 @@ func (r *StageResource) HasAccessLogs() bool {
 	return r.Item.AccessLogSettings != nil && r.Item.AccessLogSettings.DestinationArn != nil
 }
 
+// ExecutionLogGroup returns the execution log group, or "" if no method
+// has CloudWatch logging enabled
+func (r *StageResource) ExecutionLogGroup() string {
+	for _, ms := range r.Item.MethodSettings {
+		if level := appaws.Str(ms.LoggingLevel); level != "" && level != "OFF" {
+			return fmt.Sprintf("API-Gateway-Execution-Logs_%s/%s", r.RestApiId, r.StageName())
+		}
+	}
+	return ""
+}
+
+// AccessLogGroup returns the access log group name, or "" if access logs
+// are not configured or go to Firehose
+func (r *StageResource) AccessLogGroup() string {
+	if a := appaws.ParseARN(r.AccessLogDestination()); a != nil && a.Service == "logs" {
+		return appaws.LogGroupNameFromARN(a.Raw)
+	}
+	return ""
+}
+
 // AccessLogDestination returns the access log destination ARN
 func (r *StageResource) AccessLogDestination() string {
 	if r.Item.AccessLogSettings != nil && r.Item.AccessLogSettings.DestinationArn != nil {
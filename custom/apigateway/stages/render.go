@@ -7,6 +7,8 @@ import (
 	"github.com/clawscli/claws/internal/render"
 )
 
+var _ render.LogSource = (*StageRenderer)(nil)
+
 // StageRenderer renders API Gateway stages
 type StageRenderer struct {
 	render.BaseRenderer
@@ -186,3 +188,19 @@ func (r *StageRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 		{Label: "X-Ray", Value: tracingStatus},
 	}
 }
+
+// LogTargets returns the stage's CloudWatch log groups
+func (r *StageRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	rr, ok := resource.(*StageResource)
+	if !ok {
+		return nil
+	}
+	var targets []render.LogTarget
+	if group := rr.ExecutionLogGroup(); group != "" {
+		targets = append(targets, render.LogTarget{Group: group})
+	}
+	if group := rr.AccessLogGroup(); group != "" {
+		targets = append(targets, render.LogTarget{Group: group})
+	}
+	return targets
+}
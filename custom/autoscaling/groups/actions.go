@@ -0,0 +1,126 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("autoscaling", "groups", []action.Action{
+		{
+			Name:      "Increase Desired Capacity",
+			Shortcut:  "+",
+			Type:      action.ActionTypeAPI,
+			Operation: "IncreaseDesiredCapacity",
+			Confirm:   action.ConfirmSimple,
+		},
+		{
+			Name:      "Decrease Desired Capacity",
+			Shortcut:  "-",
+			Type:      action.ActionTypeAPI,
+			Operation: "DecreaseDesiredCapacity",
+			Confirm:   action.ConfirmSimple,
+		},
+		{
+			Name:         "Start Instance Refresh",
+			Shortcut:     "R",
+			Type:         action.ActionTypeAPI,
+			Operation:    "StartInstanceRefresh",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+		},
+	})
+
+	action.RegisterExecutor("autoscaling", "groups", executeGroupAction)
+}
+
+func executeGroupAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "IncreaseDesiredCapacity":
+		return executeAdjustCapacity(ctx, resource, 1)
+	case "DecreaseDesiredCapacity":
+		return executeAdjustCapacity(ctx, resource, -1)
+	case "StartInstanceRefresh":
+		return executeStartInstanceRefresh(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// NextDesiredCapacity returns the desired capacity after applying delta,
+// or an error if the result would fall outside the group's min/max bounds.
+func NextDesiredCapacity(current, minSize, maxSize, delta int32) (int32, error) {
+	next := current + delta
+	if next < minSize {
+		return current, fmt.Errorf("desired capacity %d would be below min size %d", next, minSize)
+	}
+	if next > maxSize {
+		return current, fmt.Errorf("desired capacity %d would exceed max size %d", next, maxSize)
+	}
+	return next, nil
+}
+
+func executeAdjustCapacity(ctx context.Context, resource dao.Resource, delta int32) action.ActionResult {
+	asg, ok := resource.(*AutoScalingGroupResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	current := asg.DesiredCapacity()
+	next, err := NextDesiredCapacity(current, asg.MinSize(), asg.MaxSize(), delta)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := asg.AutoScalingGroupName()
+	_, err = client.SetDesiredCapacity(ctx, &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: &name,
+		DesiredCapacity:      &next,
+	})
+	if err != nil {
+		return action.FailResultf(err, "set desired capacity for %s", name)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Set desired capacity of %s: %d → %d", name, current, next))
+}
+
+func executeStartInstanceRefresh(ctx context.Context, resource dao.Resource) action.ActionResult {
+	asg, ok := resource.(*AutoScalingGroupResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := asg.AutoScalingGroupName()
+	output, err := client.StartInstanceRefresh(ctx, &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: &name,
+	})
+	if err != nil {
+		return action.FailResultf(err, "start instance refresh for %s", name)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Started instance refresh %s for %s (press r to follow progress)", appaws.Str(output.InstanceRefreshId), name))
+}
+
+func getClient(ctx context.Context) (*autoscaling.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return autoscaling.NewFromConfig(cfg), nil
+}
@@ -0,0 +1,31 @@
+package groups
+
+import "testing"
+
+func TestNextDesiredCapacity(t *testing.T) {
+	tests := []struct {
+		name                     string
+		current, min, max, delta int32
+		want                     int32
+		wantErr                  bool
+	}{
+		{"increase within bounds", 2, 1, 4, 1, 3, false},
+		{"decrease within bounds", 2, 1, 4, -1, 1, false},
+		{"increase to max", 3, 1, 4, 1, 4, false},
+		{"increase beyond max", 4, 1, 4, 1, 4, true},
+		{"decrease below min", 1, 1, 4, -1, 1, true},
+		{"decrease to zero", 1, 0, 4, -1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextDesiredCapacity(tt.current, tt.min, tt.max, tt.delta)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextDesiredCapacity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NextDesiredCapacity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
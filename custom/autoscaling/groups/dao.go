@@ -60,14 +60,34 @@ func (d *AutoScalingGroupDAO) List(ctx context.Context) ([]dao.Resource, error)
 		return nil, err
 	}
 
-	resources := make([]dao.Resource, len(asgs))
-	for i, asg := range asgs {
-		resources[i] = NewAutoScalingGroupResource(asg)
+	// Filter by launch template (for navigation from ec2/launch-templates)
+	ltID := dao.GetFilterFromContext(ctx, "LaunchTemplateId")
+
+	resources := make([]dao.Resource, 0, len(asgs))
+	for _, asg := range asgs {
+		if ltID != "" && !usesLaunchTemplate(asg, ltID) {
+			continue
+		}
+		resources = append(resources, NewAutoScalingGroupResource(asg))
 	}
 
 	return resources, nil
 }
 
+// usesLaunchTemplate reports whether the ASG launches instances from the given
+// launch template, either directly or through a mixed instances policy.
+func usesLaunchTemplate(asg types.AutoScalingGroup, ltID string) bool {
+	if asg.LaunchTemplate != nil && appaws.Str(asg.LaunchTemplate.LaunchTemplateId) == ltID {
+		return true
+	}
+	if mip := asg.MixedInstancesPolicy; mip != nil && mip.LaunchTemplate != nil {
+		if spec := mip.LaunchTemplate.LaunchTemplateSpecification; spec != nil && appaws.Str(spec.LaunchTemplateId) == ltID {
+			return true
+		}
+	}
+	return false
+}
+
 // Get returns a specific Auto Scaling Group
 func (d *AutoScalingGroupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	output, err := d.client.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
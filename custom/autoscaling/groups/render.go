@@ -263,6 +263,11 @@ func (r *AutoScalingGroupRenderer) Navigations(resource dao.Resource) []render.N
 			Key: "g", Label: "Activities", Service: "autoscaling", Resource: "activities",
 			FilterField: "AutoScalingGroupName", FilterValue: rr.AutoScalingGroupName(),
 		},
+		{
+			Key: "r", Label: "Instance Refreshes", Service: "autoscaling", Resource: "instance-refreshes",
+			FilterField: "AutoScalingGroupName", FilterValue: rr.AutoScalingGroupName(),
+			AutoReload: true, // Poll refresh progress every 3s
+		},
 		{
 			Key: "e", Label: "Instances", Service: "ec2", Resource: "instances",
 			FilterField: "AutoScalingGroupName", FilterValue: rr.AutoScalingGroupName(),
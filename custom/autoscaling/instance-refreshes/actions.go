@@ -0,0 +1,62 @@
+package instancerefreshes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("autoscaling", "instance-refreshes", []action.Action{
+		{
+			Name:      "Cancel Refresh",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelInstanceRefresh",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				ir, ok := r.(*InstanceRefreshResource)
+				return ok && ir.IsActive()
+			},
+		},
+	})
+
+	action.RegisterExecutor("autoscaling", "instance-refreshes", executeInstanceRefreshAction)
+}
+
+func executeInstanceRefreshAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CancelInstanceRefresh":
+		return executeCancelInstanceRefresh(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeCancelInstanceRefresh(ctx context.Context, resource dao.Resource) action.ActionResult {
+	ir, ok := resource.(*InstanceRefreshResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	client := autoscaling.NewFromConfig(cfg)
+
+	asgName := ir.ASGName()
+	_, err = client.CancelInstanceRefresh(ctx, &autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: &asgName,
+	})
+	if err != nil {
+		return action.FailResultf(err, "cancel instance refresh for %s", asgName)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Cancelling instance refresh %s", ir.GetID()))
+}
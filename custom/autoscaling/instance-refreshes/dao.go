@@ -0,0 +1,191 @@
+package instancerefreshes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// InstanceRefreshDAO provides data access for Auto Scaling instance refreshes
+type InstanceRefreshDAO struct {
+	dao.BaseDAO
+	client *autoscaling.Client
+}
+
+// NewInstanceRefreshDAO creates a new InstanceRefreshDAO
+func NewInstanceRefreshDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &InstanceRefreshDAO{
+		BaseDAO: dao.NewBaseDAO("autoscaling", "instance-refreshes"),
+		client:  autoscaling.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns instance refreshes for the Auto Scaling group in the filter context
+func (d *InstanceRefreshDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	asgName := dao.GetFilterFromContext(ctx, "AutoScalingGroupName")
+	if asgName == "" {
+		return nil, fmt.Errorf("auto scaling group name filter required")
+	}
+
+	refreshes, err := appaws.Paginate(ctx, func(token *string) ([]types.InstanceRefresh, *string, error) {
+		output, err := d.client.DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: &asgName,
+			NextToken:            token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe instance refreshes")
+		}
+		return output.InstanceRefreshes, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(refreshes))
+	for i, refresh := range refreshes {
+		resources[i] = NewInstanceRefreshResource(refresh)
+	}
+	return resources, nil
+}
+
+// Get returns a specific instance refresh
+func (d *InstanceRefreshDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	asgName := dao.GetFilterFromContext(ctx, "AutoScalingGroupName")
+	if asgName == "" {
+		return nil, fmt.Errorf("auto scaling group name filter required")
+	}
+
+	output, err := d.client.DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: &asgName,
+		InstanceRefreshIds:   []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe instance refresh %s", id)
+	}
+
+	if len(output.InstanceRefreshes) == 0 {
+		return nil, fmt.Errorf("instance refresh not found: %s", id)
+	}
+
+	return NewInstanceRefreshResource(output.InstanceRefreshes[0]), nil
+}
+
+// Delete is not supported for instance refreshes
+func (d *InstanceRefreshDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for instance refreshes")
+}
+
+// Supports returns supported operations
+func (d *InstanceRefreshDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// InstanceRefreshResource represents an Auto Scaling instance refresh
+type InstanceRefreshResource struct {
+	dao.BaseResource
+	Refresh types.InstanceRefresh
+}
+
+// NewInstanceRefreshResource creates a new InstanceRefreshResource
+func NewInstanceRefreshResource(refresh types.InstanceRefresh) *InstanceRefreshResource {
+	id := appaws.Str(refresh.InstanceRefreshId)
+
+	return &InstanceRefreshResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Tags: make(map[string]string),
+			Data: refresh,
+		},
+		Refresh: refresh,
+	}
+}
+
+// ASGName returns the Auto Scaling group name
+func (r *InstanceRefreshResource) ASGName() string {
+	return appaws.Str(r.Refresh.AutoScalingGroupName)
+}
+
+// Status returns the refresh status
+func (r *InstanceRefreshResource) Status() string {
+	return string(r.Refresh.Status)
+}
+
+// StatusReason returns the reason for the current status
+func (r *InstanceRefreshResource) StatusReason() string {
+	return appaws.Str(r.Refresh.StatusReason)
+}
+
+// Strategy returns the refresh strategy
+func (r *InstanceRefreshResource) Strategy() string {
+	return string(r.Refresh.Strategy)
+}
+
+// PercentageComplete returns the completion percentage
+func (r *InstanceRefreshResource) PercentageComplete() int32 {
+	return appaws.Int32(r.Refresh.PercentageComplete)
+}
+
+// InstancesToUpdate returns the number of instances still pending replacement
+func (r *InstanceRefreshResource) InstancesToUpdate() int32 {
+	return appaws.Int32(r.Refresh.InstancesToUpdate)
+}
+
+// IsActive returns true if the refresh has not reached a terminal state
+func (r *InstanceRefreshResource) IsActive() bool {
+	switch r.Refresh.Status {
+	case types.InstanceRefreshStatusPending,
+		types.InstanceRefreshStatusInProgress,
+		types.InstanceRefreshStatusCancelling,
+		types.InstanceRefreshStatusRollbackInProgress,
+		types.InstanceRefreshStatusBaking:
+		return true
+	default:
+		return false
+	}
+}
+
+// StartTime returns the start time
+func (r *InstanceRefreshResource) StartTime() string {
+	if r.Refresh.StartTime != nil {
+		return r.Refresh.StartTime.Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+// EndTime returns the end time
+func (r *InstanceRefreshResource) EndTime() string {
+	if r.Refresh.EndTime != nil {
+		return r.Refresh.EndTime.Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+// Duration returns the elapsed time of the refresh
+func (r *InstanceRefreshResource) Duration() string {
+	if r.Refresh.StartTime == nil {
+		return ""
+	}
+	endTime := time.Now()
+	if r.Refresh.EndTime != nil {
+		endTime = *r.Refresh.EndTime
+	}
+	return render.FormatDuration(endTime.Sub(*r.Refresh.StartTime))
+}
@@ -0,0 +1,20 @@
+package instancerefreshes
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("autoscaling", "instance-refreshes", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewInstanceRefreshDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewInstanceRefreshRenderer()
+		},
+	})
+}
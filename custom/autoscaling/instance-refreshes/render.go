@@ -0,0 +1,155 @@
+package instancerefreshes
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// InstanceRefreshRenderer renders Auto Scaling instance refreshes
+type InstanceRefreshRenderer struct {
+	render.BaseRenderer
+}
+
+// NewInstanceRefreshRenderer creates a new InstanceRefreshRenderer
+func NewInstanceRefreshRenderer() *InstanceRefreshRenderer {
+	return &InstanceRefreshRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "autoscaling",
+			Resource: "instance-refreshes",
+			Cols: []render.Column{
+				{Name: "REFRESH ID", Width: 38, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 20, Getter: getStatus},
+				{Name: "PROGRESS", Width: 10, Getter: getProgress},
+				{Name: "TO UPDATE", Width: 10, Getter: getToUpdate},
+				{Name: "STARTED", Width: 20, Getter: getStarted},
+				{Name: "DURATION", Width: 10, Getter: getDuration},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if ir, ok := r.(*InstanceRefreshResource); ok {
+		return ir.Status()
+	}
+	return ""
+}
+
+func getProgress(r dao.Resource) string {
+	if ir, ok := r.(*InstanceRefreshResource); ok {
+		return fmt.Sprintf("%d%%", ir.PercentageComplete())
+	}
+	return ""
+}
+
+func getToUpdate(r dao.Resource) string {
+	if ir, ok := r.(*InstanceRefreshResource); ok {
+		return fmt.Sprintf("%d", ir.InstancesToUpdate())
+	}
+	return ""
+}
+
+func getStarted(r dao.Resource) string {
+	if ir, ok := r.(*InstanceRefreshResource); ok {
+		return ir.StartTime()
+	}
+	return "-"
+}
+
+func getDuration(r dao.Resource) string {
+	if ir, ok := r.(*InstanceRefreshResource); ok {
+		return ir.Duration()
+	}
+	return "-"
+}
+
+// RenderDetail renders detailed instance refresh information
+func (r *InstanceRefreshRenderer) RenderDetail(resource dao.Resource) string {
+	ir, ok := resource.(*InstanceRefreshResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Instance Refresh", ir.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Refresh ID", ir.GetID())
+	d.Field("Auto Scaling Group", ir.ASGName())
+	d.Field("Status", ir.Status())
+	if reason := ir.StatusReason(); reason != "" {
+		d.Field("Status Reason", reason)
+	}
+	if strategy := ir.Strategy(); strategy != "" {
+		d.Field("Strategy", strategy)
+	}
+
+	d.Section("Progress")
+	d.Field("Percentage Complete", fmt.Sprintf("%d%%", ir.PercentageComplete()))
+	d.Field("Instances To Update", fmt.Sprintf("%d", ir.InstancesToUpdate()))
+	if pd := ir.Refresh.ProgressDetails; pd != nil {
+		if lp := pd.LivePoolProgress; lp != nil {
+			d.Field("Live Pool", fmt.Sprintf("%d%% (%d remaining)", appaws.Int32(lp.PercentageComplete), appaws.Int32(lp.InstancesToUpdate)))
+		}
+		if wp := pd.WarmPoolProgress; wp != nil {
+			d.Field("Warm Pool", fmt.Sprintf("%d%% (%d remaining)", appaws.Int32(wp.PercentageComplete), appaws.Int32(wp.InstancesToUpdate)))
+		}
+	}
+
+	if p := ir.Refresh.Preferences; p != nil {
+		d.Section("Preferences")
+		if p.MinHealthyPercentage != nil {
+			d.Field("Min Healthy", fmt.Sprintf("%d%%", *p.MinHealthyPercentage))
+		}
+		if p.MaxHealthyPercentage != nil {
+			d.Field("Max Healthy", fmt.Sprintf("%d%%", *p.MaxHealthyPercentage))
+		}
+		if p.InstanceWarmup != nil {
+			d.Field("Instance Warmup", fmt.Sprintf("%d seconds", *p.InstanceWarmup))
+		}
+		if p.SkipMatching != nil {
+			d.Field("Skip Matching", fmt.Sprintf("%v", *p.SkipMatching))
+		}
+		if p.AutoRollback != nil {
+			d.Field("Auto Rollback", fmt.Sprintf("%v", *p.AutoRollback))
+		}
+	}
+
+	d.Section("Timestamps")
+	if started := ir.StartTime(); started != "" {
+		d.Field("Started", started)
+	}
+	if ended := ir.EndTime(); ended != "" {
+		d.Field("Ended", ended)
+	}
+	if dur := ir.Duration(); dur != "" {
+		d.Field("Duration", dur)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *InstanceRefreshRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ir, ok := resource.(*InstanceRefreshResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Refresh ID", Value: ir.GetID()},
+		{Label: "ASG", Value: ir.ASGName()},
+		{Label: "Status", Value: ir.Status()},
+		{Label: "Progress", Value: fmt.Sprintf("%d%%", ir.PercentageComplete())},
+	}
+
+	if dur := ir.Duration(); dur != "" {
+		fields = append(fields, render.SummaryField{Label: "Duration", Value: dur})
+	}
+
+	return fields
+}
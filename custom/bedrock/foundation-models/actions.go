@@ -0,0 +1,120 @@
+package foundationmodels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// testPromptMaxTokens keeps test invocations cheap
+	testPromptMaxTokens = 256
+	// testPromptPreviewLen caps the response shown in the action menu
+	testPromptPreviewLen = 500
+)
+
+func init() {
+	action.Global.Register("bedrock", "foundation-models", []action.Action{
+		{
+			Name:      "Test Prompt",
+			Shortcut:  "t",
+			Type:      action.ActionTypeAPI,
+			Operation: "TestPrompt",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{
+					Label:    "Prompt",
+					Default:  func(dao.Resource) string { return "Reply with one short sentence to confirm you are working." },
+					Validate: validatePrompt,
+				},
+			},
+		},
+	})
+
+	action.RegisterExecutor("bedrock", "foundation-models", executeFoundationModelAction)
+}
+
+func executeFoundationModelAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "TestPrompt":
+		return executeTestPrompt(ctx, resource, act.Input(0))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func validatePrompt(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("prompt is required")
+	}
+	return nil
+}
+
+// executeTestPrompt sends a single-turn Converse request to the model
+func executeTestPrompt(ctx context.Context, resource dao.Resource, prompt string) action.ActionResult {
+	model, ok := resource.(*FoundationModelResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	client := bedrockruntime.NewFromConfig(cfg)
+
+	modelID := model.GetID()
+	output, err := client.Converse(ctx, &bedrockruntime.ConverseInput{
+		ModelId: &modelID,
+		Messages: []types.Message{
+			{
+				Role:    types.ConversationRoleUser,
+				Content: []types.ContentBlock{&types.ContentBlockMemberText{Value: prompt}},
+			},
+		},
+		InferenceConfig: &types.InferenceConfiguration{
+			MaxTokens: appaws.Int32Ptr(testPromptMaxTokens),
+		},
+	})
+	if err != nil {
+		return action.FailResult(apperrors.Wrapf(err, "converse with %s", modelID))
+	}
+
+	return action.SuccessResult(formatTestPromptResult(modelID, output))
+}
+
+func formatTestPromptResult(modelID string, output *bedrockruntime.ConverseOutput) string {
+	var text strings.Builder
+	if msg, ok := output.Output.(*types.ConverseOutputMemberMessage); ok {
+		for _, block := range msg.Value.Content {
+			if t, ok := block.(*types.ContentBlockMemberText); ok {
+				text.WriteString(t.Value)
+			}
+		}
+	}
+
+	reply := strings.TrimSpace(text.String())
+	if runes := []rune(reply); len(runes) > testPromptPreviewLen {
+		reply = string(runes[:testPromptPreviewLen]) + "..."
+	}
+	if reply == "" {
+		reply = "(no text in response)"
+	}
+
+	stats := fmt.Sprintf("stop: %s", output.StopReason)
+	if u := output.Usage; u != nil {
+		stats += fmt.Sprintf(", tokens: %d in / %d out", appaws.Int32(u.InputTokens), appaws.Int32(u.OutputTokens))
+	}
+	if m := output.Metrics; m != nil && m.LatencyMs != nil {
+		stats += fmt.Sprintf(", latency: %dms", *m.LatencyMs)
+	}
+	return fmt.Sprintf("%s replied (%s):\n%s", modelID, stats, reply)
+}
@@ -0,0 +1,49 @@
+package foundationmodels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+)
+
+func TestFormatTestPromptResult(t *testing.T) {
+	output := &bedrockruntime.ConverseOutput{
+		Output: &types.ConverseOutputMemberMessage{Value: types.Message{
+			Content: []types.ContentBlock{&types.ContentBlockMemberText{Value: "  Working fine.  "}},
+		}},
+		StopReason: types.StopReasonEndTurn,
+		Usage:      &types.TokenUsage{InputTokens: aws.Int32(12), OutputTokens: aws.Int32(4)},
+		Metrics:    &types.ConverseMetrics{LatencyMs: aws.Int64(321)},
+	}
+
+	got := formatTestPromptResult("test.model-v1", output)
+	want := "test.model-v1 replied (stop: end_turn, tokens: 12 in / 4 out, latency: 321ms):\nWorking fine."
+	if got != want {
+		t.Errorf("formatTestPromptResult() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatTestPromptResult_Truncates(t *testing.T) {
+	output := &bedrockruntime.ConverseOutput{
+		Output: &types.ConverseOutputMemberMessage{Value: types.Message{
+			Content: []types.ContentBlock{&types.ContentBlockMemberText{Value: strings.Repeat("é", testPromptPreviewLen+10)}},
+		}},
+	}
+
+	got := formatTestPromptResult("m", output)
+	if !strings.HasSuffix(got, strings.Repeat("é", testPromptPreviewLen)+"...") {
+		t.Errorf("expected rune-safe truncation, got %q", got[len(got)-20:])
+	}
+}
+
+func TestValidatePrompt(t *testing.T) {
+	if err := validatePrompt("   "); err == nil {
+		t.Error("validatePrompt accepted a blank prompt")
+	}
+	if err := validatePrompt("hi"); err != nil {
+		t.Errorf("validatePrompt(\"hi\") = %v", err)
+	}
+}
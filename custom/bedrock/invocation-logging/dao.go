@@ -0,0 +1,162 @@
+package invocationlogging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// InvocationLoggingDAO provides data access for the region's Bedrock model
+// invocation logging configuration
+type InvocationLoggingDAO struct {
+	dao.BaseDAO
+	client *bedrock.Client
+	region string
+}
+
+// NewInvocationLoggingDAO creates a new InvocationLoggingDAO
+func NewInvocationLoggingDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &InvocationLoggingDAO{
+		BaseDAO: dao.NewBaseDAO("bedrock", "invocation-logging"),
+		client:  bedrock.NewFromConfig(cfg),
+		region:  cfg.Region,
+	}, nil
+}
+
+// List returns the logging configuration of the current region, including
+// when logging is disabled
+func (d *InvocationLoggingDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	r, err := d.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []dao.Resource{r}, nil
+}
+
+// Get returns the logging configuration; the ID is the region
+func (d *InvocationLoggingDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return d.load(ctx)
+}
+
+func (d *InvocationLoggingDAO) load(ctx context.Context) (*InvocationLoggingResource, error) {
+	output, err := d.client.GetModelInvocationLoggingConfiguration(ctx, &bedrock.GetModelInvocationLoggingConfigurationInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get model invocation logging configuration")
+	}
+	return NewInvocationLoggingResource(d.region, output.LoggingConfig), nil
+}
+
+func (d *InvocationLoggingDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for bedrock invocation logging")
+}
+
+// Supports returns supported operations
+func (d *InvocationLoggingDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// InvocationLoggingResource wraps a region's invocation logging configuration.
+// Item is nil when logging is not configured.
+type InvocationLoggingResource struct {
+	dao.BaseResource
+	Item *types.LoggingConfig
+}
+
+// NewInvocationLoggingResource creates a new InvocationLoggingResource
+func NewInvocationLoggingResource(region string, cfg *types.LoggingConfig) *InvocationLoggingResource {
+	return &InvocationLoggingResource{
+		BaseResource: dao.BaseResource{
+			ID:   region,
+			Name: region,
+			Data: cfg,
+		},
+		Item: cfg,
+	}
+}
+
+// Enabled reports whether logs are delivered anywhere
+func (r *InvocationLoggingResource) Enabled() bool {
+	return r.LogGroup() != "" || r.S3Destination() != ""
+}
+
+// LogGroup returns the CloudWatch Logs destination
+func (r *InvocationLoggingResource) LogGroup() string {
+	if r.Item == nil || r.Item.CloudWatchConfig == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.CloudWatchConfig.LogGroupName)
+}
+
+// LogRole returns the role Bedrock assumes to write to CloudWatch Logs
+func (r *InvocationLoggingResource) LogRole() string {
+	if r.Item == nil || r.Item.CloudWatchConfig == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.CloudWatchConfig.RoleArn)
+}
+
+// LargeDataDestination returns the S3 location for payloads too large for CloudWatch
+func (r *InvocationLoggingResource) LargeDataDestination() string {
+	if r.Item == nil || r.Item.CloudWatchConfig == nil {
+		return ""
+	}
+	return s3URI(r.Item.CloudWatchConfig.LargeDataDeliveryS3Config)
+}
+
+// S3Destination returns the S3 destination as s3://bucket/prefix
+func (r *InvocationLoggingResource) S3Destination() string {
+	if r.Item == nil {
+		return ""
+	}
+	return s3URI(r.Item.S3Config)
+}
+
+// DataTypes returns the kinds of data included in the logs
+func (r *InvocationLoggingResource) DataTypes() string {
+	if r.Item == nil {
+		return ""
+	}
+	var types []string
+	for _, t := range []struct {
+		name    string
+		enabled *bool
+	}{
+		{"Text", r.Item.TextDataDeliveryEnabled},
+		{"Image", r.Item.ImageDataDeliveryEnabled},
+		{"Embedding", r.Item.EmbeddingDataDeliveryEnabled},
+		{"Video", r.Item.VideoDataDeliveryEnabled},
+		{"Audio", r.Item.AudioDataDeliveryEnabled},
+	} {
+		if t.enabled != nil && *t.enabled {
+			types = append(types, t.name)
+		}
+	}
+	return strings.Join(types, ", ")
+}
+
+func s3URI(cfg *types.S3Config) string {
+	if cfg == nil || appaws.Str(cfg.BucketName) == "" {
+		return ""
+	}
+	uri := "s3://" + appaws.Str(cfg.BucketName)
+	if prefix := appaws.Str(cfg.KeyPrefix); prefix != "" {
+		uri += "/" + prefix
+	}
+	return uri
+}
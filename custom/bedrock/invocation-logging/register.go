@@ -0,0 +1,20 @@
+package invocationlogging
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("bedrock", "invocation-logging", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewInvocationLoggingDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewInvocationLoggingRenderer()
+		},
+	})
+}
@@ -0,0 +1,140 @@
+package invocationlogging
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// InvocationLoggingRenderer renders Bedrock model invocation logging configuration
+var (
+	_ render.Navigator = (*InvocationLoggingRenderer)(nil)
+	_ render.LogSource = (*InvocationLoggingRenderer)(nil)
+)
+
+type InvocationLoggingRenderer struct {
+	render.BaseRenderer
+}
+
+// NewInvocationLoggingRenderer creates a new InvocationLoggingRenderer
+func NewInvocationLoggingRenderer() render.Renderer {
+	return &InvocationLoggingRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "bedrock",
+			Resource: "invocation-logging",
+			Cols: []render.Column{
+				{Name: "REGION", Width: 16, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 10, Getter: getStatus},
+				{Name: "LOG GROUP", Width: 40, Getter: getLogGroup},
+				{Name: "S3", Width: 40, Getter: getS3},
+				{Name: "DATA", Width: 30, Getter: getDataTypes},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if l, ok := r.(*InvocationLoggingResource); ok {
+		if l.Enabled() {
+			return "Enabled"
+		}
+		return "Disabled"
+	}
+	return ""
+}
+
+func getLogGroup(r dao.Resource) string {
+	if l, ok := r.(*InvocationLoggingResource); ok {
+		return l.LogGroup()
+	}
+	return ""
+}
+
+func getS3(r dao.Resource) string {
+	if l, ok := r.(*InvocationLoggingResource); ok {
+		return l.S3Destination()
+	}
+	return ""
+}
+
+func getDataTypes(r dao.Resource) string {
+	if l, ok := r.(*InvocationLoggingResource); ok {
+		return l.DataTypes()
+	}
+	return ""
+}
+
+// RenderDetail renders the invocation logging configuration
+func (r *InvocationLoggingRenderer) RenderDetail(resource dao.Resource) string {
+	l, ok := resource.(*InvocationLoggingResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Bedrock Invocation Logging", l.GetID())
+
+	d.Section("Status")
+	d.Field("Region", l.GetID())
+	if !l.Enabled() {
+		d.Field("Status", "Disabled")
+		d.DimIndent("Model invocations in this region are not logged")
+		return d.String()
+	}
+	d.Field("Status", "Enabled")
+	if types := l.DataTypes(); types != "" {
+		d.Field("Data Types", types)
+	}
+
+	if group := l.LogGroup(); group != "" {
+		d.Section("CloudWatch Logs")
+		d.Field("Log Group", group)
+		if role := l.LogRole(); role != "" {
+			d.Field("Role", role)
+		}
+		if large := l.LargeDataDestination(); large != "" {
+			d.Field("Large Data (S3)", large)
+		}
+	}
+
+	if dest := l.S3Destination(); dest != "" {
+		d.Section("S3")
+		d.Field("Destination", dest)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *InvocationLoggingRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	l, ok := resource.(*InvocationLoggingResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Region", Value: l.GetID()},
+		{Label: "Status", Value: getStatus(l)},
+	}
+	if group := l.LogGroup(); group != "" {
+		fields = append(fields, render.SummaryField{Label: "Log Group", Value: group})
+	}
+	if dest := l.S3Destination(); dest != "" {
+		fields = append(fields, render.SummaryField{Label: "S3", Value: dest})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *InvocationLoggingRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	return nil
+}
+
+// LogTargets returns the CloudWatch log group invocations are delivered to
+func (r *InvocationLoggingRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	l, ok := resource.(*InvocationLoggingResource)
+	if !ok || l.LogGroup() == "" {
+		return nil
+	}
+	return []render.LogTarget{{Group: l.LogGroup()}}
+}
@@ -0,0 +1,51 @@
+package invocationlogging
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+func TestInvocationLoggingResource_Disabled(t *testing.T) {
+	r := NewInvocationLoggingResource("us-east-1", nil)
+	if r.Enabled() {
+		t.Error("Enabled() = true for a nil configuration")
+	}
+	if targets := NewInvocationLoggingRenderer().(render.LogSource).LogTargets(r); len(targets) != 0 {
+		t.Errorf("LogTargets() = %v, want none when disabled", targets)
+	}
+}
+
+func TestInvocationLoggingResource_Destinations(t *testing.T) {
+	r := NewInvocationLoggingResource("us-east-1", &types.LoggingConfig{
+		CloudWatchConfig: &types.CloudWatchConfig{
+			LogGroupName:              aws.String("/bedrock/invocations"),
+			LargeDataDeliveryS3Config: &types.S3Config{BucketName: aws.String("large")},
+		},
+		S3Config:                     &types.S3Config{BucketName: aws.String("logs"), KeyPrefix: aws.String("bedrock")},
+		TextDataDeliveryEnabled:      aws.Bool(true),
+		ImageDataDeliveryEnabled:     aws.Bool(false),
+		EmbeddingDataDeliveryEnabled: aws.Bool(true),
+	})
+
+	if !r.Enabled() {
+		t.Error("Enabled() = false")
+	}
+	if got := r.S3Destination(); got != "s3://logs/bedrock" {
+		t.Errorf("S3Destination() = %q", got)
+	}
+	if got := r.LargeDataDestination(); got != "s3://large" {
+		t.Errorf("LargeDataDestination() = %q", got)
+	}
+	if got := r.DataTypes(); got != "Text, Embedding" {
+		t.Errorf("DataTypes() = %q", got)
+	}
+
+	targets := NewInvocationLoggingRenderer().(render.LogSource).LogTargets(r)
+	if len(targets) != 1 || targets[0].Group != "/bedrock/invocations" {
+		t.Errorf("LogTargets() = %v", targets)
+	}
+}
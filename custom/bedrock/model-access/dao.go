@@ -0,0 +1,211 @@
+package modelaccess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// availabilityConcurrency bounds parallel GetFoundationModelAvailability calls
+const availabilityConcurrency = 8
+
+// Access states derived from the availability checks
+const (
+	AccessGranted    = "Granted"
+	AccessPending    = "Pending"
+	AccessNotGranted = "Not granted"
+	AccessUnknown    = "Unknown"
+)
+
+// ModelAccessDAO provides data access for Bedrock model access status
+type ModelAccessDAO struct {
+	dao.BaseDAO
+	client *bedrock.Client
+}
+
+// NewModelAccessDAO creates a new ModelAccessDAO
+func NewModelAccessDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ModelAccessDAO{
+		BaseDAO: dao.NewBaseDAO("bedrock", "model-access"),
+		client:  bedrock.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns every foundation model with its access status. Availability is
+// checked per model; a failed check leaves the model listed as Unknown.
+func (d *ModelAccessDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	output, err := d.client.ListFoundationModels(ctx, &bedrock.ListFoundationModelsInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "list foundation models")
+	}
+
+	resources := make([]dao.Resource, len(output.ModelSummaries))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(availabilityConcurrency)
+	for i, model := range output.ModelSummaries {
+		g.Go(func() error {
+			r := NewModelAccessResource(model)
+			r.Availability, r.Err = d.availability(gctx, appaws.Str(model.ModelId))
+			if r.Err != nil {
+				log.Debug("model availability check failed", "model", r.GetID(), "error", r.Err)
+			}
+			resources[i] = r
+			return nil // tolerate per-model failures
+		})
+	}
+	_ = g.Wait()
+
+	return resources, nil
+}
+
+func (d *ModelAccessDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetFoundationModel(ctx, &bedrock.GetFoundationModelInput{
+		ModelIdentifier: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get foundation model %s", id)
+	}
+	m := output.ModelDetails
+	r := NewModelAccessResource(types.FoundationModelSummary{
+		ModelId:                 m.ModelId,
+		ModelArn:                m.ModelArn,
+		ModelName:               m.ModelName,
+		ProviderName:            m.ProviderName,
+		InferenceTypesSupported: m.InferenceTypesSupported,
+		ModelLifecycle:          m.ModelLifecycle,
+	})
+	r.Availability, r.Err = d.availability(ctx, id)
+	return r, nil
+}
+
+func (d *ModelAccessDAO) availability(ctx context.Context, id string) (*bedrock.GetFoundationModelAvailabilityOutput, error) {
+	output, err := d.client.GetFoundationModelAvailability(ctx, &bedrock.GetFoundationModelAvailabilityInput{
+		ModelId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get foundation model availability %s", id)
+	}
+	return output, nil
+}
+
+func (d *ModelAccessDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for bedrock model access")
+}
+
+// Supports returns supported operations
+func (d *ModelAccessDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModelAccessResource is a foundation model with its access status
+type ModelAccessResource struct {
+	dao.BaseResource
+	Item         types.FoundationModelSummary
+	Availability *bedrock.GetFoundationModelAvailabilityOutput
+	Err          error // Availability check error, if any
+}
+
+// NewModelAccessResource creates a new ModelAccessResource
+func NewModelAccessResource(model types.FoundationModelSummary) *ModelAccessResource {
+	return &ModelAccessResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(model.ModelId),
+			Name: appaws.Str(model.ModelName),
+			ARN:  appaws.Str(model.ModelArn),
+			Data: model,
+		},
+		Item: model,
+	}
+}
+
+// Provider returns the model provider name
+func (r *ModelAccessResource) Provider() string {
+	return appaws.Str(r.Item.ProviderName)
+}
+
+// Access summarizes the availability checks: Granted only when the principal
+// is authorized, the agreement and entitlement are in place, and the model is
+// offered in the region
+func (r *ModelAccessResource) Access() string {
+	a := r.Availability
+	if a == nil {
+		return AccessUnknown
+	}
+	if a.AgreementAvailability != nil && a.AgreementAvailability.Status == types.AgreementStatusPending {
+		return AccessPending
+	}
+	if a.AuthorizationStatus == types.AuthorizationStatusAuthorized &&
+		a.EntitlementAvailability == types.EntitlementAvailabilityAvailable &&
+		a.RegionAvailability == types.RegionAvailabilityAvailable &&
+		r.Agreement() == string(types.AgreementStatusAvailable) {
+		return AccessGranted
+	}
+	return AccessNotGranted
+}
+
+// Authorization returns AUTHORIZED or NOT_AUTHORIZED
+func (r *ModelAccessResource) Authorization() string {
+	if r.Availability == nil {
+		return ""
+	}
+	return string(r.Availability.AuthorizationStatus)
+}
+
+// Agreement returns the marketplace agreement status
+func (r *ModelAccessResource) Agreement() string {
+	if r.Availability == nil || r.Availability.AgreementAvailability == nil {
+		return ""
+	}
+	return string(r.Availability.AgreementAvailability.Status)
+}
+
+// AgreementError returns the agreement error message, if any
+func (r *ModelAccessResource) AgreementError() string {
+	if r.Availability == nil || r.Availability.AgreementAvailability == nil {
+		return ""
+	}
+	return appaws.Str(r.Availability.AgreementAvailability.ErrorMessage)
+}
+
+// Entitlement returns the entitlement availability
+func (r *ModelAccessResource) Entitlement() string {
+	if r.Availability == nil {
+		return ""
+	}
+	return string(r.Availability.EntitlementAvailability)
+}
+
+// Region returns whether the model is offered in the current region
+func (r *ModelAccessResource) Region() string {
+	if r.Availability == nil {
+		return ""
+	}
+	return string(r.Availability.RegionAvailability)
+}
+
+// InferenceTypes returns the supported inference types
+func (r *ModelAccessResource) InferenceTypes() string {
+	strs := make([]string, len(r.Item.InferenceTypesSupported))
+	for i, t := range r.Item.InferenceTypesSupported {
+		strs[i] = string(t)
+	}
+	return strings.Join(strs, ", ")
+}
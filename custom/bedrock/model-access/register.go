@@ -0,0 +1,20 @@
+package modelaccess
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("bedrock", "model-access", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewModelAccessDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewModelAccessRenderer()
+		},
+	})
+}
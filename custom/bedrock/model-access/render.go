@@ -0,0 +1,122 @@
+package modelaccess
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ModelAccessRenderer renders Bedrock model access status
+// Ensure ModelAccessRenderer implements render.Navigator
+var _ render.Navigator = (*ModelAccessRenderer)(nil)
+
+type ModelAccessRenderer struct {
+	render.BaseRenderer
+}
+
+// NewModelAccessRenderer creates a new ModelAccessRenderer
+func NewModelAccessRenderer() render.Renderer {
+	return &ModelAccessRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "bedrock",
+			Resource: "model-access",
+			Cols: []render.Column{
+				{Name: "MODEL ID", Width: 45, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "PROVIDER", Width: 15, Getter: getProvider},
+				{Name: "ACCESS", Width: 12, Getter: getAccess},
+				{Name: "AUTHORIZATION", Width: 15, Getter: getAuthorization},
+				{Name: "AGREEMENT", Width: 14, Getter: getAgreement},
+				{Name: "ENTITLEMENT", Width: 14, Getter: getEntitlement},
+			},
+		},
+	}
+}
+
+func getProvider(r dao.Resource) string {
+	if m, ok := r.(*ModelAccessResource); ok {
+		return m.Provider()
+	}
+	return ""
+}
+
+func getAccess(r dao.Resource) string {
+	if m, ok := r.(*ModelAccessResource); ok {
+		return m.Access()
+	}
+	return ""
+}
+
+func getAuthorization(r dao.Resource) string {
+	if m, ok := r.(*ModelAccessResource); ok {
+		return m.Authorization()
+	}
+	return ""
+}
+
+func getAgreement(r dao.Resource) string {
+	if m, ok := r.(*ModelAccessResource); ok {
+		return m.Agreement()
+	}
+	return ""
+}
+
+func getEntitlement(r dao.Resource) string {
+	if m, ok := r.(*ModelAccessResource); ok {
+		return m.Entitlement()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed model access information
+func (r *ModelAccessRenderer) RenderDetail(resource dao.Resource) string {
+	m, ok := resource.(*ModelAccessResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Bedrock Model Access", m.GetName())
+
+	d.Section("Model")
+	d.Field("Name", m.GetName())
+	d.Field("Model ID", m.GetID())
+	d.Field("Provider", m.Provider())
+	if inference := m.InferenceTypes(); inference != "" {
+		d.Field("Inference Types", inference)
+	}
+
+	d.Section("Access")
+	d.Field("Access", m.Access())
+	if m.Err != nil {
+		d.Field("Check Failed", m.Err.Error())
+	} else {
+		d.Field("Authorization", m.Authorization())
+		d.Field("Agreement", m.Agreement())
+		if msg := m.AgreementError(); msg != "" {
+			d.Field("Agreement Error", msg)
+		}
+		d.Field("Entitlement", m.Entitlement())
+		d.Field("Region", m.Region())
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ModelAccessRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	m, ok := resource.(*ModelAccessResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Model ID", Value: m.GetID()},
+		{Label: "Provider", Value: m.Provider()},
+		{Label: "Access", Value: m.Access()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ModelAccessRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	return nil
+}
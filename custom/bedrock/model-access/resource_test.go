@@ -0,0 +1,43 @@
+package modelaccess
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+)
+
+func availability(auth types.AuthorizationStatus, agreement types.AgreementStatus, entitlement types.EntitlementAvailability, region types.RegionAvailability) *bedrock.GetFoundationModelAvailabilityOutput {
+	return &bedrock.GetFoundationModelAvailabilityOutput{
+		AuthorizationStatus:     auth,
+		AgreementAvailability:   &types.AgreementAvailability{Status: agreement},
+		EntitlementAvailability: entitlement,
+		RegionAvailability:      region,
+	}
+}
+
+func TestModelAccessResource_Access(t *testing.T) {
+	tests := []struct {
+		name  string
+		avail *bedrock.GetFoundationModelAvailabilityOutput
+		want  string
+	}{
+		{"unchecked", nil, AccessUnknown},
+		{"granted", availability(types.AuthorizationStatusAuthorized, types.AgreementStatusAvailable, types.EntitlementAvailabilityAvailable, types.RegionAvailabilityAvailable), AccessGranted},
+		{"agreement pending", availability(types.AuthorizationStatusAuthorized, types.AgreementStatusPending, types.EntitlementAvailabilityAvailable, types.RegionAvailabilityAvailable), AccessPending},
+		{"not authorized", availability(types.AuthorizationStatusNotAuthorized, types.AgreementStatusAvailable, types.EntitlementAvailabilityAvailable, types.RegionAvailabilityAvailable), AccessNotGranted},
+		{"no agreement", availability(types.AuthorizationStatusAuthorized, types.AgreementStatusNotAvailable, types.EntitlementAvailabilityAvailable, types.RegionAvailabilityAvailable), AccessNotGranted},
+		{"not in region", availability(types.AuthorizationStatusAuthorized, types.AgreementStatusAvailable, types.EntitlementAvailabilityAvailable, types.RegionAvailabilityNotAvailable), AccessNotGranted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewModelAccessResource(types.FoundationModelSummary{ModelId: aws.String("anthropic.test-v1")})
+			r.Availability = tt.avail
+			if got := r.Access(); got != tt.want {
+				t.Errorf("Access() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package provisionedthroughputs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ProvisionedThroughputDAO provides data access for Bedrock Provisioned Throughput
+type ProvisionedThroughputDAO struct {
+	dao.BaseDAO
+	client *bedrock.Client
+}
+
+// NewProvisionedThroughputDAO creates a new ProvisionedThroughputDAO
+func NewProvisionedThroughputDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ProvisionedThroughputDAO{
+		BaseDAO: dao.NewBaseDAO("bedrock", "provisioned-throughputs"),
+		client:  bedrock.NewFromConfig(cfg),
+	}, nil
+}
+
+func (d *ProvisionedThroughputDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	summaries, err := appaws.Paginate(ctx, func(token *string) ([]types.ProvisionedModelSummary, *string, error) {
+		output, err := d.client.ListProvisionedModelThroughputs(ctx, &bedrock.ListProvisionedModelThroughputsInput{
+			NextToken:  token,
+			MaxResults: appaws.Int32Ptr(100),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list provisioned model throughputs")
+		}
+		return output.ProvisionedModelSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(summaries))
+	for i, s := range summaries {
+		resources[i] = NewProvisionedThroughputResource(s)
+	}
+	return resources, nil
+}
+
+func (d *ProvisionedThroughputDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetProvisionedModelThroughput(ctx, &bedrock.GetProvisionedModelThroughputInput{
+		ProvisionedModelId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get provisioned model throughput %s", id)
+	}
+
+	r := NewProvisionedThroughputResource(types.ProvisionedModelSummary{
+		CommitmentDuration:       output.CommitmentDuration,
+		CommitmentExpirationTime: output.CommitmentExpirationTime,
+		CreationTime:             output.CreationTime,
+		DesiredModelArn:          output.DesiredModelArn,
+		DesiredModelUnits:        output.DesiredModelUnits,
+		FoundationModelArn:       output.FoundationModelArn,
+		LastModifiedTime:         output.LastModifiedTime,
+		ModelArn:                 output.ModelArn,
+		ModelUnits:               output.ModelUnits,
+		ProvisionedModelArn:      output.ProvisionedModelArn,
+		ProvisionedModelName:     output.ProvisionedModelName,
+		Status:                   output.Status,
+	})
+	r.FailureMessage = appaws.Str(output.FailureMessage)
+	return r, nil
+}
+
+func (d *ProvisionedThroughputDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for bedrock provisioned throughput")
+}
+
+// Supports returns supported operations
+func (d *ProvisionedThroughputDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProvisionedThroughputResource wraps a Bedrock Provisioned Throughput
+type ProvisionedThroughputResource struct {
+	dao.BaseResource
+	Item           types.ProvisionedModelSummary
+	FailureMessage string // Only set by Get
+}
+
+// NewProvisionedThroughputResource creates a new ProvisionedThroughputResource
+func NewProvisionedThroughputResource(s types.ProvisionedModelSummary) *ProvisionedThroughputResource {
+	arn := appaws.Str(s.ProvisionedModelArn)
+	id := arn
+	if parsed := appaws.ParseARN(arn); parsed != nil && parsed.ResourceID != "" {
+		id = parsed.ResourceID
+	}
+	return &ProvisionedThroughputResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: appaws.Str(s.ProvisionedModelName),
+			ARN:  arn,
+			Data: s,
+		},
+		Item: s,
+	}
+}
+
+// Status returns the provisioned throughput status
+func (r *ProvisionedThroughputResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// Model returns the model ID the throughput serves, from its ARN
+func (r *ProvisionedThroughputResource) Model() string {
+	return modelID(appaws.Str(r.Item.ModelArn))
+}
+
+// FoundationModel returns the base foundation model ID
+func (r *ProvisionedThroughputResource) FoundationModel() string {
+	return modelID(appaws.Str(r.Item.FoundationModelArn))
+}
+
+// Units returns allocated model units, with the desired count while updating
+func (r *ProvisionedThroughputResource) Units() string {
+	units := appaws.Int32(r.Item.ModelUnits)
+	desired := appaws.Int32(r.Item.DesiredModelUnits)
+	if desired != 0 && desired != units {
+		return fmt.Sprintf("%d → %d", units, desired)
+	}
+	return fmt.Sprintf("%d", units)
+}
+
+// Commitment returns the commitment term, or "No commitment"
+func (r *ProvisionedThroughputResource) Commitment() string {
+	if r.Item.CommitmentDuration == "" {
+		return "No commitment"
+	}
+	return string(r.Item.CommitmentDuration)
+}
+
+// CommitmentExpiration returns when the commitment term ends
+func (r *ProvisionedThroughputResource) CommitmentExpiration() *time.Time {
+	return r.Item.CommitmentExpirationTime
+}
+
+// CreatedAt returns the creation time
+func (r *ProvisionedThroughputResource) CreatedAt() *time.Time {
+	return r.Item.CreationTime
+}
+
+// modelID returns the trailing model ID of a foundation or custom model ARN
+func modelID(arn string) string {
+	if parsed := appaws.ParseARN(arn); parsed != nil && parsed.ResourceID != "" {
+		return parsed.ResourceID
+	}
+	return arn
+}
@@ -0,0 +1,20 @@
+package provisionedthroughputs
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("bedrock", "provisioned-throughputs", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewProvisionedThroughputDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewProvisionedThroughputRenderer()
+		},
+	})
+}
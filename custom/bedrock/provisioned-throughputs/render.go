@@ -0,0 +1,144 @@
+package provisionedthroughputs
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ProvisionedThroughputRenderer renders Bedrock Provisioned Throughput resources
+// Ensure ProvisionedThroughputRenderer implements render.Navigator
+var _ render.Navigator = (*ProvisionedThroughputRenderer)(nil)
+
+type ProvisionedThroughputRenderer struct {
+	render.BaseRenderer
+}
+
+// NewProvisionedThroughputRenderer creates a new ProvisionedThroughputRenderer
+func NewProvisionedThroughputRenderer() render.Renderer {
+	return &ProvisionedThroughputRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "bedrock",
+			Resource: "provisioned-throughputs",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATUS", Width: 10, Getter: getPTStatus},
+				{Name: "MODEL", Width: 40, Getter: getPTModel},
+				{Name: "UNITS", Width: 8, Getter: getPTUnits},
+				{Name: "COMMITMENT", Width: 14, Getter: getPTCommitment},
+				{Name: "EXPIRES", Width: 12, Getter: getPTExpires},
+				{Name: "AGE", Width: 10, Getter: getPTAge},
+			},
+		},
+	}
+}
+
+func getPTStatus(r dao.Resource) string {
+	if pt, ok := r.(*ProvisionedThroughputResource); ok {
+		return pt.Status()
+	}
+	return ""
+}
+
+func getPTModel(r dao.Resource) string {
+	if pt, ok := r.(*ProvisionedThroughputResource); ok {
+		return pt.Model()
+	}
+	return ""
+}
+
+func getPTUnits(r dao.Resource) string {
+	if pt, ok := r.(*ProvisionedThroughputResource); ok {
+		return pt.Units()
+	}
+	return ""
+}
+
+func getPTCommitment(r dao.Resource) string {
+	if pt, ok := r.(*ProvisionedThroughputResource); ok {
+		return pt.Commitment()
+	}
+	return ""
+}
+
+func getPTExpires(r dao.Resource) string {
+	if pt, ok := r.(*ProvisionedThroughputResource); ok {
+		if exp := pt.CommitmentExpiration(); exp != nil {
+			return exp.Format("2006-01-02")
+		}
+	}
+	return "-"
+}
+
+func getPTAge(r dao.Resource) string {
+	if pt, ok := r.(*ProvisionedThroughputResource); ok {
+		if created := pt.CreatedAt(); created != nil {
+			return render.FormatAge(*created)
+		}
+	}
+	return "-"
+}
+
+// RenderDetail renders detailed provisioned throughput information
+func (r *ProvisionedThroughputRenderer) RenderDetail(resource dao.Resource) string {
+	pt, ok := resource.(*ProvisionedThroughputResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Bedrock Provisioned Throughput", pt.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", pt.GetName())
+	d.Field("ID", pt.GetID())
+	d.Field("Status", pt.Status())
+	if pt.FailureMessage != "" {
+		d.Field("Failure", pt.FailureMessage)
+	}
+	d.Field("ARN", pt.GetARN())
+
+	d.Section("Model")
+	d.Field("Model", pt.Model())
+	if base := pt.FoundationModel(); base != "" && base != pt.Model() {
+		d.Field("Base Model", base)
+	}
+	d.Field("Model Units", pt.Units())
+
+	d.Section("Commitment")
+	d.Field("Term", pt.Commitment())
+	if exp := pt.CommitmentExpiration(); exp != nil {
+		d.Field("Expires", exp.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Timestamps")
+	if created := pt.CreatedAt(); created != nil {
+		d.Field("Created", created.Format("2006-01-02 15:04:05"))
+	}
+	if modified := pt.Item.LastModifiedTime; modified != nil {
+		d.Field("Last Modified", modified.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ProvisionedThroughputRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	pt, ok := resource.(*ProvisionedThroughputResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: pt.GetName()},
+		{Label: "Status", Value: pt.Status()},
+		{Label: "Model", Value: pt.Model()},
+		{Label: "Units", Value: pt.Units()},
+		{Label: "Commitment", Value: pt.Commitment()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ProvisionedThroughputRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	return nil
+}
@@ -0,0 +1,111 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	cfn "github.com/clawscli/claws/custom/cloudformation"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("cloudformation", "events", []action.Action{
+		{
+			Name:      "Cancel Update",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelUpdateStack",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				er, ok := r.(*EventResource)
+				return ok && er.StackStatus == types.StackStatusUpdateInProgress
+			},
+		},
+		{
+			Name:      "Continue Rollback",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "ContinueUpdateRollback",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				er, ok := r.(*EventResource)
+				return ok && er.StackStatus == types.StackStatusUpdateRollbackFailed
+			},
+			Prompts: []action.Prompt{
+				{
+					Label:   "Resources to skip (comma-separated logical IDs, empty for none)",
+					Default: defaultResourcesToSkip,
+				},
+			},
+		},
+	})
+
+	action.RegisterExecutor("cloudformation", "events", executeEventAction)
+}
+
+func executeEventAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	er, ok := resource.(*EventResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	stackName := appaws.Str(er.Item.StackName)
+	if stackName == "" {
+		return action.FailResult(fmt.Errorf("event has no stack name"))
+	}
+
+	switch act.Operation {
+	case "CancelUpdateStack":
+		return executeCancelUpdate(ctx, stackName)
+	case "ContinueUpdateRollback":
+		return executeContinueRollback(ctx, stackName, act.Input(0))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// defaultResourcesToSkip pre-fills the selected resource when its rollback failed
+func defaultResourcesToSkip(r dao.Resource) string {
+	er, ok := r.(*EventResource)
+	if !ok || er.IsStackEvent() {
+		return ""
+	}
+	if er.Item.ResourceStatus == types.ResourceStatusUpdateFailed {
+		return er.GetName()
+	}
+	return ""
+}
+
+func executeCancelUpdate(ctx context.Context, stackName string) action.ActionResult {
+	client, err := cfn.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.CancelUpdateStack(ctx, &cloudformation.CancelUpdateStackInput{
+		StackName: &stackName,
+	})
+	if err != nil {
+		return action.FailResultf(err, "cancel update stack %s", stackName)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Update cancelled for stack %s; rollback events follow", stackName))
+}
+
+func executeContinueRollback(ctx context.Context, stackName, skip string) action.ActionResult {
+	resourcesToSkip := cfn.SplitLogicalIDs(skip)
+	if err := cfn.ContinueUpdateRollback(ctx, stackName, resourcesToSkip); err != nil {
+		return action.FailResult(err)
+	}
+
+	msg := fmt.Sprintf("Rollback continued for stack %s", stackName)
+	if len(resourcesToSkip) > 0 {
+		msg += fmt.Sprintf(" (skipping %s)", strings.Join(resourcesToSkip, ", "))
+	}
+	return action.SuccessResult(msg)
+}
@@ -3,6 +3,8 @@ package events
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -10,6 +12,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // EventDAO provides data access for CloudFormation stack events
@@ -41,6 +44,10 @@ func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
 // Implements dao.PaginatedDAO interface.
 // Note: DescribeStackEvents API does not support MaxResults, so pageSize is ignored.
 // The API controls page size internally.
+//
+// The first page moves the root-cause failure of the latest operation to the top.
+// With the "ByResource" toggle, events of the latest operation are grouped into one
+// row per logical resource.
 func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
 	// Get stack name from filter context
 	stackName := dao.GetFilterFromContext(ctx, "StackName")
@@ -48,6 +55,83 @@ func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 		return nil, "", fmt.Errorf("stack name filter required")
 	}
 
+	if dao.GetFilterFromContext(ctx, "ByResource") == "true" {
+		return d.listByResource(ctx, stackName)
+	}
+
+	events, nextToken, err := d.describeEvents(ctx, stackName, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resources := make([]dao.Resource, 0, len(events))
+	for _, event := range events {
+		resources = append(resources, NewEventResource(event))
+	}
+
+	if pageToken == "" {
+		stackStatus := d.stackStatus(ctx, stackName)
+		for _, r := range resources {
+			r.(*EventResource).StackStatus = stackStatus
+		}
+		if i := RootCauseIndex(events[:OperationStart(events)+1]); i >= 0 {
+			root := resources[i]
+			root.(*EventResource).RootCause = true
+			resources = slices.Insert(slices.Delete(resources, i, i+1), 0, root)
+		}
+	}
+
+	return resources, nextToken, nil
+}
+
+// maxGroupPages bounds how far back grouping looks for the start of the latest operation
+const maxGroupPages = 10
+
+// listByResource groups the latest operation's events by logical resource
+func (d *EventDAO) listByResource(ctx context.Context, stackName string) ([]dao.Resource, string, error) {
+	var events []types.StackEvent
+	token := ""
+	for page := 0; page < maxGroupPages; page++ {
+		pageEvents, next, err := d.describeEvents(ctx, stackName, token)
+		if err != nil {
+			return nil, "", err
+		}
+		events = append(events, pageEvents...)
+		if next == "" || OperationStart(events) < len(events)-1 {
+			break
+		}
+		token = next
+	}
+	events = events[:OperationStart(events)+1]
+
+	stackStatus := d.stackStatus(ctx, stackName)
+	rootCause := ""
+	if i := RootCauseIndex(events); i >= 0 {
+		rootCause = appaws.Str(events[i].EventId)
+	}
+
+	groups := GroupByResource(events)
+	resources := make([]dao.Resource, 0, len(groups))
+	for _, g := range groups {
+		g.StackStatus = stackStatus
+		g.RootCause = g.rootCauseID == rootCause && rootCause != ""
+		resources = append(resources, g)
+	}
+	// Root cause first, then most recent activity
+	slices.SortStableFunc(resources, func(a, b dao.Resource) int {
+		ra, rb := a.(*EventResource).RootCause, b.(*EventResource).RootCause
+		switch {
+		case ra && !rb:
+			return -1
+		case rb && !ra:
+			return 1
+		}
+		return 0
+	})
+	return resources, "", nil
+}
+
+func (d *EventDAO) describeEvents(ctx context.Context, stackName, pageToken string) ([]types.StackEvent, string, error) {
 	// Events are returned in reverse chronological order by default
 	// Note: This API does not support MaxResults parameter
 	input := &cloudformation.DescribeStackEventsInput{
@@ -62,17 +146,26 @@ func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 		return nil, "", apperrors.Wrap(err, "describe stack events")
 	}
 
-	resources := make([]dao.Resource, 0, len(output.StackEvents))
-	for _, event := range output.StackEvents {
-		resources = append(resources, NewEventResource(event))
-	}
-
 	nextToken := ""
 	if output.NextToken != nil {
 		nextToken = *output.NextToken
 	}
+	return output.StackEvents, nextToken, nil
+}
 
-	return resources, nextToken, nil
+// stackStatus returns the current stack status, used to offer recovery actions
+func (d *EventDAO) stackStatus(ctx context.Context, stackName string) types.StackStatus {
+	output, err := d.client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: &stackName,
+	})
+	if err != nil {
+		log.Warn("failed to describe stack for events", "stack", stackName, "error", err)
+		return ""
+	}
+	if len(output.Stacks) == 0 {
+		return ""
+	}
+	return output.Stacks[0].StackStatus
 }
 
 func (d *EventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -97,6 +190,17 @@ func (d *EventDAO) Supports(op dao.Operation) bool {
 type EventResource struct {
 	dao.BaseResource
 	Item types.StackEvent
+
+	// StackStatus is the stack's current status (first page only)
+	StackStatus types.StackStatus
+	// RootCause marks the first failure of the latest operation
+	RootCause bool
+	// Events is the number of grouped events (ByResource view only)
+	Events int
+	// LastFailure is the most recent failure reason of a grouped resource
+	LastFailure string
+
+	rootCauseID string
 }
 
 // NewEventResource creates a new EventResource
@@ -130,3 +234,88 @@ func (r *EventResource) StatusReason() string {
 func (r *EventResource) PhysicalResourceId() string {
 	return appaws.Str(r.Item.PhysicalResourceId)
 }
+
+// IsStackEvent reports whether the event is about the stack itself
+func (r *EventResource) IsStackEvent() bool {
+	return isStackEvent(r.Item)
+}
+
+// Reason returns the status reason, or the latest failure reason for grouped rows
+func (r *EventResource) Reason() string {
+	if r.LastFailure != "" {
+		return r.LastFailure
+	}
+	return r.StatusReason()
+}
+
+func isStackEvent(e types.StackEvent) bool {
+	return appaws.Str(e.ResourceType) == "AWS::CloudFormation::Stack" &&
+		appaws.Str(e.LogicalResourceId) == appaws.Str(e.StackName)
+}
+
+func isFailed(e types.StackEvent) bool {
+	return strings.HasSuffix(string(e.ResourceStatus), "_FAILED")
+}
+
+// isCancellation reports failures that only follow from another resource failing
+func isCancellation(e types.StackEvent) bool {
+	return strings.Contains(strings.ToLower(appaws.Str(e.ResourceStatusReason)), "cancelled")
+}
+
+// OperationStart returns the index of the event that started the latest stack
+// operation in newest-first events, or the last index if it isn't in the slice
+func OperationStart(events []types.StackEvent) int {
+	for i, e := range events {
+		if !isStackEvent(e) {
+			continue
+		}
+		switch e.ResourceStatus {
+		case types.ResourceStatusCreateInProgress, types.ResourceStatusUpdateInProgress,
+			types.ResourceStatusDeleteInProgress, types.ResourceStatusImportInProgress:
+			return i
+		}
+	}
+	return len(events) - 1
+}
+
+// RootCauseIndex returns the index of the earliest resource failure in
+// newest-first events, skipping cancellations caused by other failures, or -1
+func RootCauseIndex(events []types.StackEvent) int {
+	root := -1
+	for i, e := range events {
+		if !isFailed(e) || isStackEvent(e) {
+			continue
+		}
+		if isCancellation(e) {
+			continue
+		}
+		root = i
+	}
+	return root
+}
+
+// GroupByResource collapses newest-first events into one row per logical
+// resource, keeping the latest event and the most recent failure reason
+func GroupByResource(events []types.StackEvent) []*EventResource {
+	var groups []*EventResource
+	byID := make(map[string]*EventResource)
+	for _, e := range events {
+		logicalID := appaws.Str(e.LogicalResourceId)
+		g, ok := byID[logicalID]
+		if !ok {
+			g = NewEventResource(e)
+			g.ID = logicalID
+			byID[logicalID] = g
+			groups = append(groups, g)
+		}
+		g.Events++
+		if isFailed(e) && g.LastFailure == "" {
+			g.LastFailure = appaws.Str(e.ResourceStatusReason)
+		}
+		if isFailed(e) && !isCancellation(e) {
+			// Oldest failure wins, matching RootCauseIndex
+			g.rootCauseID = appaws.Str(e.EventId)
+		}
+	}
+	return groups
+}
@@ -1,9 +1,12 @@
 package events
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
@@ -64,11 +67,41 @@ func NewEventRenderer() render.Renderer {
 					},
 					Priority: 3,
 				},
+				{
+					Name:  "REASON",
+					Width: 60,
+					Getter: func(r dao.Resource) string {
+						if er, ok := r.(*EventResource); ok {
+							return reasonColumn(er)
+						}
+						return ""
+					},
+					Priority: 4,
+				},
 			},
 		},
 	}
 }
 
+// reasonColumn flags the root cause and shows grouped event counts
+func reasonColumn(er *EventResource) string {
+	reason := er.Reason()
+	if er.Events > 1 {
+		reason = fmt.Sprintf("(%d events) %s", er.Events, reason)
+	}
+	if er.RootCause {
+		reason = "ROOT CAUSE: " + reason
+	}
+	return reason
+}
+
+// ListToggles switches between the event timeline and one row per resource
+func (r *EventRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "b", ContextKey: "ByResource", LabelOn: "by resource", LabelOff: "timeline"},
+	}
+}
+
 // RenderDetail renders detailed event information
 func (r *EventRenderer) RenderDetail(resource dao.Resource) string {
 	er, ok := resource.(*EventResource)
@@ -91,11 +124,34 @@ func (r *EventRenderer) RenderDetail(resource dao.Resource) string {
 	}
 
 	d.FieldIf("Physical Resource ID", er.Item.PhysicalResourceId)
+	if er.Events > 0 {
+		d.Field("Events In Operation", fmt.Sprintf("%d", er.Events))
+	}
+
+	if er.RootCause {
+		d.Section("Root Cause")
+		d.FieldStyled("Failure", "first resource failure of the latest operation", ui.DangerStyle())
+	}
 
 	if er.StatusReason() != "" {
 		d.Section("Status Reason")
 		d.Line("  " + er.StatusReason())
 	}
+	if er.LastFailure != "" && er.LastFailure != er.StatusReason() {
+		d.Section("Last Failure Reason")
+		d.Line("  " + er.LastFailure)
+	}
+
+	if er.StackStatus != "" {
+		d.Section("Stack")
+		d.FieldStyled("Current Status", string(er.StackStatus), cfnResourceStatusColorer(string(er.StackStatus)))
+		switch er.StackStatus {
+		case types.StackStatusUpdateInProgress:
+			d.DimIndent("Update in progress; use Cancel Update to roll back")
+		case types.StackStatusUpdateRollbackFailed:
+			d.DimIndent("Rollback is stuck; use Continue Rollback, optionally skipping failed resources")
+		}
+	}
 
 	d.FieldIf("Stack Name", er.Item.StackName)
 	d.FieldIf("Client Request Token", er.Item.ClientRequestToken)
@@ -123,7 +179,7 @@ func (r *EventRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 		})
 	}
 
-	if reason := er.StatusReason(); reason != "" {
+	if reason := er.Reason(); reason != "" {
 		if len(reason) > 80 {
 			reason = reason[:77] + "..."
 		}
@@ -0,0 +1,98 @@
+package events
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func stackEvent(id, logicalID, resType string, status types.ResourceStatus, reason string) types.StackEvent {
+	return types.StackEvent{
+		EventId:              aws.String(id),
+		StackName:            aws.String("app"),
+		LogicalResourceId:    aws.String(logicalID),
+		ResourceType:         aws.String(resType),
+		ResourceStatus:       status,
+		ResourceStatusReason: aws.String(reason),
+	}
+}
+
+// failedUpdate is a newest-first update where Queue failed and Bucket was cancelled
+func failedUpdate() []types.StackEvent {
+	return []types.StackEvent{
+		stackEvent("9", "app", "AWS::CloudFormation::Stack", types.ResourceStatus("UPDATE_ROLLBACK_IN_PROGRESS"), "The following resource(s) failed to update: [Queue]."),
+		stackEvent("8", "Bucket", "AWS::S3::Bucket", types.ResourceStatusUpdateFailed, "Resource update cancelled"),
+		stackEvent("7", "Queue", "AWS::SQS::Queue", types.ResourceStatusUpdateFailed, "Access denied"),
+		stackEvent("6", "Bucket", "AWS::S3::Bucket", types.ResourceStatusUpdateInProgress, ""),
+		stackEvent("5", "Queue", "AWS::SQS::Queue", types.ResourceStatusUpdateInProgress, ""),
+		stackEvent("4", "app", "AWS::CloudFormation::Stack", types.ResourceStatusUpdateInProgress, "User Initiated"),
+		stackEvent("3", "Queue", "AWS::SQS::Queue", types.ResourceStatusCreateFailed, "Old failure"),
+	}
+}
+
+func TestOperationStart(t *testing.T) {
+	if got := OperationStart(failedUpdate()); got != 5 {
+		t.Errorf("OperationStart = %d, want 5", got)
+	}
+	partial := failedUpdate()[:3]
+	if got := OperationStart(partial); got != 2 {
+		t.Errorf("OperationStart without start event = %d, want last index", got)
+	}
+	if got := OperationStart(nil); got != -1 {
+		t.Errorf("OperationStart(nil) = %d, want -1", got)
+	}
+}
+
+func TestRootCauseIndex(t *testing.T) {
+	events := failedUpdate()
+	if got := RootCauseIndex(events[:OperationStart(events)+1]); got != 2 {
+		t.Errorf("RootCauseIndex = %d, want 2 (Queue)", got)
+	}
+	noFailures := events[3:6]
+	if got := RootCauseIndex(noFailures); got != -1 {
+		t.Errorf("RootCauseIndex = %d, want -1", got)
+	}
+}
+
+func TestGroupByResource(t *testing.T) {
+	events := failedUpdate()
+	groups := GroupByResource(events[:OperationStart(events)+1])
+	if len(groups) != 3 {
+		t.Fatalf("groups = %d, want 3", len(groups))
+	}
+
+	bucket, queue := groups[1], groups[2]
+	if bucket.GetID() != "Bucket" || bucket.Events != 2 || bucket.LastFailure != "Resource update cancelled" {
+		t.Errorf("bucket group = %s %d %q", bucket.GetID(), bucket.Events, bucket.LastFailure)
+	}
+	if queue.rootCauseID != "7" {
+		t.Errorf("queue rootCauseID = %q, want 7", queue.rootCauseID)
+	}
+	if bucket.rootCauseID != "" {
+		t.Errorf("cancelled failures should not be root causes, got %q", bucket.rootCauseID)
+	}
+}
+
+func TestReasonColumn(t *testing.T) {
+	er := NewEventResource(failedUpdate()[2])
+	er.RootCause = true
+	if got := reasonColumn(er); got != "ROOT CAUSE: Access denied" {
+		t.Errorf("reasonColumn = %q", got)
+	}
+
+	grouped := GroupByResource(failedUpdate()[1:4])
+	if got := reasonColumn(grouped[0]); !strings.HasPrefix(got, "(2 events) ") {
+		t.Errorf("grouped reasonColumn = %q", got)
+	}
+}
+
+func TestDefaultResourcesToSkip(t *testing.T) {
+	if got := defaultResourcesToSkip(NewEventResource(failedUpdate()[2])); got != "Queue" {
+		t.Errorf("defaultResourcesToSkip = %q, want Queue", got)
+	}
+	if got := defaultResourcesToSkip(NewEventResource(failedUpdate()[0])); got != "" {
+		t.Errorf("stack event should not be skipped, got %q", got)
+	}
+}
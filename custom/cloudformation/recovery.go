@@ -0,0 +1,38 @@
+package cloudformation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ContinueUpdateRollback resumes a rollback stuck in UPDATE_ROLLBACK_FAILED,
+// optionally skipping resources that cannot be rolled back
+func ContinueUpdateRollback(ctx context.Context, stackName string, resourcesToSkip []string) error {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.ContinueUpdateRollback(ctx, &cloudformation.ContinueUpdateRollbackInput{
+		StackName:       &stackName,
+		ResourcesToSkip: resourcesToSkip,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "continue update rollback for %s", stackName)
+	}
+	return nil
+}
+
+// SplitLogicalIDs parses a comma-separated list of logical resource IDs
+func SplitLogicalIDs(s string) []string {
+	var ids []string
+	for _, id := range strings.Split(s, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
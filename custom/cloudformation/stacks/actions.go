@@ -3,8 +3,10 @@ package stacks
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 
 	cfn "github.com/clawscli/claws/custom/cloudformation"
 	"github.com/clawscli/claws/internal/action"
@@ -34,6 +36,24 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "CancelUpdateStack",
 			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				sr, ok := r.(*StackResource)
+				return ok && sr.Item.StackStatus == types.StackStatusUpdateInProgress
+			},
+		},
+		{
+			Name:      "Continue Rollback",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "ContinueUpdateRollback",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				sr, ok := r.(*StackResource)
+				return ok && sr.Item.StackStatus == types.StackStatusUpdateRollbackFailed
+			},
+			Prompts: []action.Prompt{
+				{Label: "Resources to skip (comma-separated logical IDs, empty for none)"},
+			},
 		},
 	})
 
@@ -50,6 +70,8 @@ func executeStackAction(ctx context.Context, act action.Action, resource dao.Res
 		return executeDetectStackDrift(ctx, resource)
 	case "CancelUpdateStack":
 		return executeCancelUpdateStack(ctx, resource)
+	case "ContinueUpdateRollback":
+		return executeContinueUpdateRollback(ctx, resource, act.Input(0))
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -124,3 +146,18 @@ func executeCancelUpdateStack(ctx context.Context, resource dao.Resource) action
 		Message: fmt.Sprintf("Update cancelled for stack %s", stackName),
 	}
 }
+
+func executeContinueUpdateRollback(ctx context.Context, resource dao.Resource, skip string) action.ActionResult {
+	stackName := resource.GetName()
+	resourcesToSkip := cfn.SplitLogicalIDs(skip)
+
+	if err := cfn.ContinueUpdateRollback(ctx, stackName, resourcesToSkip); err != nil {
+		return action.FailResult(err)
+	}
+
+	msg := fmt.Sprintf("Rollback continued for stack %s", stackName)
+	if len(resourcesToSkip) > 0 {
+		msg += fmt.Sprintf(" (skipping %s)", strings.Join(resourcesToSkip, ", "))
+	}
+	return action.SuccessResult(msg)
+}
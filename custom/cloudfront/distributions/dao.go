@@ -90,6 +90,7 @@ func (d *DistributionDAO) Get(ctx context.Context, id string) (dao.Resource, err
 		summary.Aliases = dist.DistributionConfig.Aliases
 		summary.Comment = dist.DistributionConfig.Comment
 		summary.DefaultCacheBehavior = dist.DistributionConfig.DefaultCacheBehavior
+		summary.CacheBehaviors = dist.DistributionConfig.CacheBehaviors
 		summary.WebACLId = dist.DistributionConfig.WebACLId
 	}
 
@@ -322,3 +323,67 @@ func (r *DistributionResource) LastModifiedTime() string {
 func (r *DistributionResource) InProgressInvalidationBatches() int32 {
 	return r.InProgressInvalidations
 }
+
+// Function association kinds
+const (
+	KindCloudFrontFunction = "CloudFront Function"
+	KindLambdaEdge         = "Lambda@Edge"
+)
+
+// FunctionAssociation is a function attached to a cache behavior event
+type FunctionAssociation struct {
+	PathPattern string // "Default (*)" for the default cache behavior
+	EventType   string
+	Kind        string
+	ARN         string
+}
+
+// FunctionName returns the function name from the association ARN, without a Lambda version suffix
+func (a FunctionAssociation) FunctionName() string {
+	name := appaws.ExtractResourceName(a.ARN)
+	if a.Kind == KindLambdaEdge {
+		// arn:aws:lambda:us-east-1:123456789012:function:name:3
+		parts := strings.Split(a.ARN, ":")
+		if len(parts) >= 7 {
+			name = parts[6]
+		}
+	}
+	return name
+}
+
+// FunctionAssociations returns functions attached to each cache behavior, default behavior first
+func (r *DistributionResource) FunctionAssociations() []FunctionAssociation {
+	var assocs []FunctionAssociation
+	add := func(path string, fns *types.FunctionAssociations, lambdas *types.LambdaFunctionAssociations) {
+		if fns != nil {
+			for _, fa := range fns.Items {
+				assocs = append(assocs, FunctionAssociation{
+					PathPattern: path,
+					EventType:   string(fa.EventType),
+					Kind:        KindCloudFrontFunction,
+					ARN:         appaws.Str(fa.FunctionARN),
+				})
+			}
+		}
+		if lambdas != nil {
+			for _, la := range lambdas.Items {
+				assocs = append(assocs, FunctionAssociation{
+					PathPattern: path,
+					EventType:   string(la.EventType),
+					Kind:        KindLambdaEdge,
+					ARN:         appaws.Str(la.LambdaFunctionARN),
+				})
+			}
+		}
+	}
+
+	if dcb := r.Item.DefaultCacheBehavior; dcb != nil {
+		add("Default (*)", dcb.FunctionAssociations, dcb.LambdaFunctionAssociations)
+	}
+	if r.Item.CacheBehaviors != nil {
+		for _, cb := range r.Item.CacheBehaviors.Items {
+			add(appaws.Str(cb.PathPattern), cb.FunctionAssociations, cb.LambdaFunctionAssociations)
+		}
+	}
+	return assocs
+}
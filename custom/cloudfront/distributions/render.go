@@ -133,6 +133,20 @@ func (r *DistributionRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Default Root Object", dist.DefaultRootObject)
 	}
 
+	// Function Associations
+	if assocs := dist.FunctionAssociations(); len(assocs) > 0 {
+		d.Section("Function Associations")
+		styles := d.Styles()
+		path := ""
+		for _, a := range assocs {
+			if a.PathPattern != path {
+				path = a.PathPattern
+				d.Line("  " + styles.Label.Render(path))
+			}
+			d.Line("    " + styles.Value.Render(a.EventType) + "  " + a.Kind + ": " + a.FunctionName() + styles.Dim.Render("  "+a.ARN))
+		}
+	}
+
 	// SSL/TLS Certificate
 	if dist.ViewerCertificate != nil {
 		vc := dist.ViewerCertificate
@@ -260,6 +274,30 @@ func (r *DistributionRenderer) RenderSummary(resource dao.Resource) []render.Sum
 
 // Navigations returns navigation shortcuts
 func (r *DistributionRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	// No navigations for now
-	return nil
+	dist, ok := resource.(*DistributionResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	var hasFunction, hasLambda bool
+	for _, a := range dist.FunctionAssociations() {
+		switch {
+		case a.Kind == KindCloudFrontFunction && !hasFunction:
+			hasFunction = true
+			navs = append(navs, render.Navigation{
+				Key: "f", Label: "Function", Service: "cloudfront", Resource: "functions",
+				FilterField: "Name", FilterValue: a.FunctionName(),
+			})
+		case a.Kind == KindLambdaEdge && !hasLambda:
+			// Lambda@Edge functions live in us-east-1
+			hasLambda = true
+			navs = append(navs, render.Navigation{
+				Key: "l", Label: "Lambda@Edge", Service: "lambda", Resource: "functions",
+				FilterField: "FunctionName", FilterValue: a.FunctionName(),
+			})
+		}
+	}
+
+	return navs
 }
@@ -0,0 +1,44 @@
+package distributions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+func TestDistributionResource_FunctionAssociations(t *testing.T) {
+	dist := NewDistributionResource(types.DistributionSummary{
+		Id: aws.String("E2EXAMPLE"),
+		DefaultCacheBehavior: &types.DefaultCacheBehavior{
+			FunctionAssociations: &types.FunctionAssociations{Items: []types.FunctionAssociation{
+				{EventType: types.EventTypeViewerRequest, FunctionARN: aws.String("arn:aws:cloudfront::123456789012:function/rewrite-index")},
+			}},
+		},
+		CacheBehaviors: &types.CacheBehaviors{Items: []types.CacheBehavior{
+			{
+				PathPattern: aws.String("/api/*"),
+				LambdaFunctionAssociations: &types.LambdaFunctionAssociations{Items: []types.LambdaFunctionAssociation{
+					{EventType: types.EventTypeOriginRequest, LambdaFunctionARN: aws.String("arn:aws:lambda:us-east-1:123456789012:function:auth-edge:7")},
+				}},
+			},
+		}},
+	})
+
+	got := dist.FunctionAssociations()
+	want := []FunctionAssociation{
+		{PathPattern: "Default (*)", EventType: "viewer-request", Kind: KindCloudFrontFunction, ARN: "arn:aws:cloudfront::123456789012:function/rewrite-index"},
+		{PathPattern: "/api/*", EventType: "origin-request", Kind: KindLambdaEdge, ARN: "arn:aws:lambda:us-east-1:123456789012:function:auth-edge:7"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FunctionAssociations() = %+v, want %+v", got, want)
+	}
+
+	if name := got[0].FunctionName(); name != "rewrite-index" {
+		t.Errorf("FunctionName() = %q, want rewrite-index", name)
+	}
+	if name := got[1].FunctionName(); name != "auth-edge" {
+		t.Errorf("FunctionName() = %q, want auth-edge (version stripped)", name)
+	}
+}
@@ -0,0 +1,109 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// sampleViewerRequest is a minimal viewer-request event used as the default test input
+const sampleViewerRequest = `{"version":"1.0","context":{"eventType":"viewer-request"},"viewer":{"ip":"198.51.100.11"},"request":{"method":"GET","uri":"/index.html","querystring":{},"headers":{"host":{"value":"example.com"}},"cookies":{}}}`
+
+func init() {
+	action.Global.Register("cloudfront", "functions", []action.Action{
+		{
+			Name:      "Test Function",
+			Shortcut:  "t",
+			Type:      action.ActionTypeAPI,
+			Operation: "TestFunction",
+			Prompts: []action.Prompt{
+				{Label: "Stage", Options: func(context.Context, dao.Resource) ([]string, error) {
+					return []string{string(types.FunctionStageDevelopment), string(types.FunctionStageLive)}, nil
+				}},
+				{Label: "Event object (JSON)", Default: func(dao.Resource) string { return sampleViewerRequest }, Validate: validateEventObject},
+			},
+		},
+	})
+
+	action.RegisterExecutor("cloudfront", "functions", executeFunctionAction)
+}
+
+func executeFunctionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "TestFunction":
+		return executeTestFunction(ctx, resource, types.FunctionStage(act.Input(0)), act.Input(1))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func validateEventObject(value string) error {
+	if !json.Valid([]byte(value)) {
+		return fmt.Errorf("event object must be valid JSON")
+	}
+	return nil
+}
+
+func executeTestFunction(ctx context.Context, resource dao.Resource, stage types.FunctionStage, event string) action.ActionResult {
+	fn, ok := resource.(*FunctionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if stage == "" || event == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := fn.GetName()
+
+	// TestFunction requires the ETag of the stage being tested
+	desc, err := client.DescribeFunction(ctx, &cloudfront.DescribeFunctionInput{
+		Name:  &name,
+		Stage: stage,
+	})
+	if err != nil {
+		return action.FailResultf(err, "describe function %s (%s)", name, stage)
+	}
+
+	output, err := client.TestFunction(ctx, &cloudfront.TestFunctionInput{
+		Name:        &name,
+		Stage:       stage,
+		IfMatch:     desc.ETag,
+		EventObject: []byte(event),
+	})
+	if err != nil {
+		return action.FailResultf(err, "test function %s", name)
+	}
+	if output.TestResult == nil {
+		return action.FailResult(fmt.Errorf("test function %s: empty result", name))
+	}
+
+	result := output.TestResult
+	if msg := appaws.Str(result.FunctionErrorMessage); msg != "" {
+		return action.FailResult(fmt.Errorf("function error: %s", msg))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Tested %s (%s) Compute: %s%% Output: %s",
+		name, stage, appaws.Str(result.ComputeUtilization), truncateOutput(appaws.Str(result.FunctionOutput))))
+}
+
+// truncateOutput shortens the function output to fit the status line
+func truncateOutput(output string) string {
+	output = strings.Join(strings.Fields(output), " ")
+	if len(output) > 100 {
+		return output[:100] + "..."
+	}
+	return output
+}
@@ -0,0 +1,24 @@
+package functions
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateEventObject(t *testing.T) {
+	if err := validateEventObject(sampleViewerRequest); err != nil {
+		t.Errorf("sample event should be valid: %v", err)
+	}
+	if err := validateEventObject(`{"version":`); err == nil {
+		t.Error("expected error for malformed JSON")
+	}
+}
+
+func TestTruncateOutput(t *testing.T) {
+	if got := truncateOutput("{\n  \"request\": {}\n}"); got != `{ "request": {} }` {
+		t.Errorf("truncateOutput() = %q", got)
+	}
+	if got := truncateOutput(strings.Repeat("x", 150)); len(got) != 103 {
+		t.Errorf("truncateOutput() length = %d, want 103", len(got))
+	}
+}
@@ -0,0 +1,173 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FunctionDAO provides data access for CloudFront Functions
+type FunctionDAO struct {
+	dao.BaseDAO
+	client *cloudfront.Client
+}
+
+// NewFunctionDAO creates a new FunctionDAO
+func NewFunctionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &FunctionDAO{
+		BaseDAO: dao.NewBaseDAO("cloudfront", "functions"),
+		client:  client,
+	}, nil
+}
+
+// List returns all CloudFront Functions. A published function is listed once, in its LIVE stage.
+func (d *FunctionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	functions, err := appaws.Paginate(ctx, func(token *string) ([]types.FunctionSummary, *string, error) {
+		output, err := d.client.ListFunctions(ctx, &cloudfront.ListFunctionsInput{
+			Marker:   token,
+			MaxItems: appaws.Int32Ptr(100),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list functions")
+		}
+		if output.FunctionList == nil {
+			return nil, nil, nil
+		}
+		return output.FunctionList.Items, output.FunctionList.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []dao.Resource
+	index := map[string]int{}
+	for _, fn := range functions {
+		r := NewFunctionResource(fn)
+		if i, ok := index[r.GetID()]; ok {
+			if r.Stage() == string(types.FunctionStageLive) {
+				resources[i] = r
+			}
+			continue
+		}
+		index[r.GetID()] = len(resources)
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// Get returns a function with its code
+func (d *FunctionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeFunction(ctx, &cloudfront.DescribeFunctionInput{
+		Name: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe function %s", id)
+	}
+	if output.FunctionSummary == nil {
+		return nil, fmt.Errorf("function not found: %s", id)
+	}
+
+	r := NewFunctionResource(*output.FunctionSummary)
+
+	code, err := d.client.GetFunction(ctx, &cloudfront.GetFunctionInput{
+		Name:  &id,
+		Stage: output.FunctionSummary.FunctionMetadata.Stage,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get function code %s", id)
+	}
+	r.Code = string(code.FunctionCode)
+
+	return r, nil
+}
+
+func (d *FunctionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for cloudfront functions")
+}
+
+// Supports returns supported operations
+func (d *FunctionDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// FunctionResource represents a CloudFront Function
+type FunctionResource struct {
+	dao.BaseResource
+	Item types.FunctionSummary
+
+	// Code holds the function source (set by Get)
+	Code string
+}
+
+// NewFunctionResource creates a new FunctionResource
+func NewFunctionResource(fn types.FunctionSummary) *FunctionResource {
+	name := appaws.Str(fn.Name)
+	var arn string
+	if fn.FunctionMetadata != nil {
+		arn = appaws.Str(fn.FunctionMetadata.FunctionARN)
+	}
+
+	return &FunctionResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  arn,
+			Tags: make(map[string]string),
+			Data: fn,
+		},
+		Item: fn,
+	}
+}
+
+// Status returns the function status (e.g., UNPUBLISHED, DEPLOYED)
+func (r *FunctionResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// Stage returns the function stage (DEVELOPMENT or LIVE)
+func (r *FunctionResource) Stage() string {
+	if r.Item.FunctionMetadata == nil {
+		return ""
+	}
+	return string(r.Item.FunctionMetadata.Stage)
+}
+
+// Runtime returns the function runtime (e.g., cloudfront-js-2.0)
+func (r *FunctionResource) Runtime() string {
+	if r.Item.FunctionConfig == nil {
+		return ""
+	}
+	return string(r.Item.FunctionConfig.Runtime)
+}
+
+// Comment returns the function comment
+func (r *FunctionResource) Comment() string {
+	if r.Item.FunctionConfig == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.FunctionConfig.Comment)
+}
+
+func getClient(ctx context.Context) (*cloudfront.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cloudfront.NewFromConfig(cfg), nil
+}
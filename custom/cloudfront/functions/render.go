@@ -0,0 +1,131 @@
+package functions
+
+import (
+	"strings"
+	"time"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// FunctionRenderer renders CloudFront Functions
+type FunctionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewFunctionRenderer creates a new FunctionRenderer
+func NewFunctionRenderer() *FunctionRenderer {
+	return &FunctionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudfront",
+			Resource: "functions",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "STAGE", Width: 12, Getter: getStage},
+				{Name: "RUNTIME", Width: 18, Getter: getRuntime},
+				{Name: "MODIFIED", Width: 10, Getter: getModified},
+				{Name: "COMMENT", Width: 30, Getter: getComment},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if fn, ok := r.(*FunctionResource); ok {
+		return fn.Status()
+	}
+	return ""
+}
+
+func getStage(r dao.Resource) string {
+	if fn, ok := r.(*FunctionResource); ok {
+		return fn.Stage()
+	}
+	return ""
+}
+
+func getRuntime(r dao.Resource) string {
+	if fn, ok := r.(*FunctionResource); ok {
+		return fn.Runtime()
+	}
+	return ""
+}
+
+func getModified(r dao.Resource) string {
+	if fn, ok := r.(*FunctionResource); ok && fn.Item.FunctionMetadata != nil && fn.Item.FunctionMetadata.LastModifiedTime != nil {
+		return render.FormatAge(*fn.Item.FunctionMetadata.LastModifiedTime)
+	}
+	return ""
+}
+
+func getComment(r dao.Resource) string {
+	if fn, ok := r.(*FunctionResource); ok {
+		return fn.Comment()
+	}
+	return ""
+}
+
+// RenderDetail renders function details and code
+func (r *FunctionRenderer) RenderDetail(resource dao.Resource) string {
+	fn, ok := resource.(*FunctionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudFront Function", fn.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", fn.GetName())
+	d.Field("ARN", fn.GetARN())
+	d.Field("Status", fn.Status())
+	d.Field("Stage", fn.Stage())
+	d.Field("Runtime", fn.Runtime())
+	if comment := fn.Comment(); comment != "" {
+		d.Field("Comment", comment)
+	}
+
+	if cfg := fn.Item.FunctionConfig; cfg != nil && cfg.KeyValueStoreAssociations != nil && len(cfg.KeyValueStoreAssociations.Items) > 0 {
+		d.Section("Key Value Stores")
+		for _, kvs := range cfg.KeyValueStoreAssociations.Items {
+			d.Line("  " + appaws.Str(kvs.KeyValueStoreARN))
+		}
+	}
+
+	if meta := fn.Item.FunctionMetadata; meta != nil {
+		d.Section("Timestamps")
+		if meta.CreatedTime != nil {
+			d.Field("Created", meta.CreatedTime.Format(time.RFC3339))
+		}
+		if meta.LastModifiedTime != nil {
+			d.Field("Last Modified", meta.LastModifiedTime.Format(time.RFC3339))
+		}
+	}
+
+	if fn.Code != "" {
+		d.Section("Code")
+		for _, line := range strings.Split(strings.TrimRight(fn.Code, "\n"), "\n") {
+			d.Line("  " + line)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *FunctionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	fn, ok := resource.(*FunctionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: fn.GetName()},
+		{Label: "Status", Value: fn.Status()},
+		{Label: "Stage", Value: fn.Stage()},
+		{Label: "Runtime", Value: fn.Runtime()},
+	}
+}
@@ -3,6 +3,8 @@ package events
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
@@ -14,12 +16,11 @@ import (
 )
 
 // EventDAO provides data access for CloudTrail events.
+// It is paginated on demand rather than streamed: LookupEvents returns at
+// most 50 events per call and is throttled to about 2 calls per second.
 type EventDAO struct {
 	dao.BaseDAO
 	client *cloudtrail.Client
-	// Pagination state - CloudTrail requires same StartTime/EndTime for NextToken
-	paginationStartTime *time.Time
-	paginationEndTime   *time.Time
 }
 
 // NewEventDAO creates a new EventDAO.
@@ -44,13 +45,15 @@ func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
 // ListPage returns a page of CloudTrail events for the last 24 hours.
 // Implements dao.PaginatedDAO interface.
 func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
-	// CloudTrail requires same StartTime/EndTime for pagination
-	// Reset time range on first page, reuse for subsequent pages
+	// CloudTrail requires same StartTime/EndTime for pagination, so the time
+	// range of the first page travels in the page token
+	startTime, endTime, nextToken, err := parsePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
 	if pageToken == "" {
-		endTime := time.Now()
-		startTime := endTime.Add(-24 * time.Hour)
-		d.paginationStartTime = &startTime
-		d.paginationEndTime = &endTime
+		endTime = time.Now()
+		startTime = endTime.Add(-24 * time.Hour)
 	}
 
 	// CloudTrail LookupEvents MaxResults is capped at 50
@@ -60,12 +63,12 @@ func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 	}
 
 	input := &cloudtrail.LookupEventsInput{
-		StartTime:  d.paginationStartTime,
-		EndTime:    d.paginationEndTime,
+		StartTime:  &startTime,
+		EndTime:    &endTime,
 		MaxResults: &maxResults,
 	}
-	if pageToken != "" {
-		input.NextToken = &pageToken
+	if nextToken != "" {
+		input.NextToken = &nextToken
 	}
 
 	output, err := d.client.LookupEvents(ctx, input)
@@ -78,12 +81,34 @@ func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 		resources[i] = NewEventResource(event)
 	}
 
-	nextToken := ""
-	if output.NextToken != nil {
-		nextToken = *output.NextToken
+	return resources, formatPageToken(startTime, endTime, appaws.Str(output.NextToken)), nil
+}
+
+// formatPageToken encodes the lookup time range with CloudTrail's NextToken,
+// or returns "" on the last page
+func formatPageToken(startTime, endTime time.Time, nextToken string) string {
+	if nextToken == "" {
+		return ""
 	}
+	return fmt.Sprintf("%d:%d:%s", startTime.UnixNano(), endTime.UnixNano(), nextToken)
+}
 
-	return resources, nextToken, nil
+// parsePageToken decodes a token from formatPageToken. An empty token yields
+// zero times.
+func parsePageToken(token string) (startTime, endTime time.Time, nextToken string, err error) {
+	if token == "" {
+		return time.Time{}, time.Time{}, "", nil
+	}
+	parts := strings.SplitN(token, ":", 3)
+	if len(parts) != 3 {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("invalid cloudtrail page token")
+	}
+	start, err1 := strconv.ParseInt(parts[0], 10, 64)
+	end, err2 := strconv.ParseInt(parts[1], 10, 64)
+	if err1 != nil || err2 != nil {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("invalid cloudtrail page token")
+	}
+	return time.Unix(0, start), time.Unix(0, end), parts[2], nil
 }
 
 // Get returns a specific event by ID.
@@ -0,0 +1,27 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPageToken(t *testing.T) {
+	end := time.Unix(1700000000, 123)
+	start := end.Add(-24 * time.Hour)
+
+	token := formatPageToken(start, end, "abc:def")
+	gotStart, gotEnd, next, err := parsePageToken(token)
+	if err != nil {
+		t.Fatalf("parsePageToken(%q) error = %v", token, err)
+	}
+	if !gotStart.Equal(start) || !gotEnd.Equal(end) || next != "abc:def" {
+		t.Errorf("parsePageToken(%q) = %v, %v, %q", token, gotStart, gotEnd, next)
+	}
+
+	if got := formatPageToken(start, end, ""); got != "" {
+		t.Errorf("formatPageToken() on last page = %q, want empty", got)
+	}
+	if _, _, _, err := parsePageToken("not-a-token"); err == nil {
+		t.Error("parsePageToken() accepted an invalid token")
+	}
+}
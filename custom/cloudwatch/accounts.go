@@ -0,0 +1,75 @@
+package cloudwatch
+
+import (
+	"sync"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// monitoringAccounts records accounts seen returning data owned by other
+// accounts, i.e. CloudWatch cross-account observability monitoring accounts.
+var monitoringAccounts sync.Map // account ID -> struct{}
+
+// currentAccount returns the selected account ID. Multi-profile views already
+// show a per-row ACCOUNT column, so cross-account detection is skipped there.
+func currentAccount() string {
+	if config.Global().IsMultiProfile() {
+		return ""
+	}
+	return config.Global().AccountID()
+}
+
+// NoteOwners marks the current account as a monitoring account if any of the
+// given owning account IDs belongs to another (linked source) account.
+func NoteOwners(owners ...string) {
+	current := currentAccount()
+	if current == "" {
+		return
+	}
+	for _, owner := range owners {
+		if owner != "" && owner != current {
+			monitoringAccounts.Store(current, struct{}{})
+			return
+		}
+	}
+}
+
+// IsMonitoringAccount reports whether the current account has returned
+// source-account data from a linked listing.
+func IsMonitoringAccount() bool {
+	current := currentAccount()
+	if current == "" {
+		return false
+	}
+	_, ok := monitoringAccounts.Load(current)
+	return ok
+}
+
+// IsSourceAccount reports whether owner is a linked source account rather
+// than the current account.
+func IsSourceAccount(owner string) bool {
+	current := currentAccount()
+	return owner != "" && current != "" && owner != current
+}
+
+// AccountFromARN returns the account ID of an ARN, or "" if it has none.
+func AccountFromARN(arn string) string {
+	if parsed := appaws.ParseARN(arn); parsed != nil {
+		return parsed.AccountID
+	}
+	return ""
+}
+
+// WithAccountColumn appends an ACCOUNT column to cols when the current
+// account is a monitoring account.
+func WithAccountColumn(cols []render.Column, owner func(dao.Resource) string) []render.Column {
+	if !IsMonitoringAccount() {
+		return cols
+	}
+	out := make([]render.Column, len(cols), len(cols)+1)
+	copy(out, cols)
+	return append(out, render.Column{Name: "ACCOUNT", Width: 14, Getter: owner})
+}
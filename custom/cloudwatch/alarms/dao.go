@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -35,7 +36,10 @@ func (d *AlarmDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	stateFilter := dao.GetFilterFromContext(ctx, "StateValue")
 
 	input := &cloudwatch.DescribeAlarmsInput{}
-	if stateFilter != "" {
+	if parent := dao.GetFilterFromContext(ctx, "ChildrenOfAlarmName"); parent != "" {
+		// ChildrenOfAlarmName cannot be combined with other parameters
+		input.ChildrenOfAlarmName = &parent
+	} else if stateFilter != "" {
 		input.StateValue = types.StateValue(stateFilter)
 	}
 
@@ -54,9 +58,13 @@ func (d *AlarmDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	}
 
 	resources := make([]dao.Resource, 0, len(allMetricAlarms)+len(allCompositeAlarms))
+	owners := make([]string, 0, len(allMetricAlarms))
 	for _, a := range allMetricAlarms {
-		resources = append(resources, NewMetricAlarmResource(a))
+		r := NewMetricAlarmResource(a)
+		resources = append(resources, r)
+		owners = append(owners, r.MetricAccount())
 	}
+	cwClient.NoteOwners(owners...)
 	for _, a := range allCompositeAlarms {
 		resources = append(resources, NewCompositeAlarmResource(a))
 	}
@@ -82,13 +90,40 @@ func (d *AlarmDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 
 	for _, a := range output.CompositeAlarms {
 		if appaws.Str(a.AlarmName) == id {
-			return NewCompositeAlarmResource(a), nil
+			r := NewCompositeAlarmResource(a)
+			states, err := d.childStates(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			r.ChildStates = states
+			return r, nil
 		}
 	}
 
 	return nil, fmt.Errorf("alarm not found: %s", id)
 }
 
+// childStates returns the current state of each alarm referenced by a composite alarm rule
+func (d *AlarmDAO) childStates(ctx context.Context, name string) (map[string]string, error) {
+	states := map[string]string{}
+	paginator := cloudwatch.NewDescribeAlarmsPaginator(d.client, &cloudwatch.DescribeAlarmsInput{
+		ChildrenOfAlarmName: &name,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe children of alarm %s", name)
+		}
+		for _, a := range output.MetricAlarms {
+			states[appaws.Str(a.AlarmName)] = string(a.StateValue)
+		}
+		for _, a := range output.CompositeAlarms {
+			states[appaws.Str(a.AlarmName)] = string(a.StateValue)
+		}
+	}
+	return states, nil
+}
+
 func (d *AlarmDAO) Delete(ctx context.Context, id string) error {
 	input := &cloudwatch.DeleteAlarmsInput{
 		AlarmNames: []string{id},
@@ -143,6 +178,9 @@ type AlarmResource struct {
 
 	MetricAlarmItem    *types.MetricAlarm
 	CompositeAlarmItem *types.CompositeAlarm
+
+	// ChildStates maps child alarm names to their current state (composite alarms, set by Get)
+	ChildStates map[string]string
 }
 
 func NewMetricAlarmResource(a types.MetricAlarm) *AlarmResource {
@@ -224,6 +262,18 @@ func NewCompositeAlarmResource(a types.CompositeAlarm) *AlarmResource {
 	return r
 }
 
+// MetricAccount returns the account whose metrics the alarm watches. Alarms in
+// a monitoring account can watch source-account metrics, set per query;
+// otherwise it is the alarm's own account.
+func (r *AlarmResource) MetricAccount() string {
+	for _, m := range r.Metrics {
+		if account := appaws.Str(m.AccountId); account != "" {
+			return account
+		}
+	}
+	return cwClient.AccountFromARN(r.GetARN())
+}
+
 func (r *AlarmResource) IsMetricAlarm() bool {
 	return r.AlarmType == "Metric"
 }
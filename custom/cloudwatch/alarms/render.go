@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 var _ render.Navigator = (*AlarmRenderer)(nil)
@@ -31,6 +33,19 @@ func NewAlarmRenderer() render.Renderer {
 	}
 }
 
+// Columns adds an ACCOUNT column in CloudWatch monitoring accounts
+func (r *AlarmRenderer) Columns() []render.Column {
+	return cwClient.WithAccountColumn(r.Cols, getAccount)
+}
+
+func getAccount(r dao.Resource) string {
+	alarm, ok := r.(*AlarmResource)
+	if !ok {
+		return ""
+	}
+	return alarm.MetricAccount()
+}
+
 func getName(r dao.Resource) string {
 	alarm, ok := r.(*AlarmResource)
 	if !ok {
@@ -106,6 +121,9 @@ func (r *AlarmRenderer) RenderDetail(resource dao.Resource) string {
 
 	if alarm.IsMetricAlarm() {
 		d.Section("Metric Configuration")
+		if account := alarm.MetricAccount(); cwClient.IsSourceAccount(account) {
+			d.Field("Source Account", account)
+		}
 		if alarm.Namespace != "" {
 			d.Field("Namespace", alarm.Namespace)
 		}
@@ -190,6 +208,17 @@ func (r *AlarmRenderer) RenderDetail(resource dao.Resource) string {
 		if alarm.ActionsSuppressorWaitPeriod > 0 {
 			d.Field("Suppressor Wait Period", fmt.Sprintf("%d seconds", alarm.ActionsSuppressorWaitPeriod))
 		}
+
+		if alarm.AlarmRule != "" {
+			d.Section("Rule Tree")
+			if tree, err := ParseAlarmRule(alarm.AlarmRule); err != nil {
+				d.DimIndent("Unable to parse rule: " + err.Error())
+			} else {
+				for _, line := range ruleTreeLines(tree, alarm.ChildStates, d.Styles()) {
+					d.Line("  " + line)
+				}
+			}
+		}
 	}
 
 	d.Section("Actions Configuration")
@@ -229,6 +258,71 @@ func (r *AlarmRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// ruleTreeLines renders a parsed alarm rule as an indented tree with each
+// referenced alarm's current state. states is nil until child states are loaded.
+func ruleTreeLines(root *RuleNode, states map[string]string, styles render.DetailStyles) []string {
+	alarmLine := func(label, name string) string {
+		state, ok := states[name]
+		switch {
+		case states == nil:
+			return label + "  " + styles.Dim.Render("loading...")
+		case !ok:
+			return label + "  " + styles.Dim.Render("unknown")
+		}
+		return label + "  " + renderAlarmState(state)
+	}
+
+	var lines []string
+	var walk func(n *RuleNode, prefix, childPrefix string)
+	walk = func(n *RuleNode, prefix, childPrefix string) {
+		var label string
+		children := n.Children
+		switch n.Op {
+		case RuleState:
+			label = alarmLine(styles.Value.Render(fmt.Sprintf("%s(%s)", n.State, n.Alarm)), n.Alarm)
+		case RuleAtLeast:
+			label = styles.Dim.Render(fmt.Sprintf("AT_LEAST %s of %d in %s", n.Threshold, len(n.Alarms), n.State))
+		default:
+			label = styles.Dim.Render(n.Op)
+		}
+		lines = append(lines, prefix+label)
+
+		var leaves []string
+		if n.Op == RuleAtLeast {
+			leaves = n.Alarms
+		}
+		total := len(children) + len(leaves)
+		for i := range total {
+			branch, indent := "├─ ", "│  "
+			if i == total-1 {
+				branch, indent = "└─ ", "   "
+			}
+			if i < len(children) {
+				walk(children[i], childPrefix+branch, childPrefix+indent)
+			} else {
+				name := leaves[i-len(children)]
+				lines = append(lines, childPrefix+branch+alarmLine(styles.Value.Render(name), name))
+			}
+		}
+	}
+	walk(root, "", "")
+	return lines
+}
+
+// renderAlarmState colors an alarm state value
+func renderAlarmState(state string) string {
+	switch state {
+	case "ALARM":
+		return ui.DangerStyle().Render(state)
+	case "OK":
+		return ui.SuccessStyle().Render(state)
+	case "INSUFFICIENT_DATA":
+		return ui.WarningStyle().Render(state)
+	default:
+		return ui.MutedStyle().Render(state)
+	}
+}
+
 func (r *AlarmRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	alarm, ok := resource.(*AlarmResource)
 	if !ok {
@@ -266,6 +360,17 @@ func (r *AlarmRenderer) Navigations(resource dao.Resource) []render.Navigation {
 
 	var navs []render.Navigation
 
+	if alarm.IsCompositeAlarm() && alarm.AlarmRule != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "C",
+			Label:       "Child Alarms",
+			Service:     "cloudwatch",
+			Resource:    "alarms",
+			FilterField: "ChildrenOfAlarmName",
+			FilterValue: alarm.GetName(),
+		})
+	}
+
 	if len(alarm.AlarmActions) > 0 && strings.Contains(alarm.AlarmActions[0], ":sns:") {
 		navs = append(navs, render.Navigation{
 			Key:         "t",
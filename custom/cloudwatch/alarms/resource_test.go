@@ -1,11 +1,14 @@
 package alarms
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/clawscli/claws/internal/render"
 )
 
 func TestNewMetricAlarmResource(t *testing.T) {
@@ -252,3 +255,139 @@ func TestAlarmResource_AlarmActions(t *testing.T) {
 		t.Errorf("InsufficientDataActions len = %d, want 3", len(resource.InsufficientDataActions))
 	}
 }
+
+// ruleString renders a parsed rule tree as a compact s-expression for comparison
+func ruleString(n *RuleNode) string {
+	switch n.Op {
+	case RuleState:
+		return n.State + "(" + n.Alarm + ")"
+	case RuleAtLeast:
+		return "AT_LEAST(" + n.Threshold + "," + n.State + "," + strings.Join(n.Alarms, "|") + ")"
+	case RuleTrue, RuleFalse:
+		return n.Op
+	}
+	parts := make([]string, len(n.Children))
+	for i, c := range n.Children {
+		parts[i] = ruleString(c)
+	}
+	return n.Op + "[" + strings.Join(parts, " ") + "]"
+}
+
+func TestParseAlarmRule(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"ALARM(a)", "ALARM(a)"},
+		{"ALARM(a) OR ALARM(b) OR OK(c)", "OR[ALARM(a) ALARM(b) OK(c)]"},
+		{"ALARM(a) AND ALARM(b) OR ALARM(c)", "OR[AND[ALARM(a) ALARM(b)] ALARM(c)]"},
+		{"ALARM(a) AND (ALARM(b) OR NOT OK(c))", "AND[ALARM(a) OR[ALARM(b) NOT[OK(c)]]]"},
+		{`ALARM("my alarm") AND INSUFFICIENT_DATA("x\"y")`, `AND[ALARM(my alarm) INSUFFICIENT_DATA(x"y)]`},
+		{"ALARM(arn:aws:cloudwatch:us-east-1:123456789012:alarm:cpu-high)", "ALARM(cpu-high)"},
+		{"AT_LEAST(2, NOT OK, (a, \"b\", c)) AND TRUE", "AND[AT_LEAST(2,NOT OK,a|b|c) TRUE]"},
+		{"AT_LEAST(50%, ALARM, (a, b))", "AT_LEAST(50%,ALARM,a|b)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			node, err := ParseAlarmRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseAlarmRule() error = %v", err)
+			}
+			if got := ruleString(node); got != tt.want {
+				t.Errorf("ParseAlarmRule() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAlarmRule_Errors(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"ALARM(a",
+		"ALARM(a) AND",
+		"ALARM(a) ALARM(b)",
+		"FOO(a)",
+		"AT_LEAST(2, MAYBE, (a))",
+	} {
+		if _, err := ParseAlarmRule(rule); err == nil {
+			t.Errorf("ParseAlarmRule(%q) expected error", rule)
+		}
+	}
+}
+
+func TestRuleNode_ReferencedAlarms(t *testing.T) {
+	node, err := ParseAlarmRule("ALARM(a) AND (OK(b) OR AT_LEAST(1, ALARM, (c, a)))")
+	if err != nil {
+		t.Fatalf("ParseAlarmRule() error = %v", err)
+	}
+	got := node.ReferencedAlarms()
+	want := []string{"a", "b", "c"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ReferencedAlarms() = %v, want %v", got, want)
+	}
+}
+
+func TestRuleTreeLines(t *testing.T) {
+	node, err := ParseAlarmRule("ALARM(a) OR (OK(b) AND NOT ALARM(c))")
+	if err != nil {
+		t.Fatalf("ParseAlarmRule() error = %v", err)
+	}
+	states := map[string]string{"a": "ALARM", "b": "OK"}
+	lines := ruleTreeLines(node, states, render.DefaultDetailStyles())
+
+	wantContains := []string{"OR", "├─ ", "ALARM(a)", "└─ ", "AND", "OK(b)", "NOT", "ALARM(c)", "unknown"}
+	joined := strings.Join(lines, "\n")
+	for _, want := range wantContains {
+		if !strings.Contains(joined, want) {
+			t.Errorf("rule tree missing %q:\n%s", want, joined)
+		}
+	}
+	if len(lines) != 6 {
+		t.Errorf("rule tree has %d lines, want 6:\n%s", len(lines), joined)
+	}
+
+	loading := strings.Join(ruleTreeLines(node, nil, render.DefaultDetailStyles()), "\n")
+	if !strings.Contains(loading, "loading...") {
+		t.Errorf("expected loading placeholder before child states are fetched:\n%s", loading)
+	}
+}
+
+func TestAlarmRenderer_CompositeNavigation(t *testing.T) {
+	r := NewAlarmRenderer().(*AlarmRenderer)
+	composite := NewCompositeAlarmResource(types.CompositeAlarm{
+		AlarmName: aws.String("parent"),
+		AlarmRule: aws.String("ALARM(a)"),
+	})
+
+	navs := r.Navigations(composite)
+	if len(navs) != 1 || navs[0].FilterField != "ChildrenOfAlarmName" || navs[0].FilterValue != "parent" {
+		t.Errorf("Navigations() = %+v, want child alarms navigation", navs)
+	}
+
+	metric := NewMetricAlarmResource(types.MetricAlarm{AlarmName: aws.String("a")})
+	if navs := r.Navigations(metric); len(navs) != 0 {
+		t.Errorf("Navigations() for metric alarm = %+v, want none", navs)
+	}
+}
+
+func TestAlarmResource_MetricAccount(t *testing.T) {
+	arn := aws.String("arn:aws:cloudwatch:us-east-1:111111111111:alarm:cpu")
+
+	local := NewMetricAlarmResource(types.MetricAlarm{AlarmName: aws.String("cpu"), AlarmArn: arn})
+	if got := local.MetricAccount(); got != "111111111111" {
+		t.Errorf("MetricAccount() = %q, want alarm account", got)
+	}
+
+	crossAccount := NewMetricAlarmResource(types.MetricAlarm{
+		AlarmName: aws.String("cpu"),
+		AlarmArn:  arn,
+		Metrics: []types.MetricDataQuery{
+			{Id: aws.String("e1"), Expression: aws.String("m1 * 100")},
+			{Id: aws.String("m1"), AccountId: aws.String("222222222222")},
+		},
+	})
+	if got := crossAccount.MetricAccount(); got != "222222222222" {
+		t.Errorf("MetricAccount() = %q, want source account", got)
+	}
+}
@@ -0,0 +1,309 @@
+package alarms
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Rule node operators
+const (
+	RuleAnd     = "AND"
+	RuleOr      = "OR"
+	RuleNot     = "NOT"
+	RuleState   = "STATE"
+	RuleAtLeast = "AT_LEAST"
+	RuleTrue    = "TRUE"
+	RuleFalse   = "FALSE"
+)
+
+var ruleStates = map[string]bool{"ALARM": true, "OK": true, "INSUFFICIENT_DATA": true}
+
+// RuleNode is a node of a parsed composite alarm rule
+type RuleNode struct {
+	Op string
+
+	// State is the state function (ALARM, OK, INSUFFICIENT_DATA) for STATE nodes,
+	// or the state condition (e.g. "NOT OK") for AT_LEAST nodes
+	State string
+
+	// Alarm is the alarm name referenced by a STATE node
+	Alarm string
+
+	// Threshold is the count or percentage for AT_LEAST nodes (e.g. "2" or "50%")
+	Threshold string
+
+	// Alarms are the alarm names referenced by an AT_LEAST node
+	Alarms []string
+
+	Children []*RuleNode
+}
+
+// ParseAlarmRule parses a composite alarm rule expression such as
+// ALARM(a) AND (OK(b) OR NOT ALARM("c")) into a tree.
+// Alarm ARNs are reduced to alarm names.
+func ParseAlarmRule(rule string) (*RuleNode, error) {
+	p := &ruleParser{tokens: tokenizeRule(rule)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("empty alarm rule")
+	}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	return node, nil
+}
+
+// ReferencedAlarms returns the alarm names referenced by the rule in order of appearance
+func (n *RuleNode) ReferencedAlarms() []string {
+	seen := map[string]bool{}
+	var names []string
+	var walk func(*RuleNode)
+	walk = func(node *RuleNode) {
+		refs := node.Alarms
+		if node.Alarm != "" {
+			refs = []string{node.Alarm}
+		}
+		for _, name := range refs {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		for _, c := range node.Children {
+			walk(c)
+		}
+	}
+	walk(n)
+	return names
+}
+
+// alarmNameFromRef returns the alarm name for a rule reference, which may be an ARN
+func alarmNameFromRef(ref string) string {
+	if strings.HasPrefix(ref, "arn:") {
+		if idx := strings.Index(ref, ":alarm:"); idx >= 0 {
+			return ref[idx+len(":alarm:"):]
+		}
+	}
+	return ref
+}
+
+type ruleToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeRule(rule string) []ruleToken {
+	var tokens []ruleToken
+	runes := []rune(rule)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, ruleToken{text: string(c)})
+			i++
+		case c == '"':
+			var sb strings.Builder
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			i++ // closing quote
+			tokens = append(tokens, ruleToken{text: sb.String(), quoted: true})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("(),\"", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, ruleToken{text: string(runes[start:i])})
+		}
+	}
+	return tokens
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) peek() (ruleToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return ruleToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// peekKeyword reports whether the next token is the given unquoted keyword
+func (p *ruleParser) peekKeyword(kw string) bool {
+	t, ok := p.peek()
+	return ok && !t.quoted && strings.EqualFold(t.text, kw)
+}
+
+func (p *ruleParser) expect(text string) error {
+	t, ok := p.peek()
+	if !ok {
+		return fmt.Errorf("expected %q, got end of rule", text)
+	}
+	if t.quoted || t.text != text {
+		return fmt.Errorf("expected %q, got %q", text, t.text)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *ruleParser) parseOr() (*RuleNode, error) {
+	return p.parseBinary(RuleOr, p.parseAnd)
+}
+
+func (p *ruleParser) parseAnd() (*RuleNode, error) {
+	return p.parseBinary(RuleAnd, p.parseUnary)
+}
+
+// parseBinary parses operands joined by op, flattening chains into one node
+func (p *ruleParser) parseBinary(op string, operand func() (*RuleNode, error)) (*RuleNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekKeyword(op) {
+		return left, nil
+	}
+	node := &RuleNode{Op: op, Children: []*RuleNode{left}}
+	for p.peekKeyword(op) {
+		p.pos++
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, right)
+	}
+	return node, nil
+}
+
+func (p *ruleParser) parseUnary() (*RuleNode, error) {
+	if p.peekKeyword(RuleNot) {
+		p.pos++
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &RuleNode{Op: RuleNot, Children: []*RuleNode{child}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *ruleParser) parsePrimary() (*RuleNode, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of rule")
+	}
+	if t.quoted {
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+
+	kw := strings.ToUpper(t.text)
+	switch {
+	case t.text == "(":
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case kw == RuleTrue || kw == RuleFalse:
+		p.pos++
+		return &RuleNode{Op: kw}, nil
+	case ruleStates[kw]:
+		p.pos++
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		name, err := p.parseAlarmRef()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &RuleNode{Op: RuleState, State: kw, Alarm: name}, nil
+	case kw == RuleAtLeast:
+		p.pos++
+		return p.parseAtLeast()
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+// parseAtLeast parses the arguments of AT_LEAST(M, [NOT] STATE, (alarm, ...))
+func (p *ruleParser) parseAtLeast() (*RuleNode, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	threshold, ok := p.peek()
+	if !ok || threshold.quoted || threshold.text == ")" || threshold.text == "," {
+		return nil, fmt.Errorf("expected AT_LEAST threshold")
+	}
+	p.pos++
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+
+	condition := ""
+	if p.peekKeyword(RuleNot) {
+		p.pos++
+		condition = RuleNot + " "
+	}
+	state, ok := p.peek()
+	if !ok || state.quoted || !ruleStates[strings.ToUpper(state.text)] {
+		return nil, fmt.Errorf("expected AT_LEAST state condition")
+	}
+	p.pos++
+	condition += strings.ToUpper(state.text)
+
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	node := &RuleNode{Op: RuleAtLeast, State: condition, Threshold: threshold.text}
+	for {
+		name, err := p.parseAlarmRef()
+		if err != nil {
+			return nil, err
+		}
+		node.Alarms = append(node.Alarms, name)
+		if t, ok := p.peek(); ok && !t.quoted && t.text == "," {
+			p.pos++
+			continue
+		}
+		break
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (p *ruleParser) parseAlarmRef() (string, error) {
+	t, ok := p.peek()
+	if !ok || (!t.quoted && (t.text == "(" || t.text == ")" || t.text == ",")) {
+		return "", fmt.Errorf("expected alarm name")
+	}
+	p.pos++
+	return alarmNameFromRef(t.text), nil
+}
@@ -0,0 +1,94 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// insightsPeriod is the aggregation period of Metrics Insights queries.
+// Container Insights publishes at 1-minute resolution.
+const insightsPeriod = 300
+
+// labelSeparator joins dimension values in series labels
+const labelSeparator = "|"
+
+// InsightsQuery is a CloudWatch Metrics Insights query grouped by GroupBy
+// dimensions, e.g. SELECT AVG(CpuUtilized) FROM SCHEMA(...) GROUP BY ServiceName.
+type InsightsQuery struct {
+	ID         string
+	Expression string
+	GroupBy    []string
+}
+
+// InsightsSeries maps the GroupBy dimension values of each series, joined by
+// "|", to its latest value
+type InsightsSeries map[string]float64
+
+// QueryInsights runs the queries over the last window and returns the latest
+// value of each series, keyed by query ID.
+func QueryInsights(ctx context.Context, client *cloudwatch.Client, queries []InsightsQuery, window time.Duration) (map[string]InsightsSeries, error) {
+	dataQueries := make([]types.MetricDataQuery, len(queries))
+	for i, q := range queries {
+		dataQueries[i] = types.MetricDataQuery{
+			Id:         appaws.StringPtr(q.ID),
+			Expression: appaws.StringPtr(q.Expression),
+			Label:      appaws.StringPtr(insightsLabel(q.GroupBy)),
+			Period:     appaws.Int32Ptr(insightsPeriod),
+		}
+	}
+
+	end := time.Now()
+	start := end.Add(-window)
+	results := make(map[string]InsightsSeries, len(queries))
+	paginator := cloudwatch.NewGetMetricDataPaginator(client, &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: dataQueries,
+		StartTime:         &start,
+		EndTime:           &end,
+		ScanBy:            types.ScanByTimestampDescending,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "get metric data")
+		}
+		for _, r := range output.MetricDataResults {
+			id := appaws.Str(r.Id)
+			if results[id] == nil {
+				results[id] = make(InsightsSeries)
+			}
+			// Values are newest first; later pages continue existing series
+			label := appaws.Str(r.Label)
+			if _, seen := results[id][label]; !seen && len(r.Values) > 0 {
+				results[id][label] = r.Values[0]
+			}
+		}
+	}
+	return results, nil
+}
+
+// insightsLabel builds a dynamic label that expands to the dimension values
+func insightsLabel(dims []string) string {
+	parts := make([]string, len(dims))
+	for i, dim := range dims {
+		parts[i] = fmt.Sprintf("${PROP('Dim.%s')}", dim)
+	}
+	return strings.Join(parts, labelSeparator)
+}
+
+// SplitInsightsLabel returns the dimension values of a series label
+func SplitInsightsLabel(label string) []string {
+	return strings.Split(label, labelSeparator)
+}
+
+// InsightsString quotes s as a Metrics Insights string literal
+func InsightsString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "\\'") + "'"
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -38,6 +39,8 @@ func (d *LogGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	logGroups, err := appaws.Paginate(ctx, func(token *string) ([]types.LogGroup, *string, error) {
 		input := &cloudwatchlogs.DescribeLogGroupsInput{
 			NextToken: token,
+			// Includes source-account log groups when this is a monitoring account
+			IncludeLinkedAccounts: appaws.BoolPtr(true),
 		}
 		if prefix != "" {
 			input.LogGroupNamePrefix = &prefix
@@ -53,16 +56,26 @@ func (d *LogGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	}
 
 	resources := make([]dao.Resource, len(logGroups))
+	owners := make([]string, len(logGroups))
 	for i, lg := range logGroups {
-		resources[i] = NewLogGroupResource(lg)
+		r := NewLogGroupResource(lg)
+		resources[i] = r
+		owners[i] = r.Account()
 	}
+	cwClient.NoteOwners(owners...)
 	return resources, nil
 }
 
+// Get returns a log group by name, or by ARN for source-account log groups.
 func (d *LogGroupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
-	input := &cloudwatchlogs.DescribeLogGroupsInput{
-		LogGroupNamePrefix: &id,
+	name := id
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	if account := cwClient.AccountFromARN(id); account != "" {
+		name = nameFromARN(id)
+		input.IncludeLinkedAccounts = appaws.BoolPtr(true)
+		input.AccountIdentifiers = []string{account}
 	}
+	input.LogGroupNamePrefix = &name
 
 	output, err := d.client.DescribeLogGroups(ctx, input)
 	if err != nil {
@@ -71,7 +84,7 @@ func (d *LogGroupDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 
 	// Find exact match
 	for _, lg := range output.LogGroups {
-		if lg.LogGroupName != nil && *lg.LogGroupName == id {
+		if lg.LogGroupName != nil && *lg.LogGroupName == name {
 			return NewLogGroupResource(lg), nil
 		}
 	}
@@ -101,9 +114,15 @@ type LogGroupResource struct {
 	Item types.LogGroup
 }
 
-// NewLogGroupResource creates a new LogGroupResource
+// NewLogGroupResource creates a new LogGroupResource. Source-account log
+// groups seen from a monitoring account are identified by ARN, since names
+// are only unique within an account.
 func NewLogGroupResource(lg types.LogGroup) *LogGroupResource {
 	name := appaws.Str(lg.LogGroupName)
+	id := name
+	if arn := appaws.Str(lg.LogGroupArn); cwClient.IsSourceAccount(cwClient.AccountFromARN(arn)) {
+		id = arn
+	}
 
 	// Extract short name for display (last part of path)
 	shortName := name
@@ -113,7 +132,7 @@ func NewLogGroupResource(lg types.LogGroup) *LogGroupResource {
 
 	return &LogGroupResource{
 		BaseResource: dao.BaseResource{
-			ID:   name,
+			ID:   id,
 			Name: shortName,
 			ARN:  appaws.Str(lg.Arn),
 			Tags: nil, // Tags require separate API call
@@ -128,6 +147,17 @@ func (r *LogGroupResource) LogGroupName() string {
 	return appaws.Str(r.Item.LogGroupName)
 }
 
+// Account returns the ID of the account that owns the log group
+func (r *LogGroupResource) Account() string {
+	return cwClient.AccountFromARN(r.GetARN())
+}
+
+// LogGroupIdentifier returns the name or, for source-account log groups, the
+// ARN to pass as logGroupIdentifier to CloudWatch Logs APIs
+func (r *LogGroupResource) LogGroupIdentifier() string {
+	return r.GetID()
+}
+
 // StoredBytes returns the stored bytes
 func (r *LogGroupResource) StoredBytes() int64 {
 	if r.Item.StoredBytes != nil {
@@ -174,3 +204,13 @@ func (r *LogGroupResource) MetricFilterCount() int32 {
 func (r *LogGroupResource) DataProtectionStatus() string {
 	return string(r.Item.DataProtectionStatus)
 }
+
+// nameFromARN returns the log group name from a log group ARN
+// (arn:aws:logs:region:account:log-group:NAME[:*])
+func nameFromARN(arn string) string {
+	_, name, ok := strings.Cut(arn, ":log-group:")
+	if !ok {
+		return arn
+	}
+	return strings.TrimSuffix(name, ":*")
+}
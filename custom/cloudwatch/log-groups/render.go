@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -23,7 +24,7 @@ func NewLogGroupRenderer() render.Renderer {
 			Service:  "cloudwatch",
 			Resource: "log-groups",
 			Cols: []render.Column{
-				{Name: "LOG GROUP", Width: 50, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "LOG GROUP", Width: 50, Getter: getName},
 				{Name: "SIZE", Width: 12, Getter: getSize},
 				{Name: "RETENTION", Width: 12, Getter: getRetention},
 				{Name: "CLASS", Width: 12, Getter: getClass},
@@ -33,6 +34,25 @@ func NewLogGroupRenderer() render.Renderer {
 	}
 }
 
+// Columns adds an ACCOUNT column in CloudWatch monitoring accounts
+func (r *LogGroupRenderer) Columns() []render.Column {
+	return cwClient.WithAccountColumn(r.Cols, getAccount)
+}
+
+func getName(r dao.Resource) string {
+	if lg, ok := dao.UnwrapResource(r).(*LogGroupResource); ok {
+		return lg.LogGroupName()
+	}
+	return r.GetID()
+}
+
+func getAccount(r dao.Resource) string {
+	if lg, ok := dao.UnwrapResource(r).(*LogGroupResource); ok {
+		return lg.Account()
+	}
+	return ""
+}
+
 func getSize(r dao.Resource) string {
 	if lg, ok := dao.UnwrapResource(r).(*LogGroupResource); ok {
 		return render.FormatSize(lg.StoredBytes())
@@ -91,6 +111,9 @@ func (r *LogGroupRenderer) RenderDetail(resource dao.Resource) string {
 	d.Section("Basic Information")
 	d.Field("Log Group Name", lg.LogGroupName())
 	d.Field("ARN", lg.GetARN())
+	if account := lg.Account(); cwClient.IsSourceAccount(account) {
+		d.Field("Source Account", account)
+	}
 
 	// Storage
 	d.Section("Storage")
@@ -190,7 +213,7 @@ func (r *LogGroupRenderer) Navigations(resource dao.Resource) []render.Navigatio
 			Service:     "cloudwatch",
 			Resource:    "log-streams",
 			FilterField: "LogGroupName",
-			FilterValue: lg.LogGroupName(),
+			FilterValue: lg.LogGroupIdentifier(),
 		},
 	}
 }
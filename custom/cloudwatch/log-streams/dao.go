@@ -50,11 +50,12 @@ func (d *LogStreamDAO) ListPage(ctx context.Context, pageSize int, pageToken str
 		limit = 50 // AWS API max
 	}
 
+	// logGroupIdentifier accepts the name, or the ARN of a source-account log group
 	input := &cloudwatchlogs.DescribeLogStreamsInput{
-		LogGroupName: &logGroupName,
-		Descending:   appaws.BoolPtr(true),
-		OrderBy:      types.OrderByLastEventTime,
-		Limit:        &limit,
+		LogGroupIdentifier: &logGroupName,
+		Descending:         appaws.BoolPtr(true),
+		OrderBy:            types.OrderByLastEventTime,
+		Limit:              &limit,
 	}
 	if pageToken != "" {
 		input.NextToken = &pageToken
@@ -85,7 +86,7 @@ func (d *LogStreamDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 	}
 
 	input := &cloudwatchlogs.DescribeLogStreamsInput{
-		LogGroupName:        &logGroupName,
+		LogGroupIdentifier:  &logGroupName,
 		LogStreamNamePrefix: &id,
 	}
 
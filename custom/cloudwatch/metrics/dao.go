@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// MetricDAO provides data access for CloudWatch metrics
+type MetricDAO struct {
+	dao.BaseDAO
+	client *cloudwatch.Client
+}
+
+// NewMetricDAO creates a new MetricDAO
+func NewMetricDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &MetricDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "metrics"),
+		client:  cloudwatch.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns metrics with data in the last 3 hours, including source-account
+// metrics when this is a monitoring account. Optionally filtered by Namespace.
+func (d *MetricDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &cloudwatch.ListMetricsInput{
+		IncludeLinkedAccounts: appaws.BoolPtr(true),
+		RecentlyActive:        types.RecentlyActivePt3h,
+	}
+	if ns := dao.GetFilterFromContext(ctx, "Namespace"); ns != "" {
+		input.Namespace = &ns
+	}
+
+	var resources []dao.Resource
+	var owners []string
+	paginator := cloudwatch.NewListMetricsPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list metrics")
+		}
+		// OwningAccounts is parallel to Metrics when IncludeLinkedAccounts is set
+		for i, m := range output.Metrics {
+			account := ""
+			if i < len(output.OwningAccounts) {
+				account = output.OwningAccounts[i]
+			}
+			resources = append(resources, NewMetricResource(m, account))
+			owners = append(owners, account)
+		}
+	}
+	cwClient.NoteOwners(owners...)
+
+	return resources, nil
+}
+
+func (d *MetricDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for cloudwatch metrics")
+}
+
+func (d *MetricDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for cloudwatch metrics")
+}
+
+// Supports returns supported operations
+func (d *MetricDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// MetricResource wraps a CloudWatch metric
+type MetricResource struct {
+	dao.BaseResource
+	Item    types.Metric
+	Account string // Owning account; empty unless listed with linked accounts
+}
+
+// NewMetricResource creates a new MetricResource
+func NewMetricResource(m types.Metric, account string) *MetricResource {
+	r := &MetricResource{
+		BaseResource: dao.BaseResource{
+			Name: appaws.Str(m.MetricName),
+			Data: m,
+		},
+		Item:    m,
+		Account: account,
+	}
+	// Metric identity is namespace, name and dimensions, per account
+	r.ID = r.Namespace() + "/" + r.MetricName()
+	if dims := r.DimensionsStr(); dims != "" {
+		r.ID += " " + dims
+	}
+	if cwClient.IsSourceAccount(account) {
+		r.ID += " @" + account
+	}
+	return r
+}
+
+// Namespace returns the metric namespace
+func (r *MetricResource) Namespace() string {
+	return appaws.Str(r.Item.Namespace)
+}
+
+// MetricName returns the metric name
+func (r *MetricResource) MetricName() string {
+	return appaws.Str(r.Item.MetricName)
+}
+
+// DimensionsStr returns dimensions as comma-separated Name=Value pairs
+func (r *MetricResource) DimensionsStr() string {
+	parts := make([]string, len(r.Item.Dimensions))
+	for i, dim := range r.Item.Dimensions {
+		parts[i] = appaws.Str(dim.Name) + "=" + appaws.Str(dim.Value)
+	}
+	return strings.Join(parts, ", ")
+}
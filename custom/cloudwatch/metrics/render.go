@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// MetricRenderer renders CloudWatch metrics
+type MetricRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMetricRenderer creates a new MetricRenderer
+func NewMetricRenderer() render.Renderer {
+	return &MetricRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "metrics",
+			Cols: []render.Column{
+				{Name: "NAMESPACE", Width: 24, Getter: getNamespace},
+				{Name: "METRIC", Width: 32, Getter: getMetricName},
+				{Name: "DIMENSIONS", Width: 60, Getter: getDimensions},
+			},
+		},
+	}
+}
+
+// Columns adds an ACCOUNT column in CloudWatch monitoring accounts
+func (r *MetricRenderer) Columns() []render.Column {
+	return cwClient.WithAccountColumn(r.Cols, getAccount)
+}
+
+func getNamespace(r dao.Resource) string {
+	if m, ok := r.(*MetricResource); ok {
+		return m.Namespace()
+	}
+	return ""
+}
+
+func getMetricName(r dao.Resource) string {
+	if m, ok := r.(*MetricResource); ok {
+		return m.MetricName()
+	}
+	return ""
+}
+
+func getDimensions(r dao.Resource) string {
+	if m, ok := r.(*MetricResource); ok {
+		return m.DimensionsStr()
+	}
+	return ""
+}
+
+func getAccount(r dao.Resource) string {
+	if m, ok := r.(*MetricResource); ok {
+		return m.Account
+	}
+	return ""
+}
+
+// RenderDetail renders detailed metric information
+func (r *MetricRenderer) RenderDetail(resource dao.Resource) string {
+	m, ok := resource.(*MetricResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudWatch Metric", m.MetricName())
+
+	d.Section("Basic Information")
+	d.Field("Namespace", m.Namespace())
+	d.Field("Metric Name", m.MetricName())
+	if m.Account != "" {
+		d.Field("Account", m.Account)
+	}
+
+	if len(m.Item.Dimensions) > 0 {
+		d.Section("Dimensions")
+		for _, dim := range m.Item.Dimensions {
+			d.Field(appaws.Str(dim.Name), appaws.Str(dim.Value))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *MetricRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	m, ok := resource.(*MetricResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Namespace", Value: m.Namespace()},
+		{Label: "Metric", Value: m.MetricName()},
+	}
+	if dims := m.DimensionsStr(); dims != "" {
+		fields = append(fields, render.SummaryField{Label: "Dimensions", Value: dims})
+	}
+	if m.Account != "" {
+		fields = append(fields, render.SummaryField{Label: "Account", Value: m.Account})
+	}
+	return fields
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	"github.com/clawscli/claws/internal/config"
+)
+
+func TestNewMetricResource(t *testing.T) {
+	config.Global().SetAccountID("111111111111")
+	metric := types.Metric{
+		Namespace:  aws.String("AWS/EC2"),
+		MetricName: aws.String("CPUUtilization"),
+		Dimensions: []types.Dimension{
+			{Name: aws.String("InstanceId"), Value: aws.String("i-0abc")},
+			{Name: aws.String("AutoScalingGroupName"), Value: aws.String("web")},
+		},
+	}
+
+	local := NewMetricResource(metric, "111111111111")
+	if want := "AWS/EC2/CPUUtilization InstanceId=i-0abc, AutoScalingGroupName=web"; local.GetID() != want {
+		t.Errorf("GetID() = %q, want %q", local.GetID(), want)
+	}
+
+	// The same metric in a source account must not collide with the local one
+	source := NewMetricResource(metric, "222222222222")
+	if source.GetID() == local.GetID() {
+		t.Errorf("source-account metric ID %q collides with local metric", source.GetID())
+	}
+}
+
+func TestMetricRenderer_AccountColumn(t *testing.T) {
+	config.Global().SetAccountID("333333333333")
+	renderer := NewMetricRenderer()
+	base := len(renderer.Columns())
+
+	cwClient.NoteOwners("333333333333")
+	if got := len(renderer.Columns()); got != base {
+		t.Fatalf("Columns() = %d before any source-account data, want %d", got, base)
+	}
+
+	cwClient.NoteOwners("333333333333", "444444444444")
+	cols := renderer.Columns()
+	if len(cols) != base+1 || cols[base].Name != "ACCOUNT" {
+		t.Fatalf("Columns() = %+v, want trailing ACCOUNT column", cols)
+	}
+	r := NewMetricResource(types.Metric{Namespace: aws.String("App"), MetricName: aws.String("Errors")}, "444444444444")
+	if got := cols[base].Getter(r); got != "444444444444" {
+		t.Errorf("ACCOUNT = %q, want %q", got, "444444444444")
+	}
+}
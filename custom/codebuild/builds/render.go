@@ -8,8 +8,10 @@ import (
 )
 
 // BuildRenderer renders CodeBuild builds
-// Ensure BuildRenderer implements render.Navigator
-var _ render.Navigator = (*BuildRenderer)(nil)
+var (
+	_ render.Navigator = (*BuildRenderer)(nil)
+	_ render.LogSource = (*BuildRenderer)(nil)
+)
 
 type BuildRenderer struct {
 	render.BaseRenderer
@@ -181,3 +183,12 @@ func (r *BuildRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 func (r *BuildRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	return nil
 }
+
+// LogTargets returns the build's own log stream
+func (r *BuildRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	build, ok := resource.(*BuildResource)
+	if !ok || build.LogsGroupName() == "" {
+		return nil
+	}
+	return []render.LogTarget{{Group: build.LogsGroupName(), Stream: build.LogsStreamName()}}
+}
@@ -138,6 +138,19 @@ func (r *ProjectResource) ProjectName() string {
 	return appaws.Str(r.Project.Name)
 }
 
+// LogGroup returns the CloudWatch log group builds write to, or "" if disabled
+func (r *ProjectResource) LogGroup() string {
+	if logs := r.Project.LogsConfig; logs != nil && logs.CloudWatchLogs != nil {
+		if logs.CloudWatchLogs.Status == types.LogsConfigStatusTypeDisabled {
+			return ""
+		}
+		if group := appaws.Str(logs.CloudWatchLogs.GroupName); group != "" {
+			return group
+		}
+	}
+	return "/aws/codebuild/" + r.ProjectName()
+}
+
 // Description returns the project description
 func (r *ProjectResource) Description() string {
 	return appaws.Str(r.Project.Description)
@@ -10,8 +10,10 @@ import (
 )
 
 // ProjectRenderer renders CodeBuild projects
-// Ensure ProjectRenderer implements render.Navigator
-var _ render.Navigator = (*ProjectRenderer)(nil)
+var (
+	_ render.Navigator = (*ProjectRenderer)(nil)
+	_ render.LogSource = (*ProjectRenderer)(nil)
+)
 
 type ProjectRenderer struct {
 	render.BaseRenderer
@@ -260,3 +262,15 @@ func (r *ProjectRenderer) Navigations(resource dao.Resource) []render.Navigation
 		},
 	}
 }
+
+// LogTargets returns the project's build log group unless CloudWatch Logs is disabled
+func (r *ProjectRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	project, ok := resource.(*ProjectResource)
+	if !ok {
+		return nil
+	}
+	if group := project.LogGroup(); group != "" {
+		return []render.LogTarget{{Group: group}}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
 
 	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
 )
@@ -65,6 +66,12 @@ func (d *RuleDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 	return resources, nextToken, nil
 }
 
+// ListStream emits every page of Config rules as it arrives.
+// Implements dao.StreamingDAO interface.
+func (d *RuleDAO) ListStream(ctx context.Context, emit func([]dao.Resource) bool) error {
+	return dao.StreamPages(ctx, d, config.File().PageSize("configservice", "rules"), emit)
+}
+
 // Get returns a specific Config rule by name.
 func (d *RuleDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	output, err := d.client.DescribeConfigRules(ctx, &configservice.DescribeConfigRulesInput{
@@ -0,0 +1,117 @@
+package encryptionaudit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "encryption-audit", []action.Action{
+		{
+			Name:      "Copy Encrypted",
+			Shortcut:  "e",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyEncrypted",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				f, ok := r.(*FindingResource)
+				return ok && f.Remediable()
+			},
+		},
+		{
+			Name:      "Enable Encryption by Default",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "EnableEbsEncryptionByDefault",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				f, ok := r.(*FindingResource)
+				return ok && f.Kind == KindAccountSetting
+			},
+		},
+	})
+
+	action.RegisterExecutor("ec2", "encryption-audit", executeEncryptionAuditAction)
+}
+
+func executeEncryptionAuditAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	f, ok := resource.(*FindingResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "CopyEncrypted":
+		return executeCopyEncrypted(ctx, f)
+	case "EnableEbsEncryptionByDefault":
+		return executeEnableEncryptionByDefault(ctx)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// encryptedCopyName returns the name used for an encrypted AMI copy
+func encryptedCopyName(f *FindingResource) string {
+	name := f.GetName()
+	if name == "" {
+		name = f.GetID()
+	}
+	return name + "-encrypted"
+}
+
+func executeCopyEncrypted(ctx context.Context, f *FindingResource) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	region := client.Options().Region
+	id := f.GetID()
+
+	switch f.Kind {
+	case KindSnapshot:
+		output, err := client.CopySnapshot(ctx, &ec2.CopySnapshotInput{
+			SourceSnapshotId: &id,
+			SourceRegion:     &region,
+			Encrypted:        appaws.BoolPtr(true),
+			Description:      appaws.StringPtr("Encrypted copy of " + id),
+		})
+		if err != nil {
+			return action.FailResultf(err, "copy snapshot %s", id)
+		}
+		return action.SuccessResult(fmt.Sprintf("Copying %s as encrypted %s", id, appaws.Str(output.SnapshotId)))
+	case KindImage:
+		output, err := client.CopyImage(ctx, &ec2.CopyImageInput{
+			SourceImageId: &id,
+			SourceRegion:  &region,
+			Name:          appaws.StringPtr(encryptedCopyName(f)),
+			Encrypted:     appaws.BoolPtr(true),
+			CopyImageTags: appaws.BoolPtr(true),
+		})
+		if err != nil {
+			return action.FailResultf(err, "copy image %s", id)
+		}
+		return action.SuccessResult(fmt.Sprintf("Copying %s as encrypted %s", id, appaws.Str(output.ImageId)))
+	default:
+		return action.FailResult(fmt.Errorf("%s findings cannot be copied; see remediation steps", f.Kind))
+	}
+}
+
+func executeEnableEncryptionByDefault(ctx context.Context) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	if _, err := client.EnableEbsEncryptionByDefault(ctx, &ec2.EnableEbsEncryptionByDefaultInput{}); err != nil {
+		return action.FailResultf(err, "enable ebs encryption by default")
+	}
+	return action.SuccessResult(fmt.Sprintf("Enabled EBS encryption by default in %s", client.Options().Region))
+}
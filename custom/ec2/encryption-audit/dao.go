@@ -0,0 +1,213 @@
+package encryptionaudit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Finding kinds
+const (
+	KindVolume         = "Volume"
+	KindSnapshot       = "Snapshot"
+	KindImage          = "AMI"
+	KindAccountSetting = "Account Setting"
+)
+
+// encryptionByDefaultID identifies the account-level EBS encryption finding
+const encryptionByDefaultID = "ebs-encryption-by-default"
+
+// EncryptionAuditDAO finds unencrypted EBS volumes, snapshots and AMIs
+type EncryptionAuditDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewEncryptionAuditDAO creates a new EncryptionAuditDAO
+func NewEncryptionAuditDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EncryptionAuditDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "encryption-audit"),
+		client:  ec2.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns one finding per unencrypted resource in the current region,
+// plus a finding if EBS encryption by default is disabled.
+func (d *EncryptionAuditDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	region := d.client.Options().Region
+	var resources []dao.Resource
+
+	byDefault, err := d.client.GetEbsEncryptionByDefault(ctx, &ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get ebs encryption by default")
+	}
+	if !appaws.Bool(byDefault.EbsEncryptionByDefault) {
+		resources = append(resources, &FindingResource{
+			BaseResource: dao.BaseResource{ID: encryptionByDefaultID, Name: "EBS encryption by default"},
+			Kind:         KindAccountSetting,
+			Region:       region,
+			Detail:       "disabled",
+		})
+	}
+
+	unencrypted := []types.Filter{{Name: appaws.StringPtr("encrypted"), Values: []string{"false"}}}
+
+	volumes, err := appaws.Paginate(ctx, func(token *string) ([]types.Volume, *string, error) {
+		output, err := d.client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+			Filters:   unencrypted,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe volumes")
+		}
+		return output.Volumes, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range volumes {
+		resources = append(resources, newVolumeFinding(v, region))
+	}
+
+	snapshots, err := appaws.Paginate(ctx, func(token *string) ([]types.Snapshot, *string, error) {
+		output, err := d.client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+			OwnerIds:  []string{"self"},
+			Filters:   unencrypted,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe snapshots")
+		}
+		return output.Snapshots, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snapshots {
+		resources = append(resources, newSnapshotFinding(s, region))
+	}
+
+	images, err := d.client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "describe images")
+	}
+	for _, img := range images.Images {
+		if devices := UnencryptedDevices(img); len(devices) > 0 {
+			resources = append(resources, newImageFinding(img, devices, region))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get returns a single finding by resource ID
+func (d *EncryptionAuditDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("encryption finding %s not found (already remediated?)", id)
+}
+
+// Delete is not supported for findings
+func (d *EncryptionAuditDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for encryption findings")
+}
+
+// Supports returns supported operations
+func (d *EncryptionAuditDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnencryptedDevices returns the device names of EBS mappings that are not encrypted
+func UnencryptedDevices(img types.Image) []string {
+	var devices []string
+	for _, bdm := range img.BlockDeviceMappings {
+		if bdm.Ebs != nil && !appaws.Bool(bdm.Ebs.Encrypted) {
+			devices = append(devices, appaws.Str(bdm.DeviceName))
+		}
+	}
+	return devices
+}
+
+// FindingResource is a single unencrypted resource or account setting
+type FindingResource struct {
+	dao.BaseResource
+	Kind   string
+	Region string
+	Detail string
+}
+
+func newVolumeFinding(v types.Volume, region string) *FindingResource {
+	detail := fmt.Sprintf("%d GiB %s, %s", appaws.Int32(v.Size), v.VolumeType, v.State)
+	if len(v.Attachments) > 0 {
+		detail += ", attached to " + appaws.Str(v.Attachments[0].InstanceId)
+	}
+	return &FindingResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(v.VolumeId),
+			Name: appaws.EC2NameTag(v.Tags),
+			Tags: appaws.TagsToMap(v.Tags),
+			Data: v,
+		},
+		Kind:   KindVolume,
+		Region: region,
+		Detail: detail,
+	}
+}
+
+func newSnapshotFinding(s types.Snapshot, region string) *FindingResource {
+	return &FindingResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(s.SnapshotId),
+			Name: appaws.EC2NameTag(s.Tags),
+			Tags: appaws.TagsToMap(s.Tags),
+			Data: s,
+		},
+		Kind:   KindSnapshot,
+		Region: region,
+		Detail: fmt.Sprintf("%d GiB from %s", appaws.Int32(s.VolumeSize), appaws.Str(s.VolumeId)),
+	}
+}
+
+func newImageFinding(img types.Image, devices []string, region string) *FindingResource {
+	return &FindingResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(img.ImageId),
+			Name: appaws.Str(img.Name),
+			Tags: appaws.TagsToMap(img.Tags),
+			Data: img,
+		},
+		Kind:   KindImage,
+		Region: region,
+		Detail: "unencrypted: " + strings.Join(devices, ", "),
+	}
+}
+
+// Remediable returns whether the finding can be fixed with a single API call
+func (r *FindingResource) Remediable() bool {
+	return r.Kind == KindSnapshot || r.Kind == KindImage
+}
@@ -0,0 +1,20 @@
+package encryptionaudit
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "encryption-audit", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewEncryptionAuditDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewEncryptionAuditRenderer()
+		},
+	})
+}
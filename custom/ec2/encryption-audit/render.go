@@ -0,0 +1,153 @@
+package encryptionaudit
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// EncryptionAuditRenderer renders encryption audit findings
+type EncryptionAuditRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEncryptionAuditRenderer creates a new EncryptionAuditRenderer
+func NewEncryptionAuditRenderer() render.Renderer {
+	return &EncryptionAuditRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "encryption-audit",
+			Cols: []render.Column{
+				{Name: "TYPE", Width: 16, Getter: getKind, Priority: 0},
+				{Name: "RESOURCE ID", Width: 26, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 1},
+				{Name: "NAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 2},
+				{Name: "DETAIL", Width: 40, Getter: getDetail, Priority: 3},
+			},
+		},
+	}
+}
+
+func getKind(r dao.Resource) string {
+	if f, ok := r.(*FindingResource); ok {
+		return f.Kind
+	}
+	return ""
+}
+
+func getDetail(r dao.Resource) string {
+	if f, ok := r.(*FindingResource); ok {
+		return f.Detail
+	}
+	return ""
+}
+
+// RenderDetail renders the finding with remediation guidance
+func (r *EncryptionAuditRenderer) RenderDetail(resource dao.Resource) string {
+	f, ok := resource.(*FindingResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Encryption Finding", f.GetID())
+
+	d.Section("Finding")
+	d.Field("Type", f.Kind)
+	d.Field("Resource ID", f.GetID())
+	if f.GetName() != "" && f.GetName() != f.GetID() {
+		d.Field("Name", f.GetName())
+	}
+	d.Field("Region", f.Region)
+	d.FieldStyled("Encryption", "Not encrypted", ui.WarningStyle())
+	d.Field("Detail", f.Detail)
+
+	d.Section("Remediation")
+	for _, step := range remediationSteps(f) {
+		d.Line("  " + step)
+	}
+
+	d.Section("Bulk Remediation")
+	for _, line := range bulkRemediation(f) {
+		d.DimIndent(line)
+	}
+
+	d.Tags(f.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EncryptionAuditRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	f, ok := resource.(*FindingResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Type", Value: f.Kind},
+		{Label: "Resource", Value: f.GetID()},
+		{Label: "Region", Value: f.Region},
+		{Label: "Encryption", Value: "Not encrypted", Style: ui.WarningStyle()},
+		{Label: "Detail", Value: f.Detail},
+	}
+}
+
+// remediationSteps returns the steps to encrypt a single finding.
+// EBS encryption cannot be enabled in place; data is copied to an encrypted copy.
+func remediationSteps(f *FindingResource) []string {
+	switch f.Kind {
+	case KindAccountSetting:
+		return []string{
+			"Enable encryption by default so new volumes and snapshot copies are encrypted:",
+			fmt.Sprintf("aws ec2 enable-ebs-encryption-by-default --region %s", f.Region),
+		}
+	case KindVolume:
+		return []string{
+			"1. Snapshot the volume:",
+			fmt.Sprintf("   aws ec2 create-snapshot --volume-id %s --region %s", f.GetID(), f.Region),
+			"2. Copy the snapshot with encryption:",
+			fmt.Sprintf("   aws ec2 copy-snapshot --source-snapshot-id <snap-id> --source-region %s --encrypted --region %s", f.Region, f.Region),
+			"3. Create a volume from the encrypted snapshot in the same AZ:",
+			"   aws ec2 create-volume --snapshot-id <encrypted-snap-id> --availability-zone <az>",
+			"4. Stop the instance, detach the old volume, attach the new one, then delete the old volume.",
+		}
+	case KindSnapshot:
+		return []string{
+			"Copy the snapshot with encryption (action: Copy Encrypted), then delete the original:",
+			fmt.Sprintf("aws ec2 copy-snapshot --source-snapshot-id %s --source-region %s --encrypted --region %s", f.GetID(), f.Region, f.Region),
+		}
+	case KindImage:
+		return []string{
+			"Copy the AMI with encryption (action: Copy Encrypted), then deregister the original:",
+			fmt.Sprintf("aws ec2 copy-image --source-image-id %s --source-region %s --name %q --encrypted --region %s", f.GetID(), f.Region, encryptedCopyName(f), f.Region),
+		}
+	}
+	return nil
+}
+
+// bulkRemediation returns guidance for remediating all findings of the same kind in the region
+func bulkRemediation(f *FindingResource) []string {
+	switch f.Kind {
+	case KindVolume:
+		return []string{
+			"Volumes need a maintenance window per instance. Enable encryption by default",
+			"first so replacement volumes are encrypted, then follow the steps above per volume.",
+		}
+	case KindSnapshot:
+		return []string{
+			"aws ec2 describe-snapshots --owner-ids self --filters Name=encrypted,Values=false \\",
+			fmt.Sprintf("  --query 'Snapshots[].SnapshotId' --output text --region %s | tr '\\t' '\\n' | \\", f.Region),
+			fmt.Sprintf("  xargs -I{} aws ec2 copy-snapshot --source-snapshot-id {} --source-region %[1]s --encrypted --region %[1]s", f.Region),
+		}
+	case KindImage:
+		return []string{
+			"Copy each AMI with Copy Encrypted, point launch templates at the new AMI IDs,",
+			"then deregister the originals and delete their snapshots.",
+		}
+	default:
+		return []string{"Repeat in every region listed in this view."}
+	}
+}
@@ -0,0 +1,51 @@
+package encryptionaudit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestUnencryptedDevices(t *testing.T) {
+	img := types.Image{
+		BlockDeviceMappings: []types.BlockDeviceMapping{
+			{DeviceName: aws.String("/dev/xvda"), Ebs: &types.EbsBlockDevice{Encrypted: aws.Bool(false)}},
+			{DeviceName: aws.String("/dev/xvdb"), Ebs: &types.EbsBlockDevice{Encrypted: aws.Bool(true)}},
+			{DeviceName: aws.String("/dev/xvdc"), Ebs: &types.EbsBlockDevice{}},
+			{DeviceName: aws.String("/dev/sdb"), VirtualName: aws.String("ephemeral0")},
+		},
+	}
+
+	got := UnencryptedDevices(img)
+	want := []string{"/dev/xvda", "/dev/xvdc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnencryptedDevices() = %v, want %v", got, want)
+	}
+}
+
+func TestFindingResource_Remediation(t *testing.T) {
+	snap := newSnapshotFinding(types.Snapshot{
+		SnapshotId: aws.String("snap-123"),
+		VolumeId:   aws.String("vol-123"),
+		VolumeSize: aws.Int32(8),
+	}, "us-east-1")
+
+	if snap.GetID() != "snap-123" || snap.Kind != KindSnapshot {
+		t.Fatalf("unexpected finding %s/%s", snap.Kind, snap.GetID())
+	}
+	if !snap.Remediable() {
+		t.Error("snapshot findings should be remediable")
+	}
+	steps := strings.Join(remediationSteps(snap), "\n")
+	if !strings.Contains(steps, "copy-snapshot --source-snapshot-id snap-123 --source-region us-east-1 --encrypted") {
+		t.Errorf("remediation steps missing copy-snapshot command:\n%s", steps)
+	}
+
+	vol := newVolumeFinding(types.Volume{VolumeId: aws.String("vol-1"), Size: aws.Int32(20)}, "us-east-1")
+	if vol.Remediable() {
+		t.Error("volume findings need manual remediation")
+	}
+}
@@ -3,16 +3,65 @@ package images
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	appec2 "github.com/clawscli/claws/custom/ec2"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Encryption choices for Copy to Region
+const (
+	copyKeepEncryption = "Keep source encryption"
+	copyEncrypt        = "Encrypt with default KMS key"
 )
 
 func init() {
 	action.Global.Register("ec2", "images", []action.Action{
+		{
+			Name:      "Copy to Region",
+			Shortcut:  "c",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyImage",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{Label: "Destination region", Options: listDestinationRegions},
+				{Label: "Name", Default: func(r dao.Resource) string { return r.GetName() }, Validate: validateImageName},
+				{Label: "Encryption", Options: func(context.Context, dao.Resource) ([]string, error) {
+					return []string{copyKeepEncryption, copyEncrypt}, nil
+				}},
+			},
+		},
+		{
+			Name:      "Share with Accounts",
+			Shortcut:  "s",
+			Type:      action.ActionTypeAPI,
+			Operation: "ShareImage",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{Label: "Account IDs (comma-separated)", Validate: func(v string) error {
+					_, err := parseAccountIDs(v)
+					return err
+				}},
+			},
+		},
+		{
+			Name:      "Unshare from Account",
+			Shortcut:  "u",
+			Type:      action.ActionTypeAPI,
+			Operation: "UnshareImage",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{Label: "Account ID", Options: listSharedAccounts},
+			},
+		},
 		{
 			Name:      "Deregister",
 			Shortcut:  "D",
@@ -29,6 +78,16 @@ func executeImageAction(ctx context.Context, act action.Action, resource dao.Res
 	switch act.Operation {
 	case "DeregisterImage":
 		return executeDeregisterImage(ctx, resource)
+	case "CopyImage":
+		return executeCopyImage(ctx, resource, act.Input(0), act.Input(1), act.Input(2) == copyEncrypt)
+	case "ShareImage":
+		ids, err := parseAccountIDs(act.Input(0))
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return executeModifyLaunchPermission(ctx, resource, ids, true)
+	case "UnshareImage":
+		return executeModifyLaunchPermission(ctx, resource, []string{act.Input(0)}, false)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -53,3 +112,140 @@ func executeDeregisterImage(ctx context.Context, resource dao.Resource) action.A
 		Message: fmt.Sprintf("Deregistered image %s", imageID),
 	}
 }
+
+// parseAccountIDs splits a comma or space separated list of 12-digit AWS account IDs.
+func parseAccountIDs(value string) ([]string, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("enter at least one account ID")
+	}
+
+	var ids []string
+	for _, f := range fields {
+		if len(f) != 12 || strings.Trim(f, "0123456789") != "" {
+			return nil, fmt.Errorf("invalid account ID %q (expected 12 digits)", f)
+		}
+		if !slices.Contains(ids, f) {
+			ids = append(ids, f)
+		}
+	}
+	return ids, nil
+}
+
+func validateImageName(value string) error {
+	// AMI names must be 3-128 characters
+	if n := len(strings.TrimSpace(value)); n < 3 || n > 128 {
+		return fmt.Errorf("name must be 3-128 characters")
+	}
+	return nil
+}
+
+// listDestinationRegions lists regions other than the image's own region
+func listDestinationRegions(ctx context.Context, _ dao.Resource) ([]string, error) {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	source := client.Options().Region
+
+	regions, err := appaws.FetchAvailableRegions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	regions = slices.DeleteFunc(slices.Clone(regions), func(r string) bool { return r == source })
+	slices.Sort(regions)
+	return regions, nil
+}
+
+// listSharedAccounts lists account IDs the image is currently shared with
+func listSharedAccounts(ctx context.Context, resource dao.Resource) ([]string, error) {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	imageID := resource.GetID()
+	output, err := client.DescribeImageAttribute(ctx, &ec2.DescribeImageAttributeInput{
+		ImageId:   &imageID,
+		Attribute: types.ImageAttributeNameLaunchPermission,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe launch permissions %s", imageID)
+	}
+
+	var accounts []string
+	for _, perm := range output.LaunchPermissions {
+		if id := appaws.Str(perm.UserId); id != "" {
+			accounts = append(accounts, id)
+		}
+	}
+	slices.Sort(accounts)
+	return accounts, nil
+}
+
+func executeCopyImage(ctx context.Context, resource dao.Resource, destRegion, name string, encrypt bool) action.ActionResult {
+	source, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	sourceRegion := source.Options().Region
+
+	// CopyImage is called in the destination region and pulls from the source
+	cfg, err := appaws.NewConfigWithRegion(ctx, destRegion)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	client := ec2.NewFromConfig(cfg)
+
+	imageID := resource.GetID()
+	input := &ec2.CopyImageInput{
+		Name:          aws.String(strings.TrimSpace(name)),
+		SourceImageId: &imageID,
+		SourceRegion:  &sourceRegion,
+		CopyImageTags: aws.Bool(true),
+	}
+	if encrypt {
+		input.Encrypted = aws.Bool(true)
+	}
+
+	output, err := client.CopyImage(ctx, input)
+	if err != nil {
+		return action.FailResultf(err, "copy image %s to %s", imageID, destRegion)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Copying %s to %s as %s", imageID, destRegion, appaws.Str(output.ImageId)))
+}
+
+func executeModifyLaunchPermission(ctx context.Context, resource dao.Resource, accountIDs []string, add bool) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	perms := make([]types.LaunchPermission, len(accountIDs))
+	for i, id := range accountIDs {
+		perms[i] = types.LaunchPermission{UserId: aws.String(id)}
+	}
+
+	modification := &types.LaunchPermissionModifications{}
+	verb := "Shared %s with %s"
+	if add {
+		modification.Add = perms
+	} else {
+		modification.Remove = perms
+		verb = "Unshared %s from %s"
+	}
+
+	imageID := resource.GetID()
+	_, err = client.ModifyImageAttribute(ctx, &ec2.ModifyImageAttributeInput{
+		ImageId:          &imageID,
+		LaunchPermission: modification,
+	})
+	if err != nil {
+		return action.FailResultf(err, "modify launch permissions %s", imageID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf(verb, imageID, strings.Join(accountIDs, ", ")))
+}
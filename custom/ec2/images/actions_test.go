@@ -0,0 +1,34 @@
+package images
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAccountIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{"single", "123456789012", []string{"123456789012"}, false},
+		{"comma and space", "123456789012, 210987654321", []string{"123456789012", "210987654321"}, false},
+		{"dedupe", "123456789012,123456789012", []string{"123456789012"}, false},
+		{"empty", " , ", nil, true},
+		{"too short", "12345", nil, true},
+		{"non digits", "12345678901a", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAccountIDs(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAccountIDs(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseAccountIDs(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
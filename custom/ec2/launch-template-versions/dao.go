@@ -0,0 +1,185 @@
+package launchtemplateversions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// LaunchTemplateVersionDAO provides data access for EC2 Launch Template versions
+type LaunchTemplateVersionDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewLaunchTemplateVersionDAO creates a new LaunchTemplateVersionDAO
+func NewLaunchTemplateVersionDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &LaunchTemplateVersionDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "launch-template-versions"),
+		client:  ec2.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns all versions of the launch template in the filter context
+func (d *LaunchTemplateVersionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	ltID := dao.GetFilterFromContext(ctx, "LaunchTemplateId")
+	if ltID == "" {
+		return nil, fmt.Errorf("launch template id filter required")
+	}
+
+	versions, err := appaws.Paginate(ctx, func(token *string) ([]types.LaunchTemplateVersion, *string, error) {
+		output, err := d.client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: &ltID,
+			NextToken:        token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe launch template versions")
+		}
+		return output.LaunchTemplateVersions, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defaultData := findDefaultData(versions)
+	resources := make([]dao.Resource, len(versions))
+	for i, v := range versions {
+		resources[i] = NewLaunchTemplateVersionResource(v, defaultData)
+	}
+	return resources, nil
+}
+
+// Get returns a specific launch template version
+func (d *LaunchTemplateVersionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	ltID := dao.GetFilterFromContext(ctx, "LaunchTemplateId")
+	if ltID == "" {
+		return nil, fmt.Errorf("launch template id filter required")
+	}
+
+	output, err := d.client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: &ltID,
+		Versions:         []string{id, "$Default"},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe launch template version %s", id)
+	}
+
+	defaultData := findDefaultData(output.LaunchTemplateVersions)
+	for _, v := range output.LaunchTemplateVersions {
+		if strconv.FormatInt(appaws.Int64(v.VersionNumber), 10) == id {
+			return NewLaunchTemplateVersionResource(v, defaultData), nil
+		}
+	}
+	return nil, fmt.Errorf("launch template version not found: %s", id)
+}
+
+// Delete is not supported for launch template versions
+func (d *LaunchTemplateVersionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for launch template versions")
+}
+
+// Supports returns supported operations
+func (d *LaunchTemplateVersionDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+func findDefaultData(versions []types.LaunchTemplateVersion) *types.ResponseLaunchTemplateData {
+	for _, v := range versions {
+		if appaws.Bool(v.DefaultVersion) {
+			return v.LaunchTemplateData
+		}
+	}
+	return nil
+}
+
+// LaunchTemplateVersionResource wraps a single launch template version
+type LaunchTemplateVersionResource struct {
+	dao.BaseResource
+	Item types.LaunchTemplateVersion
+	// DefaultData is the launch template data of the current default version,
+	// used to show what this version changes relative to the default.
+	DefaultData *types.ResponseLaunchTemplateData
+}
+
+// NewLaunchTemplateVersionResource creates a new LaunchTemplateVersionResource
+func NewLaunchTemplateVersionResource(v types.LaunchTemplateVersion, defaultData *types.ResponseLaunchTemplateData) *LaunchTemplateVersionResource {
+	version := strconv.FormatInt(appaws.Int64(v.VersionNumber), 10)
+	return &LaunchTemplateVersionResource{
+		BaseResource: dao.BaseResource{
+			ID:   version,
+			Name: "v" + version,
+			Tags: make(map[string]string),
+			Data: v,
+		},
+		Item:        v,
+		DefaultData: defaultData,
+	}
+}
+
+// LaunchTemplateId returns the parent launch template ID
+func (r *LaunchTemplateVersionResource) LaunchTemplateId() string {
+	return appaws.Str(r.Item.LaunchTemplateId)
+}
+
+// LaunchTemplateName returns the parent launch template name
+func (r *LaunchTemplateVersionResource) LaunchTemplateName() string {
+	return appaws.Str(r.Item.LaunchTemplateName)
+}
+
+// VersionNumber returns the version number
+func (r *LaunchTemplateVersionResource) VersionNumber() int64 {
+	return appaws.Int64(r.Item.VersionNumber)
+}
+
+// IsDefault returns true if this is the default version
+func (r *LaunchTemplateVersionResource) IsDefault() bool {
+	return appaws.Bool(r.Item.DefaultVersion)
+}
+
+// Description returns the version description
+func (r *LaunchTemplateVersionResource) Description() string {
+	return appaws.Str(r.Item.VersionDescription)
+}
+
+// CreatedBy returns who created the version
+func (r *LaunchTemplateVersionResource) CreatedBy() string {
+	return appaws.Str(r.Item.CreatedBy)
+}
+
+// CreateTime returns the creation time
+func (r *LaunchTemplateVersionResource) CreateTime() time.Time {
+	return appaws.Time(r.Item.CreateTime)
+}
+
+// InstanceType returns the instance type configured in this version
+func (r *LaunchTemplateVersionResource) InstanceType() string {
+	if r.Item.LaunchTemplateData == nil {
+		return ""
+	}
+	return string(r.Item.LaunchTemplateData.InstanceType)
+}
+
+// ImageId returns the AMI configured in this version
+func (r *LaunchTemplateVersionResource) ImageId() string {
+	if r.Item.LaunchTemplateData == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.LaunchTemplateData.ImageId)
+}
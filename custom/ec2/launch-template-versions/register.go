@@ -0,0 +1,20 @@
+package launchtemplateversions
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "launch-template-versions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewLaunchTemplateVersionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewLaunchTemplateVersionRenderer()
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package launchtemplateversions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// LaunchTemplateVersionRenderer renders launch template versions
+type LaunchTemplateVersionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewLaunchTemplateVersionRenderer creates a new LaunchTemplateVersionRenderer
+func NewLaunchTemplateVersionRenderer() *LaunchTemplateVersionRenderer {
+	return &LaunchTemplateVersionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "launch-template-versions",
+			Cols: []render.Column{
+				{Name: "VERSION", Width: 8, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "DEFAULT", Width: 8, Getter: getDefault, Priority: 1},
+				{Name: "DESCRIPTION", Width: 30, Getter: getDescription, Priority: 2},
+				{Name: "INSTANCE TYPE", Width: 14, Getter: getInstanceType, Priority: 3},
+				{Name: "AMI", Width: 22, Getter: getImageId, Priority: 4},
+				{Name: "CREATED", Width: 20, Getter: getCreated, Priority: 5},
+			},
+		},
+	}
+}
+
+func getDefault(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok && v.IsDefault() {
+		return "Yes"
+	}
+	return ""
+}
+
+func getDescription(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return v.Description()
+	}
+	return ""
+}
+
+func getInstanceType(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return v.InstanceType()
+	}
+	return ""
+}
+
+func getImageId(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return v.ImageId()
+	}
+	return ""
+}
+
+func getCreated(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		if t := v.CreateTime(); !t.IsZero() {
+			return t.Format("2006-01-02 15:04")
+		}
+	}
+	return ""
+}
+
+// RenderDetail renders detailed launch template version information
+func (r *LaunchTemplateVersionRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*LaunchTemplateVersionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Launch Template Version", fmt.Sprintf("%s %s", v.LaunchTemplateName(), v.GetName()))
+
+	d.Section("Basic Information")
+	d.Field("Launch Template", v.LaunchTemplateName())
+	d.Field("Template ID", v.LaunchTemplateId())
+	d.Field("Version", fmt.Sprintf("%d", v.VersionNumber()))
+	if v.IsDefault() {
+		d.FieldStyled("Default", "Yes", ui.SuccessStyle())
+	} else {
+		d.Field("Default", "No")
+	}
+	if desc := v.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+	d.Field("Created By", v.CreatedBy())
+	if t := v.CreateTime(); !t.IsZero() {
+		d.Field("Created", t.Format("2006-01-02 15:04:05 MST"))
+	}
+
+	if !v.IsDefault() && v.DefaultData != nil {
+		d.Section("Changes from Default Version")
+		changes, err := render.DiffJSON(v.DefaultData, v.Item.LaunchTemplateData)
+		switch {
+		case err != nil:
+			d.Field("Error", err.Error())
+		case len(changes) == 0:
+			d.DimIndent("No differences")
+		default:
+			for _, c := range changes {
+				d.Field(c.Path, formatChange(c))
+			}
+		}
+	}
+
+	d.Section("Launch Template Data")
+	if jsonBytes, err := json.MarshalIndent(v.Item.LaunchTemplateData, "", "  "); err == nil {
+		d.Line(string(jsonBytes))
+	}
+
+	return d.String()
+}
+
+// RenderDiff renders the launch template data fields that differ between two versions
+func (r *LaunchTemplateVersionRenderer) RenderDiff(left, right dao.Resource) string {
+	lv, lok := left.(*LaunchTemplateVersionResource)
+	rv, rok := right.(*LaunchTemplateVersionResource)
+	if !lok || !rok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Section("Versions")
+	d.Field(lv.LaunchTemplateName()+" "+lv.GetName(), lv.Description())
+	d.Field(rv.LaunchTemplateName()+" "+rv.GetName(), rv.Description())
+	if lv.LaunchTemplateId() != rv.LaunchTemplateId() {
+		d.FieldStyled("Note", "Versions belong to different launch templates", ui.WarningStyle())
+	}
+
+	changes, err := render.DiffJSON(lv.Item.LaunchTemplateData, rv.Item.LaunchTemplateData)
+	if err != nil {
+		d.Section("Differing Fields")
+		d.Field("Error", err.Error())
+		return d.String()
+	}
+	d.Section(fmt.Sprintf("Differing Fields (%d)", len(changes)))
+	if len(changes) == 0 {
+		d.DimIndent("Launch template data is identical")
+	}
+	for _, c := range changes {
+		d.FieldStyled(c.Path, formatChange(c), ui.WarningStyle())
+	}
+
+	return d.String()
+}
+
+func formatChange(c render.JSONChange) string {
+	switch {
+	case c.Old == "":
+		return "+ " + c.New
+	case c.New == "":
+		return "- " + c.Old
+	default:
+		return c.Old + " → " + c.New
+	}
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *LaunchTemplateVersionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*LaunchTemplateVersionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Template", Value: v.LaunchTemplateName()},
+		{Label: "Version", Value: v.GetName()},
+	}
+	if v.IsDefault() {
+		fields = append(fields, render.SummaryField{Label: "Default", Value: "Yes", Style: ui.SuccessStyle()})
+	}
+	if it := v.InstanceType(); it != "" {
+		fields = append(fields, render.SummaryField{Label: "Type", Value: it})
+	}
+	return fields
+}
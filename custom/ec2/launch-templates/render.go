@@ -8,6 +8,9 @@ import (
 )
 
 // LaunchTemplateRenderer renders EC2 Launch Templates
+// Ensure LaunchTemplateRenderer implements render.Navigator
+var _ render.Navigator = (*LaunchTemplateRenderer)(nil)
+
 type LaunchTemplateRenderer struct {
 	render.BaseRenderer
 }
@@ -124,3 +127,22 @@ func (r *LaunchTemplateRenderer) RenderSummary(resource dao.Resource) []render.S
 		{Label: "Latest", Value: fmt.Sprintf("v%d", rr.LatestVersionNumber())},
 	}
 }
+
+// Navigations returns navigation shortcuts
+func (r *LaunchTemplateRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	rr, ok := resource.(*LaunchTemplateResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "v", Label: "Versions", Service: "ec2", Resource: "launch-template-versions",
+			FilterField: "LaunchTemplateId", FilterValue: rr.LaunchTemplateId(),
+		},
+		{
+			Key: "s", Label: "Auto Scaling Groups", Service: "autoscaling", Resource: "groups",
+			FilterField: "LaunchTemplateId", FilterValue: rr.LaunchTemplateId(),
+		},
+	}
+}
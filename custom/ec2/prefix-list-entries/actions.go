@@ -0,0 +1,100 @@
+package prefixlistentries
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	prefixlists "github.com/clawscli/claws/custom/ec2/prefix-lists"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "prefix-list-entries", []action.Action{
+		{
+			Name:      "Add Entry",
+			Shortcut:  "a",
+			Type:      action.ActionTypeAPI,
+			Operation: "AddPrefixListEntry",
+			Confirm:   action.ConfirmSimple,
+			Filter:    isCustomerManaged,
+			Prompts: []action.Prompt{
+				{Label: "CIDR", Validate: prefixlists.ValidateCIDR},
+				{Label: "Description (optional)"},
+			},
+		},
+		{
+			Name:      "Remove Entry",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "RemovePrefixListEntry",
+			Confirm:   action.ConfirmDangerous,
+			Filter:    isCustomerManaged,
+		},
+	})
+
+	action.RegisterExecutor("ec2", "prefix-list-entries", executePrefixListEntryAction)
+}
+
+func isCustomerManaged(r dao.Resource) bool {
+	e, ok := r.(*PrefixListEntryResource)
+	return ok && e.CustomerManaged()
+}
+
+func executePrefixListEntryAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	e, ok := resource.(*PrefixListEntryResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "AddPrefixListEntry":
+		return executeAddEntry(ctx, e, act.Input(0), act.Input(1))
+	case "RemovePrefixListEntry":
+		return executeRemoveEntry(ctx, e)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeAddEntry(ctx context.Context, e *PrefixListEntryResource, cidr, description string) action.ActionResult {
+	cidr = strings.TrimSpace(cidr)
+	if cidr == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+	if err := prefixlists.ValidateCIDR(cidr); err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	entry := types.AddPrefixListEntry{Cidr: &cidr}
+	if description = strings.TrimSpace(description); description != "" {
+		entry.Description = &description
+	}
+
+	if err := prefixlists.ModifyEntries(ctx, client, e.PrefixListID(), []types.AddPrefixListEntry{entry}, nil); err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResult(fmt.Sprintf("Adding %s to prefix list %s", cidr, e.PrefixListID()))
+}
+
+func executeRemoveEntry(ctx context.Context, e *PrefixListEntryResource) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	cidr := e.GetID()
+	if err := prefixlists.ModifyEntries(ctx, client, e.PrefixListID(), nil, []types.RemovePrefixListEntry{{Cidr: &cidr}}); err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResult(fmt.Sprintf("Removing %s from prefix list %s", cidr, e.PrefixListID()))
+}
@@ -0,0 +1,129 @@
+package prefixlistentries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	prefixlists "github.com/clawscli/claws/custom/ec2/prefix-lists"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// PrefixListEntryDAO provides data access for the entries of a managed prefix list
+type PrefixListEntryDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewPrefixListEntryDAO creates a new PrefixListEntryDAO
+func NewPrefixListEntryDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PrefixListEntryDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "prefix-list-entries"),
+		client:  ec2.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the entries of the prefix list in the filter context
+func (d *PrefixListEntryDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	plID := dao.GetFilterFromContext(ctx, "PrefixListId")
+	if plID == "" {
+		return nil, fmt.Errorf("prefix list id filter required")
+	}
+
+	pl, err := prefixlists.Describe(ctx, d.client, plID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := appaws.Paginate(ctx, func(token *string) ([]types.PrefixListEntry, *string, error) {
+		output, err := d.client.GetManagedPrefixListEntries(ctx, &ec2.GetManagedPrefixListEntriesInput{
+			PrefixListId: &plID,
+			NextToken:    token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "get entries of prefix list %s", plID)
+		}
+		return output.Entries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(entries))
+	for i, e := range entries {
+		resources[i] = NewPrefixListEntryResource(e, *pl)
+	}
+	return resources, nil
+}
+
+// Get returns the entry with the given CIDR
+func (d *PrefixListEntryDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("prefix list entry not found: %s", id)
+}
+
+// Delete removes the entry from its prefix list
+func (d *PrefixListEntryDAO) Delete(ctx context.Context, id string) error {
+	plID := dao.GetFilterFromContext(ctx, "PrefixListId")
+	if plID == "" {
+		return fmt.Errorf("prefix list id filter required")
+	}
+	return prefixlists.ModifyEntries(ctx, d.client, plID, nil, []types.RemovePrefixListEntry{{Cidr: &id}})
+}
+
+// PrefixListEntryResource wraps a single CIDR entry of a prefix list
+type PrefixListEntryResource struct {
+	dao.BaseResource
+	Item       types.PrefixListEntry
+	PrefixList types.ManagedPrefixList
+}
+
+// NewPrefixListEntryResource creates a new PrefixListEntryResource
+func NewPrefixListEntryResource(e types.PrefixListEntry, pl types.ManagedPrefixList) *PrefixListEntryResource {
+	cidr := appaws.Str(e.Cidr)
+	return &PrefixListEntryResource{
+		BaseResource: dao.BaseResource{
+			ID:   cidr,
+			Name: cidr,
+			Data: e,
+		},
+		Item:       e,
+		PrefixList: pl,
+	}
+}
+
+// Description returns the entry description
+func (r *PrefixListEntryResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// PrefixListID returns the parent prefix list ID
+func (r *PrefixListEntryResource) PrefixListID() string {
+	return appaws.Str(r.PrefixList.PrefixListId)
+}
+
+// PrefixListName returns the parent prefix list name
+func (r *PrefixListEntryResource) PrefixListName() string {
+	return appaws.Str(r.PrefixList.PrefixListName)
+}
+
+// CustomerManaged returns true if the parent list can be modified
+func (r *PrefixListEntryResource) CustomerManaged() bool {
+	return prefixlists.NewPrefixListResource(r.PrefixList).CustomerManaged()
+}
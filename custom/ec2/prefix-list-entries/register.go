@@ -0,0 +1,20 @@
+package prefixlistentries
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "prefix-list-entries", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPrefixListEntryDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPrefixListEntryRenderer()
+		},
+	})
+}
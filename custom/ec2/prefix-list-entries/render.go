@@ -0,0 +1,78 @@
+package prefixlistentries
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PrefixListEntryRenderer renders prefix list entries
+type PrefixListEntryRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPrefixListEntryRenderer creates a new PrefixListEntryRenderer
+func NewPrefixListEntryRenderer() *PrefixListEntryRenderer {
+	return &PrefixListEntryRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "prefix-list-entries",
+			Cols: []render.Column{
+				{Name: "CIDR", Width: 44, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "DESCRIPTION", Width: 50, Getter: getDescription, Priority: 1},
+			},
+		},
+	}
+}
+
+func getDescription(r dao.Resource) string {
+	if e, ok := r.(*PrefixListEntryResource); ok {
+		return e.Description()
+	}
+	return ""
+}
+
+// RenderDetail renders the entry and its prefix list
+func (r *PrefixListEntryRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*PrefixListEntryResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Prefix List Entry", e.GetID())
+
+	d.Section("Basic Information")
+	d.Field("CIDR", e.GetID())
+	if desc := e.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+
+	d.Section("Prefix List")
+	d.Field("ID", e.PrefixListID())
+	d.Field("Name", e.PrefixListName())
+	if e.CustomerManaged() {
+		d.Field("Managed By", "Customer")
+	} else {
+		d.Field("Managed By", "AWS")
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PrefixListEntryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*PrefixListEntryResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "CIDR", Value: e.GetID()},
+		{Label: "Prefix List", Value: e.PrefixListName()},
+	}
+	if desc := e.Description(); desc != "" {
+		fields = append(fields, render.SummaryField{Label: "Description", Value: desc})
+	}
+	return fields
+}
@@ -0,0 +1,102 @@
+package prefixlists
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "prefix-lists", []action.Action{
+		{
+			Name:      "Add Entry",
+			Shortcut:  "a",
+			Type:      action.ActionTypeAPI,
+			Operation: "AddPrefixListEntry",
+			Confirm:   action.ConfirmSimple,
+			Filter:    isCustomerManaged,
+			Prompts: []action.Prompt{
+				{Label: "CIDR", Validate: ValidateCIDR},
+				{Label: "Description (optional)"},
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteManagedPrefixList",
+			Confirm:   action.ConfirmDangerous,
+			Filter:    isCustomerManaged,
+		},
+	})
+
+	action.RegisterExecutor("ec2", "prefix-lists", executePrefixListAction)
+}
+
+func isCustomerManaged(r dao.Resource) bool {
+	pl, ok := r.(*PrefixListResource)
+	return ok && pl.CustomerManaged()
+}
+
+func executePrefixListAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "AddPrefixListEntry":
+		return executeAddEntry(ctx, resource, act.Input(0), act.Input(1))
+	case "DeleteManagedPrefixList":
+		return executeDeletePrefixList(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// ValidateCIDR checks that value is an IPv4 or IPv6 CIDR block
+func ValidateCIDR(value string) error {
+	if _, _, err := net.ParseCIDR(strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("invalid CIDR %q", value)
+	}
+	return nil
+}
+
+func executeAddEntry(ctx context.Context, resource dao.Resource, cidr, description string) action.ActionResult {
+	cidr = strings.TrimSpace(cidr)
+	if cidr == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+	if err := ValidateCIDR(cidr); err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	entry := types.AddPrefixListEntry{Cidr: &cidr}
+	if description = strings.TrimSpace(description); description != "" {
+		entry.Description = &description
+	}
+
+	id := resource.GetID()
+	if err := ModifyEntries(ctx, client, id, []types.AddPrefixListEntry{entry}, nil); err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResult(fmt.Sprintf("Adding %s to prefix list %s", cidr, id))
+}
+
+func executeDeletePrefixList(ctx context.Context, resource dao.Resource) action.ActionResult {
+	d, err := NewPrefixListDAO(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if err := d.Delete(ctx, resource.GetID()); err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResult(fmt.Sprintf("Deleted prefix list %s", resource.GetID()))
+}
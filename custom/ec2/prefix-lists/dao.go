@@ -0,0 +1,317 @@
+package prefixlists
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// awsOwner is the OwnerId of AWS-managed prefix lists
+const awsOwner = "AWS"
+
+// PrefixListDAO provides data access for EC2 managed prefix lists
+type PrefixListDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewPrefixListDAO creates a new PrefixListDAO
+func NewPrefixListDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PrefixListDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "prefix-lists"),
+		client:  ec2.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns all customer-managed and AWS-managed prefix lists
+func (d *PrefixListDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	lists, err := appaws.Paginate(ctx, func(token *string) ([]types.ManagedPrefixList, *string, error) {
+		output, err := d.client.DescribeManagedPrefixLists(ctx, &ec2.DescribeManagedPrefixListsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe managed prefix lists")
+		}
+		return output.PrefixLists, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(lists))
+	for i, pl := range lists {
+		resources[i] = NewPrefixListResource(pl)
+	}
+	return resources, nil
+}
+
+// Get returns a prefix list together with the security groups and route
+// tables that reference it
+func (d *PrefixListDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	pl, err := Describe(ctx, d.client, id)
+	if err != nil {
+		return nil, err
+	}
+	r := NewPrefixListResource(*pl)
+
+	r.SecurityGroups, err = ReferencingSecurityGroups(ctx, d.client, id)
+	if err != nil {
+		return nil, err
+	}
+	r.RouteTables, err = ReferencingRouteTables(ctx, d.client, id)
+	if err != nil {
+		return nil, err
+	}
+	r.References = true
+	return r, nil
+}
+
+// Delete deletes a customer-managed prefix list
+func (d *PrefixListDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteManagedPrefixList(ctx, &ec2.DeleteManagedPrefixListInput{
+		PrefixListId: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		if apperrors.IsResourceInUse(err) {
+			return apperrors.Wrapf(err, "prefix list %s is referenced by other resources", id)
+		}
+		return apperrors.Wrapf(err, "delete prefix list %s", id)
+	}
+	return nil
+}
+
+// Describe returns a single prefix list by ID
+func Describe(ctx context.Context, client *ec2.Client, id string) (*types.ManagedPrefixList, error) {
+	output, err := client.DescribeManagedPrefixLists(ctx, &ec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe prefix list %s", id)
+	}
+	if len(output.PrefixLists) == 0 {
+		return nil, fmt.Errorf("prefix list not found: %s", id)
+	}
+	return &output.PrefixLists[0], nil
+}
+
+// ModifyEntries adds and removes entries of a customer-managed prefix list
+// at its current version
+func ModifyEntries(ctx context.Context, client *ec2.Client, id string, add []types.AddPrefixListEntry, remove []types.RemovePrefixListEntry) error {
+	pl, err := Describe(ctx, client, id)
+	if err != nil {
+		return err
+	}
+	if appaws.Str(pl.OwnerId) == awsOwner {
+		return fmt.Errorf("prefix list %s is managed by AWS", id)
+	}
+	_, err = client.ModifyManagedPrefixList(ctx, &ec2.ModifyManagedPrefixListInput{
+		PrefixListId:   &id,
+		CurrentVersion: pl.Version,
+		AddEntries:     add,
+		RemoveEntries:  remove,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "modify prefix list %s", id)
+	}
+	return nil
+}
+
+// SecurityGroupRef is a security group with a rule referencing a prefix list
+type SecurityGroupRef struct {
+	GroupID   string
+	GroupName string
+	VpcID     string
+	Ingress   bool
+	Egress    bool
+}
+
+// Direction returns which rules reference the prefix list: inbound, outbound or both
+func (r SecurityGroupRef) Direction() string {
+	switch {
+	case r.Ingress && r.Egress:
+		return "inbound+outbound"
+	case r.Egress:
+		return "outbound"
+	default:
+		return "inbound"
+	}
+}
+
+// ReferencingSecurityGroups returns the security groups with inbound or
+// outbound rules referencing the prefix list
+func ReferencingSecurityGroups(ctx context.Context, client *ec2.Client, id string) ([]SecurityGroupRef, error) {
+	var refs []SecurityGroupRef
+	for _, filter := range []string{"ip-permission.prefix-list-id", "egress.ip-permission.prefix-list-id"} {
+		groups, err := appaws.Paginate(ctx, func(token *string) ([]types.SecurityGroup, *string, error) {
+			output, err := client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+				Filters:   []types.Filter{{Name: appaws.StringPtr(filter), Values: []string{id}}},
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "describe security groups")
+			}
+			return output.SecurityGroups, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, sg := range groups {
+			refs = mergeSecurityGroupRef(refs, sg, id)
+		}
+	}
+	return refs, nil
+}
+
+// mergeSecurityGroupRef adds sg to refs, or updates its entry when the group
+// was already found by the other direction's filter
+func mergeSecurityGroupRef(refs []SecurityGroupRef, sg types.SecurityGroup, prefixListID string) []SecurityGroupRef {
+	groupID := appaws.Str(sg.GroupId)
+	i := slices.IndexFunc(refs, func(r SecurityGroupRef) bool { return r.GroupID == groupID })
+	if i < 0 {
+		refs = append(refs, SecurityGroupRef{
+			GroupID:   groupID,
+			GroupName: appaws.Str(sg.GroupName),
+			VpcID:     appaws.Str(sg.VpcId),
+		})
+		i = len(refs) - 1
+	}
+	refs[i].Ingress = refs[i].Ingress || permissionsReference(sg.IpPermissions, prefixListID)
+	refs[i].Egress = refs[i].Egress || permissionsReference(sg.IpPermissionsEgress, prefixListID)
+	return refs
+}
+
+func permissionsReference(perms []types.IpPermission, prefixListID string) bool {
+	for _, perm := range perms {
+		for _, pl := range perm.PrefixListIds {
+			if appaws.Str(pl.PrefixListId) == prefixListID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RouteTableRef is a route table with a route to a prefix list
+type RouteTableRef struct {
+	RouteTableID string
+	VpcID        string
+	Targets      []string
+}
+
+// ReferencingRouteTables returns the route tables with routes whose
+// destination is the prefix list
+func ReferencingRouteTables(ctx context.Context, client *ec2.Client, id string) ([]RouteTableRef, error) {
+	tables, err := appaws.Paginate(ctx, func(token *string) ([]types.RouteTable, *string, error) {
+		output, err := client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
+			Filters:   []types.Filter{{Name: appaws.StringPtr("route.destination-prefix-list-id"), Values: []string{id}}},
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe route tables")
+		}
+		return output.RouteTables, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]RouteTableRef, len(tables))
+	for i, rt := range tables {
+		refs[i] = RouteTableRef{
+			RouteTableID: appaws.Str(rt.RouteTableId),
+			VpcID:        appaws.Str(rt.VpcId),
+			Targets:      routeTargets(rt.Routes, id),
+		}
+	}
+	return refs, nil
+}
+
+// routeTargets returns the targets of the routes to the prefix list
+func routeTargets(routes []types.Route, prefixListID string) []string {
+	var targets []string
+	for _, route := range routes {
+		if appaws.Str(route.DestinationPrefixListId) != prefixListID {
+			continue
+		}
+		for _, target := range []*string{
+			route.GatewayId, route.NatGatewayId, route.TransitGatewayId,
+			route.VpcPeeringConnectionId, route.NetworkInterfaceId, route.InstanceId,
+			route.CoreNetworkArn, route.LocalGatewayId,
+		} {
+			if t := appaws.Str(target); t != "" {
+				targets = append(targets, t)
+				break
+			}
+		}
+	}
+	return targets
+}
+
+// PrefixListResource wraps a managed prefix list
+type PrefixListResource struct {
+	dao.BaseResource
+	Item types.ManagedPrefixList
+
+	// References is true once the referencing resources below were looked up
+	References     bool
+	SecurityGroups []SecurityGroupRef
+	RouteTables    []RouteTableRef
+}
+
+// NewPrefixListResource creates a new PrefixListResource
+func NewPrefixListResource(pl types.ManagedPrefixList) *PrefixListResource {
+	return &PrefixListResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(pl.PrefixListId),
+			Name: appaws.Str(pl.PrefixListName),
+			ARN:  appaws.Str(pl.PrefixListArn),
+			Tags: appaws.TagsToMap(pl.Tags),
+			Data: pl,
+		},
+		Item: pl,
+	}
+}
+
+// State returns the prefix list state, e.g. create-complete
+func (r *PrefixListResource) State() string {
+	return string(r.Item.State)
+}
+
+// OwnerID returns the owning account ID, or "AWS" for AWS-managed lists
+func (r *PrefixListResource) OwnerID() string {
+	return appaws.Str(r.Item.OwnerId)
+}
+
+// CustomerManaged returns true if the list is owned by an account rather than AWS
+func (r *PrefixListResource) CustomerManaged() bool {
+	return r.OwnerID() != awsOwner
+}
+
+// AddressFamily returns IPv4 or IPv6
+func (r *PrefixListResource) AddressFamily() string {
+	return appaws.Str(r.Item.AddressFamily)
+}
+
+// MaxEntries returns the maximum number of entries
+func (r *PrefixListResource) MaxEntries() int32 {
+	return appaws.Int32(r.Item.MaxEntries)
+}
+
+// Version returns the current version of the list
+func (r *PrefixListResource) Version() int64 {
+	return appaws.Int64(r.Item.Version)
+}
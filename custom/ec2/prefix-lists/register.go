@@ -0,0 +1,20 @@
+package prefixlists
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "prefix-lists", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPrefixListDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPrefixListRenderer()
+		},
+	})
+}
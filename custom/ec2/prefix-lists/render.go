@@ -0,0 +1,190 @@
+package prefixlists
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PrefixListRenderer renders managed prefix lists
+type PrefixListRenderer struct {
+	render.BaseRenderer
+}
+
+var _ render.Navigator = (*PrefixListRenderer)(nil)
+
+// NewPrefixListRenderer creates a new PrefixListRenderer
+func NewPrefixListRenderer() *PrefixListRenderer {
+	return &PrefixListRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "prefix-lists",
+			Cols: []render.Column{
+				{Name: "ID", Width: 24, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "NAME", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 1},
+				{Name: "OWNER", Width: 14, Getter: getOwner, Priority: 2},
+				{Name: "FAMILY", Width: 7, Getter: getFamily, Priority: 3},
+				{Name: "MAX", Width: 5, Getter: getMaxEntries, Priority: 4},
+				{Name: "VERSION", Width: 8, Getter: getVersion, Priority: 5},
+				{Name: "STATE", Width: 18, Getter: getState, Priority: 6},
+			},
+		},
+	}
+}
+
+func getOwner(r dao.Resource) string {
+	if pl, ok := r.(*PrefixListResource); ok {
+		return pl.OwnerID()
+	}
+	return ""
+}
+
+func getFamily(r dao.Resource) string {
+	if pl, ok := r.(*PrefixListResource); ok {
+		return pl.AddressFamily()
+	}
+	return ""
+}
+
+func getMaxEntries(r dao.Resource) string {
+	if pl, ok := r.(*PrefixListResource); ok && pl.MaxEntries() > 0 {
+		return fmt.Sprintf("%d", pl.MaxEntries())
+	}
+	return ""
+}
+
+func getVersion(r dao.Resource) string {
+	if pl, ok := r.(*PrefixListResource); ok && pl.Version() > 0 {
+		return fmt.Sprintf("%d", pl.Version())
+	}
+	return ""
+}
+
+func getState(r dao.Resource) string {
+	if pl, ok := r.(*PrefixListResource); ok {
+		return pl.State()
+	}
+	return ""
+}
+
+// stateKey maps prefix list states like modify-in-progress onto StateColorer keys
+func stateKey(state string) string {
+	switch {
+	case state == "delete-complete":
+		return "deleted"
+	case strings.HasSuffix(state, "-complete"):
+		return "available"
+	case strings.HasSuffix(state, "-in-progress"):
+		return "pending"
+	case strings.HasSuffix(state, "-failed"):
+		return "failed"
+	default:
+		return state
+	}
+}
+
+// RenderDetail renders the prefix list and the resources referencing it
+func (r *PrefixListRenderer) RenderDetail(resource dao.Resource) string {
+	pl, ok := resource.(*PrefixListResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Prefix List", pl.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Prefix List ID", pl.GetID())
+	d.Field("Name", pl.GetName())
+	d.Field("ARN", pl.GetARN())
+	d.Field("Owner", pl.OwnerID())
+	if pl.CustomerManaged() {
+		d.Field("Managed By", "Customer")
+	} else {
+		d.Field("Managed By", "AWS")
+	}
+	d.Field("Address Family", pl.AddressFamily())
+	if pl.MaxEntries() > 0 {
+		d.Field("Max Entries", fmt.Sprintf("%d", pl.MaxEntries()))
+	}
+	if pl.Version() > 0 {
+		d.Field("Version", fmt.Sprintf("%d", pl.Version()))
+	}
+	d.FieldStyled("State", pl.State(), render.StateColorer()(stateKey(pl.State())))
+	d.FieldIf("State Message", pl.Item.StateMessage)
+
+	if pl.References {
+		d.Section("Referencing Security Groups")
+		if len(pl.SecurityGroups) == 0 {
+			d.DimIndent("(none)")
+		}
+		for _, sg := range pl.SecurityGroups {
+			d.Field(sg.GroupID, fmt.Sprintf("%s (%s, %s)", sg.GroupName, sg.VpcID, sg.Direction()))
+		}
+
+		d.Section("Referencing Route Tables")
+		if len(pl.RouteTables) == 0 {
+			d.DimIndent("(none)")
+		}
+		for _, rt := range pl.RouteTables {
+			value := rt.VpcID
+			if len(rt.Targets) > 0 {
+				value += " → " + strings.Join(rt.Targets, ", ")
+			}
+			d.Field(rt.RouteTableID, value)
+		}
+	}
+
+	d.Tags(pl.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PrefixListRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	pl, ok := resource.(*PrefixListResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "ID", Value: pl.GetID()},
+		{Label: "Name", Value: pl.GetName()},
+		{Label: "State", Value: pl.State(), Style: render.StateColorer()(stateKey(pl.State()))},
+		{Label: "Owner", Value: pl.OwnerID()},
+		{Label: "Family", Value: pl.AddressFamily()},
+	}
+	if pl.References {
+		fields = append(fields,
+			render.SummaryField{Label: "Security Groups", Value: fmt.Sprintf("%d", len(pl.SecurityGroups))},
+			render.SummaryField{Label: "Route Tables", Value: fmt.Sprintf("%d", len(pl.RouteTables))},
+		)
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts for prefix lists
+func (r *PrefixListRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	pl, ok := resource.(*PrefixListResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "e", Label: "Entries", Service: "ec2", Resource: "prefix-list-entries",
+			FilterField: "PrefixListId", FilterValue: pl.GetID(),
+		},
+		{
+			Key: "s", Label: "Security Groups", Service: "ec2", Resource: "security-groups",
+			FilterField: "PrefixListId", FilterValue: pl.GetID(),
+		},
+		{
+			Key: "t", Label: "Route Tables", Service: "vpc", Resource: "route-tables",
+			FilterField: "PrefixListId", FilterValue: pl.GetID(),
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package prefixlists
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestPrefixListResource(t *testing.T) {
+	pl := NewPrefixListResource(types.ManagedPrefixList{
+		PrefixListId:   aws.String("pl-0123456789abcdef0"),
+		PrefixListName: aws.String("office"),
+		OwnerId:        aws.String("123456789012"),
+		State:          types.PrefixListStateModifyComplete,
+	})
+	if pl.GetID() != "pl-0123456789abcdef0" || pl.GetName() != "office" {
+		t.Errorf("id/name = %q/%q", pl.GetID(), pl.GetName())
+	}
+	if !pl.CustomerManaged() {
+		t.Error("account-owned list should be customer managed")
+	}
+
+	awsList := NewPrefixListResource(types.ManagedPrefixList{OwnerId: aws.String("AWS")})
+	if awsList.CustomerManaged() {
+		t.Error("AWS-owned list should not be customer managed")
+	}
+	if isCustomerManaged(awsList) {
+		t.Error("entry actions should be hidden for AWS-managed lists")
+	}
+}
+
+func TestStateKey(t *testing.T) {
+	tests := map[string]string{
+		"create-complete":    "available",
+		"modify-in-progress": "pending",
+		"restore-failed":     "failed",
+		"delete-complete":    "deleted",
+	}
+	for state, want := range tests {
+		if got := stateKey(state); got != want {
+			t.Errorf("stateKey(%q) = %q, want %q", state, got, want)
+		}
+	}
+}
+
+func TestMergeSecurityGroupRef(t *testing.T) {
+	const plID = "pl-1"
+	perms := []types.IpPermission{{PrefixListIds: []types.PrefixListId{{PrefixListId: aws.String(plID)}}}}
+
+	sg := types.SecurityGroup{GroupId: aws.String("sg-1"), GroupName: aws.String("web"), IpPermissions: perms}
+	refs := mergeSecurityGroupRef(nil, sg, plID)
+
+	// The egress filter finds the same group again
+	sg.IpPermissionsEgress = perms
+	refs = mergeSecurityGroupRef(refs, sg, plID)
+
+	if len(refs) != 1 {
+		t.Fatalf("refs = %+v, want one merged group", refs)
+	}
+	if got := refs[0].Direction(); got != "inbound+outbound" {
+		t.Errorf("Direction() = %q", got)
+	}
+}
+
+func TestRouteTargets(t *testing.T) {
+	routes := []types.Route{
+		{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+		{DestinationPrefixListId: aws.String("pl-1"), TransitGatewayId: aws.String("tgw-1")},
+		{DestinationPrefixListId: aws.String("pl-2"), NatGatewayId: aws.String("nat-1")},
+	}
+	if got := routeTargets(routes, "pl-1"); !reflect.DeepEqual(got, []string{"tgw-1"}) {
+		t.Errorf("routeTargets = %v", got)
+	}
+}
+
+func TestValidateCIDR(t *testing.T) {
+	for _, v := range []string{"10.0.0.0/8", "2001:db8::/32", " 192.168.1.0/24 "} {
+		if err := ValidateCIDR(v); err != nil {
+			t.Errorf("ValidateCIDR(%q) = %v", v, err)
+		}
+	}
+	for _, v := range []string{"", "10.0.0.1", "not-a-cidr"} {
+		if err := ValidateCIDR(v); err == nil {
+			t.Errorf("ValidateCIDR(%q) should fail", v)
+		}
+	}
+}
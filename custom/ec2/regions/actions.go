@@ -0,0 +1,91 @@
+package regions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	accountClient "github.com/clawscli/claws/custom/account"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "regions", []action.Action{
+		{
+			Name:         "Enable Region",
+			Shortcut:     "E",
+			Type:         action.ActionTypeAPI,
+			Operation:    "EnableRegion",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+			Filter: func(r dao.Resource) bool {
+				rr, ok := r.(*RegionResource)
+				return ok && rr.OptStatus() == types.RegionOptStatusDisabled
+			},
+		},
+		{
+			Name:         "Disable Region",
+			Shortcut:     "D",
+			Type:         action.ActionTypeAPI,
+			Operation:    "DisableRegion",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+			Filter: func(r dao.Resource) bool {
+				rr, ok := r.(*RegionResource)
+				return ok && rr.OptStatus() == types.RegionOptStatusEnabled
+			},
+		},
+	})
+
+	action.RegisterExecutor("ec2", "regions", executeRegionAction)
+}
+
+func executeRegionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "EnableRegion":
+		return executeEnableRegion(ctx, resource)
+	case "DisableRegion":
+		return executeDisableRegion(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeEnableRegion(ctx context.Context, resource dao.Resource) action.ActionResult {
+	region, ok := resource.(*RegionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := region.GetID()
+	if _, err := client.EnableRegion(ctx, &account.EnableRegionInput{RegionName: &name}); err != nil {
+		return action.FailResultf(err, "enable region %s", name)
+	}
+	return action.SuccessResult(fmt.Sprintf("Enabling region %s (this can take several minutes)", name))
+}
+
+func executeDisableRegion(ctx context.Context, resource dao.Resource) action.ActionResult {
+	region, ok := resource.(*RegionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := region.GetID()
+	if _, err := client.DisableRegion(ctx, &account.DisableRegionInput{RegionName: &name}); err != nil {
+		return action.FailResultf(err, "disable region %s", name)
+	}
+	return action.SuccessResult(fmt.Sprintf("Disabling region %s (this can take several minutes)", name))
+}
@@ -0,0 +1,143 @@
+package regions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	accountClient "github.com/clawscli/claws/custom/account"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// RegionDAO provides data access for the account's AWS regions. Regions are
+// read from the Account API, which, unlike EC2 DescribeRegions, reports
+// regions that are still being enabled or disabled.
+type RegionDAO struct {
+	dao.BaseDAO
+	client *account.Client
+}
+
+// NewRegionDAO creates a new RegionDAO
+func NewRegionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := accountClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &RegionDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "regions"),
+		client:  client,
+	}, nil
+}
+
+// List returns all regions, including opt-in regions that are not enabled
+func (d *RegionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	regions, err := appaws.Paginate(ctx, func(token *string) ([]types.Region, *string, error) {
+		output, err := d.client.ListRegions(ctx, &account.ListRegionsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list regions")
+		}
+		return output.Regions, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(regions))
+	for _, region := range regions {
+		resources = append(resources, NewRegionResource(region))
+	}
+	return resources, nil
+}
+
+func (d *RegionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetRegionOptStatus(ctx, &account.GetRegionOptStatusInput{
+		RegionName: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get region opt status %s", id)
+	}
+	return NewRegionResource(types.Region{
+		RegionName:      output.RegionName,
+		RegionOptStatus: output.RegionOptStatus,
+	}), nil
+}
+
+func (d *RegionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for regions")
+}
+
+// Supports returns supported operations
+func (d *RegionDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// RegionResource wraps an AWS region and its opt status
+type RegionResource struct {
+	dao.BaseResource
+	Item types.Region
+}
+
+// NewRegionResource creates a new RegionResource
+func NewRegionResource(region types.Region) *RegionResource {
+	name := appaws.Str(region.RegionName)
+	return &RegionResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: region,
+		},
+		Item: region,
+	}
+}
+
+// OptStatus returns the raw opt status
+func (r *RegionResource) OptStatus() types.RegionOptStatus {
+	return r.Item.RegionOptStatus
+}
+
+// OptInRequired returns whether the region must be enabled before use
+func (r *RegionResource) OptInRequired() bool {
+	return r.OptStatus() != types.RegionOptStatusEnabledByDefault
+}
+
+// Enabled returns whether the account can use the region
+func (r *RegionResource) Enabled() bool {
+	switch r.OptStatus() {
+	case types.RegionOptStatusEnabled, types.RegionOptStatusEnabledByDefault:
+		return true
+	}
+	return false
+}
+
+// Pending returns whether the region is still being enabled or disabled
+func (r *RegionResource) Pending() bool {
+	switch r.OptStatus() {
+	case types.RegionOptStatusEnabling, types.RegionOptStatusDisabling:
+		return true
+	}
+	return false
+}
+
+// Status returns the region status for display
+func (r *RegionResource) Status() string {
+	switch r.OptStatus() {
+	case types.RegionOptStatusEnabledByDefault:
+		return "enabled-by-default"
+	case types.RegionOptStatusEnabled:
+		return "enabled"
+	case types.RegionOptStatusEnabling:
+		return "enabling"
+	case types.RegionOptStatusDisabling:
+		return "disabling"
+	case types.RegionOptStatusDisabled:
+		return "disabled"
+	default:
+		return string(r.OptStatus())
+	}
+}
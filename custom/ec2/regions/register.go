@@ -0,0 +1,20 @@
+package regions
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "regions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewRegionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewRegionRenderer()
+		},
+	})
+}
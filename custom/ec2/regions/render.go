@@ -0,0 +1,111 @@
+package regions
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// RegionRenderer renders AWS regions and their opt-in status
+type RegionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewRegionRenderer creates a new RegionRenderer
+func NewRegionRenderer() render.Renderer {
+	return &RegionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "regions",
+			Cols: []render.Column{
+				{
+					Name:  "REGION",
+					Width: 18,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*RegionResource); ok {
+							return v.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "OPT-IN",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*RegionResource); ok && v.OptInRequired() {
+							return "Yes"
+						}
+						return "No"
+					},
+					Priority: 2,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed region information
+func (r *RegionRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*RegionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("AWS Region", v.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Region", v.GetID())
+	d.FieldStyled("Status", v.Status(), statusStyle(v))
+	d.Field("Opt Status", string(v.OptStatus()))
+
+	if v.OptInRequired() {
+		d.Section("Opt-In")
+		switch {
+		case v.Pending():
+			d.Field("Note", "The status change can take several minutes to complete")
+		case v.Enabled():
+			d.Field("Note", "Opt-in region enabled for this account")
+		default:
+			d.Field("Note", "Enable the region before using it")
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *RegionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*RegionResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.SummaryField{
+		{Label: "Region", Value: v.GetID()},
+		{Label: "Status", Value: v.Status(), Style: statusStyle(v)},
+	}
+}
+
+// statusStyle highlights enabled regions and regions that are still being
+// enabled or disabled
+func statusStyle(v *RegionResource) render.Style {
+	switch {
+	case v.Pending():
+		return ui.WarningStyle()
+	case v.Enabled():
+		return ui.SuccessStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
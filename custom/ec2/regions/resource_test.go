@@ -0,0 +1,88 @@
+package regions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	"github.com/clawscli/claws/internal/action"
+)
+
+func TestRegionResourceStatus(t *testing.T) {
+	tests := []struct {
+		optStatus   types.RegionOptStatus
+		wantStatus  string
+		wantEnabled bool
+		wantOptIn   bool
+		wantPending bool
+	}{
+		{types.RegionOptStatusEnabledByDefault, "enabled-by-default", true, false, false},
+		{types.RegionOptStatusEnabled, "enabled", true, true, false},
+		{types.RegionOptStatusEnabling, "enabling", false, true, true},
+		{types.RegionOptStatusDisabling, "disabling", false, true, true},
+		{types.RegionOptStatusDisabled, "disabled", false, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.optStatus), func(t *testing.T) {
+			r := NewRegionResource(types.Region{
+				RegionName:      aws.String("ap-east-1"),
+				RegionOptStatus: tt.optStatus,
+			})
+			if r.GetID() != "ap-east-1" {
+				t.Errorf("GetID() = %q, want ap-east-1", r.GetID())
+			}
+			if got := r.Status(); got != tt.wantStatus {
+				t.Errorf("Status() = %q, want %q", got, tt.wantStatus)
+			}
+			if got := r.Enabled(); got != tt.wantEnabled {
+				t.Errorf("Enabled() = %v, want %v", got, tt.wantEnabled)
+			}
+			if got := r.OptInRequired(); got != tt.wantOptIn {
+				t.Errorf("OptInRequired() = %v, want %v", got, tt.wantOptIn)
+			}
+			if got := r.Pending(); got != tt.wantPending {
+				t.Errorf("Pending() = %v, want %v", got, tt.wantPending)
+			}
+		})
+	}
+}
+
+func TestRegionActionFilters(t *testing.T) {
+	actions := map[string]action.Action{}
+	for _, act := range action.Global.Get("ec2", "regions") {
+		actions[act.Operation] = act
+	}
+
+	tests := []struct {
+		optStatus   types.RegionOptStatus
+		wantEnable  bool
+		wantDisable bool
+	}{
+		{types.RegionOptStatusEnabledByDefault, false, false},
+		{types.RegionOptStatusEnabled, false, true},
+		{types.RegionOptStatusEnabling, false, false},
+		{types.RegionOptStatusDisabling, false, false},
+		{types.RegionOptStatusDisabled, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.optStatus), func(t *testing.T) {
+			r := NewRegionResource(types.Region{
+				RegionName:      aws.String("ap-east-1"),
+				RegionOptStatus: tt.optStatus,
+			})
+			if got := actions["EnableRegion"].Filter(r); got != tt.wantEnable {
+				t.Errorf("EnableRegion filter = %v, want %v", got, tt.wantEnable)
+			}
+			if got := actions["DisableRegion"].Filter(r); got != tt.wantDisable {
+				t.Errorf("DisableRegion filter = %v, want %v", got, tt.wantDisable)
+			}
+		})
+	}
+	for op, act := range actions {
+		if act.Confirm != action.ConfirmDangerous {
+			t.Errorf("%s Confirm = %v, want ConfirmDangerous", op, act.Confirm)
+		}
+	}
+}
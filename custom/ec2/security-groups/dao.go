@@ -31,22 +31,51 @@ func NewSecurityGroupDAO(ctx context.Context) (dao.DAO, error) {
 }
 
 func (d *SecurityGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	input := &ec2.DescribeSecurityGroupsInput{}
+	// Filter by referenced prefix list if provided. Inbound and outbound
+	// references need separate calls since EC2 ANDs different filter names.
+	if plID := dao.GetFilterFromContext(ctx, "PrefixListId"); plID != "" {
+		var resources []dao.Resource
+		seen := make(map[string]bool)
+		for _, name := range []string{"ip-permission.prefix-list-id", "egress.ip-permission.prefix-list-id"} {
+			groups, err := d.list(ctx, []types.Filter{{Name: appaws.StringPtr(name), Values: []string{plID}}})
+			if err != nil {
+				return nil, err
+			}
+			for _, sg := range groups {
+				if id := appaws.Str(sg.GroupId); !seen[id] {
+					seen[id] = true
+					resources = append(resources, NewSecurityGroupResource(sg))
+				}
+			}
+		}
+		return resources, nil
+	}
+
+	groups, err := d.list(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(groups))
+	for i, sg := range groups {
+		resources[i] = NewSecurityGroupResource(sg)
+	}
+	return resources, nil
+}
+
+func (d *SecurityGroupDAO) list(ctx context.Context, filters []types.Filter) ([]types.SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{Filters: filters}
 	paginator := ec2.NewDescribeSecurityGroupsPaginator(d.client, input)
 
-	var resources []dao.Resource
+	var groups []types.SecurityGroup
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, apperrors.Wrap(err, "describe security groups")
 		}
-
-		for _, sg := range output.SecurityGroups {
-			resources = append(resources, NewSecurityGroupResource(sg))
-		}
+		groups = append(groups, output.SecurityGroups...)
 	}
-
-	return resources, nil
+	return groups, nil
 }
 
 func (d *SecurityGroupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -0,0 +1,148 @@
+package registrysettings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	ecrClient "github.com/clawscli/claws/custom/ecr"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ecr", "registry-settings", []action.Action{
+		{
+			Name:      "Edit Scanning",
+			Shortcut:  "e",
+			Type:      action.ActionTypeAPI,
+			Operation: "PutRegistryScanningConfiguration",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{
+					Label: "Scan type",
+					Options: func(context.Context, dao.Resource) ([]string, error) {
+						return []string{string(types.ScanTypeBasic), string(types.ScanTypeEnhanced)}, nil
+					},
+				},
+				{
+					Label: "Scan frequency",
+					Options: func(context.Context, dao.Resource) ([]string, error) {
+						return ScanFrequencies(string(types.ScanTypeEnhanced)), nil
+					},
+				},
+				{
+					Label:   "Repository filters (comma-separated wildcards, empty to remove rule)",
+					Default: defaultScanFilters,
+				},
+			},
+			Preview: previewScanningEdit,
+		},
+	})
+
+	action.RegisterExecutor("ecr", "registry-settings", executeRegistrySettingsAction)
+}
+
+func executeRegistrySettingsAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "PutRegistryScanningConfiguration":
+		return executeEditScanning(ctx, resource, act.Inputs)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// defaultScanFilters pre-fills the filters of the first existing rule, or "*"
+func defaultScanFilters(resource dao.Resource) string {
+	rs, ok := resource.(*RegistrySettingsResource)
+	if !ok {
+		return ""
+	}
+	if rules := rs.ScanRules(); len(rules) > 0 {
+		return scanFiltersString(rules[0].RepositoryFilters)
+	}
+	return "*"
+}
+
+// planScanningEdit builds the new scanning configuration from prompt inputs
+func planScanningEdit(rs *RegistrySettingsResource, inputs []string) (*types.RegistryScanningConfiguration, error) {
+	if len(inputs) < 3 || inputs[0] == "" || inputs[1] == "" {
+		return nil, action.ErrMissingInput
+	}
+	scanType, freq := inputs[0], inputs[1]
+	if !slices.Contains(ScanFrequencies(scanType), freq) {
+		return nil, fmt.Errorf("%s scanning does not support %s", scanType, freq)
+	}
+
+	var filters []string
+	for _, f := range strings.Split(inputs[2], ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			filters = append(filters, f)
+		}
+	}
+
+	rules := WithScanRule(rs.ScanRules(), types.ScanFrequency(freq), filters)
+	for _, rule := range rules {
+		if !slices.Contains(ScanFrequencies(scanType), string(rule.ScanFrequency)) {
+			return nil, fmt.Errorf("existing %s rule is not supported by %s scanning", rule.ScanFrequency, scanType)
+		}
+	}
+	return &types.RegistryScanningConfiguration{ScanType: types.ScanType(scanType), Rules: rules}, nil
+}
+
+func describeScanning(scanType string, rules []types.RegistryScanningRule) string {
+	if len(rules) == 0 {
+		return scanType + ", no rules"
+	}
+	parts := make([]string, len(rules))
+	for i, rule := range rules {
+		parts[i] = fmt.Sprintf("%s [%s]", rule.ScanFrequency, scanFiltersString(rule.RepositoryFilters))
+	}
+	return scanType + ", " + strings.Join(parts, "; ")
+}
+
+func previewScanningEdit(resource dao.Resource, inputs []string) string {
+	rs, ok := resource.(*RegistrySettingsResource)
+	if !ok {
+		return ""
+	}
+	cfg, err := planScanningEdit(rs, inputs)
+	if err != nil {
+		return "Error: " + err.Error()
+	}
+	return fmt.Sprintf("Before: %s\nAfter:  %s",
+		describeScanning(rs.ScanType(), rs.ScanRules()),
+		describeScanning(string(cfg.ScanType), cfg.Rules))
+}
+
+func executeEditScanning(ctx context.Context, resource dao.Resource, inputs []string) action.ActionResult {
+	rs, ok := resource.(*RegistrySettingsResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	cfg, err := planScanningEdit(rs, inputs)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := ecrClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.PutRegistryScanningConfiguration(ctx, &ecr.PutRegistryScanningConfigurationInput{
+		ScanType: cfg.ScanType,
+		Rules:    cfg.Rules,
+	})
+	if err != nil {
+		return action.FailResultf(err, "put registry scanning configuration")
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Updated scanning for registry %s: %s",
+		rs.GetID(), describeScanning(string(cfg.ScanType), cfg.Rules)))
+}
@@ -0,0 +1,169 @@
+package registrysettings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// RegistrySettingsDAO provides data access for registry-level ECR settings
+type RegistrySettingsDAO struct {
+	dao.BaseDAO
+	client *ecr.Client
+}
+
+// NewRegistrySettingsDAO creates a new RegistrySettingsDAO
+func NewRegistrySettingsDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &RegistrySettingsDAO{
+		BaseDAO: dao.NewBaseDAO("ecr", "registry-settings"),
+		client:  ecr.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the settings of the account's private registry in the current region
+func (d *RegistrySettingsDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	r, err := d.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []dao.Resource{r}, nil
+}
+
+// Get returns the registry settings; the ID is the registry (account) ID
+func (d *RegistrySettingsDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return d.load(ctx)
+}
+
+func (d *RegistrySettingsDAO) load(ctx context.Context) (*RegistrySettingsResource, error) {
+	registry, err := d.client.DescribeRegistry(ctx, &ecr.DescribeRegistryInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "describe registry")
+	}
+
+	scanning, err := d.client.GetRegistryScanningConfiguration(ctx, &ecr.GetRegistryScanningConfigurationInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get registry scanning configuration")
+	}
+
+	rules, err := appaws.Paginate(ctx, func(token *string) ([]types.PullThroughCacheRule, *string, error) {
+		output, err := d.client.DescribePullThroughCacheRules(ctx, &ecr.DescribePullThroughCacheRulesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe pull through cache rules")
+		}
+		return output.PullThroughCacheRules, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRegistrySettingsResource(appaws.Str(registry.RegistryId), Settings{
+		Replication:           registry.ReplicationConfiguration,
+		PullThroughCacheRules: rules,
+		Scanning:              scanning.ScanningConfiguration,
+	}), nil
+}
+
+func (d *RegistrySettingsDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for ecr registry settings")
+}
+
+// Supports returns supported operations
+func (d *RegistrySettingsDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// Settings groups the registry-level configuration
+type Settings struct {
+	Replication           *types.ReplicationConfiguration
+	PullThroughCacheRules []types.PullThroughCacheRule
+	Scanning              *types.RegistryScanningConfiguration
+}
+
+// RegistrySettingsResource wraps the settings of a private registry
+type RegistrySettingsResource struct {
+	dao.BaseResource
+	Item Settings
+}
+
+// NewRegistrySettingsResource creates a new RegistrySettingsResource
+func NewRegistrySettingsResource(registryID string, settings Settings) *RegistrySettingsResource {
+	return &RegistrySettingsResource{
+		BaseResource: dao.BaseResource{
+			ID:   registryID,
+			Name: registryID,
+			Data: settings,
+		},
+		Item: settings,
+	}
+}
+
+// ScanType returns BASIC or ENHANCED
+func (r *RegistrySettingsResource) ScanType() string {
+	if r.Item.Scanning != nil {
+		return string(r.Item.Scanning.ScanType)
+	}
+	return ""
+}
+
+// ScanRules returns the registry scanning rules
+func (r *RegistrySettingsResource) ScanRules() []types.RegistryScanningRule {
+	if r.Item.Scanning != nil {
+		return r.Item.Scanning.Rules
+	}
+	return nil
+}
+
+// ReplicationRules returns the cross-region/cross-account replication rules
+func (r *RegistrySettingsResource) ReplicationRules() []types.ReplicationRule {
+	if r.Item.Replication != nil {
+		return r.Item.Replication.Rules
+	}
+	return nil
+}
+
+// ScanFrequencies returns the frequencies a scan type supports in registry rules
+func ScanFrequencies(scanType string) []string {
+	if scanType == string(types.ScanTypeEnhanced) {
+		return []string{string(types.ScanFrequencyScanOnPush), string(types.ScanFrequencyContinuousScan)}
+	}
+	return []string{string(types.ScanFrequencyScanOnPush)}
+}
+
+// WithScanRule returns rules with the rule for freq replaced by one matching filters.
+// Empty filters remove the rule for freq. Other rules are kept in order.
+func WithScanRule(rules []types.RegistryScanningRule, freq types.ScanFrequency, filters []string) []types.RegistryScanningRule {
+	out := slices.DeleteFunc(slices.Clone(rules), func(rule types.RegistryScanningRule) bool {
+		return rule.ScanFrequency == freq
+	})
+	if len(filters) == 0 {
+		return out
+	}
+
+	rule := types.RegistryScanningRule{ScanFrequency: freq}
+	for _, f := range filters {
+		rule.RepositoryFilters = append(rule.RepositoryFilters, types.ScanningRepositoryFilter{
+			Filter:     aws.String(f),
+			FilterType: types.ScanningRepositoryFilterTypeWildcard,
+		})
+	}
+	return append(out, rule)
+}
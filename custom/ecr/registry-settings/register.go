@@ -0,0 +1,20 @@
+package registrysettings
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ecr", "registry-settings", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewRegistrySettingsDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewRegistrySettingsRenderer()
+		},
+	})
+}
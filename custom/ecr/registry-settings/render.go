@@ -0,0 +1,166 @@
+package registrysettings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// RegistrySettingsRenderer renders registry-level ECR settings
+type RegistrySettingsRenderer struct {
+	render.BaseRenderer
+}
+
+// NewRegistrySettingsRenderer creates a new RegistrySettingsRenderer
+func NewRegistrySettingsRenderer() render.Renderer {
+	return &RegistrySettingsRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecr",
+			Resource: "registry-settings",
+			Cols: []render.Column{
+				{
+					Name:     "REGISTRY",
+					Width:    14,
+					Getter:   func(r dao.Resource) string { return r.GetID() },
+					Priority: 0,
+				},
+				{
+					Name:  "SCAN TYPE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if rs, ok := r.(*RegistrySettingsResource); ok {
+							return rs.ScanType()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "SCAN RULES",
+					Width: 11,
+					Getter: func(r dao.Resource) string {
+						if rs, ok := r.(*RegistrySettingsResource); ok {
+							return fmt.Sprintf("%d", len(rs.ScanRules()))
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "REPLICATION",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if rs, ok := r.(*RegistrySettingsResource); ok {
+							return fmt.Sprintf("%d", len(rs.ReplicationRules()))
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "PULL-THROUGH",
+					Width: 13,
+					Getter: func(r dao.Resource) string {
+						if rs, ok := r.(*RegistrySettingsResource); ok {
+							return fmt.Sprintf("%d", len(rs.Item.PullThroughCacheRules))
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders scanning, replication and pull-through cache settings
+func (r *RegistrySettingsRenderer) RenderDetail(resource dao.Resource) string {
+	rs, ok := resource.(*RegistrySettingsResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ECR Registry Settings", rs.GetID())
+
+	d.Section("Scanning Configuration")
+	d.Field("Scan Type", rs.ScanType())
+	if rules := rs.ScanRules(); len(rules) > 0 {
+		for _, rule := range rules {
+			d.Field(string(rule.ScanFrequency), scanFiltersString(rule.RepositoryFilters))
+		}
+	} else {
+		d.FieldStyled("Rules", "none (repositories are not scanned automatically)", ui.WarningStyle())
+	}
+
+	d.Section("Replication Rules")
+	if rules := rs.ReplicationRules(); len(rules) > 0 {
+		for i, rule := range rules {
+			var dests []string
+			for _, dest := range rule.Destinations {
+				dests = append(dests, fmt.Sprintf("%s/%s", appaws.Str(dest.Region), appaws.Str(dest.RegistryId)))
+			}
+			d.Field(fmt.Sprintf("Rule %d", i+1), strings.Join(dests, ", "))
+			if len(rule.RepositoryFilters) > 0 {
+				d.Field("  Filters", replicationFiltersString(rule.RepositoryFilters))
+			}
+		}
+	} else {
+		d.DimIndent("No replication rules")
+	}
+
+	d.Section("Pull-Through Cache Rules")
+	if len(rs.Item.PullThroughCacheRules) > 0 {
+		for _, rule := range rs.Item.PullThroughCacheRules {
+			d.Field(appaws.Str(rule.EcrRepositoryPrefix), appaws.Str(rule.UpstreamRegistryUrl))
+			if prefix := appaws.Str(rule.UpstreamRepositoryPrefix); prefix != "" {
+				d.Field("  Upstream Prefix", prefix)
+			}
+			if cred := appaws.Str(rule.CredentialArn); cred != "" {
+				d.Field("  Credential", cred)
+			}
+		}
+	} else {
+		d.DimIndent("No pull-through cache rules")
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *RegistrySettingsRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	rs, ok := resource.(*RegistrySettingsResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Registry", Value: rs.GetID()},
+		{Label: "Scan Type", Value: rs.ScanType()},
+		{Label: "Replication Rules", Value: fmt.Sprintf("%d", len(rs.ReplicationRules()))},
+		{Label: "Pull-Through Rules", Value: fmt.Sprintf("%d", len(rs.Item.PullThroughCacheRules))},
+	}
+}
+
+func scanFiltersString(filters []types.ScanningRepositoryFilter) string {
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = appaws.Str(f.Filter)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func replicationFiltersString(filters []types.RepositoryFilter) string {
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = appaws.Str(f.Filter) + "*"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,133 @@
+package registrysettings
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+func scanRule(freq types.ScanFrequency, filters ...string) types.RegistryScanningRule {
+	rule := types.RegistryScanningRule{ScanFrequency: freq}
+	for _, f := range filters {
+		rule.RepositoryFilters = append(rule.RepositoryFilters, types.ScanningRepositoryFilter{
+			Filter:     aws.String(f),
+			FilterType: types.ScanningRepositoryFilterTypeWildcard,
+		})
+	}
+	return rule
+}
+
+func newSettings(scanType types.ScanType, rules ...types.RegistryScanningRule) *RegistrySettingsResource {
+	return NewRegistrySettingsResource("123456789012", Settings{
+		Scanning: &types.RegistryScanningConfiguration{ScanType: scanType, Rules: rules},
+	})
+}
+
+func TestWithScanRule(t *testing.T) {
+	existing := []types.RegistryScanningRule{
+		scanRule(types.ScanFrequencyScanOnPush, "*"),
+		scanRule(types.ScanFrequencyContinuousScan, "prod-*"),
+	}
+
+	replaced := WithScanRule(existing, types.ScanFrequencyScanOnPush, []string{"app-*", "web-*"})
+	if len(replaced) != 2 {
+		t.Fatalf("len = %d, want 2", len(replaced))
+	}
+	if replaced[0].ScanFrequency != types.ScanFrequencyContinuousScan {
+		t.Errorf("untouched rule should keep its position, got %s first", replaced[0].ScanFrequency)
+	}
+	if got := scanFiltersString(replaced[1].RepositoryFilters); got != "app-*, web-*" {
+		t.Errorf("filters = %q, want %q", got, "app-*, web-*")
+	}
+
+	removed := WithScanRule(existing, types.ScanFrequencyContinuousScan, nil)
+	if len(removed) != 1 || removed[0].ScanFrequency != types.ScanFrequencyScanOnPush {
+		t.Errorf("expected only the scan-on-push rule to remain, got %+v", removed)
+	}
+
+	if len(existing) != 2 || scanFiltersString(existing[0].RepositoryFilters) != "*" {
+		t.Error("WithScanRule must not modify its input")
+	}
+}
+
+func TestPlanScanningEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		rs      *RegistrySettingsResource
+		inputs  []string
+		wantErr string
+		want    string
+	}{
+		{
+			name:   "enable continuous scanning",
+			rs:     newSettings(types.ScanTypeBasic, scanRule(types.ScanFrequencyScanOnPush, "*")),
+			inputs: []string{"ENHANCED", "CONTINUOUS_SCAN", "prod-*"},
+			want:   "ENHANCED, SCAN_ON_PUSH [*]; CONTINUOUS_SCAN [prod-*]",
+		},
+		{
+			name:    "basic does not support continuous",
+			rs:      newSettings(types.ScanTypeBasic),
+			inputs:  []string{"BASIC", "CONTINUOUS_SCAN", "*"},
+			wantErr: "does not support",
+		},
+		{
+			name:    "downgrade with existing continuous rule",
+			rs:      newSettings(types.ScanTypeEnhanced, scanRule(types.ScanFrequencyContinuousScan, "*")),
+			inputs:  []string{"BASIC", "SCAN_ON_PUSH", "*"},
+			wantErr: "existing CONTINUOUS_SCAN rule",
+		},
+		{
+			name:   "empty filters remove rule",
+			rs:     newSettings(types.ScanTypeBasic, scanRule(types.ScanFrequencyScanOnPush, "*")),
+			inputs: []string{"BASIC", "SCAN_ON_PUSH", " , "},
+			want:   "BASIC, no rules",
+		},
+		{
+			name:    "missing input",
+			rs:      newSettings(types.ScanTypeBasic),
+			inputs:  []string{"BASIC"},
+			wantErr: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := planScanningEdit(tt.rs, tt.inputs)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := describeScanning(string(cfg.ScanType), cfg.Rules); got != tt.want {
+				t.Errorf("plan = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistrySettingsRenderer_RenderDetail(t *testing.T) {
+	rs := NewRegistrySettingsResource("123456789012", Settings{
+		Scanning: &types.RegistryScanningConfiguration{ScanType: types.ScanTypeBasic},
+		Replication: &types.ReplicationConfiguration{Rules: []types.ReplicationRule{{
+			Destinations:      []types.ReplicationDestination{{Region: aws.String("eu-west-1"), RegistryId: aws.String("123456789012")}},
+			RepositoryFilters: []types.RepositoryFilter{{Filter: aws.String("prod"), FilterType: types.RepositoryFilterTypePrefixMatch}},
+		}}},
+		PullThroughCacheRules: []types.PullThroughCacheRule{{
+			EcrRepositoryPrefix: aws.String("docker-hub"),
+			UpstreamRegistryUrl: aws.String("registry-1.docker.io"),
+		}},
+	})
+
+	detail := NewRegistrySettingsRenderer().RenderDetail(rs)
+	for _, want := range []string{"BASIC", "not scanned automatically", "eu-west-1/123456789012", "prod*", "docker-hub", "registry-1.docker.io"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("RenderDetail() missing %q", want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/enrichment"
 	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
@@ -34,6 +35,9 @@ func (d *RepositoryDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	input := &ecr.DescribeRepositoriesInput{}
 	paginator := ecr.NewDescribeRepositoriesPaginator(d.client, input)
 
+	// Replication rules are registry-wide, so they are fetched once per list
+	rules, status := d.replicationRules(ctx)
+
 	var resources []dao.Resource
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
@@ -42,7 +46,9 @@ func (d *RepositoryDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		}
 
 		for _, repo := range output.Repositories {
-			resources = append(resources, NewRepositoryResource(repo))
+			r := NewRepositoryResource(repo)
+			r.setReplication(rules, status)
+			resources = append(resources, r)
 		}
 	}
 
@@ -63,7 +69,12 @@ func (d *RepositoryDAO) Get(ctx context.Context, id string) (dao.Resource, error
 		return nil, fmt.Errorf("repository not found: %s", id)
 	}
 
-	return NewRepositoryResource(output.Repositories[0]), nil
+	r := NewRepositoryResource(output.Repositories[0])
+	r.setReplication(d.replicationRules(ctx))
+	if len(r.ReplicationDestinations) > 0 {
+		d.fetchImageReplication(ctx, r)
+	}
+	return r, nil
 }
 
 func (d *RepositoryDAO) Delete(ctx context.Context, id string) error {
@@ -90,6 +101,12 @@ func (d *RepositoryDAO) Delete(ctx context.Context, id string) error {
 type RepositoryResource struct {
 	dao.BaseResource
 	Item types.Repository
+
+	// Destinations of the registry replication rules matching the repository
+	ReplicationDestinations []types.ReplicationDestination
+	ReplicationStatus       enrichment.Status
+	// Replication status of the latest image (fetched in Get() only)
+	LatestImage *ImageReplication
 }
 
 // NewRepositoryResource creates a new RepositoryResource
@@ -1,10 +1,16 @@
 package repositories
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/enrichment"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // RepositoryRenderer renders ECR repositories with custom columns
@@ -77,6 +83,17 @@ func NewRepositoryRenderer() render.Renderer {
 					},
 					Priority: 4,
 				},
+				{
+					Name:  "REPLICATION",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if rr, ok := r.(*RepositoryResource); ok {
+							return rr.ReplicationSummary()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
 				{
 					Name:  "AGE",
 					Width: 8,
@@ -88,7 +105,7 @@ func NewRepositoryRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 5,
+					Priority: 6,
 				},
 			},
 		},
@@ -132,9 +149,56 @@ func (r *RepositoryRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("KMS Key", *rr.Item.EncryptionConfiguration.KmsKey)
 	}
 
+	// Replication
+	d.Section("Replication")
+	switch {
+	case enrichment.IsFailure(rr.ReplicationStatus):
+		d.Field("Status", enrichment.Display(rr.ReplicationStatus))
+	case len(rr.ReplicationDestinations) == 0:
+		d.Field("Status", "Not replicated")
+	default:
+		for _, dest := range rr.ReplicationDestinations {
+			d.Field("Destination", rr.destinationString(dest))
+		}
+		renderLatestImageReplication(d, rr)
+	}
+
 	return d.String()
 }
 
+// renderLatestImageReplication renders the per-destination replication
+// status of the most recently pushed image
+func renderLatestImageReplication(d *render.DetailBuilder, rr *RepositoryResource) {
+	ir := rr.LatestImage
+	if ir == nil {
+		return
+	}
+
+	image := ir.Digest
+	if ir.Tag != "" {
+		image = ir.Tag + " (" + ir.Digest + ")"
+	}
+	d.Field("Latest Image", image)
+	if !ir.PushedAt.IsZero() {
+		d.Field("  Pushed", ir.PushedAt.Format(time.RFC3339)+" ("+render.FormatAge(ir.PushedAt)+" ago)")
+	}
+	for _, s := range ir.Statuses {
+		dest := rr.destinationString(types.ReplicationDestination{Region: s.Region, RegistryId: s.RegistryId})
+		switch s.Status {
+		case types.ReplicationStatusComplete:
+			d.FieldStyled("  "+dest, string(s.Status), ui.SuccessStyle())
+		case types.ReplicationStatusFailed:
+			status := string(s.Status)
+			if code := appaws.Str(s.FailureCode); code != "" {
+				status += ": " + code
+			}
+			d.FieldStyled("  "+dest, status, ui.DangerStyle())
+		default:
+			d.FieldStyled("  "+dest, string(s.Status), ui.WarningStyle())
+		}
+	}
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *RepositoryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	rr, ok := resource.(*RepositoryResource)
@@ -157,6 +221,13 @@ func (r *RepositoryRenderer) RenderSummary(resource dao.Resource) []render.Summa
 
 	fields = append(fields, render.SummaryField{Label: "Encryption", Value: rr.EncryptionType()})
 
+	if dests := rr.ReplicationSummary(); dests != "" {
+		fields = append(fields, render.SummaryField{Label: "Replication", Value: dests})
+	}
+	if failed := rr.LatestImage.FailedReplications(); failed > 0 {
+		fields = append(fields, render.SummaryField{Label: "Failed Replications", Value: fmt.Sprintf("%d (latest image)", failed)})
+	}
+
 	if rr.Item.CreatedAt != nil {
 		fields = append(fields, render.SummaryField{
 			Label: "Created",
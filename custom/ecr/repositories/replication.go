@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/enrichment"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ImageReplication is the replication status of the most recently pushed
+// image in a repository
+type ImageReplication struct {
+	Digest   string
+	Tag      string
+	PushedAt time.Time
+	Statuses []types.ImageReplicationStatus
+}
+
+// ReplicationDestinations returns the destinations the registry replication
+// rules copy repository to, in rule order without duplicates. Rules without
+// filters apply to every repository.
+func ReplicationDestinations(rules []types.ReplicationRule, repository string) []types.ReplicationDestination {
+	var dests []types.ReplicationDestination
+	seen := make(map[string]struct{})
+	for _, rule := range rules {
+		if !ruleMatches(rule, repository) {
+			continue
+		}
+		for _, dest := range rule.Destinations {
+			key := appaws.Str(dest.Region) + "/" + appaws.Str(dest.RegistryId)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			dests = append(dests, dest)
+		}
+	}
+	return dests
+}
+
+func ruleMatches(rule types.ReplicationRule, repository string) bool {
+	if len(rule.RepositoryFilters) == 0 {
+		return true
+	}
+	for _, filter := range rule.RepositoryFilters {
+		if filter.FilterType == types.RepositoryFilterTypePrefixMatch && strings.HasPrefix(repository, appaws.Str(filter.Filter)) {
+			return true
+		}
+	}
+	return false
+}
+
+// replicationRules returns the registry replication rules. Failures are
+// logged and reported through the returned status.
+func (d *RepositoryDAO) replicationRules(ctx context.Context) ([]types.ReplicationRule, enrichment.Status) {
+	output, err := d.client.DescribeRegistry(ctx, &ecr.DescribeRegistryInput{})
+	if err != nil {
+		log.Warn("failed to describe registry replication", "error", err)
+		return nil, enrichment.FailureStatus(err)
+	}
+	if output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0 {
+		return nil, enrichment.NotConfigured
+	}
+	return output.ReplicationConfiguration.Rules, enrichment.Configured
+}
+
+// setReplication applies the registry replication rules to r
+func (r *RepositoryResource) setReplication(rules []types.ReplicationRule, status enrichment.Status) {
+	r.ReplicationStatus = status
+	r.ReplicationDestinations = ReplicationDestinations(rules, r.GetID())
+}
+
+// fetchImageReplication fetches the replication status of the most recently
+// pushed image. Failures are logged and leave LatestImage unset.
+func (d *RepositoryDAO) fetchImageReplication(ctx context.Context, r *RepositoryResource) {
+	name := r.GetID()
+	images, err := appaws.Paginate(ctx, func(token *string) ([]types.ImageDetail, *string, error) {
+		output, err := d.client.DescribeImages(ctx, &ecr.DescribeImagesInput{
+			RepositoryName: &name,
+			NextToken:      token,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return output.ImageDetails, output.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to describe images for replication status", "repository", name, "error", err)
+		return
+	}
+
+	var image *types.ImageDetail
+	for i := range images {
+		if image == nil || appaws.Time(images[i].ImagePushedAt).After(appaws.Time(image.ImagePushedAt)) {
+			image = &images[i]
+		}
+	}
+	if image == nil || image.ImageDigest == nil {
+		return
+	}
+
+	output, err := d.client.DescribeImageReplicationStatus(ctx, &ecr.DescribeImageReplicationStatusInput{
+		RepositoryName: &name,
+		ImageId:        &types.ImageIdentifier{ImageDigest: image.ImageDigest},
+	})
+	if err != nil {
+		log.Warn("failed to describe image replication status", "repository", name, "error", err)
+		return
+	}
+
+	ir := &ImageReplication{
+		Digest:   appaws.Str(image.ImageDigest),
+		PushedAt: appaws.Time(image.ImagePushedAt),
+		Statuses: output.ReplicationStatuses,
+	}
+	if len(image.ImageTags) > 0 {
+		ir.Tag = image.ImageTags[0]
+	}
+	r.LatestImage = ir
+}
+
+// ReplicationSummary returns the replication destinations for the list
+// view, omitting the registry when it is the repository's own
+func (r *RepositoryResource) ReplicationSummary() string {
+	if enrichment.IsFailure(r.ReplicationStatus) {
+		return "unknown"
+	}
+	parts := make([]string, 0, len(r.ReplicationDestinations))
+	for _, dest := range r.ReplicationDestinations {
+		parts = append(parts, r.destinationString(dest))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (r *RepositoryResource) destinationString(dest types.ReplicationDestination) string {
+	region := appaws.Str(dest.Region)
+	if registry := appaws.Str(dest.RegistryId); registry != "" && registry != appaws.Str(r.Item.RegistryId) {
+		return region + "/" + registry
+	}
+	return region
+}
+
+// FailedReplications returns the number of destinations the latest image
+// failed to replicate to
+func (ir *ImageReplication) FailedReplications() int {
+	if ir == nil {
+		return 0
+	}
+	var failed int
+	for _, s := range ir.Statuses {
+		if s.Status == types.ReplicationStatusFailed {
+			failed++
+		}
+	}
+	return failed
+}
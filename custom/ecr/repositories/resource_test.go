@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/clawscli/claws/internal/enrichment"
+)
+
+func replicationRule(dests []types.ReplicationDestination, prefixes ...string) types.ReplicationRule {
+	rule := types.ReplicationRule{Destinations: dests}
+	for _, p := range prefixes {
+		rule.RepositoryFilters = append(rule.RepositoryFilters, types.RepositoryFilter{
+			Filter:     aws.String(p),
+			FilterType: types.RepositoryFilterTypePrefixMatch,
+		})
+	}
+	return rule
+}
+
+func destination(region, registry string) types.ReplicationDestination {
+	return types.ReplicationDestination{Region: aws.String(region), RegistryId: aws.String(registry)}
+}
+
+func newRepository(name string) *RepositoryResource {
+	return NewRepositoryResource(types.Repository{
+		RepositoryName: aws.String(name),
+		RegistryId:     aws.String("123456789012"),
+	})
+}
+
+func TestReplicationDestinations(t *testing.T) {
+	rules := []types.ReplicationRule{
+		replicationRule([]types.ReplicationDestination{destination("us-west-2", "123456789012")}),
+		replicationRule([]types.ReplicationDestination{
+			destination("us-west-2", "123456789012"),
+			destination("eu-west-1", "210987654321"),
+		}, "prod/", "shared/"),
+	}
+
+	tests := []struct {
+		repo string
+		want []string
+	}{
+		{"prod/api", []string{"us-west-2/123456789012", "eu-west-1/210987654321"}},
+		{"shared/base", []string{"us-west-2/123456789012", "eu-west-1/210987654321"}},
+		{"dev/api", []string{"us-west-2/123456789012"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			var got []string
+			for _, d := range ReplicationDestinations(rules, tt.repo) {
+				got = append(got, aws.ToString(d.Region)+"/"+aws.ToString(d.RegistryId))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("ReplicationDestinations(%q) = %v, want %v", tt.repo, got, tt.want)
+			}
+		})
+	}
+
+	if got := ReplicationDestinations(nil, "prod/api"); len(got) != 0 {
+		t.Errorf("no rules should yield no destinations, got %v", got)
+	}
+}
+
+func TestRepositoryReplicationSummary(t *testing.T) {
+	rules := []types.ReplicationRule{
+		replicationRule([]types.ReplicationDestination{
+			destination("us-west-2", "123456789012"),
+			destination("eu-west-1", "210987654321"),
+		}, "prod/"),
+	}
+
+	r := newRepository("prod/api")
+	r.setReplication(rules, enrichment.Configured)
+	if got, want := r.ReplicationSummary(), "us-west-2, eu-west-1/210987654321"; got != want {
+		t.Errorf("ReplicationSummary() = %q, want %q", got, want)
+	}
+
+	r = newRepository("dev/api")
+	r.setReplication(rules, enrichment.Configured)
+	if got := r.ReplicationSummary(); got != "" {
+		t.Errorf("unmatched repository ReplicationSummary() = %q, want empty", got)
+	}
+
+	r.setReplication(nil, enrichment.AccessDenied)
+	if got := r.ReplicationSummary(); got != "unknown" {
+		t.Errorf("failed lookup ReplicationSummary() = %q, want unknown", got)
+	}
+}
+
+func TestRenderLatestImageReplication(t *testing.T) {
+	r := newRepository("prod/api")
+	r.setReplication([]types.ReplicationRule{
+		replicationRule([]types.ReplicationDestination{
+			destination("us-west-2", "123456789012"),
+			destination("eu-west-1", "210987654321"),
+		}),
+	}, enrichment.Configured)
+	r.LatestImage = &ImageReplication{
+		Digest: "sha256:abc",
+		Tag:    "v1.2.3",
+		Statuses: []types.ImageReplicationStatus{
+			{Region: aws.String("us-west-2"), RegistryId: aws.String("123456789012"), Status: types.ReplicationStatusComplete},
+			{Region: aws.String("eu-west-1"), RegistryId: aws.String("210987654321"), Status: types.ReplicationStatusFailed, FailureCode: aws.String("ACCESS_DENIED")},
+		},
+	}
+
+	if got := r.LatestImage.FailedReplications(); got != 1 {
+		t.Errorf("FailedReplications() = %d, want 1", got)
+	}
+
+	detail := NewRepositoryRenderer().RenderDetail(r)
+	for _, want := range []string{"Replication", "v1.2.3 (sha256:abc)", "COMPLETE", "FAILED: ACCESS_DENIED", "eu-west-1/210987654321"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected %q in detail, got %q", want, detail)
+		}
+	}
+}
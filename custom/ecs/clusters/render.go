@@ -204,6 +204,14 @@ func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation
 			FilterField: "ClusterName",
 			FilterValue: clusterName,
 		},
+		{
+			Key:         "i",
+			Label:       "Performance",
+			Service:     "ecs",
+			Resource:    "performance",
+			FilterField: "ClusterName",
+			FilterValue: clusterName,
+		},
 		{
 			Key:      "D",
 			Label:    "Task Definitions",
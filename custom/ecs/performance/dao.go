@@ -0,0 +1,174 @@
+package performance
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Container Insights metric query IDs
+const (
+	queryCPUUsed     = "cpu_used"
+	queryCPUReserved = "cpu_reserved"
+	queryMemUsed     = "mem_used"
+	queryMemReserved = "mem_reserved"
+)
+
+// PerformanceDAO reads per-service CPU and memory usage from ECS Container Insights
+type PerformanceDAO struct {
+	dao.BaseDAO
+	client *cloudwatch.Client
+}
+
+// NewPerformanceDAO creates a new PerformanceDAO
+func NewPerformanceDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PerformanceDAO{
+		BaseDAO: dao.NewBaseDAO("ecs", "performance"),
+		client:  cloudwatch.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns services with Container Insights data in the metrics window,
+// heaviest CPU consumers first. Optionally filtered by ClusterName.
+func (d *PerformanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	queries := serviceQueries(dao.GetFilterFromContext(ctx, "ClusterName"))
+	results, err := cwClient.QueryInsights(ctx, d.client, queries, config.File().MetricsWindow())
+	if err != nil {
+		return nil, apperrors.Wrap(err, "query container insights")
+	}
+
+	usage := NewServiceUsages(results)
+	resources := make([]dao.Resource, len(usage))
+	for i, u := range usage {
+		resources[i] = u
+	}
+	return resources, nil
+}
+
+func (d *PerformanceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for ecs performance")
+}
+
+func (d *PerformanceDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for ecs performance")
+}
+
+// Supports returns supported operations
+func (d *PerformanceDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// serviceQueries builds the Metrics Insights queries for per-service usage,
+// limited to cluster if set
+func serviceQueries(cluster string) []cwClient.InsightsQuery {
+	where := ""
+	if cluster != "" {
+		where = " WHERE ClusterName = " + cwClient.InsightsString(cluster)
+	}
+	query := func(id, metric string) cwClient.InsightsQuery {
+		return cwClient.InsightsQuery{
+			ID:         id,
+			Expression: fmt.Sprintf(`SELECT AVG(%s) FROM SCHEMA("ECS/ContainerInsights", ClusterName, ServiceName)%s GROUP BY ClusterName, ServiceName`, metric, where),
+			GroupBy:    []string{"ClusterName", "ServiceName"},
+		}
+	}
+	return []cwClient.InsightsQuery{
+		query(queryCPUUsed, "CpuUtilized"),
+		query(queryCPUReserved, "CpuReserved"),
+		query(queryMemUsed, "MemoryUtilized"),
+		query(queryMemReserved, "MemoryReserved"),
+	}
+}
+
+// ServiceUsage is the latest CPU and memory usage of an ECS service
+type ServiceUsage struct {
+	dao.BaseResource
+	Cluster     string
+	Service     string
+	CPUUsed     float64 // CPU units (1024 per vCPU)
+	CPUReserved float64
+	MemUsed     float64 // MiB
+	MemReserved float64
+	Rank        int // 1-based position by CPU utilization
+}
+
+// NewServiceUsages joins the query results per service and ranks them by
+// CPU utilization, then memory utilization.
+func NewServiceUsages(results map[string]cwClient.InsightsSeries) []*ServiceUsage {
+	byLabel := make(map[string]*ServiceUsage)
+	for _, id := range []string{queryCPUUsed, queryCPUReserved, queryMemUsed, queryMemReserved} {
+		for label, value := range results[id] {
+			u, ok := byLabel[label]
+			if !ok {
+				parts := cwClient.SplitInsightsLabel(label)
+				if len(parts) != 2 {
+					continue
+				}
+				u = &ServiceUsage{Cluster: parts[0], Service: parts[1]}
+				u.BaseResource = dao.BaseResource{
+					ID:   u.Cluster + "/" + u.Service,
+					Name: u.Service,
+				}
+				byLabel[label] = u
+			}
+			switch id {
+			case queryCPUUsed:
+				u.CPUUsed = value
+			case queryCPUReserved:
+				u.CPUReserved = value
+			case queryMemUsed:
+				u.MemUsed = value
+			case queryMemReserved:
+				u.MemReserved = value
+			}
+		}
+	}
+
+	usage := make([]*ServiceUsage, 0, len(byLabel))
+	for _, u := range byLabel {
+		usage = append(usage, u)
+	}
+	slices.SortFunc(usage, func(a, b *ServiceUsage) int {
+		if c := cmp.Compare(b.CPUPercent(), a.CPUPercent()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.MemoryPercent(), a.MemoryPercent()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	for i, u := range usage {
+		u.Rank = i + 1
+	}
+	return usage
+}
+
+// CPUPercent returns CPU used as a percentage of CPU reserved
+func (u *ServiceUsage) CPUPercent() float64 {
+	return percent(u.CPUUsed, u.CPUReserved)
+}
+
+// MemoryPercent returns memory used as a percentage of memory reserved
+func (u *ServiceUsage) MemoryPercent() float64 {
+	return percent(u.MemUsed, u.MemReserved)
+}
+
+func percent(used, reserved float64) float64 {
+	if reserved <= 0 {
+		return 0
+	}
+	return used / reserved * 100
+}
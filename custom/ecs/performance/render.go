@@ -0,0 +1,151 @@
+package performance
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// barWidth is the width of the utilization bars
+const barWidth = 10
+
+// PerformanceRenderer renders ECS Container Insights usage
+// Ensure PerformanceRenderer implements render.Navigator
+var _ render.Navigator = (*PerformanceRenderer)(nil)
+
+type PerformanceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPerformanceRenderer creates a new PerformanceRenderer
+func NewPerformanceRenderer() render.Renderer {
+	return &PerformanceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecs",
+			Resource: "performance",
+			Cols: []render.Column{
+				{Name: "#", Width: 4, Getter: getRank},
+				{Name: "SERVICE", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "CLUSTER", Width: 20, Getter: getCluster},
+				{Name: "CPU", Width: 16, Getter: getCPUBar},
+				{Name: "MEMORY", Width: 16, Getter: getMemoryBar},
+				{Name: "CPU UNITS", Width: 12, Getter: getCPUUnits},
+				{Name: "MEMORY MIB", Width: 14, Getter: getMemoryMiB},
+			},
+		},
+	}
+}
+
+func getRank(r dao.Resource) string {
+	if u, ok := r.(*ServiceUsage); ok {
+		return fmt.Sprintf("%d", u.Rank)
+	}
+	return ""
+}
+
+func getCluster(r dao.Resource) string {
+	if u, ok := r.(*ServiceUsage); ok {
+		return u.Cluster
+	}
+	return ""
+}
+
+func getCPUBar(r dao.Resource) string {
+	if u, ok := r.(*ServiceUsage); ok {
+		return render.FormatPercentBar(u.CPUPercent(), barWidth)
+	}
+	return ""
+}
+
+func getMemoryBar(r dao.Resource) string {
+	if u, ok := r.(*ServiceUsage); ok {
+		return render.FormatPercentBar(u.MemoryPercent(), barWidth)
+	}
+	return ""
+}
+
+func getCPUUnits(r dao.Resource) string {
+	if u, ok := r.(*ServiceUsage); ok {
+		return fmt.Sprintf("%.0f/%.0f", u.CPUUsed, u.CPUReserved)
+	}
+	return ""
+}
+
+func getMemoryMiB(r dao.Resource) string {
+	if u, ok := r.(*ServiceUsage); ok {
+		return fmt.Sprintf("%.0f/%.0f", u.MemUsed, u.MemReserved)
+	}
+	return ""
+}
+
+// RenderDetail renders detailed usage information
+func (r *PerformanceRenderer) RenderDetail(resource dao.Resource) string {
+	u, ok := resource.(*ServiceUsage)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ECS Service Performance", u.Service)
+
+	d.Section("Basic Information")
+	d.Field("Service", u.Service)
+	d.Field("Cluster", u.Cluster)
+	d.Field("Rank", fmt.Sprintf("%d (by CPU utilization)", u.Rank))
+
+	d.Section("CPU")
+	d.Field("Utilization", render.FormatPercentBar(u.CPUPercent(), barWidth*2))
+	d.Field("Used", fmt.Sprintf("%.0f units (%.2f vCPU)", u.CPUUsed, u.CPUUsed/1024))
+	d.Field("Reserved", fmt.Sprintf("%.0f units (%.2f vCPU)", u.CPUReserved, u.CPUReserved/1024))
+
+	d.Section("Memory")
+	d.Field("Utilization", render.FormatPercentBar(u.MemoryPercent(), barWidth*2))
+	d.Field("Used", fmt.Sprintf("%.0f MiB", u.MemUsed))
+	d.Field("Reserved", fmt.Sprintf("%.0f MiB", u.MemReserved))
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PerformanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	u, ok := resource.(*ServiceUsage)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Service", Value: u.Service},
+		{Label: "Cluster", Value: u.Cluster},
+		{Label: "CPU", Value: fmt.Sprintf("%.0f%% of %.0f units", u.CPUPercent(), u.CPUReserved)},
+		{Label: "Memory", Value: fmt.Sprintf("%.0f%% of %.0f MiB", u.MemoryPercent(), u.MemReserved)},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *PerformanceRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	u, ok := resource.(*ServiceUsage)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "s",
+			Label:       "Cluster Services",
+			Service:     "ecs",
+			Resource:    "services",
+			FilterField: "ClusterName",
+			FilterValue: u.Cluster,
+		},
+		{
+			Key:         "t",
+			Label:       "Tasks",
+			Service:     "ecs",
+			Resource:    "tasks",
+			FilterField: "ServiceName",
+			FilterValue: u.Service,
+		},
+	}
+}
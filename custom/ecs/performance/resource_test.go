@@ -0,0 +1,49 @@
+package performance
+
+import (
+	"strings"
+	"testing"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+)
+
+func TestNewServiceUsages(t *testing.T) {
+	results := map[string]cwClient.InsightsSeries{
+		queryCPUUsed:     {"prod|api": 256, "prod|worker": 900, "dev|api": 10},
+		queryCPUReserved: {"prod|api": 1024, "prod|worker": 1024, "dev|api": 512},
+		queryMemUsed:     {"prod|api": 1024, "prod|worker": 512, "dev|api": 100},
+		queryMemReserved: {"prod|api": 2048, "prod|worker": 2048},
+	}
+
+	usage := NewServiceUsages(results)
+	if len(usage) != 3 {
+		t.Fatalf("len(usage) = %d, want 3", len(usage))
+	}
+
+	top := usage[0]
+	if top.ID != "prod/worker" || top.Rank != 1 || top.Cluster != "prod" || top.Service != "worker" {
+		t.Errorf("top = %+v, want prod/worker ranked 1", top)
+	}
+	if got := top.CPUPercent(); got < 87.8 || got > 87.9 {
+		t.Errorf("CPUPercent() = %v, want ~87.9", got)
+	}
+	if usage[1].ID != "prod/api" || usage[1].MemoryPercent() != 50 {
+		t.Errorf("second = %s mem %v, want prod/api at 50%%", usage[1].ID, usage[1].MemoryPercent())
+	}
+	if usage[2].MemoryPercent() != 0 {
+		t.Errorf("MemoryPercent() without reservation = %v, want 0", usage[2].MemoryPercent())
+	}
+}
+
+func TestServiceQueries(t *testing.T) {
+	for _, q := range serviceQueries("") {
+		if strings.Contains(q.Expression, "WHERE") {
+			t.Errorf("unfiltered query has WHERE: %s", q.Expression)
+		}
+	}
+	for _, q := range serviceQueries("it's") {
+		if !strings.Contains(q.Expression, `WHERE ClusterName = 'it\'s' GROUP BY ClusterName, ServiceName`) {
+			t.Errorf("filtered query = %s", q.Expression)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package serviceconnect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// proxyContainerPrefix is the name prefix of the Service Connect proxy
+// container ECS injects into each task (e.g., "ecs-service-connect-AbCd").
+const proxyContainerPrefix = "ecs-service-connect"
+
+// EndpointDAO lists the Service Connect endpoints of ECS services
+type EndpointDAO struct {
+	dao.BaseDAO
+	client *ecs.Client
+}
+
+// NewEndpointDAO creates a new EndpointDAO
+func NewEndpointDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EndpointDAO{
+		BaseDAO: dao.NewBaseDAO("ecs", "service-connect"),
+		client:  ecs.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns one resource per Service Connect endpoint (or per client-only
+// service), optionally filtered by ClusterName and ServiceName.
+func (d *EndpointDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	clusters := []string{dao.GetFilterFromContext(ctx, "ClusterName")}
+	if clusters[0] == "" {
+		arns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+			output, err := d.client.ListClusters(ctx, &ecs.ListClustersInput{NextToken: token})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "list clusters")
+			}
+			return output.ClusterArns, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		clusters = arns
+	}
+
+	serviceName := dao.GetFilterFromContext(ctx, "ServiceName")
+	var resources []dao.Resource
+	for _, cluster := range clusters {
+		services, err := d.describeServices(ctx, cluster, serviceName)
+		if err != nil {
+			log.Warn("failed to list services in cluster", "cluster", cluster, "error", err)
+			continue
+		}
+		for _, svc := range services {
+			deployment := PrimaryDeployment(svc)
+			if deployment == nil || deployment.ServiceConnectConfiguration == nil || !deployment.ServiceConnectConfiguration.Enabled {
+				continue
+			}
+			proxy := d.proxyHealth(ctx, cluster, appaws.Str(svc.ServiceName))
+			resources = append(resources, NewEndpointResources(svc, *deployment, proxy)...)
+		}
+	}
+	return resources, nil
+}
+
+// describeServices returns the services of cluster, or only serviceName if set
+func (d *EndpointDAO) describeServices(ctx context.Context, cluster, serviceName string) ([]types.Service, error) {
+	serviceArns := []string{serviceName}
+	if serviceName == "" {
+		arns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+			output, err := d.client.ListServices(ctx, &ecs.ListServicesInput{
+				Cluster:   &cluster,
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "list services")
+			}
+			return output.ServiceArns, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		serviceArns = arns
+	}
+
+	// Describe services in batches of 10 (API limit)
+	var services []types.Service
+	for i := 0; i < len(serviceArns); i += 10 {
+		end := min(i+10, len(serviceArns))
+		output, err := d.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  &cluster,
+			Services: serviceArns[i:end],
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe services in %s", cluster)
+		}
+		services = append(services, output.Services...)
+	}
+	return services, nil
+}
+
+// proxyHealth summarizes the Service Connect proxy container health of the
+// service's running tasks. Only the first page (100 tasks) is inspected.
+func (d *EndpointDAO) proxyHealth(ctx context.Context, cluster, serviceName string) ProxyHealth {
+	listOutput, err := d.client.ListTasks(ctx, &ecs.ListTasksInput{
+		Cluster:       &cluster,
+		ServiceName:   &serviceName,
+		DesiredStatus: types.DesiredStatusRunning,
+	})
+	if err != nil {
+		log.Warn("failed to list service tasks", "service", serviceName, "error", err)
+		return ProxyHealth{}
+	}
+	if len(listOutput.TaskArns) == 0 {
+		return ProxyHealth{}
+	}
+
+	descOutput, err := d.client.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+		Cluster: &cluster,
+		Tasks:   listOutput.TaskArns,
+	})
+	if err != nil {
+		log.Warn("failed to describe service tasks", "service", serviceName, "error", err)
+		return ProxyHealth{}
+	}
+	return ProxyHealthOf(descOutput.Tasks)
+}
+
+func (d *EndpointDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	service, _, _ := strings.Cut(id, "/")
+	resources, err := d.List(dao.WithFilter(ctx, "ServiceName", service))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("service connect endpoint not found: %s", id)
+}
+
+func (d *EndpointDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for service connect endpoints")
+}
+
+// PrimaryDeployment returns the service's PRIMARY deployment, whose Service
+// Connect configuration is the one being rolled out.
+func PrimaryDeployment(svc types.Service) *types.Deployment {
+	for i := range svc.Deployments {
+		if appaws.Str(svc.Deployments[i].Status) == "PRIMARY" {
+			return &svc.Deployments[i]
+		}
+	}
+	return nil
+}
+
+// ProxyHealth counts Service Connect proxy containers by health status
+type ProxyHealth struct {
+	Healthy   int
+	Unhealthy int
+	Unknown   int
+}
+
+// ProxyHealthOf counts the proxy containers of tasks
+func ProxyHealthOf(tasks []types.Task) ProxyHealth {
+	var h ProxyHealth
+	for _, task := range tasks {
+		for _, c := range task.Containers {
+			if !strings.HasPrefix(appaws.Str(c.Name), proxyContainerPrefix) {
+				continue
+			}
+			switch c.HealthStatus {
+			case types.HealthStatusHealthy:
+				h.Healthy++
+			case types.HealthStatusUnhealthy:
+				h.Unhealthy++
+			default:
+				h.Unknown++
+			}
+		}
+	}
+	return h
+}
+
+// Total returns the number of proxies counted
+func (h ProxyHealth) Total() int {
+	return h.Healthy + h.Unhealthy + h.Unknown
+}
+
+// String formats the health as "healthy/total", with unhealthy proxies noted
+func (h ProxyHealth) String() string {
+	if h.Total() == 0 {
+		return ""
+	}
+	s := fmt.Sprintf("%d/%d healthy", h.Healthy, h.Total())
+	if h.Unhealthy > 0 {
+		s += fmt.Sprintf(" (%d unhealthy)", h.Unhealthy)
+	}
+	return s
+}
+
+// EndpointResource is one Service Connect endpoint exposed by an ECS service.
+// Client-only services, which resolve endpoints but expose none, have a
+// single resource with an empty DiscoveryName.
+type EndpointResource struct {
+	dao.BaseResource
+	ClusterArn   string
+	ServiceName  string
+	Namespace    string
+	Endpoint     types.ServiceConnectService
+	DiscoveryArn string
+	Proxy        ProxyHealth
+}
+
+// NewEndpointResources returns the Service Connect endpoints of svc as
+// configured by its deployment
+func NewEndpointResources(svc types.Service, deployment types.Deployment, proxy ProxyHealth) []dao.Resource {
+	sc := deployment.ServiceConnectConfiguration
+	if sc == nil || !sc.Enabled {
+		return nil
+	}
+
+	discoveryArns := make(map[string]string, len(deployment.ServiceConnectResources))
+	for _, r := range deployment.ServiceConnectResources {
+		discoveryArns[appaws.Str(r.DiscoveryName)] = appaws.Str(r.DiscoveryArn)
+	}
+
+	base := EndpointResource{
+		ClusterArn:  appaws.Str(svc.ClusterArn),
+		ServiceName: appaws.Str(svc.ServiceName),
+		Namespace:   appaws.Str(sc.Namespace),
+		Proxy:       proxy,
+	}
+
+	endpoints := sc.Services
+	if len(endpoints) == 0 {
+		endpoints = []types.ServiceConnectService{{}}
+	}
+	resources := make([]dao.Resource, 0, len(endpoints))
+	for _, ep := range endpoints {
+		r := base
+		r.Endpoint = ep
+		name := r.DiscoveryName()
+		r.DiscoveryArn = discoveryArns[name]
+		id := base.ServiceName + "/" + name
+		if name == "" {
+			id = base.ServiceName + "/client"
+		}
+		r.BaseResource = dao.BaseResource{
+			ID:   id,
+			Name: name,
+			ARN:  r.DiscoveryArn,
+			Data: ep,
+		}
+		resources = append(resources, &r)
+	}
+	return resources
+}
+
+// DiscoveryName returns the Cloud Map service name, which defaults to the port name
+func (r *EndpointResource) DiscoveryName() string {
+	if name := appaws.Str(r.Endpoint.DiscoveryName); name != "" {
+		return name
+	}
+	return appaws.Str(r.Endpoint.PortName)
+}
+
+// IsClientOnly reports whether the service only consumes Service Connect endpoints
+func (r *EndpointResource) IsClientOnly() bool {
+	return r.Endpoint.PortName == nil
+}
+
+// ClientAliases returns the endpoint's client aliases as "dns:port"
+func (r *EndpointResource) ClientAliases() []string {
+	aliases := make([]string, 0, len(r.Endpoint.ClientAliases))
+	for _, a := range r.Endpoint.ClientAliases {
+		dns := appaws.Str(a.DnsName)
+		if dns == "" {
+			// Defaults to discoveryName.namespace; a namespace given by ARN has no name
+			dns = r.DiscoveryName()
+			if r.Namespace != "" && appaws.ParseARN(r.Namespace) == nil {
+				dns += "." + r.Namespace
+			}
+		}
+		aliases = append(aliases, fmt.Sprintf("%s:%d", dns, appaws.Int32(a.Port)))
+	}
+	return aliases
+}
+
+// CloudMapServiceID returns the Cloud Map service ID (srv-...) from the discovery ARN
+func (r *EndpointResource) CloudMapServiceID() string {
+	if parsed := appaws.ParseARN(r.DiscoveryArn); parsed != nil {
+		return parsed.ResourceID
+	}
+	return ""
+}
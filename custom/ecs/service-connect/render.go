@@ -0,0 +1,186 @@
+package serviceconnect
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// EndpointRenderer renders ECS Service Connect endpoints
+// Ensure EndpointRenderer implements render.Navigator
+var _ render.Navigator = (*EndpointRenderer)(nil)
+
+type EndpointRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEndpointRenderer creates a new EndpointRenderer
+func NewEndpointRenderer() render.Renderer {
+	return &EndpointRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecs",
+			Resource: "service-connect",
+			Cols: []render.Column{
+				{Name: "DISCOVERY NAME", Width: 25, Getter: getDiscoveryName},
+				{Name: "SERVICE", Width: 30, Getter: getService},
+				{Name: "NAMESPACE", Width: 20, Getter: getNamespace},
+				{Name: "CLIENT ALIASES", Width: 35, Getter: getClientAliases},
+				{Name: "PROXY HEALTH", Width: 22, Getter: getProxyHealth},
+			},
+		},
+	}
+}
+
+func getDiscoveryName(r dao.Resource) string {
+	if ep, ok := r.(*EndpointResource); ok {
+		if ep.IsClientOnly() {
+			return "(client only)"
+		}
+		return ep.DiscoveryName()
+	}
+	return ""
+}
+
+func getService(r dao.Resource) string {
+	if ep, ok := r.(*EndpointResource); ok {
+		return ep.ServiceName
+	}
+	return ""
+}
+
+func getNamespace(r dao.Resource) string {
+	if ep, ok := r.(*EndpointResource); ok {
+		return appaws.ExtractResourceName(ep.Namespace)
+	}
+	return ""
+}
+
+func getClientAliases(r dao.Resource) string {
+	if ep, ok := r.(*EndpointResource); ok {
+		if aliases := ep.ClientAliases(); len(aliases) > 0 {
+			return strings.Join(aliases, ", ")
+		}
+		return "-"
+	}
+	return ""
+}
+
+func getProxyHealth(r dao.Resource) string {
+	if ep, ok := r.(*EndpointResource); ok {
+		if health := ep.Proxy.String(); health != "" {
+			return health
+		}
+		return "-"
+	}
+	return ""
+}
+
+// RenderDetail renders detailed endpoint information
+func (r *EndpointRenderer) RenderDetail(resource dao.Resource) string {
+	ep, ok := resource.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ECS Service Connect Endpoint", getDiscoveryName(ep))
+
+	d.Section("Basic Information")
+	d.Field("Service", ep.ServiceName)
+	d.Field("Cluster", appaws.ExtractResourceName(ep.ClusterArn))
+	d.Field("Namespace", ep.Namespace)
+	if ep.IsClientOnly() {
+		d.Field("Role", "Client only")
+	} else {
+		d.Field("Role", "Client and server")
+		d.Field("Port Name", appaws.Str(ep.Endpoint.PortName))
+		if port := ep.Endpoint.IngressPortOverride; port != nil {
+			d.Field("Ingress Port Override", fmt.Sprintf("%d", *port))
+		}
+	}
+
+	if aliases := ep.ClientAliases(); len(aliases) > 0 {
+		d.Section("Client Aliases")
+		for _, alias := range aliases {
+			d.Field("Endpoint", alias)
+		}
+	}
+
+	if ep.DiscoveryArn != "" {
+		d.Section("Cloud Map Service")
+		d.Field("Name", ep.DiscoveryName())
+		d.Field("Service ID", ep.CloudMapServiceID())
+		d.Field("ARN", ep.DiscoveryArn)
+	}
+
+	d.Section("Proxy Health")
+	if ep.Proxy.Total() == 0 {
+		d.Field("Proxies", "No running tasks")
+	} else {
+		d.Field("Healthy", fmt.Sprintf("%d", ep.Proxy.Healthy))
+		d.Field("Unhealthy", fmt.Sprintf("%d", ep.Proxy.Unhealthy))
+		d.Field("Unknown", fmt.Sprintf("%d", ep.Proxy.Unknown))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EndpointRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ep, ok := resource.(*EndpointResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Discovery Name", Value: getDiscoveryName(ep)},
+		{Label: "Service", Value: ep.ServiceName},
+		{Label: "Namespace", Value: ep.Namespace},
+	}
+	if health := ep.Proxy.String(); health != "" {
+		fields = append(fields, render.SummaryField{Label: "Proxy Health", Value: health})
+	}
+	if ep.DiscoveryArn != "" {
+		fields = append(fields, render.SummaryField{Label: "Cloud Map Service", Value: ep.DiscoveryArn})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *EndpointRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	ep, ok := resource.(*EndpointResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "s",
+			Label:       "Service",
+			Service:     "ecs",
+			Resource:    "services",
+			FilterField: "ServiceName",
+			FilterValue: ep.ServiceName,
+		},
+		{
+			Key:         "t",
+			Label:       "Tasks",
+			Service:     "ecs",
+			Resource:    "tasks",
+			FilterField: "ServiceName",
+			FilterValue: ep.ServiceName,
+		},
+		{
+			Key:         "p",
+			Label:       "Cluster",
+			Service:     "ecs",
+			Resource:    "clusters",
+			FilterField: "ClusterName",
+			FilterValue: appaws.ExtractResourceName(ep.ClusterArn),
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package serviceconnect
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestNewEndpointResources(t *testing.T) {
+	svc := types.Service{
+		ServiceName: aws.String("orders"),
+		ClusterArn:  aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/prod"),
+	}
+	deployment := types.Deployment{
+		Status: aws.String("PRIMARY"),
+		ServiceConnectConfiguration: &types.ServiceConnectConfiguration{
+			Enabled:   true,
+			Namespace: aws.String("internal"),
+			Services: []types.ServiceConnectService{
+				{
+					PortName: aws.String("http"),
+					ClientAliases: []types.ServiceConnectClientAlias{
+						{Port: aws.Int32(80), DnsName: aws.String("orders.internal")},
+					},
+				},
+				{
+					PortName:      aws.String("grpc"),
+					DiscoveryName: aws.String("orders-grpc"),
+					ClientAliases: []types.ServiceConnectClientAlias{{Port: aws.Int32(9090)}},
+				},
+			},
+		},
+		ServiceConnectResources: []types.ServiceConnectServiceResource{
+			{DiscoveryName: aws.String("http"), DiscoveryArn: aws.String("arn:aws:servicediscovery:us-east-1:123456789012:service/srv-abc123")},
+		},
+	}
+	proxy := ProxyHealth{Healthy: 2, Unhealthy: 1}
+
+	resources := NewEndpointResources(svc, deployment, proxy)
+	if len(resources) != 2 {
+		t.Fatalf("len(resources) = %d, want 2", len(resources))
+	}
+
+	http := resources[0].(*EndpointResource)
+	if http.GetID() != "orders/http" || http.DiscoveryName() != "http" {
+		t.Errorf("http endpoint id=%q name=%q", http.GetID(), http.DiscoveryName())
+	}
+	if http.CloudMapServiceID() != "srv-abc123" {
+		t.Errorf("CloudMapServiceID() = %q, want srv-abc123", http.CloudMapServiceID())
+	}
+	if got := http.ClientAliases(); len(got) != 1 || got[0] != "orders.internal:80" {
+		t.Errorf("ClientAliases() = %v", got)
+	}
+
+	grpc := resources[1].(*EndpointResource)
+	if grpc.DiscoveryName() != "orders-grpc" || grpc.DiscoveryArn != "" {
+		t.Errorf("grpc endpoint name=%q arn=%q", grpc.DiscoveryName(), grpc.DiscoveryArn)
+	}
+	if got := grpc.ClientAliases(); len(got) != 1 || got[0] != "orders-grpc.internal:9090" {
+		t.Errorf("ClientAliases() default = %v, want [orders-grpc.internal:9090]", got)
+	}
+	if got := grpc.Proxy.String(); got != "2/3 healthy (1 unhealthy)" {
+		t.Errorf("Proxy.String() = %q", got)
+	}
+}
+
+func TestNewEndpointResourcesClientOnly(t *testing.T) {
+	svc := types.Service{ServiceName: aws.String("web")}
+	deployment := types.Deployment{
+		Status: aws.String("PRIMARY"),
+		ServiceConnectConfiguration: &types.ServiceConnectConfiguration{
+			Enabled:   true,
+			Namespace: aws.String("arn:aws:servicediscovery:us-east-1:123456789012:namespace/ns-xyz"),
+		},
+	}
+
+	resources := NewEndpointResources(svc, deployment, ProxyHealth{})
+	if len(resources) != 1 {
+		t.Fatalf("len(resources) = %d, want 1", len(resources))
+	}
+	ep := resources[0].(*EndpointResource)
+	if !ep.IsClientOnly() || ep.GetID() != "web/client" {
+		t.Errorf("client-only endpoint id=%q clientOnly=%v", ep.GetID(), ep.IsClientOnly())
+	}
+
+	deployment.ServiceConnectConfiguration.Enabled = false
+	if got := NewEndpointResources(svc, deployment, ProxyHealth{}); len(got) != 0 {
+		t.Errorf("disabled Service Connect returned %d resources", len(got))
+	}
+}
+
+func TestProxyHealthOf(t *testing.T) {
+	tasks := []types.Task{
+		{Containers: []types.Container{
+			{Name: aws.String("app"), HealthStatus: types.HealthStatusUnhealthy},
+			{Name: aws.String("ecs-service-connect-AbCd"), HealthStatus: types.HealthStatusHealthy},
+		}},
+		{Containers: []types.Container{
+			{Name: aws.String("ecs-service-connect-EfGh"), HealthStatus: types.HealthStatusUnknown},
+		}},
+	}
+
+	h := ProxyHealthOf(tasks)
+	if h.Healthy != 1 || h.Unhealthy != 0 || h.Unknown != 1 {
+		t.Errorf("ProxyHealthOf() = %+v, want 1 healthy, 1 unknown", h)
+	}
+	if (ProxyHealth{}).String() != "" {
+		t.Error("empty ProxyHealth should render as empty string")
+	}
+}
+
+func TestPrimaryDeployment(t *testing.T) {
+	svc := types.Service{Deployments: []types.Deployment{
+		{Id: aws.String("old"), Status: aws.String("ACTIVE")},
+		{Id: aws.String("new"), Status: aws.String("PRIMARY")},
+	}}
+	if dep := PrimaryDeployment(svc); dep == nil || aws.ToString(dep.Id) != "new" {
+		t.Errorf("PrimaryDeployment() = %v, want new", dep)
+	}
+	if PrimaryDeployment(types.Service{}) != nil {
+		t.Error("PrimaryDeployment() of service without deployments should be nil")
+	}
+}
@@ -299,6 +299,34 @@ func (r *ServiceRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Assign Public IP", string(vpc.AssignPublicIp))
 	}
 
+	// Service Connect (from the primary deployment)
+	for _, dep := range deployments {
+		sc := dep.ServiceConnectConfiguration
+		if appaws.Str(dep.Status) != "PRIMARY" || sc == nil || !sc.Enabled {
+			continue
+		}
+		d.Section("Service Connect")
+		d.Field("Namespace", appaws.Str(sc.Namespace))
+		if len(sc.Services) == 0 {
+			d.Field("Role", "Client only")
+		}
+		for _, scs := range sc.Services {
+			name := appaws.Str(scs.DiscoveryName)
+			if name == "" {
+				name = appaws.Str(scs.PortName)
+			}
+			var aliases []string
+			for _, a := range scs.ClientAliases {
+				dns := appaws.Str(a.DnsName)
+				if dns == "" {
+					dns = name
+				}
+				aliases = append(aliases, fmt.Sprintf("%s:%d", dns, appaws.Int32(a.Port)))
+			}
+			d.Field(name, strings.Join(aliases, ", "))
+		}
+	}
+
 	// Service Discovery
 	if registries := svc.ServiceRegistries(); len(registries) > 0 {
 		d.Section("Service Discovery")
@@ -446,6 +474,14 @@ func (r *ServiceRenderer) Navigations(resource dao.Resource) []render.Navigation
 			FilterField: "LogGroupPrefix",
 			FilterValue: "/ecs/" + svc.GetName(),
 		},
+		{
+			Key:         "e",
+			Label:       "Service Connect",
+			Service:     "ecs",
+			Resource:    "service-connect",
+			FilterField: "ServiceName",
+			FilterValue: svc.GetName(),
+		},
 	}
 
 	if td := svc.TaskDefinition(); td != "" {
@@ -12,7 +12,10 @@ import (
 	"github.com/clawscli/claws/internal/ui"
 )
 
-var _ render.Navigator = (*TaskDefinitionRenderer)(nil)
+var (
+	_ render.Navigator = (*TaskDefinitionRenderer)(nil)
+	_ render.LogSource = (*TaskDefinitionRenderer)(nil)
+)
 
 type TaskDefinitionRenderer struct {
 	render.BaseRenderer
@@ -255,6 +258,19 @@ func (r *TaskDefinitionRenderer) RenderSummary(resource dao.Resource) []render.S
 	return fields
 }
 
+// LogTargets returns the awslogs groups of the container definitions
+func (r *TaskDefinitionRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	td, ok := resource.(*TaskDefinitionResource)
+	if !ok {
+		return nil
+	}
+	var targets []render.LogTarget
+	for _, group := range td.GetAllCloudWatchLogGroups() {
+		targets = append(targets, render.LogTarget{Group: group})
+	}
+	return targets
+}
+
 func (r *TaskDefinitionRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	td, ok := resource.(*TaskDefinitionResource)
 	if !ok {
@@ -263,17 +279,6 @@ func (r *TaskDefinitionRenderer) Navigations(resource dao.Resource) []render.Nav
 
 	var navs []render.Navigation
 
-	if groups := td.GetAllCloudWatchLogGroups(); len(groups) > 0 {
-		navs = append(navs, render.Navigation{
-			Key:         "l",
-			Label:       "Logs",
-			Service:     "cloudwatch",
-			Resource:    "log-groups",
-			FilterField: "LogGroupPrefix",
-			FilterValue: groups[0],
-		})
-	}
-
 	if role := td.TaskRoleArn(); role != "" {
 		navs = append(navs, render.Navigation{
 			Key:         "r",
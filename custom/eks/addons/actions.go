@@ -0,0 +1,127 @@
+package addons
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("eks", "addons", []action.Action{
+		{
+			Name:      "Upgrade Add-on",
+			Shortcut:  "U",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateAddon",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				ar, ok := r.(*AddonResource)
+				return ok && ar.UpgradeAvailable()
+			},
+			Prompts: []action.Prompt{
+				{
+					Label: "Version",
+					Options: func(_ context.Context, r dao.Resource) ([]string, error) {
+						if ar, ok := r.(*AddonResource); ok {
+							return ar.UpgradeVersions(), nil
+						}
+						return nil, nil
+					},
+				},
+				{
+					Label: "Conflict resolution",
+					Options: func(context.Context, dao.Resource) ([]string, error) {
+						return ConflictResolutions(), nil
+					},
+				},
+			},
+			Preview: previewUpgrade,
+		},
+	})
+
+	action.RegisterExecutor("eks", "addons", executeAddonAction)
+}
+
+func executeAddonAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "UpdateAddon":
+		return executeUpgradeAddon(ctx, resource, act.Input(0), act.Input(1))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// ConflictResolutions returns the ResolveConflicts values, NONE first as the safest choice
+func ConflictResolutions() []string {
+	return []string{
+		string(types.ResolveConflictsNone),
+		string(types.ResolveConflictsOverwrite),
+		string(types.ResolveConflictsPreserve),
+	}
+}
+
+// validateUpgrade checks the prompt inputs against the add-on's compatible versions
+func validateUpgrade(ar *AddonResource, version, resolve string) error {
+	if version == "" || resolve == "" {
+		return action.ErrMissingInput
+	}
+	if !slices.Contains(ar.UpgradeVersions(), version) {
+		return fmt.Errorf("%s is not a newer compatible version of %s", version, ar.GetName())
+	}
+	if !slices.Contains(ConflictResolutions(), resolve) {
+		return fmt.Errorf("unknown conflict resolution %q", resolve)
+	}
+	return nil
+}
+
+func previewUpgrade(resource dao.Resource, inputs []string) string {
+	ar, ok := resource.(*AddonResource)
+	if !ok {
+		return ""
+	}
+	version, resolve := "", ""
+	if len(inputs) > 1 {
+		version, resolve = inputs[0], inputs[1]
+	}
+	if err := validateUpgrade(ar, version, resolve); err != nil {
+		return "Error: " + err.Error()
+	}
+	return fmt.Sprintf("Version:   %s -> %s\nConflicts: %s", ar.Version(), version, resolve)
+}
+
+func executeUpgradeAddon(ctx context.Context, resource dao.Resource, version, resolve string) action.ActionResult {
+	ar, ok := resource.(*AddonResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if err := validateUpgrade(ar, version, resolve); err != nil {
+		return action.FailResult(err)
+	}
+
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	client := eks.NewFromConfig(cfg)
+
+	name := ar.GetName()
+	_, err = client.UpdateAddon(ctx, &eks.UpdateAddonInput{
+		ClusterName:      ar.Addon.ClusterName,
+		AddonName:        &name,
+		AddonVersion:     &version,
+		ResolveConflicts: types.ResolveConflicts(resolve),
+	})
+	if err != nil {
+		return action.FailResultf(err, "update addon %s", name)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Upgrading %s from %s to %s (list auto-refreshes; wait for ACTIVE)",
+		name, ar.Version(), version))
+}
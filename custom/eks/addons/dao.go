@@ -3,6 +3,9 @@ package addons
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -10,6 +13,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/render"
 )
 
@@ -55,6 +59,8 @@ func (d *AddonDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		return nil, nil
 	}
 
+	k8sVersion := d.clusterVersion(ctx, clusterName)
+
 	resources := make([]dao.Resource, 0, len(addonNames))
 	for _, name := range addonNames {
 		output, err := d.client.DescribeAddon(ctx, &eks.DescribeAddonInput{
@@ -68,7 +74,9 @@ func (d *AddonDAO) List(ctx context.Context) ([]dao.Resource, error) {
 			return nil, apperrors.Wrapf(err, "describe addon %s", name)
 		}
 		if output.Addon != nil {
-			resources = append(resources, NewAddonResource(*output.Addon))
+			ar := NewAddonResource(*output.Addon)
+			ar.AvailableVersions, ar.DefaultVersion = d.addonVersions(ctx, name, k8sVersion)
+			resources = append(resources, ar)
 		}
 	}
 
@@ -93,7 +101,65 @@ func (d *AddonDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 		return nil, fmt.Errorf("addon not found: %s", id)
 	}
 
-	return NewAddonResource(*output.Addon), nil
+	ar := NewAddonResource(*output.Addon)
+	ar.AvailableVersions, ar.DefaultVersion = d.addonVersions(ctx, id, d.clusterVersion(ctx, clusterName))
+	return ar, nil
+}
+
+// clusterVersion returns the cluster's Kubernetes version, or "" if it can't be described
+func (d *AddonDAO) clusterVersion(ctx context.Context, clusterName string) string {
+	output, err := d.client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: &clusterName})
+	if err != nil {
+		log.Warn("failed to describe cluster for addon versions", "cluster", clusterName, "error", err)
+		return ""
+	}
+	if output.Cluster == nil {
+		return ""
+	}
+	return appaws.Str(output.Cluster.Version)
+}
+
+// addonVersions returns the add-on versions compatible with k8sVersion, newest first,
+// and the default version for that Kubernetes version. Failures are logged, not returned.
+func (d *AddonDAO) addonVersions(ctx context.Context, name, k8sVersion string) ([]string, string) {
+	if k8sVersion == "" {
+		return nil, ""
+	}
+
+	infos, err := appaws.Paginate(ctx, func(token *string) ([]types.AddonInfo, *string, error) {
+		output, err := d.client.DescribeAddonVersions(ctx, &eks.DescribeAddonVersionsInput{
+			AddonName:         &name,
+			KubernetesVersion: &k8sVersion,
+			NextToken:         token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe addon versions %s", name)
+		}
+		return output.Addons, output.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to describe addon versions", "addon", name, "error", err)
+		return nil, ""
+	}
+
+	var versions []string
+	var defaultVersion string
+	for _, info := range infos {
+		for _, v := range info.AddonVersions {
+			version := appaws.Str(v.AddonVersion)
+			if version == "" || slices.Contains(versions, version) {
+				continue
+			}
+			versions = append(versions, version)
+			for _, c := range v.Compatibilities {
+				if c.DefaultVersion && appaws.Str(c.ClusterVersion) == k8sVersion {
+					defaultVersion = version
+				}
+			}
+		}
+	}
+	SortVersions(versions)
+	return versions, defaultVersion
 }
 
 func (d *AddonDAO) Delete(ctx context.Context, id string) error {
@@ -119,6 +185,11 @@ func (d *AddonDAO) Delete(ctx context.Context, id string) error {
 type AddonResource struct {
 	dao.BaseResource
 	Addon types.Addon
+
+	// AvailableVersions lists versions compatible with the cluster, newest first
+	AvailableVersions []string
+	// DefaultVersion is the version EKS installs by default on this cluster
+	DefaultVersion string
 }
 
 // NewAddonResource creates a new AddonResource
@@ -148,3 +219,55 @@ func (r *AddonResource) Version() string {
 func (r *AddonResource) CreatedAge() string {
 	return render.FormatAge(appaws.Time(r.Addon.CreatedAt))
 }
+
+// LatestVersion returns the newest compatible version, or "" if unknown
+func (r *AddonResource) LatestVersion() string {
+	if len(r.AvailableVersions) == 0 {
+		return ""
+	}
+	return r.AvailableVersions[0]
+}
+
+// UpgradeVersions returns the compatible versions newer than the installed one, newest first
+func (r *AddonResource) UpgradeVersions() []string {
+	current := r.Version()
+	var newer []string
+	for _, v := range r.AvailableVersions {
+		if CompareVersions(v, current) > 0 {
+			newer = append(newer, v)
+		}
+	}
+	return newer
+}
+
+// UpgradeAvailable reports whether a newer compatible version exists
+func (r *AddonResource) UpgradeAvailable() bool {
+	return len(r.UpgradeVersions()) > 0
+}
+
+// SortVersions sorts add-on versions newest first
+func SortVersions(versions []string) {
+	slices.SortFunc(versions, func(a, b string) int {
+		return CompareVersions(b, a)
+	})
+}
+
+// CompareVersions compares add-on versions such as "v1.19.0-eksbuild.1" by their
+// numeric components. It returns -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	return slices.Compare(versionNumbers(a), versionNumbers(b))
+}
+
+// versionNumbers extracts the numeric runs of a version string in order
+func versionNumbers(v string) []int {
+	fields := strings.FieldsFunc(v, func(r rune) bool { return r < '0' || r > '9' })
+	nums := make([]int, 0, len(fields))
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			continue
+		}
+		nums = append(nums, n)
+	}
+	return nums
+}
@@ -8,6 +8,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // AddonRenderer renders EKS add-on resources
@@ -43,6 +44,17 @@ func NewAddonRenderer() render.Renderer {
 						return ""
 					},
 				},
+				{
+					Name:     "LATEST",
+					Width:    20,
+					Priority: 4,
+					Getter: func(r dao.Resource) string {
+						if ar, ok := r.(*AddonResource); ok {
+							return latestColumn(ar)
+						}
+						return ""
+					},
+				},
 				{
 					Name:     "STATUS",
 					Width:    15,
@@ -91,6 +103,25 @@ func (rnd *AddonRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Modified", render.FormatAge(modified))
 	}
 
+	// Versions
+	if len(ar.AvailableVersions) > 0 {
+		d.Section("Versions")
+		d.Field("Current", ar.Version())
+		if upgrades := ar.UpgradeVersions(); len(upgrades) > 0 {
+			d.FieldStyled("Latest", ar.LatestVersion()+" (upgrade available)", ui.WarningStyle())
+			newer := strings.Join(upgrades, ", ")
+			if len(upgrades) > maxUpgradeVersions {
+				newer = fmt.Sprintf("%s, ... %d more", strings.Join(upgrades[:maxUpgradeVersions], ", "), len(upgrades)-maxUpgradeVersions)
+			}
+			d.Field("Newer Versions", newer)
+		} else {
+			d.FieldStyled("Latest", ar.LatestVersion()+" (up to date)", ui.SuccessStyle())
+		}
+		if ar.DefaultVersion != "" {
+			d.Field("Default", ar.DefaultVersion)
+		}
+	}
+
 	// Configuration
 	d.Section("Configuration")
 	d.Field("Service Account Role", appaws.Str(ar.Addon.ServiceAccountRoleArn))
@@ -157,10 +188,25 @@ func (rnd *AddonRenderer) RenderSummary(resource dao.Resource) []render.SummaryF
 	return []render.SummaryField{
 		{Label: "Name", Value: ar.GetName()},
 		{Label: "Version", Value: ar.Version()},
+		{Label: "Latest", Value: latestColumn(ar)},
 		{Label: "Status", Value: ar.Status()},
 	}
 }
 
+// maxUpgradeVersions caps the newer versions listed in the detail view
+const maxUpgradeVersions = 5
+
+// latestColumn shows the newest compatible version when it differs from the installed one
+func latestColumn(ar *AddonResource) string {
+	if ar.UpgradeAvailable() {
+		return ar.LatestVersion()
+	}
+	if len(ar.AvailableVersions) > 0 {
+		return "up to date"
+	}
+	return ""
+}
+
 func (rnd *AddonRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	ar, ok := resource.(*AddonResource)
 	if !ok {
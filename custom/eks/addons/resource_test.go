@@ -0,0 +1,96 @@
+package addons
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+func newAddon(version string, available ...string) *AddonResource {
+	ar := NewAddonResource(types.Addon{
+		AddonName:    aws.String("vpc-cni"),
+		ClusterName:  aws.String("prod"),
+		AddonVersion: aws.String(version),
+		Status:       types.AddonStatusActive,
+	})
+	ar.AvailableVersions = available
+	return ar
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1.19.0-eksbuild.1", "v1.19.0-eksbuild.1", 0},
+		{"v1.19.0-eksbuild.2", "v1.19.0-eksbuild.1", 1},
+		{"v1.9.0-eksbuild.1", "v1.10.0-eksbuild.1", -1},
+		{"v1.20.1-eksbuild.1", "v1.20.0-eksbuild.3", 1},
+	}
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSortVersions(t *testing.T) {
+	versions := []string{"v1.9.0-eksbuild.1", "v1.10.0-eksbuild.2", "v1.10.0-eksbuild.10"}
+	SortVersions(versions)
+	want := []string{"v1.10.0-eksbuild.10", "v1.10.0-eksbuild.2", "v1.9.0-eksbuild.1"}
+	if !slices.Equal(versions, want) {
+		t.Errorf("SortVersions = %v, want %v", versions, want)
+	}
+}
+
+func TestUpgradeVersions(t *testing.T) {
+	ar := newAddon("v1.18.0-eksbuild.1", "v1.19.0-eksbuild.1", "v1.18.1-eksbuild.1", "v1.18.0-eksbuild.1", "v1.17.0-eksbuild.1")
+
+	want := []string{"v1.19.0-eksbuild.1", "v1.18.1-eksbuild.1"}
+	if got := ar.UpgradeVersions(); !slices.Equal(got, want) {
+		t.Errorf("UpgradeVersions = %v, want %v", got, want)
+	}
+	if !ar.UpgradeAvailable() {
+		t.Error("UpgradeAvailable should be true")
+	}
+	if got := latestColumn(ar); got != "v1.19.0-eksbuild.1" {
+		t.Errorf("latestColumn = %q", got)
+	}
+
+	current := newAddon("v1.19.0-eksbuild.1", "v1.19.0-eksbuild.1", "v1.18.0-eksbuild.1")
+	if current.UpgradeAvailable() {
+		t.Error("UpgradeAvailable should be false on the latest version")
+	}
+	if got := latestColumn(current); got != "up to date" {
+		t.Errorf("latestColumn = %q, want up to date", got)
+	}
+
+	if got := latestColumn(newAddon("v1.19.0-eksbuild.1")); got != "" {
+		t.Errorf("latestColumn without versions = %q, want empty", got)
+	}
+}
+
+func TestValidateUpgrade(t *testing.T) {
+	ar := newAddon("v1.18.0-eksbuild.1", "v1.19.0-eksbuild.1", "v1.18.0-eksbuild.1")
+
+	if err := validateUpgrade(ar, "v1.19.0-eksbuild.1", "OVERWRITE"); err != nil {
+		t.Errorf("valid upgrade: %v", err)
+	}
+	if err := validateUpgrade(ar, "v1.18.0-eksbuild.1", "NONE"); err == nil {
+		t.Error("same version should be rejected")
+	}
+	if err := validateUpgrade(ar, "v1.19.0-eksbuild.1", "MERGE"); err == nil {
+		t.Error("unknown conflict resolution should be rejected")
+	}
+	if err := validateUpgrade(ar, "", "NONE"); err == nil {
+		t.Error("missing version should be rejected")
+	}
+
+	preview := previewUpgrade(ar, []string{"v1.19.0-eksbuild.1", "PRESERVE"})
+	if !strings.Contains(preview, "v1.18.0-eksbuild.1 -> v1.19.0-eksbuild.1") || !strings.Contains(preview, "PRESERVE") {
+		t.Errorf("preview = %q", preview)
+	}
+}
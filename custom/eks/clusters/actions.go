@@ -0,0 +1,142 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+const (
+	onIncompatibleAbort   = "abort"
+	onIncompatibleProceed = "upgrade anyway"
+)
+
+func init() {
+	action.Global.Register("eks", "clusters", []action.Action{
+		{
+			Name:         "Upgrade Version",
+			Shortcut:     "U",
+			Type:         action.ActionTypeAPI,
+			Operation:    "UpdateClusterVersion",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+			Filter: func(r dao.Resource) bool {
+				cr, ok := r.(*ClusterResource)
+				return ok && cr.Cluster.Status == types.ClusterStatusActive
+			},
+			Prompts: []action.Prompt{
+				{Label: "Target version", Options: upgradeTargetOptions},
+				{
+					Label: "If add-ons are incompatible",
+					Options: func(context.Context, dao.Resource) ([]string, error) {
+						return []string{onIncompatibleAbort, onIncompatibleProceed}, nil
+					},
+				},
+			},
+			Preview: previewClusterUpgrade,
+		},
+	})
+
+	action.RegisterExecutor("eks", "clusters", executeClusterAction)
+}
+
+func executeClusterAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "UpdateClusterVersion":
+		return executeUpgradeCluster(ctx, resource, act.Input(0), act.Input(1))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func newClient(ctx context.Context) (*eks.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return eks.NewFromConfig(cfg), nil
+}
+
+// upgradeTargetOptions offers the next minor version when EKS supports it
+func upgradeTargetOptions(ctx context.Context, r dao.Resource) ([]string, error) {
+	cr, ok := r.(*ClusterResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := UpgradeTarget(ctx, client, cr.Version())
+	if err != nil {
+		return nil, err
+	}
+	if target == "" {
+		return nil, fmt.Errorf("%s already runs the newest available version (%s)", cr.GetName(), cr.Version())
+	}
+	return []string{target}, nil
+}
+
+func previewClusterUpgrade(resource dao.Resource, inputs []string) string {
+	cr, ok := resource.(*ClusterResource)
+	if !ok || len(inputs) == 0 {
+		return ""
+	}
+	addons := "checked before the update starts"
+	if p := cr.Preflight; p != nil && p.Target == inputs[0] {
+		addons = p.Describe()
+	}
+	return fmt.Sprintf("Version: %s -> %s\nAdd-ons: %s\nNode groups are not upgraded; update them afterwards.",
+		cr.Version(), inputs[0], addons)
+}
+
+func executeUpgradeCluster(ctx context.Context, resource dao.Resource, target, onIncompatible string) action.ActionResult {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if target == "" || onIncompatible == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+	if target != NextVersion(cr.Version()) {
+		return action.FailResult(fmt.Errorf("can only upgrade %s one minor version to %s", cr.Version(), NextVersion(cr.Version())))
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := cr.GetName()
+	preflight, err := CheckAddons(ctx, client, name, target)
+	if err != nil {
+		return action.FailResultf(err, "add-on preflight for %s", name)
+	}
+	incompatible := len(preflight.Incompatible()) > 0
+	if incompatible && onIncompatible != onIncompatibleProceed {
+		return action.FailResult(fmt.Errorf("preflight failed: %s", preflight.Describe()))
+	}
+
+	output, err := client.UpdateClusterVersion(ctx, &eks.UpdateClusterVersionInput{
+		Name:    &name,
+		Version: &target,
+	})
+	if err != nil {
+		return action.FailResultf(err, "update cluster version for %s", name)
+	}
+
+	msg := fmt.Sprintf("Started upgrade of %s to %s", name, target)
+	if output.Update != nil {
+		msg = fmt.Sprintf("Started update %s: %s %s -> %s", appaws.Str(output.Update.Id), name, cr.Version(), target)
+	}
+	if incompatible {
+		msg += "; warning: " + preflight.Describe()
+	}
+	return action.SuccessResult(msg + " (press u to follow progress)")
+}
@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/render"
 )
 
@@ -35,7 +36,7 @@ func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	// Check for ClusterName filter (for navigation from child resources)
 	if clusterName := dao.GetFilterFromContext(ctx, "ClusterName"); clusterName != "" {
 		// Direct lookup for specific cluster
-		cluster, err := d.Get(ctx, clusterName)
+		cluster, err := d.describe(ctx, clusterName)
 		if err != nil {
 			// If not found, return empty list (not an error for filtering)
 			if apperrors.IsNotFound(err) {
@@ -84,7 +85,29 @@ func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	return resources, nil
 }
 
+// Get describes a cluster and, when a newer version is available, runs the
+// add-on compatibility preflight for the upgrade
 func (d *ClusterDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	cr, err := d.describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cr.Cluster.Status == types.ClusterStatusActive {
+		target, err := UpgradeTarget(ctx, d.client, cr.Version())
+		if err != nil {
+			log.Warn("failed to check cluster upgrade target", "cluster", id, "error", err)
+		} else if target != "" {
+			if cr.Preflight, err = CheckAddons(ctx, d.client, id, target); err != nil {
+				log.Warn("failed to check addon compatibility", "cluster", id, "error", err)
+			}
+		}
+	}
+
+	return cr, nil
+}
+
+func (d *ClusterDAO) describe(ctx context.Context, id string) (*ClusterResource, error) {
 	output, err := d.client.DescribeCluster(ctx, &eks.DescribeClusterInput{
 		Name: &id,
 	})
@@ -116,6 +139,9 @@ func (d *ClusterDAO) Delete(ctx context.Context, id string) error {
 type ClusterResource struct {
 	dao.BaseResource
 	Cluster types.Cluster
+
+	// Preflight is the add-on check for the next version (detail view only)
+	Preflight *Preflight
 }
 
 // NewClusterResource creates a new ClusterResource
@@ -8,9 +8,13 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
-var _ render.Navigator = (*ClusterRenderer)(nil)
+var (
+	_ render.Navigator = (*ClusterRenderer)(nil)
+	_ render.LogSource = (*ClusterRenderer)(nil)
+)
 
 // formatBool converts bool to Yes/No string
 func formatBool(b bool) string {
@@ -108,6 +112,24 @@ func (rnd *ClusterRenderer) RenderDetail(resource dao.Resource) string {
 	d.Field("Created", cr.CreatedAge())
 	d.Field("Role ARN", appaws.Str(cr.Cluster.RoleArn))
 
+	// Upgrade Preflight
+	if p := cr.Preflight; p != nil {
+		d.Section("Upgrade Preflight")
+		d.Field("Target Version", p.Target)
+		if len(p.Addons) == 0 {
+			d.DimIndent("No managed add-ons installed")
+		}
+		for _, a := range p.Addons {
+			if a.Compatible {
+				d.FieldStyled(a.Name, a.Version+" (compatible)", ui.SuccessStyle())
+			} else if a.Suggested != "" {
+				d.FieldStyled(a.Name, fmt.Sprintf("%s (incompatible, upgrade to %s first)", a.Version, a.Suggested), ui.WarningStyle())
+			} else {
+				d.FieldStyled(a.Name, a.Version+" (incompatible)", ui.WarningStyle())
+			}
+		}
+	}
+
 	// Endpoint & Certificate Authority
 	d.Section("Endpoint & Certificate")
 	if endpoint := appaws.Str(cr.Cluster.Endpoint); endpoint != "" {
@@ -245,6 +267,20 @@ func (rnd *ClusterRenderer) RenderSummary(resource dao.Resource) []render.Summar
 	}
 }
 
+// LogTargets returns the control plane log group when any log type is enabled
+func (rnd *ClusterRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	cr, ok := resource.(*ClusterResource)
+	if !ok || cr.Cluster.Logging == nil {
+		return nil
+	}
+	for _, l := range cr.Cluster.Logging.ClusterLogging {
+		if l.Enabled != nil && *l.Enabled {
+			return []render.LogTarget{{Group: "/aws/eks/" + cr.GetName() + "/cluster"}}
+		}
+	}
+	return nil
+}
+
 func (rnd *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	cr, ok := resource.(*ClusterResource)
 	if !ok {
@@ -260,6 +296,14 @@ func (rnd *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigati
 			FilterField: "ClusterName",
 			FilterValue: cr.GetName(),
 		},
+		{
+			Key:         "i",
+			Label:       "Performance",
+			Service:     "eks",
+			Resource:    "performance",
+			FilterField: "ClusterName",
+			FilterValue: cr.GetName(),
+		},
 		{
 			Key:         "f",
 			Label:       "Fargate Profiles",
@@ -275,6 +319,7 @@ func (rnd *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigati
 			Resource:    "addons",
 			FilterField: "ClusterName",
 			FilterValue: cr.GetName(),
+			AutoReload:  true, // Follow add-on upgrades until ACTIVE
 		},
 		{
 			Key:         "e",
@@ -284,18 +329,17 @@ func (rnd *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigati
 			FilterField: "ClusterName",
 			FilterValue: cr.GetName(),
 		},
+		{
+			Key:         "u",
+			Label:       "Updates",
+			Service:     "eks",
+			Resource:    "updates",
+			FilterField: "ClusterName",
+			FilterValue: cr.GetName(),
+			AutoReload:  true, // Follow version upgrades in progress
+		},
 	}
 
-	// CloudWatch Logs (Control Plane)
-	navs = append(navs, render.Navigation{
-		Key:         "l",
-		Label:       "Control Plane Logs",
-		Service:     "cloudwatch",
-		Resource:    "log-groups",
-		FilterField: "LogGroupPrefix",
-		FilterValue: "/aws/eks/" + cr.GetName() + "/cluster",
-	})
-
 	// IAM Cluster Role
 	if roleArn := appaws.Str(cr.Cluster.RoleArn); roleArn != "" {
 		roleName := appaws.ExtractResourceName(roleArn)
@@ -0,0 +1,166 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// NextVersion returns the next Kubernetes minor version ("1.29" -> "1.30").
+// EKS only upgrades the control plane one minor version at a time.
+func NextVersion(version string) string {
+	major, minor, ok := strings.Cut(version, ".")
+	if !ok {
+		return ""
+	}
+	n, err := strconv.Atoi(minor)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s.%d", major, n+1)
+}
+
+// UpgradeTarget returns the next minor version if EKS offers it, or "" when the
+// cluster already runs the newest available version
+func UpgradeTarget(ctx context.Context, client *eks.Client, version string) (string, error) {
+	next := NextVersion(version)
+	if next == "" {
+		return "", fmt.Errorf("unrecognized cluster version %q", version)
+	}
+
+	output, err := client.DescribeClusterVersions(ctx, &eks.DescribeClusterVersionsInput{
+		ClusterVersions: []string{next},
+	})
+	if err != nil {
+		return "", apperrors.Wrap(err, "describe cluster versions")
+	}
+	for _, v := range output.ClusterVersions {
+		if appaws.Str(v.ClusterVersion) == next && v.VersionStatus != types.VersionStatusUnsupported {
+			return next, nil
+		}
+	}
+	return "", nil
+}
+
+// AddonCheck is the result of checking an installed add-on against a target Kubernetes version
+type AddonCheck struct {
+	Name       string
+	Version    string
+	Compatible bool
+	// Suggested is the add-on version EKS recommends for the target, if any
+	Suggested string
+}
+
+// Preflight is the add-on compatibility check for a control plane upgrade
+type Preflight struct {
+	Target string
+	Addons []AddonCheck
+}
+
+// Incompatible returns the add-ons whose installed version doesn't support the target
+func (p *Preflight) Incompatible() []AddonCheck {
+	var out []AddonCheck
+	for _, a := range p.Addons {
+		if !a.Compatible {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// Describe summarizes the preflight, listing incompatible add-ons
+func (p *Preflight) Describe() string {
+	bad := p.Incompatible()
+	if len(bad) == 0 {
+		return fmt.Sprintf("all %d add-ons support %s", len(p.Addons), p.Target)
+	}
+	parts := make([]string, len(bad))
+	for i, a := range bad {
+		parts[i] = a.Name + " " + a.Version
+		if a.Suggested != "" {
+			parts[i] += " (upgrade to " + a.Suggested + ")"
+		}
+	}
+	return fmt.Sprintf("%d add-ons don't support %s: %s", len(bad), p.Target, strings.Join(parts, ", "))
+}
+
+// CheckAddons checks every installed add-on of a cluster against the target version
+func CheckAddons(ctx context.Context, client *eks.Client, clusterName, target string) (*Preflight, error) {
+	names, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := client.ListAddons(ctx, &eks.ListAddonsInput{
+			ClusterName: &clusterName,
+			NextToken:   token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list addons")
+		}
+		return output.Addons, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Preflight{Target: target}
+	for _, name := range names {
+		addon, err := client.DescribeAddon(ctx, &eks.DescribeAddonInput{
+			ClusterName: &clusterName,
+			AddonName:   &name,
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe addon %s", name)
+		}
+		version := ""
+		if addon.Addon != nil {
+			version = appaws.Str(addon.Addon.AddonVersion)
+		}
+
+		infos, err := appaws.Paginate(ctx, func(token *string) ([]types.AddonInfo, *string, error) {
+			output, err := client.DescribeAddonVersions(ctx, &eks.DescribeAddonVersionsInput{
+				AddonName:         &name,
+				KubernetesVersion: &target,
+				NextToken:         token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrapf(err, "describe addon versions %s", name)
+			}
+			return output.Addons, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.Addons = append(p.Addons, checkAddon(name, version, target, infos))
+	}
+	return p, nil
+}
+
+// checkAddon decides compatibility from the versions EKS lists for the target
+func checkAddon(name, version, target string, infos []types.AddonInfo) AddonCheck {
+	check := AddonCheck{Name: name, Version: version}
+	var compatible []string
+	for _, info := range infos {
+		for _, v := range info.AddonVersions {
+			av := appaws.Str(v.AddonVersion)
+			compatible = append(compatible, av)
+			for _, c := range v.Compatibilities {
+				if c.DefaultVersion && appaws.Str(c.ClusterVersion) == target {
+					check.Suggested = av
+				}
+			}
+		}
+	}
+	check.Compatible = slices.Contains(compatible, version)
+	if check.Compatible {
+		check.Suggested = ""
+	} else if check.Suggested == "" && len(compatible) > 0 {
+		check.Suggested = compatible[0]
+	}
+	return check
+}
@@ -0,0 +1,77 @@
+package clusters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+func TestNextVersion(t *testing.T) {
+	tests := map[string]string{
+		"1.29": "1.30",
+		"1.9":  "1.10",
+		"":     "",
+		"1.x":  "",
+	}
+	for in, want := range tests {
+		if got := NextVersion(in); got != want {
+			t.Errorf("NextVersion(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func addonInfo(target string, defaultVersion string, versions ...string) []types.AddonInfo {
+	info := types.AddonInfo{AddonName: aws.String("vpc-cni")}
+	for _, v := range versions {
+		info.AddonVersions = append(info.AddonVersions, types.AddonVersionInfo{
+			AddonVersion: aws.String(v),
+			Compatibilities: []types.Compatibility{
+				{ClusterVersion: aws.String(target), DefaultVersion: v == defaultVersion},
+			},
+		})
+	}
+	return []types.AddonInfo{info}
+}
+
+func TestCheckAddon(t *testing.T) {
+	infos := addonInfo("1.30", "v1.18.1-eksbuild.1", "v1.19.0-eksbuild.1", "v1.18.1-eksbuild.1")
+
+	ok := checkAddon("vpc-cni", "v1.18.1-eksbuild.1", "1.30", infos)
+	if !ok.Compatible || ok.Suggested != "" {
+		t.Errorf("compatible check = %+v", ok)
+	}
+
+	old := checkAddon("vpc-cni", "v1.12.0-eksbuild.1", "1.30", infos)
+	if old.Compatible {
+		t.Error("old version should be incompatible")
+	}
+	if old.Suggested != "v1.18.1-eksbuild.1" {
+		t.Errorf("Suggested = %q, want the default version", old.Suggested)
+	}
+
+	noDefault := checkAddon("vpc-cni", "v1.12.0-eksbuild.1", "1.30", addonInfo("1.30", "", "v1.19.0-eksbuild.1"))
+	if noDefault.Suggested != "v1.19.0-eksbuild.1" {
+		t.Errorf("Suggested = %q, want first listed version", noDefault.Suggested)
+	}
+}
+
+func TestPreflightDescribe(t *testing.T) {
+	p := &Preflight{Target: "1.30", Addons: []AddonCheck{
+		{Name: "coredns", Version: "v1.11.1", Compatible: true},
+		{Name: "vpc-cni", Version: "v1.12.0", Suggested: "v1.18.1"},
+	}}
+	if got := len(p.Incompatible()); got != 1 {
+		t.Fatalf("Incompatible = %d, want 1", got)
+	}
+	desc := p.Describe()
+	if !strings.Contains(desc, "vpc-cni v1.12.0 (upgrade to v1.18.1)") || strings.Contains(desc, "coredns") {
+		t.Errorf("Describe = %q", desc)
+	}
+
+	p.Addons = p.Addons[:1]
+	if got := p.Describe(); got != "all 1 add-ons support 1.30" {
+		t.Errorf("Describe = %q", got)
+	}
+}
@@ -0,0 +1,134 @@
+package nodegroups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+const (
+	evictionRespectPDB = "respect pod disruption budgets"
+	evictionForce      = "force"
+)
+
+func init() {
+	action.Global.Register("eks", "node-groups", []action.Action{
+		{
+			Name:         "Upgrade Version",
+			Shortcut:     "U",
+			Type:         action.ActionTypeAPI,
+			Operation:    "UpdateNodegroupVersion",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+			Filter: func(r dao.Resource) bool {
+				ngr, ok := r.(*NodeGroupResource)
+				return ok && ngr.NodeGroup.Status == types.NodegroupStatusActive
+			},
+			Prompts: []action.Prompt{
+				{Label: "Kubernetes version", Options: nodegroupVersionOptions},
+				{Label: "AMI release version (empty for latest)"},
+				{
+					Label: "Pod eviction",
+					Options: func(context.Context, dao.Resource) ([]string, error) {
+						return []string{evictionRespectPDB, evictionForce}, nil
+					},
+				},
+			},
+			Preview: previewNodegroupUpgrade,
+		},
+	})
+
+	action.RegisterExecutor("eks", "node-groups", executeNodeGroupAction)
+}
+
+func executeNodeGroupAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "UpdateNodegroupVersion":
+		return executeUpgradeNodegroup(ctx, resource, act.Input(0), strings.TrimSpace(act.Input(1)), act.Input(2))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// nodegroupVersionOptions offers the control plane version when the node group
+// lags behind it, otherwise the current version (AMI release update only)
+func nodegroupVersionOptions(ctx context.Context, r dao.Resource) ([]string, error) {
+	ngr, ok := r.(*NodeGroupResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output, err := eks.NewFromConfig(cfg).DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: ngr.NodeGroup.ClusterName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe cluster: %w", err)
+	}
+	if output.Cluster != nil {
+		if clusterVersion := appaws.Str(output.Cluster.Version); clusterVersion != "" && clusterVersion != ngr.Version() {
+			return []string{clusterVersion}, nil
+		}
+	}
+	return []string{ngr.Version()}, nil
+}
+
+func previewNodegroupUpgrade(resource dao.Resource, inputs []string) string {
+	ngr, ok := resource.(*NodeGroupResource)
+	if !ok || len(inputs) < 3 {
+		return ""
+	}
+	release := strings.TrimSpace(inputs[1])
+	if release == "" {
+		release = "latest"
+	}
+	return fmt.Sprintf("Version:     %s -> %s\nAMI release: %s -> %s\nEviction:    %s",
+		ngr.Version(), inputs[0], appaws.Str(ngr.NodeGroup.ReleaseVersion), release, inputs[2])
+}
+
+func executeUpgradeNodegroup(ctx context.Context, resource dao.Resource, version, release, eviction string) action.ActionResult {
+	ngr, ok := resource.(*NodeGroupResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if version == "" || eviction == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := ngr.GetName()
+	input := &eks.UpdateNodegroupVersionInput{
+		ClusterName:   ngr.NodeGroup.ClusterName,
+		NodegroupName: &name,
+		Version:       &version,
+		Force:         eviction == evictionForce,
+	}
+	if release != "" {
+		input.ReleaseVersion = &release
+	}
+
+	output, err := eks.NewFromConfig(cfg).UpdateNodegroupVersion(ctx, input)
+	if err != nil {
+		return action.FailResultf(err, "update node group version for %s", name)
+	}
+
+	id := ""
+	if output.Update != nil {
+		id = appaws.Str(output.Update.Id)
+	}
+	return action.SuccessResult(fmt.Sprintf("Started update %s: %s %s -> %s (press u to follow progress)",
+		id, name, ngr.Version(), version))
+}
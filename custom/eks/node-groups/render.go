@@ -266,6 +266,19 @@ func (rnd *NodeGroupRenderer) Navigations(resource dao.Resource) []render.Naviga
 		})
 	}
 
+	// Version updates (cluster/nodegroup filter)
+	if clusterName := appaws.Str(ngr.NodeGroup.ClusterName); clusterName != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "u",
+			Label:       "Updates",
+			Service:     "eks",
+			Resource:    "updates",
+			FilterField: "Nodegroup",
+			FilterValue: clusterName + "/" + ngr.GetName(),
+			AutoReload:  true, // Follow rolling node upgrades
+		})
+	}
+
 	// IAM Node Role
 	if nodeRole := appaws.Str(ngr.NodeGroup.NodeRole); nodeRole != "" {
 		roleName := appaws.ExtractResourceName(nodeRole)
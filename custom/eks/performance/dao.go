@@ -0,0 +1,148 @@
+package performance
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Container Insights metric query IDs
+const (
+	queryCPU    = "cpu"
+	queryMemory = "memory"
+)
+
+// PerformanceDAO reads per-pod CPU and memory usage from EKS Container Insights
+type PerformanceDAO struct {
+	dao.BaseDAO
+	client *cloudwatch.Client
+}
+
+// NewPerformanceDAO creates a new PerformanceDAO
+func NewPerformanceDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PerformanceDAO{
+		BaseDAO: dao.NewBaseDAO("eks", "performance"),
+		client:  cloudwatch.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns pods with Container Insights data in the metrics window,
+// heaviest CPU consumers first. Optionally filtered by ClusterName.
+func (d *PerformanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	queries := podQueries(dao.GetFilterFromContext(ctx, "ClusterName"))
+	results, err := cwClient.QueryInsights(ctx, d.client, queries, config.File().MetricsWindow())
+	if err != nil {
+		return nil, apperrors.Wrap(err, "query container insights")
+	}
+
+	usage := NewPodUsages(results)
+	resources := make([]dao.Resource, len(usage))
+	for i, u := range usage {
+		resources[i] = u
+	}
+	return resources, nil
+}
+
+func (d *PerformanceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for eks performance")
+}
+
+func (d *PerformanceDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for eks performance")
+}
+
+// Supports returns supported operations
+func (d *PerformanceDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// podQueries builds the Metrics Insights queries for per-pod usage, limited
+// to cluster if set
+func podQueries(cluster string) []cwClient.InsightsQuery {
+	where := ""
+	if cluster != "" {
+		where = " WHERE ClusterName = " + cwClient.InsightsString(cluster)
+	}
+	query := func(id, metric string) cwClient.InsightsQuery {
+		return cwClient.InsightsQuery{
+			ID:         id,
+			Expression: fmt.Sprintf(`SELECT AVG(%s) FROM SCHEMA(ContainerInsights, ClusterName, Namespace, PodName)%s GROUP BY ClusterName, Namespace, PodName`, metric, where),
+			GroupBy:    []string{"ClusterName", "Namespace", "PodName"},
+		}
+	}
+	return []cwClient.InsightsQuery{
+		query(queryCPU, "pod_cpu_utilization"),
+		query(queryMemory, "pod_memory_utilization"),
+	}
+}
+
+// PodUsage is the latest CPU and memory utilization of an EKS pod, as a
+// percentage of node capacity
+type PodUsage struct {
+	dao.BaseResource
+	Cluster   string
+	Namespace string
+	Pod       string
+	CPU       float64
+	Memory    float64
+	Rank      int // 1-based position by CPU utilization
+}
+
+// NewPodUsages joins the query results per pod and ranks them by CPU
+// utilization, then memory utilization.
+func NewPodUsages(results map[string]cwClient.InsightsSeries) []*PodUsage {
+	byLabel := make(map[string]*PodUsage)
+	for _, id := range []string{queryCPU, queryMemory} {
+		for label, value := range results[id] {
+			u, ok := byLabel[label]
+			if !ok {
+				parts := cwClient.SplitInsightsLabel(label)
+				if len(parts) != 3 {
+					continue
+				}
+				u = &PodUsage{Cluster: parts[0], Namespace: parts[1], Pod: parts[2]}
+				u.BaseResource = dao.BaseResource{
+					ID:   u.Cluster + "/" + u.Namespace + "/" + u.Pod,
+					Name: u.Pod,
+				}
+				byLabel[label] = u
+			}
+			if id == queryCPU {
+				u.CPU = value
+			} else {
+				u.Memory = value
+			}
+		}
+	}
+
+	usage := make([]*PodUsage, 0, len(byLabel))
+	for _, u := range byLabel {
+		usage = append(usage, u)
+	}
+	slices.SortFunc(usage, func(a, b *PodUsage) int {
+		if c := cmp.Compare(b.CPU, a.CPU); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Memory, a.Memory); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	for i, u := range usage {
+		u.Rank = i + 1
+	}
+	return usage
+}
@@ -0,0 +1,20 @@
+package performance
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("eks", "performance", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPerformanceDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPerformanceRenderer()
+		},
+	})
+}
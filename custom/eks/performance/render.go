@@ -0,0 +1,138 @@
+package performance
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// barWidth is the width of the utilization bars
+const barWidth = 10
+
+// PerformanceRenderer renders EKS Container Insights usage
+// Ensure PerformanceRenderer implements render.Navigator
+var _ render.Navigator = (*PerformanceRenderer)(nil)
+
+type PerformanceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPerformanceRenderer creates a new PerformanceRenderer
+func NewPerformanceRenderer() render.Renderer {
+	return &PerformanceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "eks",
+			Resource: "performance",
+			Cols: []render.Column{
+				{Name: "#", Width: 4, Getter: getRank},
+				{Name: "POD", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "NAMESPACE", Width: 18, Getter: getNamespace},
+				{Name: "CLUSTER", Width: 20, Getter: getCluster},
+				{Name: "CPU", Width: 16, Getter: getCPUBar},
+				{Name: "MEMORY", Width: 16, Getter: getMemoryBar},
+			},
+		},
+	}
+}
+
+func getRank(r dao.Resource) string {
+	if u, ok := r.(*PodUsage); ok {
+		return fmt.Sprintf("%d", u.Rank)
+	}
+	return ""
+}
+
+func getNamespace(r dao.Resource) string {
+	if u, ok := r.(*PodUsage); ok {
+		return u.Namespace
+	}
+	return ""
+}
+
+func getCluster(r dao.Resource) string {
+	if u, ok := r.(*PodUsage); ok {
+		return u.Cluster
+	}
+	return ""
+}
+
+func getCPUBar(r dao.Resource) string {
+	if u, ok := r.(*PodUsage); ok {
+		return render.FormatPercentBar(u.CPU, barWidth)
+	}
+	return ""
+}
+
+func getMemoryBar(r dao.Resource) string {
+	if u, ok := r.(*PodUsage); ok {
+		return render.FormatPercentBar(u.Memory, barWidth)
+	}
+	return ""
+}
+
+// RenderDetail renders detailed usage information
+func (r *PerformanceRenderer) RenderDetail(resource dao.Resource) string {
+	u, ok := resource.(*PodUsage)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("EKS Pod Performance", u.Pod)
+
+	d.Section("Basic Information")
+	d.Field("Pod", u.Pod)
+	d.Field("Namespace", u.Namespace)
+	d.Field("Cluster", u.Cluster)
+	d.Field("Rank", fmt.Sprintf("%d (by CPU utilization)", u.Rank))
+
+	d.Section("Utilization (of node capacity)")
+	d.Field("CPU", render.FormatPercentBar(u.CPU, barWidth*2))
+	d.Field("Memory", render.FormatPercentBar(u.Memory, barWidth*2))
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PerformanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	u, ok := resource.(*PodUsage)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Pod", Value: u.Namespace + "/" + u.Pod},
+		{Label: "Cluster", Value: u.Cluster},
+		{Label: "CPU", Value: fmt.Sprintf("%.1f%%", u.CPU)},
+		{Label: "Memory", Value: fmt.Sprintf("%.1f%%", u.Memory)},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *PerformanceRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	u, ok := resource.(*PodUsage)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "p",
+			Label:       "Cluster",
+			Service:     "eks",
+			Resource:    "clusters",
+			FilterField: "ClusterName",
+			FilterValue: u.Cluster,
+		},
+		{
+			Key:         "n",
+			Label:       "Node Groups",
+			Service:     "eks",
+			Resource:    "node-groups",
+			FilterField: "ClusterName",
+			FilterValue: u.Cluster,
+		},
+	}
+}
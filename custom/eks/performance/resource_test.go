@@ -0,0 +1,25 @@
+package performance
+
+import (
+	"testing"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+)
+
+func TestNewPodUsages(t *testing.T) {
+	results := map[string]cwClient.InsightsSeries{
+		queryCPU:    {"prod|default|web-1": 12.5, "prod|kube-system|coredns-1": 40, "malformed": 99},
+		queryMemory: {"prod|default|web-1": 30, "prod|kube-system|coredns-1": 5},
+	}
+
+	usage := NewPodUsages(results)
+	if len(usage) != 2 {
+		t.Fatalf("len(usage) = %d, want 2 (malformed label skipped)", len(usage))
+	}
+	if usage[0].ID != "prod/kube-system/coredns-1" || usage[0].Rank != 1 {
+		t.Errorf("top = %s rank %d, want coredns-1 ranked 1", usage[0].ID, usage[0].Rank)
+	}
+	if usage[1].Namespace != "default" || usage[1].Pod != "web-1" || usage[1].Memory != 30 {
+		t.Errorf("second = %+v", usage[1])
+	}
+}
@@ -0,0 +1,209 @@
+package updates
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// UpdateDAO provides data access for EKS cluster and node group updates
+type UpdateDAO struct {
+	dao.BaseDAO
+	client *eks.Client
+}
+
+// NewUpdateDAO creates a new UpdateDAO
+func NewUpdateDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &UpdateDAO{
+		BaseDAO: dao.NewBaseDAO("eks", "updates"),
+		client:  eks.NewFromConfig(cfg),
+	}, nil
+}
+
+// target resolves the cluster and optional node group from the "ClusterName"
+// or "Nodegroup" (cluster/nodegroup) filter
+func target(ctx context.Context) (clusterName, nodegroupName string, err error) {
+	if ng := dao.GetFilterFromContext(ctx, "Nodegroup"); ng != "" {
+		clusterName, nodegroupName, ok := strings.Cut(ng, "/")
+		if !ok || clusterName == "" || nodegroupName == "" {
+			return "", "", fmt.Errorf("nodegroup filter must be cluster/nodegroup")
+		}
+		return clusterName, nodegroupName, nil
+	}
+	if clusterName := dao.GetFilterFromContext(ctx, "ClusterName"); clusterName != "" {
+		return clusterName, "", nil
+	}
+	return "", "", fmt.Errorf("ClusterName filter required")
+}
+
+// List returns the updates of a cluster or node group, newest first
+func (d *UpdateDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	clusterName, nodegroupName, err := target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		input := &eks.ListUpdatesInput{
+			Name:      &clusterName,
+			NextToken: token,
+		}
+		if nodegroupName != "" {
+			input.NodegroupName = &nodegroupName
+		}
+		output, err := d.client.ListUpdates(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list updates")
+		}
+		return output.UpdateIds, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(ids))
+	for _, id := range ids {
+		r, err := d.describe(ctx, clusterName, nodegroupName, id)
+		if err != nil {
+			if apperrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	slices.SortFunc(resources, func(a, b dao.Resource) int {
+		return appaws.Time(b.(*UpdateResource).Update.CreatedAt).Compare(appaws.Time(a.(*UpdateResource).Update.CreatedAt))
+	})
+	return resources, nil
+}
+
+// Get returns a single update of the filtered cluster or node group
+func (d *UpdateDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	clusterName, nodegroupName, err := target(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.describe(ctx, clusterName, nodegroupName, id)
+}
+
+func (d *UpdateDAO) describe(ctx context.Context, clusterName, nodegroupName, id string) (*UpdateResource, error) {
+	input := &eks.DescribeUpdateInput{
+		Name:     &clusterName,
+		UpdateId: &id,
+	}
+	if nodegroupName != "" {
+		input.NodegroupName = &nodegroupName
+	}
+	output, err := d.client.DescribeUpdate(ctx, input)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe update %s", id)
+	}
+	if output.Update == nil {
+		return nil, fmt.Errorf("update not found: %s", id)
+	}
+	return NewUpdateResource(*output.Update, clusterName, nodegroupName), nil
+}
+
+func (d *UpdateDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for eks updates")
+}
+
+// Supports returns supported operations
+func (d *UpdateDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// UpdateResource represents an EKS update of a cluster or node group
+type UpdateResource struct {
+	dao.BaseResource
+	Update        types.Update
+	ClusterName   string
+	NodegroupName string
+}
+
+// NewUpdateResource creates a new UpdateResource
+func NewUpdateResource(update types.Update, clusterName, nodegroupName string) *UpdateResource {
+	return &UpdateResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(update.Id),
+			Name: appaws.Str(update.Id),
+			Data: update,
+		},
+		Update:        update,
+		ClusterName:   clusterName,
+		NodegroupName: nodegroupName,
+	}
+}
+
+// Status returns InProgress, Failed, Cancelled or Successful
+func (r *UpdateResource) Status() string {
+	return string(r.Update.Status)
+}
+
+// Type returns the update type, e.g. VersionUpdate
+func (r *UpdateResource) Type() string {
+	return string(r.Update.Type)
+}
+
+// Target returns the updated cluster or node group
+func (r *UpdateResource) Target() string {
+	if r.NodegroupName != "" {
+		return r.NodegroupName
+	}
+	return r.ClusterName
+}
+
+// Param returns the value of an update parameter, e.g. Version
+func (r *UpdateResource) Param(t types.UpdateParamType) string {
+	for _, p := range r.Update.Params {
+		if p.Type == t {
+			return appaws.Str(p.Value)
+		}
+	}
+	return ""
+}
+
+// Summary returns the most relevant parameters, e.g. "Version=1.30 ReleaseVersion=..."
+func (r *UpdateResource) Summary() string {
+	var parts []string
+	for _, t := range []types.UpdateParamType{types.UpdateParamTypeVersion, types.UpdateParamTypeReleaseVersion, types.UpdateParamTypePlatformVersion} {
+		if v := r.Param(t); v != "" {
+			parts = append(parts, string(t)+"="+v)
+		}
+	}
+	if len(parts) == 0 && len(r.Update.Params) > 0 {
+		p := r.Update.Params[0]
+		parts = append(parts, string(p.Type)+"="+appaws.Str(p.Value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// InProgress reports whether the update is still running
+func (r *UpdateResource) InProgress() bool {
+	return r.Update.Status == types.UpdateStatusInProgress
+}
+
+// CreatedAge returns age since the update started
+func (r *UpdateResource) CreatedAge() string {
+	return render.FormatAge(appaws.Time(r.Update.CreatedAt))
+}
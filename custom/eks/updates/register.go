@@ -0,0 +1,20 @@
+package updates
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("eks", "updates", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewUpdateDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewUpdateRenderer()
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package updates
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// UpdateRenderer renders EKS updates
+type UpdateRenderer struct {
+	render.BaseRenderer
+}
+
+// NewUpdateRenderer creates a new UpdateRenderer
+func NewUpdateRenderer() render.Renderer {
+	return &UpdateRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "eks",
+			Resource: "updates",
+			Cols: []render.Column{
+				{
+					Name:     "UPDATE ID",
+					Width:    38,
+					Priority: 0,
+					Getter:   func(r dao.Resource) string { return r.GetID() },
+				},
+				{
+					Name:     "TARGET",
+					Width:    24,
+					Priority: 1,
+					Getter: func(r dao.Resource) string {
+						if ur, ok := r.(*UpdateResource); ok {
+							return ur.Target()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "TYPE",
+					Width:    22,
+					Priority: 3,
+					Getter: func(r dao.Resource) string {
+						if ur, ok := r.(*UpdateResource); ok {
+							return ur.Type()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "STATUS",
+					Width:    12,
+					Priority: 0,
+					Getter: func(r dao.Resource) string {
+						if ur, ok := r.(*UpdateResource); ok {
+							return ur.Status()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "PARAMS",
+					Width:    40,
+					Priority: 2,
+					Getter: func(r dao.Resource) string {
+						if ur, ok := r.(*UpdateResource); ok {
+							return ur.Summary()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "AGE",
+					Width:    10,
+					Priority: 4,
+					Getter: func(r dao.Resource) string {
+						if ur, ok := r.(*UpdateResource); ok {
+							return ur.CreatedAge()
+						}
+						return ""
+					},
+				},
+			},
+		},
+	}
+}
+
+// colorState maps update statuses onto the shared state colors
+func colorState(status types.UpdateStatus) string {
+	switch status {
+	case types.UpdateStatusSuccessful:
+		return "active"
+	case types.UpdateStatusInProgress:
+		return "pending"
+	case types.UpdateStatusFailed:
+		return "failed"
+	case types.UpdateStatusCancelled:
+		return "stopped"
+	default:
+		return ""
+	}
+}
+
+// RenderDetail renders the update parameters and errors
+func (rnd *UpdateRenderer) RenderDetail(resource dao.Resource) string {
+	ur, ok := resource.(*UpdateResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("EKS Update", ur.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Cluster", ur.ClusterName)
+	d.FieldIf("Node Group", &ur.NodegroupName)
+	d.Field("Type", ur.Type())
+	d.FieldStyled("Status", ur.Status(), render.StateColorer()(colorState(ur.Update.Status)))
+	if created := appaws.Time(ur.Update.CreatedAt); !created.IsZero() {
+		d.Field("Started", fmt.Sprintf("%s (%s ago)", created.Format("2006-01-02 15:04:05"), ur.CreatedAge()))
+	}
+	if ur.InProgress() {
+		d.DimIndent("Update in progress; refresh to follow")
+	}
+
+	if len(ur.Update.Params) > 0 {
+		d.Section("Parameters")
+		for _, p := range ur.Update.Params {
+			d.Field(string(p.Type), appaws.Str(p.Value))
+		}
+	}
+
+	if len(ur.Update.Errors) > 0 {
+		d.Section("Errors")
+		for i, e := range ur.Update.Errors {
+			d.FieldStyled(fmt.Sprintf("Error #%d", i+1), string(e.ErrorCode), ui.DangerStyle())
+			if msg := appaws.Str(e.ErrorMessage); msg != "" {
+				d.Field("  Message", msg)
+			}
+			for _, id := range e.ResourceIds {
+				d.Field("  Resource", id)
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (rnd *UpdateRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ur, ok := resource.(*UpdateResource)
+	if !ok {
+		return rnd.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Update", Value: ur.GetID()},
+		{Label: "Target", Value: ur.Target()},
+		{Label: "Status", Value: ur.Status(), Style: render.StateColorer()(colorState(ur.Update.Status))},
+		{Label: "Params", Value: ur.Summary()},
+	}
+}
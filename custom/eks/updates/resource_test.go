@@ -0,0 +1,57 @@
+package updates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestTarget(t *testing.T) {
+	ctx := dao.WithFilter(context.Background(), "Nodegroup", "prod/workers")
+	cluster, ng, err := target(ctx)
+	if err != nil || cluster != "prod" || ng != "workers" {
+		t.Errorf("target = %q, %q, %v", cluster, ng, err)
+	}
+
+	ctx = dao.WithFilter(context.Background(), "ClusterName", "prod")
+	cluster, ng, err = target(ctx)
+	if err != nil || cluster != "prod" || ng != "" {
+		t.Errorf("target = %q, %q, %v", cluster, ng, err)
+	}
+
+	if _, _, err := target(dao.WithFilter(context.Background(), "Nodegroup", "prod")); err == nil {
+		t.Error("malformed nodegroup filter should fail")
+	}
+	if _, _, err := target(context.Background()); err == nil {
+		t.Error("missing filter should fail")
+	}
+}
+
+func TestUpdateResource(t *testing.T) {
+	r := NewUpdateResource(types.Update{
+		Id:     aws.String("u-1"),
+		Status: types.UpdateStatusInProgress,
+		Type:   types.UpdateTypeVersionUpdate,
+		Params: []types.UpdateParam{
+			{Type: types.UpdateParamTypeReleaseVersion, Value: aws.String("1.30.0-20240601")},
+			{Type: types.UpdateParamTypeVersion, Value: aws.String("1.30")},
+		},
+	}, "prod", "workers")
+
+	if r.Target() != "workers" {
+		t.Errorf("Target = %q", r.Target())
+	}
+	if !r.InProgress() {
+		t.Error("InProgress should be true")
+	}
+	if got := r.Summary(); got != "Version=1.30 ReleaseVersion=1.30.0-20240601" {
+		t.Errorf("Summary = %q", got)
+	}
+	if got := colorState(r.Update.Status); got != "pending" {
+		t.Errorf("colorState = %q", got)
+	}
+}
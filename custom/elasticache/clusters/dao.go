@@ -241,3 +241,34 @@ func (r *ClusterResource) AtRestEncryptionEnabled() bool {
 	}
 	return false
 }
+
+// LogDestination returns the destination (log group or delivery stream) of a log delivery configuration
+func LogDestination(cfg types.LogDeliveryConfiguration) string {
+	if cfg.DestinationDetails == nil {
+		return ""
+	}
+	if cw := cfg.DestinationDetails.CloudWatchLogsDetails; cw != nil {
+		return appaws.Str(cw.LogGroup)
+	}
+	if fh := cfg.DestinationDetails.KinesisFirehoseDetails; fh != nil {
+		return appaws.Str(fh.DeliveryStream)
+	}
+	return ""
+}
+
+// SlowLogGroup returns the CloudWatch Logs group receiving the engine slow log, or "" if
+// slow log delivery is disabled or goes to Kinesis Data Firehose.
+// The engine SLOWLOG itself is not exposed by the ElastiCache API.
+func (r *ClusterResource) SlowLogGroup() string {
+	for _, cfg := range r.Item.LogDeliveryConfigurations {
+		if cfg.LogType == types.LogTypeSlowLog && cfg.DestinationType == types.DestinationTypeCloudWatchLogs {
+			return LogDestination(cfg)
+		}
+	}
+	return ""
+}
+
+// SupportsSlowLog returns whether the engine supports slow log delivery (Redis OSS and Valkey)
+func (r *ClusterResource) SupportsSlowLog() bool {
+	return r.Engine() == "redis" || r.Engine() == "valkey"
+}
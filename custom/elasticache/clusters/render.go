@@ -4,14 +4,19 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
 )
 
 // ClusterRenderer renders ElastiCache clusters
-// Ensure ClusterRenderer implements render.Navigator
-var _ render.Navigator = (*ClusterRenderer)(nil)
+var (
+	_ render.Navigator = (*ClusterRenderer)(nil)
+	_ render.LogSource = (*ClusterRenderer)(nil)
+)
 
 type ClusterRenderer struct {
 	render.BaseRenderer
@@ -176,6 +181,25 @@ func (r *ClusterRenderer) RenderDetail(resource dao.Resource) string {
 	}
 	d.Field("Auto Minor Version Upgrade", formatBool(cluster.AutoMinorVersionUpgrade()))
 
+	// Log Delivery (Redis OSS / Valkey)
+	if cluster.SupportsSlowLog() {
+		d.Section("Log Delivery")
+		if len(cluster.Item.LogDeliveryConfigurations) == 0 {
+			d.DimIndent("Slow log delivery is not configured")
+		}
+		for _, cfg := range cluster.Item.LogDeliveryConfigurations {
+			value := fmt.Sprintf("%s → %s (%s)", cfg.DestinationType, LogDestination(cfg), cfg.Status)
+			if cfg.Status == types.LogDeliveryConfigurationStatusError {
+				d.FieldStyled(string(cfg.LogType), value, ui.DangerStyle())
+			} else {
+				d.Field(string(cfg.LogType), value)
+			}
+			if msg := appaws.Str(cfg.Message); msg != "" {
+				d.DimIndent(msg)
+			}
+		}
+	}
+
 	// Notification
 	if cluster.Item.NotificationConfiguration != nil && cluster.Item.NotificationConfiguration.TopicArn != nil {
 		d.Section("Notifications")
@@ -230,6 +254,30 @@ func (r *ClusterRenderer) RenderSummary(resource dao.Resource) []render.SummaryF
 
 // Navigations returns navigation shortcuts
 func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	// No navigations for now
+	cluster, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key: "e", Label: "Events", Service: "elasticache", Resource: "events",
+			FilterField: "CacheClusterId", FilterValue: cluster.ClusterId(),
+		},
+	}
+
+	return navs
+}
+
+// LogTargets returns the slow log group, which is only readable through its
+// CloudWatch Logs delivery destination
+func (r *ClusterRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	cluster, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil
+	}
+	if logGroup := cluster.SlowLogGroup(); logGroup != "" {
+		return []render.LogTarget{{Group: logGroup}}
+	}
 	return nil
 }
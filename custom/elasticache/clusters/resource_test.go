@@ -0,0 +1,55 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+)
+
+func TestClusterResource_SlowLogGroup(t *testing.T) {
+	cloudwatch := types.LogDeliveryConfiguration{
+		LogType:         types.LogTypeSlowLog,
+		DestinationType: types.DestinationTypeCloudWatchLogs,
+		DestinationDetails: &types.DestinationDetails{
+			CloudWatchLogsDetails: &types.CloudWatchLogsDestinationDetails{LogGroup: aws.String("/elasticache/sessions/slow")},
+		},
+	}
+	firehose := types.LogDeliveryConfiguration{
+		LogType:         types.LogTypeSlowLog,
+		DestinationType: types.DestinationTypeKinesisFirehose,
+		DestinationDetails: &types.DestinationDetails{
+			KinesisFirehoseDetails: &types.KinesisFirehoseDestinationDetails{DeliveryStream: aws.String("slow-stream")},
+		},
+	}
+	engine := cloudwatch
+	engine.LogType = types.LogTypeEngineLog
+
+	tests := []struct {
+		name    string
+		configs []types.LogDeliveryConfiguration
+		want    string
+	}{
+		{"cloudwatch", []types.LogDeliveryConfiguration{engine, cloudwatch}, "/elasticache/sessions/slow"},
+		{"firehose", []types.LogDeliveryConfiguration{firehose}, ""},
+		{"engine log only", []types.LogDeliveryConfiguration{engine}, ""},
+		{"none", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewClusterResource(types.CacheCluster{
+				CacheClusterId:            aws.String("sessions-001"),
+				Engine:                    aws.String("redis"),
+				LogDeliveryConfigurations: tt.configs,
+			})
+			if got := r.SlowLogGroup(); got != tt.want {
+				t.Errorf("SlowLogGroup() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := LogDestination(firehose); got != "slow-stream" {
+		t.Errorf("LogDestination(firehose) = %q, want slow-stream", got)
+	}
+}
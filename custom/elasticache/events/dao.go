@@ -0,0 +1,133 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// eventWindowMinutes is the maximum lookback supported by DescribeEvents (14 days)
+const eventWindowMinutes = 14 * 24 * 60
+
+// EventDAO provides data access for ElastiCache events
+type EventDAO struct {
+	dao.BaseDAO
+	client *elasticache.Client
+}
+
+// NewEventDAO creates a new EventDAO
+func NewEventDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EventDAO{
+		BaseDAO: dao.NewBaseDAO("elasticache", "events"),
+		client:  elasticache.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns cluster events (first page only for backwards compatibility).
+// For paginated access, use ListPage instead.
+func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 100, "")
+	return resources, err
+}
+
+// ListPage returns a page of events for a cache cluster from the last 14 days.
+// Implements dao.PaginatedDAO interface.
+func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	clusterID := dao.GetFilterFromContext(ctx, "CacheClusterId")
+	if clusterID == "" {
+		return nil, "", fmt.Errorf("cache cluster id filter required")
+	}
+
+	maxRecords := int32(pageSize)
+	if maxRecords < 20 || maxRecords > 100 {
+		maxRecords = 100 // AWS API accepts 20-100
+	}
+
+	input := &elasticache.DescribeEventsInput{
+		SourceIdentifier: &clusterID,
+		SourceType:       types.SourceTypeCacheCluster,
+		Duration:         appaws.Int32Ptr(eventWindowMinutes),
+		MaxRecords:       &maxRecords,
+	}
+	if pageToken != "" {
+		input.Marker = &pageToken
+	}
+
+	output, err := d.client.DescribeEvents(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "describe events for cache cluster %s", clusterID)
+	}
+
+	resources := make([]dao.Resource, len(output.Events))
+	for i, event := range output.Events {
+		resources[i] = NewEventResource(event)
+	}
+
+	return resources, appaws.Str(output.Marker), nil
+}
+
+func (d *EventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	// Events don't have a direct get by ID, return not supported
+	return nil, fmt.Errorf("get by ID not supported for elasticache events")
+}
+
+func (d *EventDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for elasticache events")
+}
+
+func (d *EventDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList:
+		return true
+	default:
+		return false
+	}
+}
+
+// EventResource wraps an ElastiCache event
+type EventResource struct {
+	dao.BaseResource
+	Item types.Event
+}
+
+// NewEventResource creates a new EventResource
+func NewEventResource(event types.Event) *EventResource {
+	source := appaws.Str(event.SourceIdentifier)
+	// Events have no ID; source and timestamp are unique enough for a list key
+	id := fmt.Sprintf("%s@%s", source, appaws.Time(event.Date).Format(time.RFC3339Nano))
+
+	return &EventResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: source,
+			Data: event,
+		},
+		Item: event,
+	}
+}
+
+// Message returns the event message
+func (r *EventResource) Message() string {
+	return appaws.Str(r.Item.Message)
+}
+
+// SourceType returns the event source type (e.g., cache-cluster)
+func (r *EventResource) SourceType() string {
+	return string(r.Item.SourceType)
+}
+
+// Date returns the event time
+func (r *EventResource) Date() time.Time {
+	return appaws.Time(r.Item.Date)
+}
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// EventRenderer renders ElastiCache events
+type EventRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEventRenderer creates a new EventRenderer
+func NewEventRenderer() *EventRenderer {
+	return &EventRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticache",
+			Resource: "events",
+			Cols: []render.Column{
+				{Name: "TIME", Width: 20, Getter: getTime, Priority: 0},
+				{Name: "AGE", Width: 8, Getter: getAge, Priority: 2},
+				{Name: "MESSAGE", Width: 80, Getter: getMessage, Priority: 1},
+			},
+		},
+	}
+}
+
+func getTime(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok && !e.Date().IsZero() {
+		return e.Date().Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok && !e.Date().IsZero() {
+		return render.FormatAge(e.Date())
+	}
+	return ""
+}
+
+func getMessage(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok {
+		return e.Message()
+	}
+	return ""
+}
+
+// RenderDetail renders the full event
+func (r *EventRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ElastiCache Event", e.GetName())
+
+	d.Section("Event")
+	d.Field("Source", e.GetName())
+	d.Field("Source Type", e.SourceType())
+	if !e.Date().IsZero() {
+		d.Field("Time", e.Date().Format(time.RFC3339))
+		d.Field("Age", render.FormatAge(e.Date()))
+	}
+
+	d.Section("Message")
+	d.Line("  " + e.Message())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EventRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Source", Value: e.GetName()},
+		{Label: "Type", Value: e.SourceType()},
+	}
+	if !e.Date().IsZero() {
+		fields = append(fields, render.SummaryField{Label: "Time", Value: e.Date().Format("2006-01-02 15:04:05")})
+	}
+	return fields
+}
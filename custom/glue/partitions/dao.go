@@ -0,0 +1,163 @@
+package partitions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// PartitionDAO provides data access for Glue table partitions.
+type PartitionDAO struct {
+	dao.BaseDAO
+	client *glue.Client
+}
+
+// NewPartitionDAO creates a new PartitionDAO.
+func NewPartitionDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PartitionDAO{
+		BaseDAO: dao.NewBaseDAO("glue", "partitions"),
+		client:  glue.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns partitions (first page only for backwards compatibility).
+// For paginated access, use ListPage instead.
+func (d *PartitionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 100, "")
+	return resources, err
+}
+
+// ListPage returns a page of partitions for the table given by the "Table"
+// filter in database.table form.
+// Implements dao.PaginatedDAO interface.
+func (d *PartitionDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	databaseName, tableName, ok := strings.Cut(dao.GetFilterFromContext(ctx, "Table"), ".")
+	if !ok || databaseName == "" || tableName == "" {
+		return nil, "", fmt.Errorf("table filter required (database.table)")
+	}
+
+	// Partition keys live on the table; partitions only carry values
+	table, err := d.client.GetTable(ctx, &glue.GetTableInput{
+		DatabaseName: &databaseName,
+		Name:         &tableName,
+	})
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "get glue table %s.%s", databaseName, tableName)
+	}
+	var keys []string
+	if table.Table != nil {
+		for _, k := range table.Table.PartitionKeys {
+			keys = append(keys, appaws.Str(k.Name))
+		}
+	}
+
+	maxResults := int32(pageSize)
+	if maxResults > 1000 {
+		maxResults = 1000 // AWS API max
+	}
+
+	input := &glue.GetPartitionsInput{
+		DatabaseName: &databaseName,
+		TableName:    &tableName,
+		MaxResults:   &maxResults,
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.GetPartitions(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "get partitions for %s.%s", databaseName, tableName)
+	}
+
+	resources := make([]dao.Resource, len(output.Partitions))
+	for i, p := range output.Partitions {
+		resources[i] = NewPartitionResource(p, keys)
+	}
+
+	return resources, appaws.Str(output.NextToken), nil
+}
+
+func (d *PartitionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for glue partitions")
+}
+
+func (d *PartitionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for glue partitions")
+}
+
+func (d *PartitionDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList:
+		return true
+	default:
+		return false
+	}
+}
+
+// PartitionResource wraps a Glue partition.
+type PartitionResource struct {
+	dao.BaseResource
+	Item types.Partition
+
+	// Keys are the table's partition key names, in the same order as Item.Values
+	Keys []string
+}
+
+// NewPartitionResource creates a new PartitionResource.
+func NewPartitionResource(p types.Partition, keys []string) *PartitionResource {
+	spec := PartitionSpec(keys, p.Values)
+	return &PartitionResource{
+		BaseResource: dao.BaseResource{
+			ID:   spec,
+			Name: spec,
+			Data: p,
+		},
+		Item: p,
+		Keys: keys,
+	}
+}
+
+// PartitionSpec formats partition values Hive-style (e.g., year=2024/month=01).
+// Values without a matching key are included as-is.
+func PartitionSpec(keys, values []string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		if i < len(keys) {
+			parts[i] = keys[i] + "=" + v
+		} else {
+			parts[i] = v
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Location returns the partition's storage location.
+func (r *PartitionResource) Location() string {
+	if r.Item.StorageDescriptor != nil {
+		return appaws.Str(r.Item.StorageDescriptor.Location)
+	}
+	return ""
+}
+
+// CreationTime returns when the partition was created.
+func (r *PartitionResource) CreationTime() *time.Time {
+	return r.Item.CreationTime
+}
+
+// LastAccessTime returns when the partition was last accessed.
+func (r *PartitionResource) LastAccessTime() *time.Time {
+	return r.Item.LastAccessTime
+}
@@ -0,0 +1,20 @@
+package partitions
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("glue", "partitions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPartitionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPartitionRenderer()
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package partitions
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PartitionRenderer renders Glue partitions.
+type PartitionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPartitionRenderer creates a new PartitionRenderer.
+func NewPartitionRenderer() render.Renderer {
+	return &PartitionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "glue",
+			Resource: "partitions",
+			Cols: []render.Column{
+				{Name: "PARTITION", Width: 40, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "LOCATION", Width: 55, Getter: getLocation},
+				{Name: "CREATED", Width: 20, Getter: getCreated},
+				{Name: "LAST ACCESS", Width: 20, Getter: getLastAccess},
+			},
+		},
+	}
+}
+
+func getLocation(r dao.Resource) string {
+	p, ok := r.(*PartitionResource)
+	if !ok {
+		return ""
+	}
+	return p.Location()
+}
+
+func getCreated(r dao.Resource) string {
+	p, ok := r.(*PartitionResource)
+	if !ok {
+		return ""
+	}
+	if t := p.CreationTime(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func getLastAccess(r dao.Resource) string {
+	p, ok := r.(*PartitionResource)
+	if !ok {
+		return ""
+	}
+	if t := p.LastAccessTime(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// RenderDetail renders the detail view for a Glue partition.
+func (r *PartitionRenderer) RenderDetail(resource dao.Resource) string {
+	p, ok := resource.(*PartitionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Glue Partition", p.GetID())
+
+	d.Section("Basic Information")
+	d.FieldIf("Database", p.Item.DatabaseName)
+	d.FieldIf("Table", p.Item.TableName)
+	for i, v := range p.Item.Values {
+		key := fmt.Sprintf("Value %d", i+1)
+		if i < len(p.Keys) {
+			key = p.Keys[i]
+		}
+		d.Field(key, v)
+	}
+
+	if sd := p.Item.StorageDescriptor; sd != nil {
+		d.Section("Storage")
+		d.FieldIf("Location", sd.Location)
+		d.FieldIf("Input Format", sd.InputFormat)
+		d.FieldIf("Output Format", sd.OutputFormat)
+		if sd.SerdeInfo != nil {
+			d.FieldIf("SerDe", sd.SerdeInfo.SerializationLibrary)
+		}
+	}
+
+	d.Section("Timestamps")
+	if t := p.CreationTime(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := p.LastAccessTime(); t != nil {
+		d.Field("Last Accessed", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := p.Item.LastAnalyzedTime; t != nil {
+		d.Field("Last Analyzed", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a Glue partition.
+func (r *PartitionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	p, ok := resource.(*PartitionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Partition", Value: p.GetID()},
+	}
+	if loc := p.Location(); loc != "" {
+		fields = append(fields, render.SummaryField{Label: "Location", Value: loc})
+	}
+	return fields
+}
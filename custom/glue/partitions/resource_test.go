@@ -0,0 +1,46 @@
+package partitions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+func TestPartitionSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   []string
+		values []string
+		want   string
+	}{
+		{"hive style", []string{"year", "month"}, []string{"2024", "01"}, "year=2024/month=01"},
+		{"missing keys", nil, []string{"2024", "01"}, "2024/01"},
+		{"extra values", []string{"year"}, []string{"2024", "01"}, "year=2024/01"},
+		{"empty", []string{"year"}, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PartitionSpec(tt.keys, tt.values); got != tt.want {
+				t.Errorf("PartitionSpec() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPartitionResource(t *testing.T) {
+	r := NewPartitionResource(types.Partition{
+		Values: []string{"2024-06-01"},
+		StorageDescriptor: &types.StorageDescriptor{
+			Location: aws.String("s3://bucket/orders/dt=2024-06-01/"),
+		},
+	}, []string{"dt"})
+
+	if r.GetID() != "dt=2024-06-01" {
+		t.Errorf("GetID() = %q, want %q", r.GetID(), "dt=2024-06-01")
+	}
+	if r.Location() != "s3://bucket/orders/dt=2024-06-01/" {
+		t.Errorf("Location() = %q", r.Location())
+	}
+}
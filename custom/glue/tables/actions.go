@@ -0,0 +1,239 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	athenatypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	appconfig "github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+)
+
+const (
+	// scanConfirmToken must be typed to run a query estimated over the scan limit
+	scanConfirmToken = "scan"
+	// scanEstimateMaxPages caps the S3 listing used when a table has no size statistics
+	scanEstimateMaxPages = 10
+)
+
+func init() {
+	action.Global.Register("glue", "tables", []action.Action{
+		{
+			Name:        "Query in Athena",
+			Shortcut:    "Q",
+			Type:        action.ActionTypeAPI,
+			Operation:   "StartQueryExecution",
+			Confirm:     action.ConfirmSimple,
+			PromptsFunc: queryPrompts,
+		},
+	})
+
+	action.RegisterExecutor("glue", "tables", executeTableAction)
+}
+
+// queryPrompts asks for a workgroup and query, labelling the query with the
+// size of a full table scan. The size is an upper bound for any query on the
+// table, since the edited query is not analyzed. Tables over the configured
+// scan limit, or of unknown size, need the confirm token typed as an extra step.
+func queryPrompts(ctx context.Context, resource dao.Resource) ([]action.Prompt, error) {
+	table, ok := resource.(*TableResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+
+	estimate := estimateScan(ctx, table)
+	prompts := []action.Prompt{
+		{Label: "Workgroup", Options: listWorkGroups},
+		{
+			Label:   "Query (full table scan " + estimate.String() + "; partition filters read less)",
+			Default: func(dao.Resource) string { return table.SelectQuery() },
+			Validate: func(v string) error {
+				if strings.TrimSpace(v) == "" {
+					return fmt.Errorf("query is required")
+				}
+				return nil
+			},
+		},
+	}
+
+	limit := appconfig.File().AthenaScanLimit()
+	if estimate.exceeds(limit) {
+		reason := fmt.Sprintf("A full table scan exceeds the %s limit", render.FormatSize(limit))
+		if !estimate.known() {
+			reason = fmt.Sprintf("The table size is unknown and may exceed the %s limit", render.FormatSize(limit))
+		}
+		prompts = append(prompts, action.Prompt{
+			Label: fmt.Sprintf("%s; type %q to run", reason, scanConfirmToken),
+			Validate: func(v string) error {
+				if v != scanConfirmToken {
+					return fmt.Errorf("type %q to confirm the scan", scanConfirmToken)
+				}
+				return nil
+			},
+		})
+	}
+	return prompts, nil
+}
+
+// scanEstimate is the data a full scan of a table reads. Athena bills by bytes
+// scanned, so this is an upper bound: partition filters and columnar formats
+// read less.
+type scanEstimate struct {
+	Bytes   int64
+	Source  string // "table statistics" or "S3 listing"
+	Partial bool   // S3 listing stopped early; the table may be larger
+}
+
+// known reports whether the full table size is known
+func (e scanEstimate) known() bool {
+	return e.Source != "" && !e.Partial
+}
+
+func (e scanEstimate) String() string {
+	switch {
+	case e.Source == "":
+		return "size unknown"
+	case e.Partial:
+		return fmt.Sprintf("size unknown, at least %s from a partial %s", render.FormatSize(e.Bytes), e.Source)
+	default:
+		return fmt.Sprintf("up to %s, from %s", render.FormatSize(e.Bytes), e.Source)
+	}
+}
+
+// exceeds reports whether a full scan may be over limit bytes. An unknown or
+// partial estimate counts as exceeding, so the scan is confirmed rather than
+// assumed to be small.
+func (e scanEstimate) exceeds(limit int64) bool {
+	return !e.known() || e.Bytes > limit
+}
+
+// estimateScan sizes the table from its Glue statistics, falling back to
+// summing object sizes under its S3 location. Errors leave the size unknown.
+func estimateScan(ctx context.Context, table *TableResource) scanEstimate {
+	if size, ok := table.StatsSize(); ok {
+		return scanEstimate{Bytes: size, Source: "table statistics"}
+	}
+
+	bucket, prefix, ok := parseS3Location(table.Location())
+	if !ok {
+		return scanEstimate{}
+	}
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return scanEstimate{}
+	}
+
+	estimate := scanEstimate{Source: "S3 listing"}
+	paginator := s3.NewListObjectsV2Paginator(s3.NewFromConfig(cfg), &s3.ListObjectsV2Input{
+		Bucket: &bucket,
+		Prefix: &prefix,
+	})
+	for pages := 0; paginator.HasMorePages(); pages++ {
+		if pages == scanEstimateMaxPages {
+			estimate.Partial = true
+			break
+		}
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			log.Debug("athena scan estimate listing failed", "location", table.Location(), "error", err)
+			return scanEstimate{}
+		}
+		for _, obj := range output.Contents {
+			estimate.Bytes += appaws.Int64(obj.Size)
+		}
+	}
+	return estimate
+}
+
+// parseS3Location splits an s3:// table location into bucket and key prefix
+func parseS3Location(location string) (bucket, prefix string, ok bool) {
+	rest, ok := strings.CutPrefix(location, "s3://")
+	if !ok {
+		return "", "", false
+	}
+	bucket, prefix, _ = strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", false
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return bucket, prefix, true
+}
+
+func executeTableAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StartQueryExecution":
+		return executeQueryInAthena(ctx, resource, act.Input(0), act.Input(1))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func newAthenaClient(ctx context.Context) (*athena.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return athena.NewFromConfig(cfg), nil
+}
+
+// listWorkGroups lists enabled Athena workgroups
+func listWorkGroups(ctx context.Context, _ dao.Resource) ([]string, error) {
+	client, err := newAthenaClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	paginator := athena.NewListWorkGroupsPaginator(client, &athena.ListWorkGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list athena workgroups: %w", err)
+		}
+		for _, wg := range output.WorkGroups {
+			if wg.State == athenatypes.WorkGroupStateEnabled {
+				names = append(names, appaws.Str(wg.Name))
+			}
+		}
+	}
+	return names, nil
+}
+
+func executeQueryInAthena(ctx context.Context, resource dao.Resource, workGroup, query string) action.ActionResult {
+	table, ok := resource.(*TableResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if workGroup == "" || query == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+
+	client, err := newAthenaClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	output, err := client.StartQueryExecution(ctx, &athena.StartQueryExecutionInput{
+		QueryString: &query,
+		WorkGroup:   &workGroup,
+		QueryExecutionContext: &athenatypes.QueryExecutionContext{
+			Database: &table.DatabaseName,
+		},
+	})
+	if err != nil {
+		return action.FailResultf(err, "start athena query for %s", table.QualifiedName())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Started query %s in workgroup %s (see athena/query-executions)",
+		appaws.Str(output.QueryExecutionId), workGroup))
+}
@@ -3,6 +3,8 @@ package tables
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/glue"
@@ -170,3 +172,53 @@ func (r *TableResource) CreateTime() *time.Time {
 func (r *TableResource) UpdateTime() *time.Time {
 	return r.Item.UpdateTime
 }
+
+// Columns returns the table's data columns (excluding partition keys).
+func (r *TableResource) Columns() []types.Column {
+	if r.Item.StorageDescriptor != nil {
+		return r.Item.StorageDescriptor.Columns
+	}
+	return nil
+}
+
+// PartitionKeys returns the table's partition key columns.
+func (r *TableResource) PartitionKeys() []types.Column {
+	return r.Item.PartitionKeys
+}
+
+// sizeParameters are table parameters that record the data size in bytes,
+// set by Glue crawlers (sizeKey) or Hive statistics (totalSize, rawDataSize).
+var sizeParameters = []string{"sizeKey", "totalSize", "rawDataSize"}
+
+// StatsSize returns the table's data size in bytes from its Glue statistics,
+// or false if the table has none.
+func (r *TableResource) StatsSize() (int64, bool) {
+	var params []map[string]string
+	params = append(params, r.Item.Parameters)
+	if r.Item.StorageDescriptor != nil {
+		params = append(params, r.Item.StorageDescriptor.Parameters)
+	}
+	for _, key := range sizeParameters {
+		for _, p := range params {
+			if n, err := strconv.ParseInt(p[key], 10, 64); err == nil && n > 0 {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// QualifiedName returns the table name qualified with its database (database.table).
+func (r *TableResource) QualifiedName() string {
+	return r.DatabaseName + "." + r.Name()
+}
+
+// SelectQuery returns an Athena SELECT for a sample of the table's rows.
+func (r *TableResource) SelectQuery() string {
+	return fmt.Sprintf("SELECT * FROM %s.%s LIMIT 10", quoteIdentifier(r.DatabaseName), quoteIdentifier(r.Name()))
+}
+
+// quoteIdentifier double-quotes an Athena identifier, escaping embedded quotes.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -3,10 +3,15 @@ package tables
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
 
+var _ render.Navigator = (*TableRenderer)(nil)
+
 // TableRenderer renders Glue tables.
 type TableRenderer struct {
 	render.BaseRenderer
@@ -105,6 +110,12 @@ func (r *TableRenderer) RenderDetail(resource dao.Resource) string {
 	// Schema
 	d.Section("Schema")
 	d.Field("Column Count", fmt.Sprintf("%d", table.ColumnCount()))
+	renderColumns(d, table.Columns())
+
+	if keys := table.PartitionKeys(); len(keys) > 0 {
+		d.Section("Partition Keys")
+		renderColumns(d, keys)
+	}
 
 	// Timestamps
 	d.Section("Timestamps")
@@ -118,6 +129,22 @@ func (r *TableRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// renderColumns lists columns as name, type and optional comment.
+func renderColumns(d *render.DetailBuilder, cols []types.Column) {
+	styles := d.Styles()
+	nameWidth := 0
+	for _, c := range cols {
+		nameWidth = max(nameWidth, len(appaws.Str(c.Name)))
+	}
+	for _, c := range cols {
+		line := "  " + styles.Value.Render(fmt.Sprintf("%-*s", nameWidth, appaws.Str(c.Name))) + "  " + styles.Dim.Render(appaws.Str(c.Type))
+		if comment := appaws.Str(c.Comment); comment != "" {
+			line += styles.Dim.Render("  -- " + comment)
+		}
+		d.Line(line)
+	}
+}
+
 // RenderSummary renders summary fields for a Glue table.
 func (r *TableRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	table, ok := resource.(*TableResource)
@@ -141,3 +168,18 @@ func (r *TableRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts for a Glue table.
+func (r *TableRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	table, ok := resource.(*TableResource)
+	if !ok || len(table.PartitionKeys()) == 0 {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "p", Label: "Partitions", Service: "glue", Resource: "partitions",
+			FilterField: "Table", FilterValue: table.QualifiedName(),
+		},
+	}
+}
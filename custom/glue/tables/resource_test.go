@@ -0,0 +1,195 @@
+package tables
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+func TestTableResource_SelectQuery(t *testing.T) {
+	tests := []struct {
+		database string
+		table    string
+		want     string
+	}{
+		{"sales", "orders", `SELECT * FROM "sales"."orders" LIMIT 10`},
+		{"sales", `odd"name`, `SELECT * FROM "sales"."odd""name" LIMIT 10`},
+	}
+
+	for _, tt := range tests {
+		r := NewTableResource(types.Table{Name: aws.String(tt.table)}, tt.database)
+		if got := r.SelectQuery(); got != tt.want {
+			t.Errorf("SelectQuery() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestTableResource_QualifiedName(t *testing.T) {
+	r := NewTableResource(types.Table{Name: aws.String("orders")}, "sales")
+	if got := r.QualifiedName(); got != "sales.orders" {
+		t.Errorf("QualifiedName() = %q, want %q", got, "sales.orders")
+	}
+}
+
+func TestTableRenderer_Schema(t *testing.T) {
+	r := NewTableResource(types.Table{
+		Name: aws.String("orders"),
+		StorageDescriptor: &types.StorageDescriptor{
+			Columns: []types.Column{
+				{Name: aws.String("order_id"), Type: aws.String("bigint"), Comment: aws.String("primary key")},
+				{Name: aws.String("amount"), Type: aws.String("decimal(10,2)")},
+			},
+		},
+		PartitionKeys: []types.Column{{Name: aws.String("dt"), Type: aws.String("string")}},
+	}, "sales")
+
+	renderer := NewTableRenderer().(*TableRenderer)
+	detail := renderer.RenderDetail(r)
+	for _, want := range []string{"order_id", "bigint", "primary key", "decimal(10,2)", "Partition Keys", "dt"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("RenderDetail() missing %q", want)
+		}
+	}
+
+	navs := renderer.Navigations(r)
+	if len(navs) != 1 || navs[0].Resource != "partitions" || navs[0].FilterValue != "sales.orders" {
+		t.Errorf("Navigations() = %+v, want partitions navigation", navs)
+	}
+
+	unpartitioned := NewTableResource(types.Table{Name: aws.String("dim")}, "sales")
+	if navs := renderer.Navigations(unpartitioned); len(navs) != 0 {
+		t.Errorf("Navigations() for unpartitioned table = %+v, want none", navs)
+	}
+}
+
+func TestTableResource_StatsSize(t *testing.T) {
+	tests := []struct {
+		name   string
+		table  types.Table
+		want   int64
+		wantOK bool
+	}{
+		{"none", types.Table{}, 0, false},
+		{"crawler", types.Table{Parameters: map[string]string{"sizeKey": "2048", "totalSize": "99"}}, 2048, true},
+		{"hive", types.Table{Parameters: map[string]string{"totalSize": "4096"}}, 4096, true},
+		{"storage descriptor", types.Table{StorageDescriptor: &types.StorageDescriptor{
+			Parameters: map[string]string{"sizeKey": "512"},
+		}}, 512, true},
+		{"invalid", types.Table{Parameters: map[string]string{"sizeKey": "-1", "totalSize": "n/a"}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NewTableResource(tt.table, "db").StatsSize()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("StatsSize() = %d, %v, want %d, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseS3Location(t *testing.T) {
+	tests := []struct {
+		location string
+		bucket   string
+		prefix   string
+		ok       bool
+	}{
+		{"s3://data/sales/orders", "data", "sales/orders/", true},
+		{"s3://data/sales/orders/", "data", "sales/orders/", true},
+		{"s3://data", "data", "", true},
+		{"hdfs://data/orders", "", "", false},
+		{"s3:///orders", "", "", false},
+	}
+
+	for _, tt := range tests {
+		bucket, prefix, ok := parseS3Location(tt.location)
+		if bucket != tt.bucket || prefix != tt.prefix || ok != tt.ok {
+			t.Errorf("parseS3Location(%q) = %q, %q, %v", tt.location, bucket, prefix, ok)
+		}
+	}
+}
+
+func TestScanEstimate(t *testing.T) {
+	const limit = 10 << 30
+
+	tests := []struct {
+		name    string
+		est     scanEstimate
+		exceeds bool
+		text    string
+	}{
+		{"unknown", scanEstimate{}, true, "size unknown"},
+		{"under", scanEstimate{Bytes: 1 << 30, Source: "table statistics"}, false, "up to 1.0 GiB, from table statistics"},
+		{"over", scanEstimate{Bytes: 20 << 30, Source: "S3 listing"}, true, "up to 20.0 GiB"},
+		{"partial under", scanEstimate{Bytes: 1 << 20, Source: "S3 listing", Partial: true}, true, "size unknown, at least 1.0 MiB"},
+		{"partial over", scanEstimate{Bytes: 20 << 30, Source: "S3 listing", Partial: true}, true, "size unknown, at least 20.0 GiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.est.exceeds(limit); got != tt.exceeds {
+				t.Errorf("exceeds() = %v, want %v", got, tt.exceeds)
+			}
+			if got := tt.est.String(); !strings.Contains(got, tt.text) {
+				t.Errorf("String() = %q, want it to contain %q", got, tt.text)
+			}
+		})
+	}
+}
+
+func TestQueryPrompts_ScanLimit(t *testing.T) {
+	small := NewTableResource(types.Table{
+		Name:       aws.String("orders"),
+		Parameters: map[string]string{"sizeKey": "1024"},
+	}, "sales")
+	prompts, err := queryPrompts(context.Background(), small)
+	if err != nil {
+		t.Fatalf("queryPrompts() error: %v", err)
+	}
+	if len(prompts) != 2 {
+		t.Fatalf("got %d prompts for a small table, want 2", len(prompts))
+	}
+	if !strings.Contains(prompts[1].Label, "1.0 KiB") {
+		t.Errorf("query label %q missing estimate", prompts[1].Label)
+	}
+
+	large := NewTableResource(types.Table{
+		Name:       aws.String("events"),
+		Parameters: map[string]string{"sizeKey": "1099511627776"}, // 1 TiB
+	}, "sales")
+	prompts, err = queryPrompts(context.Background(), large)
+	if err != nil {
+		t.Fatalf("queryPrompts() error: %v", err)
+	}
+	if len(prompts) != 3 {
+		t.Fatalf("got %d prompts for a large table, want 3", len(prompts))
+	}
+	confirm := prompts[2].Validate
+	if confirm("yes") == nil {
+		t.Error("scan confirmation accepted the wrong token")
+	}
+	if err := confirm(scanConfirmToken); err != nil {
+		t.Errorf("scan confirmation rejected %q: %v", scanConfirmToken, err)
+	}
+
+	// No statistics and no S3 location: the size is unknown, so the scan
+	// must be confirmed
+	unknown := NewTableResource(types.Table{Name: aws.String("raw")}, "sales")
+	prompts, err = queryPrompts(context.Background(), unknown)
+	if err != nil {
+		t.Fatalf("queryPrompts() error: %v", err)
+	}
+	if len(prompts) != 3 {
+		t.Fatalf("got %d prompts for a table of unknown size, want 3", len(prompts))
+	}
+	if !strings.Contains(prompts[1].Label, "size unknown") {
+		t.Errorf("query label %q does not say the size is unknown", prompts[1].Label)
+	}
+	if !strings.Contains(prompts[2].Label, "unknown") {
+		t.Errorf("confirm label %q does not say the size is unknown", prompts[2].Label)
+	}
+}
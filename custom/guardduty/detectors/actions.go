@@ -0,0 +1,93 @@
+package detectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	shortcuts := map[types.DetectorFeature]string{
+		types.DetectorFeatureRuntimeMonitoring:    "r",
+		types.DetectorFeatureEbsMalwareProtection: "m",
+		types.DetectorFeatureS3DataEvents:         "s",
+	}
+
+	actions := make([]action.Action, 0, len(Protections))
+	for _, p := range Protections {
+		actions = append(actions, action.Action{
+			Name:      "Enable " + p.Name,
+			Shortcut:  shortcuts[p.Feature],
+			Type:      action.ActionTypeAPI,
+			Operation: p.Operation,
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				d, ok := r.(*DetectorResource)
+				return ok && !d.ProtectionEnabled(p)
+			},
+		})
+	}
+	action.Global.Register("guardduty", "detectors", actions)
+
+	action.RegisterExecutor("guardduty", "detectors", executeDetectorAction)
+}
+
+func executeDetectorAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	d, ok := resource.(*DetectorResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	for _, p := range Protections {
+		if p.Operation == act.Operation {
+			return executeEnableProtection(ctx, d, p)
+		}
+	}
+	return action.UnknownOperationResult(act.Operation)
+}
+
+// protectionFeature returns the feature configuration that enables p. Runtime
+// Monitoring also enables automated agent management for EKS, ECS Fargate and
+// EC2, without which no runtime events are collected.
+func protectionFeature(p Protection) types.DetectorFeatureConfiguration {
+	feature := types.DetectorFeatureConfiguration{
+		Name:   p.Feature,
+		Status: types.FeatureStatusEnabled,
+	}
+	if p.Feature == types.DetectorFeatureRuntimeMonitoring {
+		for _, name := range []types.FeatureAdditionalConfiguration{
+			types.FeatureAdditionalConfigurationEksAddonManagement,
+			types.FeatureAdditionalConfigurationEcsFargateAgentManagement,
+			types.FeatureAdditionalConfigurationEc2AgentManagement,
+		} {
+			feature.AdditionalConfiguration = append(feature.AdditionalConfiguration, types.DetectorAdditionalConfiguration{
+				Name:   name,
+				Status: types.FeatureStatusEnabled,
+			})
+		}
+	}
+	return feature
+}
+
+func executeEnableProtection(ctx context.Context, d *DetectorResource, p Protection) action.ActionResult {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	client := guardduty.NewFromConfig(cfg)
+
+	_, err = client.UpdateDetector(ctx, &guardduty.UpdateDetectorInput{
+		DetectorId: &d.DetectorId,
+		Features:   []types.DetectorFeatureConfiguration{protectionFeature(p)},
+	})
+	if err != nil {
+		return action.FailResultf(err, "enable %s on detector %s", p.Name, d.DetectorId)
+	}
+	return action.SuccessResult(fmt.Sprintf("Enabled %s on detector %s", p.Name, d.DetectorId))
+}
@@ -54,7 +54,9 @@ func (d *DetectorDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		if err != nil {
 			return nil, apperrors.Wrapf(err, "get detector %s", detectorId)
 		}
-		resources = append(resources, NewDetectorResource(detectorId, detail))
+		r := NewDetectorResource(detectorId, detail)
+		r.Region = d.client.Options().Region
+		resources = append(resources, r)
 	}
 
 	return resources, nil
@@ -69,7 +71,9 @@ func (d *DetectorDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 		return nil, apperrors.Wrapf(err, "get detector %s", id)
 	}
 
-	return NewDetectorResource(id, output), nil
+	r := NewDetectorResource(id, output)
+	r.Region = d.client.Options().Region
+	return r, nil
 }
 
 // Delete deletes a GuardDuty detector
@@ -88,6 +92,7 @@ type DetectorResource struct {
 	dao.BaseResource
 	DetectorId string
 	Detail     *guardduty.GetDetectorOutput
+	Region     string
 }
 
 // NewDetectorResource creates a new DetectorResource
@@ -180,3 +185,56 @@ func (r *DetectorResource) FeaturesStatus() map[string]types.FeatureStatus {
 
 	return result
 }
+
+// AccountID returns the account that owns the detector, from its service role ARN
+func (r *DetectorResource) AccountID() string {
+	if parsed := appaws.ParseARN(r.ServiceRole()); parsed != nil {
+		return parsed.AccountID
+	}
+	return ""
+}
+
+// Protection is a GuardDuty protection plan controlled by a detector feature
+type Protection struct {
+	Name      string
+	Feature   types.DetectorFeature
+	Operation string // Action operation that enables the protection
+}
+
+// Protections are the protection plans surfaced in the detector view
+var Protections = []Protection{
+	{Name: "Runtime Monitoring", Feature: types.DetectorFeatureRuntimeMonitoring, Operation: "EnableRuntimeMonitoring"},
+	{Name: "Malware Protection for EC2", Feature: types.DetectorFeatureEbsMalwareProtection, Operation: "EnableMalwareProtection"},
+	{Name: "S3 Protection", Feature: types.DetectorFeatureS3DataEvents, Operation: "EnableS3Protection"},
+}
+
+// Feature returns the detector's configuration of feature, or nil if the
+// detector does not report it
+func (r *DetectorResource) Feature(feature types.DetectorFeature) *types.DetectorFeatureConfigurationResult {
+	if r.Detail == nil {
+		return nil
+	}
+	for i := range r.Detail.Features {
+		if string(r.Detail.Features[i].Name) == string(feature) {
+			return &r.Detail.Features[i]
+		}
+	}
+	return nil
+}
+
+// ProtectionEnabled reports whether the protection's feature is enabled
+func (r *DetectorResource) ProtectionEnabled(p Protection) bool {
+	f := r.Feature(p.Feature)
+	return f != nil && f.Status == types.FeatureStatusEnabled
+}
+
+// MissingProtections returns the protections that are not enabled
+func (r *DetectorResource) MissingProtections() []Protection {
+	var missing []Protection
+	for _, p := range Protections {
+		if !r.ProtectionEnabled(p) {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
@@ -3,6 +3,9 @@ package detectors
 import (
 	"fmt"
 	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -26,6 +29,9 @@ func NewDetectorRenderer() *DetectorRenderer {
 				{Name: "DETECTOR ID", Width: 36, Getter: getDetectorId},
 				{Name: "STATUS", Width: 10, Getter: getStatus},
 				{Name: "FREQUENCY", Width: 15, Getter: getFrequency},
+				{Name: "RUNTIME", Width: 8, Getter: protectionGetter(Protections[0])},
+				{Name: "MALWARE", Width: 8, Getter: protectionGetter(Protections[1])},
+				{Name: "S3", Width: 5, Getter: protectionGetter(Protections[2])},
 				{Name: "AGE", Width: 12, Getter: getAge},
 			},
 		},
@@ -53,6 +59,18 @@ func getFrequency(r dao.Resource) string {
 	return ""
 }
 
+func protectionGetter(p Protection) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if d, ok := r.(*DetectorResource); ok {
+			if d.ProtectionEnabled(p) {
+				return "on"
+			}
+			return "off"
+		}
+		return ""
+	}
+}
+
 func getAge(r dao.Resource) string {
 	if d, ok := r.(*DetectorResource); ok {
 		if t := d.CreatedAtTime(); t != nil {
@@ -82,6 +100,33 @@ func (r *DetectorRenderer) RenderDetail(resource dao.Resource) string {
 	if role := detector.ServiceRole(); role != "" {
 		d.Field("Service Role", role)
 	}
+	if account := detector.AccountID(); account != "" {
+		d.Field("Account", account)
+	}
+	if detector.Region != "" {
+		d.Field("Region", detector.Region)
+	}
+
+	// Protection plans, with the agent management of Runtime Monitoring
+	d.Section("Protection Plans")
+	for _, p := range Protections {
+		status := "DISABLED"
+		if f := detector.Feature(p.Feature); f != nil {
+			status = string(f.Status)
+		}
+		d.FieldStyled(p.Name, status, render.StateColorer()(strings.ToLower(status)))
+		if p.Feature != types.DetectorFeatureRuntimeMonitoring {
+			continue
+		}
+		if f := detector.Feature(p.Feature); f != nil {
+			for _, ac := range f.AdditionalConfiguration {
+				d.Field("  "+agentManagementLabel(ac.Name), string(ac.Status))
+			}
+		}
+	}
+	if missing := detector.MissingProtections(); len(missing) > 0 {
+		d.Field("Missing", fmt.Sprintf("%d (enable from the actions menu)", len(missing)))
+	}
 
 	// Features
 	featuresStatus := detector.FeaturesStatus()
@@ -124,6 +169,20 @@ func (r *DetectorRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// agentManagementLabel names a Runtime Monitoring agent management setting
+func agentManagementLabel(name types.FeatureAdditionalConfiguration) string {
+	switch name {
+	case types.FeatureAdditionalConfigurationEksAddonManagement:
+		return "EKS Agent Management"
+	case types.FeatureAdditionalConfigurationEcsFargateAgentManagement:
+		return "ECS Fargate Agent Management"
+	case types.FeatureAdditionalConfigurationEc2AgentManagement:
+		return "EC2 Agent Management"
+	default:
+		return string(name)
+	}
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *DetectorRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	detector, ok := resource.(*DetectorResource)
@@ -152,6 +211,14 @@ func (r *DetectorRenderer) RenderSummary(resource dao.Resource) []render.Summary
 		})
 	}
 
+	if missing := detector.MissingProtections(); len(missing) > 0 {
+		names := make([]string, len(missing))
+		for i, p := range missing {
+			names[i] = p.Name
+		}
+		fields = append(fields, render.SummaryField{Label: "Missing Protections", Value: strings.Join(names, ", ")})
+	}
+
 	if created := detector.CreatedAt(); created != "" {
 		fields = append(fields, render.SummaryField{Label: "Created", Value: created})
 	}
@@ -0,0 +1,44 @@
+package detectors
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+)
+
+func TestDetectorProtections(t *testing.T) {
+	detail := &guardduty.GetDetectorOutput{
+		ServiceRole: aws.String("arn:aws:iam::123456789012:role/aws-service-role/guardduty.amazonaws.com/AWSServiceRoleForAmazonGuardDuty"),
+		Features: []types.DetectorFeatureConfigurationResult{
+			{Name: types.DetectorFeatureResultRuntimeMonitoring, Status: types.FeatureStatusEnabled},
+			{Name: types.DetectorFeatureResultEbsMalwareProtection, Status: types.FeatureStatusDisabled},
+		},
+	}
+	r := NewDetectorResource("det-1", detail)
+
+	if r.AccountID() != "123456789012" {
+		t.Errorf("AccountID() = %q, want 123456789012", r.AccountID())
+	}
+	if !r.ProtectionEnabled(Protections[0]) {
+		t.Error("Runtime Monitoring should be enabled")
+	}
+
+	missing := r.MissingProtections()
+	if len(missing) != 2 || missing[0].Feature != types.DetectorFeatureEbsMalwareProtection || missing[1].Feature != types.DetectorFeatureS3DataEvents {
+		t.Errorf("MissingProtections() = %+v, want malware and S3", missing)
+	}
+}
+
+func TestProtectionFeature(t *testing.T) {
+	runtime := protectionFeature(Protections[0])
+	if runtime.Status != types.FeatureStatusEnabled || len(runtime.AdditionalConfiguration) != 3 {
+		t.Errorf("runtime feature = %+v, want enabled with 3 agent management settings", runtime)
+	}
+
+	s3 := protectionFeature(Protections[2])
+	if s3.Name != types.DetectorFeatureS3DataEvents || len(s3.AdditionalConfiguration) != 0 {
+		t.Errorf("s3 feature = %+v", s3)
+	}
+}
@@ -0,0 +1,122 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+
+	"github.com/clawscli/claws/custom/health/events"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// historyWindow is how far back events are listed; AWS Health keeps 90 days
+const historyWindow = 90 * 24 * time.Hour
+
+// HistoryDAO provides AWS Health event history limited to the services and
+// region in use.
+type HistoryDAO struct {
+	dao.BaseDAO
+	client *health.Client
+	region string
+}
+
+// NewHistoryDAO creates a new HistoryDAO.
+func NewHistoryDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	// Health API requires us-east-1 region; the configured region is kept to
+	// scope the events
+	return &HistoryDAO{
+		BaseDAO: dao.NewBaseDAO("health", "history"),
+		client:  health.NewFromConfig(cfg, func(o *health.Options) { o.Region = "us-east-1" }),
+		region:  cfg.Region,
+	}, nil
+}
+
+// List returns the events of the last 90 days for services registered in
+// claws and the current region, newest first.
+func (d *HistoryDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	from := time.Now().Add(-historyWindow)
+	all, err := appaws.Paginate(ctx, func(token *string) ([]types.Event, *string, error) {
+		output, err := d.client.DescribeEvents(ctx, &health.DescribeEventsInput{
+			Filter: &types.EventFilter{
+				StartTimes: []types.DateTimeRange{{From: &from}},
+			},
+			MaxResults: appaws.Int32Ptr(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe health events")
+		}
+		return output.Events, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scope := NewScope(registry.Global.ListServices(), d.region, d.isPrimaryRegion())
+	matched := FilterEvents(all, scope)
+
+	resources := make([]dao.Resource, len(matched))
+	for i, event := range matched {
+		resources[i] = events.NewEventResource(event)
+	}
+	return resources, nil
+}
+
+// isPrimaryRegion returns whether d lists the first selected region, which
+// reports the events of global services in multi-region listings
+func (d *HistoryDAO) isPrimaryRegion() bool {
+	regions := config.Global().Regions()
+	return len(regions) == 0 || regions[0] == d.region
+}
+
+// FilterEvents returns the events matching scope, newest first
+func FilterEvents(all []types.Event, scope Scope) []types.Event {
+	matched := make([]types.Event, 0, len(all))
+	for _, event := range all {
+		if scope.Matches(event) {
+			matched = append(matched, event)
+		}
+	}
+	slices.SortFunc(matched, func(a, b types.Event) int {
+		return appaws.Time(b.StartTime).Compare(appaws.Time(a.StartTime))
+	})
+	return matched
+}
+
+// Get returns a specific Health event by ARN.
+func (d *HistoryDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeEvents(ctx, &health.DescribeEventsInput{
+		Filter: &types.EventFilter{
+			EventArns: []string{id},
+		},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe health event %s", id)
+	}
+	if len(output.Events) == 0 {
+		return nil, fmt.Errorf("health event not found: %s", id)
+	}
+	return events.NewEventResource(output.Events[0]), nil
+}
+
+// Delete is not supported for Health events.
+func (d *HistoryDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for health history")
+}
+
+// Supports returns supported operations
+func (d *HistoryDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
@@ -0,0 +1,20 @@
+package history
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("health", "history", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewHistoryDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewHistoryRenderer()
+		},
+	})
+}
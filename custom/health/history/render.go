@@ -0,0 +1,87 @@
+package history
+
+import (
+	"github.com/clawscli/claws/custom/health/events"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// HistoryRenderer renders AWS Health event history. Details are shared with
+// the health events view.
+type HistoryRenderer struct {
+	render.BaseRenderer
+	events render.Renderer
+}
+
+// NewHistoryRenderer creates a new HistoryRenderer.
+func NewHistoryRenderer() render.Renderer {
+	return &HistoryRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "health",
+			Resource: "history",
+			Cols: []render.Column{
+				{Name: "SERVICE", Width: 20, Getter: eventGetter((*events.EventResource).Service)},
+				{Name: "EVENT TYPE", Width: 40, Getter: eventGetter((*events.EventResource).EventTypeCode)},
+				{Name: "STATUS", Width: 10, Getter: eventGetter((*events.EventResource).StatusCode)},
+				{Name: "REGION", Width: 15, Getter: eventGetter((*events.EventResource).Region)},
+				{Name: "SCOPE", Width: 16, Getter: eventGetter((*events.EventResource).EventScopeCode)},
+				{Name: "STARTED", Width: 18, Getter: getStarted},
+				{Name: "DURATION", Width: 10, Getter: getDuration},
+			},
+		},
+		events: events.NewEventRenderer(),
+	}
+}
+
+func eventGetter(get func(*events.EventResource) string) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if event, ok := r.(*events.EventResource); ok {
+			return get(event)
+		}
+		return ""
+	}
+}
+
+func getStarted(r dao.Resource) string {
+	event, ok := r.(*events.EventResource)
+	if !ok {
+		return ""
+	}
+	if t := event.StartTime(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func getDuration(r dao.Resource) string {
+	event, ok := r.(*events.EventResource)
+	if !ok {
+		return ""
+	}
+	return EventDuration(event)
+}
+
+// EventDuration returns how long event lasted, or "ongoing" for open events
+func EventDuration(event *events.EventResource) string {
+	start := event.StartTime()
+	if start == nil {
+		return ""
+	}
+	if end := event.EndTime(); end != nil {
+		return render.FormatDuration(end.Sub(*start))
+	}
+	if event.StatusCode() == "closed" {
+		return ""
+	}
+	return "ongoing"
+}
+
+// RenderDetail renders the detail view for a Health event.
+func (r *HistoryRenderer) RenderDetail(resource dao.Resource) string {
+	return r.events.RenderDetail(resource)
+}
+
+// RenderSummary renders summary fields for a Health event.
+func (r *HistoryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	return r.events.RenderSummary(resource)
+}
@@ -0,0 +1,88 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+
+	"github.com/clawscli/claws/custom/health/events"
+)
+
+func event(arn, service, region string, scope types.EventScopeCode, start time.Time) types.Event {
+	return types.Event{
+		Arn:            aws.String(arn),
+		Service:        aws.String(service),
+		Region:         aws.String(region),
+		EventScopeCode: scope,
+		StartTime:      aws.Time(start),
+	}
+}
+
+func TestHealthServiceCodes(t *testing.T) {
+	tests := map[string]string{
+		"ec2":            "EC2",
+		"lambda":         "LAMBDA",
+		"elbv2":          "ELASTICLOADBALANCING",
+		"stepfunctions":  "STATES",
+		"cognito-idp":    "COGNITO",
+		"service-quotas": "SERVICEQUOTAS",
+	}
+	for service, want := range tests {
+		if got := HealthServiceCodes(service)[0]; got != want {
+			t.Errorf("HealthServiceCodes(%q) = %q, want %q", service, got, want)
+		}
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+	base := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	all := []types.Event{
+		event("ec2-here", "EC2", "us-east-1", types.EventScopeCodePublic, base),
+		event("ec2-elsewhere", "EC2", "eu-west-1", types.EventScopeCodePublic, base),
+		event("unused-service", "GAMESPARKS", "us-east-1", types.EventScopeCodePublic, base),
+		event("iam-global", "IAM", "global", types.EventScopeCodePublic, base.Add(time.Hour)),
+		event("account", "GAMESPARKS", "eu-west-1", types.EventScopeCodeAccountSpecific, base.Add(2*time.Hour)),
+	}
+	services := []string{"ec2", "iam"}
+
+	tests := []struct {
+		name          string
+		includeGlobal bool
+		want          []string
+	}{
+		{"primary region", true, []string{"account", "iam-global", "ec2-here"}},
+		{"secondary region", false, []string{"account", "ec2-here"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterEvents(all, NewScope(services, "us-east-1", tt.includeGlobal))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d events, want %v", len(got), tt.want)
+			}
+			for i, e := range got {
+				if aws.ToString(e.Arn) != tt.want[i] {
+					t.Errorf("event %d = %s, want %s", i, aws.ToString(e.Arn), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEventDuration(t *testing.T) {
+	start := time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	closed := event("a", "EC2", "us-east-1", types.EventScopeCodePublic, start)
+	closed.EndTime = aws.Time(start.Add(90 * time.Minute))
+	closed.StatusCode = types.EventStatusCodeClosed
+	if got := EventDuration(events.NewEventResource(closed)); got != "1h30m" {
+		t.Errorf("closed EventDuration() = %q, want 1h30m", got)
+	}
+
+	open := event("b", "EC2", "us-east-1", types.EventScopeCodePublic, start)
+	open.StatusCode = types.EventStatusCodeOpen
+	if got := EventDuration(events.NewEventResource(open)); got != "ongoing" {
+		t.Errorf("open EventDuration() = %q, want ongoing", got)
+	}
+}
@@ -0,0 +1,78 @@
+package history
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// globalRegion is the region AWS Health reports for events of global services
+const globalRegion = "global"
+
+// healthServiceCodes maps claws service names to AWS Health service codes
+// where the upper-cased name does not match
+var healthServiceCodes = map[string][]string{
+	"bedrock-agent":     {"BEDROCK"},
+	"bedrock-agentcore": {"BEDROCK"},
+	"cognito-idp":       {"COGNITO"},
+	"configservice":     {"CONFIG"},
+	"elbv2":             {"ELASTICLOADBALANCING"},
+	"emr":               {"ELASTICMAPREDUCE"},
+	"events":            {"EVENTS", "EVENTBRIDGE"},
+	"opensearch":        {"ES"},
+	"risp":              {"EC2"},
+	"s3vectors":         {"S3"},
+	"stepfunctions":     {"STATES"},
+	"vpc":               {"VPC", "EC2"},
+	"wafv2":             {"WAF"},
+}
+
+// HealthServiceCodes returns the AWS Health service codes for a claws service
+func HealthServiceCodes(service string) []string {
+	if codes, ok := healthServiceCodes[service]; ok {
+		return codes
+	}
+	return []string{strings.ToUpper(strings.ReplaceAll(service, "-", ""))}
+}
+
+// Scope is the set of services and regions whose health history is shown
+type Scope struct {
+	services      map[string]struct{}
+	region        string
+	includeGlobal bool
+}
+
+// NewScope creates a scope for the Health codes of services in region.
+// Events of global services are included when includeGlobal is set, so
+// multi-region listings report them once.
+func NewScope(services []string, region string, includeGlobal bool) Scope {
+	s := Scope{
+		services:      make(map[string]struct{}),
+		region:        region,
+		includeGlobal: includeGlobal,
+	}
+	for _, service := range services {
+		for _, code := range HealthServiceCodes(service) {
+			s.services[code] = struct{}{}
+		}
+	}
+	return s
+}
+
+// Matches returns whether event is relevant to the scope. Account-specific
+// events affect the account's own resources and always match.
+func (s Scope) Matches(event types.Event) bool {
+	if event.EventScopeCode == types.EventScopeCodeAccountSpecific {
+		return true
+	}
+	if _, ok := s.services[appaws.Str(event.Service)]; !ok {
+		return false
+	}
+	region := appaws.Str(event.Region)
+	if region == globalRegion || region == "" {
+		return s.includeGlobal
+	}
+	return region == s.region
+}
@@ -149,6 +149,14 @@ func NewFunctionResourceFromConfig(fn types.FunctionConfiguration) *FunctionReso
 	}
 }
 
+// LogGroup returns the CloudWatch log group the function writes to
+func (r *FunctionResource) LogGroup() string {
+	if r.Item.LoggingConfig != nil && r.Item.LoggingConfig.LogGroup != nil {
+		return *r.Item.LoggingConfig.LogGroup
+	}
+	return "/aws/lambda/" + r.GetName()
+}
+
 // Runtime returns the runtime
 func (r *FunctionResource) Runtime() string {
 	return string(r.Item.Runtime)
@@ -13,6 +13,7 @@ import (
 // FunctionRenderer renders Lambda functions
 var (
 	_ render.Navigator          = (*FunctionRenderer)(nil)
+	_ render.LogSource          = (*FunctionRenderer)(nil)
 	_ render.MetricSpecProvider = (*FunctionRenderer)(nil)
 )
 
@@ -312,6 +313,15 @@ func (r *FunctionRenderer) RenderSummary(resource dao.Resource) []render.Summary
 	return fields
 }
 
+// LogTargets returns the function's log group, custom or the /aws/lambda default
+func (r *FunctionRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	fn, ok := resource.(*FunctionResource)
+	if !ok {
+		return nil
+	}
+	return []render.LogTarget{{Group: fn.LogGroup()}}
+}
+
 // Navigations returns navigation shortcuts
 func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	fn, ok := resource.(*FunctionResource)
@@ -321,17 +331,6 @@ func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigatio
 
 	var navs []render.Navigation
 
-	// Navigate to CloudWatch Logs
-	logGroupName := "/aws/lambda/" + fn.GetName()
-	navs = append(navs, render.Navigation{
-		Key:         "l",
-		Label:       "Logs",
-		Service:     "cloudwatch",
-		Resource:    "log-groups",
-		FilterField: "LogGroupPrefix",
-		FilterValue: logGroupName,
-	})
-
 	// Navigate to IAM role
 	if role := fn.Role(); role != "" {
 		roleName := appaws.ExtractResourceName(role)
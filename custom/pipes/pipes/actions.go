@@ -0,0 +1,86 @@
+package pipes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("pipes", "pipes", []action.Action{
+		{
+			Name:      "Start",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartPipe",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				pr, ok := r.(*PipeResource)
+				return ok && pr.Startable()
+			},
+		},
+		{
+			Name:      "Stop",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopPipe",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				pr, ok := r.(*PipeResource)
+				return ok && pr.Stoppable()
+			},
+		},
+	})
+
+	action.RegisterExecutor("pipes", "pipes", executePipeAction)
+}
+
+func executePipeAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StartPipe":
+		return executeStartPipe(ctx, resource)
+	case "StopPipe":
+		return executeStopPipe(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeStartPipe(ctx context.Context, resource dao.Resource) action.ActionResult {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := resource.GetName()
+	output, err := pipes.NewFromConfig(cfg).StartPipe(ctx, &pipes.StartPipeInput{Name: &name})
+	if err != nil {
+		return action.FailResult(fmt.Errorf("start pipe: %w", err))
+	}
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Starting pipe %s (%s)", name, output.CurrentState),
+	}
+}
+
+func executeStopPipe(ctx context.Context, resource dao.Resource) action.ActionResult {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := resource.GetName()
+	output, err := pipes.NewFromConfig(cfg).StopPipe(ctx, &pipes.StopPipeInput{Name: &name})
+	if err != nil {
+		return action.FailResult(fmt.Errorf("stop pipe: %w", err))
+	}
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Stopping pipe %s (%s)", name, output.CurrentState),
+	}
+}
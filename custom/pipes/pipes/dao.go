@@ -0,0 +1,174 @@
+package pipes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// PipeDAO provides data access for EventBridge Pipes
+type PipeDAO struct {
+	dao.BaseDAO
+	client *pipes.Client
+}
+
+// NewPipeDAO creates a new PipeDAO
+func NewPipeDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PipeDAO{
+		BaseDAO: dao.NewBaseDAO("pipes", "pipes"),
+		client:  pipes.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns all pipes. Log configuration is only loaded by Get.
+func (d *PipeDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var resources []dao.Resource
+	paginator := pipes.NewListPipesPaginator(d.client, &pipes.ListPipesInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list pipes")
+		}
+		for _, pipe := range output.Pipes {
+			resources = append(resources, NewPipeResource(pipe, nil))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get returns a pipe with its parameters and log configuration
+func (d *PipeDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.DescribePipe(ctx, &pipes.DescribePipeInput{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe pipe %s", name)
+	}
+
+	pipe := types.Pipe{
+		Arn:              output.Arn,
+		Name:             output.Name,
+		CurrentState:     output.CurrentState,
+		DesiredState:     output.DesiredState,
+		StateReason:      output.StateReason,
+		Source:           output.Source,
+		Enrichment:       output.Enrichment,
+		Target:           output.Target,
+		CreationTime:     output.CreationTime,
+		LastModifiedTime: output.LastModifiedTime,
+	}
+	return NewPipeResource(pipe, output), nil
+}
+
+func (d *PipeDAO) Delete(ctx context.Context, name string) error {
+	return fmt.Errorf("delete not supported for pipes")
+}
+
+// Supports returns supported operations
+func (d *PipeDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// PipeResource wraps an EventBridge pipe
+type PipeResource struct {
+	dao.BaseResource
+	Item   types.Pipe
+	Detail *pipes.DescribePipeOutput
+}
+
+// NewPipeResource creates a new PipeResource. detail is nil for list items.
+func NewPipeResource(pipe types.Pipe, detail *pipes.DescribePipeOutput) *PipeResource {
+	name := appaws.Str(pipe.Name)
+	var tags map[string]string
+	if detail != nil {
+		tags = detail.Tags
+	}
+	return &PipeResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(pipe.Arn),
+			Tags: tags,
+			Data: pipe,
+		},
+		Item:   pipe,
+		Detail: detail,
+	}
+}
+
+// State returns the current state of the pipe
+func (r *PipeResource) State() string {
+	return string(r.Item.CurrentState)
+}
+
+// DesiredState returns the state the pipe is moving to
+func (r *PipeResource) DesiredState() string {
+	return string(r.Item.DesiredState)
+}
+
+// StateReason returns why the pipe is in its current state
+func (r *PipeResource) StateReason() string {
+	return appaws.Str(r.Item.StateReason)
+}
+
+// Source returns the source ARN
+func (r *PipeResource) Source() string {
+	return appaws.Str(r.Item.Source)
+}
+
+// Enrichment returns the enrichment ARN, or "" if none
+func (r *PipeResource) Enrichment() string {
+	return appaws.Str(r.Item.Enrichment)
+}
+
+// Target returns the target ARN
+func (r *PipeResource) Target() string {
+	return appaws.Str(r.Item.Target)
+}
+
+// Startable reports whether the pipe can be started
+func (r *PipeResource) Startable() bool {
+	switch r.Item.CurrentState {
+	case types.PipeStateStopped, types.PipeStateStartFailed:
+		return true
+	}
+	return false
+}
+
+// Stoppable reports whether the pipe can be stopped
+func (r *PipeResource) Stoppable() bool {
+	switch r.Item.CurrentState {
+	case types.PipeStateRunning, types.PipeStateStopFailed:
+		return true
+	}
+	return false
+}
+
+// LogGroup returns the CloudWatch log group the pipe writes to, or "" if it
+// does not log to CloudWatch Logs or the detail is not loaded
+func (r *PipeResource) LogGroup() string {
+	if r.Detail == nil || r.Detail.LogConfiguration == nil || r.Detail.LogConfiguration.CloudwatchLogsLogDestination == nil {
+		return ""
+	}
+	return appaws.LogGroupNameFromARN(appaws.Str(r.Detail.LogConfiguration.CloudwatchLogsLogDestination.LogGroupArn))
+}
+
+// LogLevel returns the configured log level, or "" if logging is off
+func (r *PipeResource) LogLevel() string {
+	if r.Detail == nil || r.Detail.LogConfiguration == nil {
+		return ""
+	}
+	return string(r.Detail.LogConfiguration.Level)
+}
@@ -0,0 +1,20 @@
+package pipes
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("pipes", "pipes", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPipeDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPipeRenderer()
+		},
+	})
+}
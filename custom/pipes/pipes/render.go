@@ -0,0 +1,157 @@
+package pipes
+
+import (
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure PipeRenderer implements render.LogSource
+var _ render.LogSource = (*PipeRenderer)(nil)
+
+// PipeRenderer renders EventBridge Pipes
+type PipeRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPipeRenderer creates a new PipeRenderer
+func NewPipeRenderer() render.Renderer {
+	return &PipeRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "pipes",
+			Resource: "pipes",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "STATE", Width: 14, Getter: getState, Priority: 1},
+				{Name: "SOURCE", Width: 30, Getter: getSource, Priority: 2},
+				{Name: "ENRICHMENT", Width: 24, Getter: getEnrichment, Priority: 4},
+				{Name: "TARGET", Width: 30, Getter: getTarget, Priority: 3},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	if pr, ok := r.(*PipeResource); ok {
+		return pr.State()
+	}
+	return ""
+}
+
+func getSource(r dao.Resource) string {
+	if pr, ok := r.(*PipeResource); ok {
+		return shortARN(pr.Source())
+	}
+	return ""
+}
+
+func getEnrichment(r dao.Resource) string {
+	if pr, ok := r.(*PipeResource); ok {
+		return shortARN(pr.Enrichment())
+	}
+	return ""
+}
+
+func getTarget(r dao.Resource) string {
+	if pr, ok := r.(*PipeResource); ok {
+		return shortARN(pr.Target())
+	}
+	return ""
+}
+
+// shortARN returns "service:name" for an ARN, or s unchanged if it is not one
+func shortARN(s string) string {
+	if a := appaws.ParseARN(s); a != nil {
+		return a.Service + ":" + a.ShortID()
+	}
+	return s
+}
+
+// RenderDetail renders detailed pipe information
+func (r *PipeRenderer) RenderDetail(resource dao.Resource) string {
+	pr, ok := resource.(*PipeResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("EventBridge Pipe", pr.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", pr.GetName())
+	d.Field("ARN", pr.GetARN())
+	d.FieldStyled("State", pr.State(), render.StateColorer()(strings.ToLower(pr.State())))
+	if pr.DesiredState() != "" && !strings.EqualFold(pr.DesiredState(), pr.State()) {
+		d.Field("Desired State", pr.DesiredState())
+	}
+	if reason := pr.StateReason(); reason != "" {
+		d.Field("State Reason", reason)
+	}
+	if pr.Detail != nil {
+		d.FieldIf("Description", pr.Detail.Description)
+	}
+
+	d.Section("Flow")
+	d.Field("Source", pr.Source())
+	if pr.Enrichment() != "" {
+		d.Field("Enrichment", pr.Enrichment())
+	}
+	d.Field("Target", pr.Target())
+	if pr.Detail != nil {
+		d.FieldIf("Role ARN", pr.Detail.RoleArn)
+	}
+
+	if level := pr.LogLevel(); level != "" {
+		d.Section("Logging")
+		d.Field("Level", level)
+		if group := pr.LogGroup(); group != "" {
+			d.Field("CloudWatch Log Group", group)
+		}
+	}
+
+	if t := pr.Item.CreationTime; t != nil {
+		d.Section("Timestamps")
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+		if m := pr.Item.LastModifiedTime; m != nil {
+			d.Field("Last Modified", m.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	d.Tags(pr.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PipeRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	pr, ok := resource.(*PipeResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: pr.GetName()},
+		{Label: "State", Value: pr.State(), Style: render.StateColorer()(strings.ToLower(pr.State()))},
+		{Label: "Source", Value: shortARN(pr.Source())},
+		{Label: "Target", Value: shortARN(pr.Target())},
+	}
+	if pr.Enrichment() != "" {
+		fields = append(fields, render.SummaryField{Label: "Enrichment", Value: shortARN(pr.Enrichment())})
+	}
+	return fields
+}
+
+// LogTargets returns the pipe's CloudWatch log group, once the detail is loaded
+func (r *PipeRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	pr, ok := resource.(*PipeResource)
+	if !ok {
+		return nil
+	}
+	if group := pr.LogGroup(); group != "" {
+		return []render.LogTarget{{Group: group}}
+	}
+	return nil
+}
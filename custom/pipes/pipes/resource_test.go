@@ -0,0 +1,76 @@
+package pipes
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+func TestPipeResourceStates(t *testing.T) {
+	tests := []struct {
+		state         types.PipeState
+		wantStartable bool
+		wantStoppable bool
+	}{
+		{types.PipeStateRunning, false, true},
+		{types.PipeStateStopped, true, false},
+		{types.PipeStateStartFailed, true, false},
+		{types.PipeStateStopFailed, false, true},
+		{types.PipeStateCreating, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			r := NewPipeResource(types.Pipe{Name: aws.String("orders"), CurrentState: tt.state}, nil)
+			if got := r.Startable(); got != tt.wantStartable {
+				t.Errorf("Startable() = %v, want %v", got, tt.wantStartable)
+			}
+			if got := r.Stoppable(); got != tt.wantStoppable {
+				t.Errorf("Stoppable() = %v, want %v", got, tt.wantStoppable)
+			}
+		})
+	}
+}
+
+func TestPipeLogTargets(t *testing.T) {
+	renderer := NewPipeRenderer().(*PipeRenderer)
+	pipe := types.Pipe{Name: aws.String("orders")}
+
+	if targets := renderer.LogTargets(NewPipeResource(pipe, nil)); targets != nil {
+		t.Errorf("LogTargets() without detail = %v, want nil", targets)
+	}
+
+	detail := &pipes.DescribePipeOutput{
+		Name: aws.String("orders"),
+		LogConfiguration: &types.PipeLogConfiguration{
+			Level: types.LogLevelError,
+			CloudwatchLogsLogDestination: &types.CloudwatchLogsLogDestination{
+				LogGroupArn: aws.String("arn:aws:logs:us-east-1:123456789012:log-group:/aws/vendedlogs/pipes/orders:*"),
+			},
+		},
+	}
+	want := []render.LogTarget{{Group: "/aws/vendedlogs/pipes/orders"}}
+	got := renderer.LogTargets(NewPipeResource(pipe, detail))
+	if len(got) != 1 || got[0] != want[0] {
+		t.Errorf("LogTargets() = %v, want %v", got, want)
+	}
+}
+
+func TestShortARN(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"arn:aws:sqs:us-east-1:123456789012:orders", "sqs:orders"},
+		{"arn:aws:lambda:us-east-1:123456789012:function:enrich", "lambda:enrich"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := shortARN(tt.in); got != tt.want {
+			t.Errorf("shortARN(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	rdsClient "github.com/clawscli/claws/custom/rds"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("rds", "clusters", []action.Action{
+		{
+			Name:      "Failover",
+			Shortcut:  "F",
+			Type:      action.ActionTypeAPI,
+			Operation: "FailoverDBCluster",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				cr, ok := r.(*ClusterResource)
+				return ok && len(cr.Readers()) > 0
+			},
+			Prompts: []action.Prompt{
+				{Label: "Target reader", Options: listFailoverTargets},
+			},
+		},
+	})
+
+	action.RegisterExecutor("rds", "clusters", executeClusterAction)
+}
+
+func executeClusterAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "FailoverDBCluster":
+		return executeFailover(ctx, resource, act.Input(0))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// listFailoverTargets lists readers in promotion tier order
+func listFailoverTargets(_ context.Context, resource dao.Resource) ([]string, error) {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+	return cr.Readers(), nil
+}
+
+func executeFailover(ctx context.Context, resource dao.Resource, target string) action.ActionResult {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if target == "" {
+		return action.FailResult(action.ErrMissingInput)
+	}
+
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	identifier := cr.GetID()
+	_, err = client.FailoverDBCluster(ctx, &rds.FailoverDBClusterInput{
+		DBClusterIdentifier:        &identifier,
+		TargetDBInstanceIdentifier: &target,
+	})
+	if err != nil {
+		return action.FailResultf(err, "failover db cluster %s", identifier)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Failing over %s from %s to %s", identifier, cr.Writer(), target))
+}
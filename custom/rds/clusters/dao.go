@@ -0,0 +1,215 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ClusterDAO provides data access for RDS DB clusters (Aurora and Multi-AZ)
+type ClusterDAO struct {
+	dao.BaseDAO
+	client *rds.Client
+}
+
+// NewClusterDAO creates a new ClusterDAO
+func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ClusterDAO{
+		BaseDAO: dao.NewBaseDAO("rds", "clusters"),
+		client:  rds.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns DB clusters. Member instance placement is loaded by Get.
+func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &rds.DescribeDBClustersInput{}
+	if id := dao.GetFilterFromContext(ctx, "DBClusterIdentifier"); id != "" {
+		input.DBClusterIdentifier = &id
+	}
+
+	var resources []dao.Resource
+	paginator := rds.NewDescribeDBClustersPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe db clusters")
+		}
+		for _, cluster := range output.DBClusters {
+			resources = append(resources, NewClusterResource(cluster))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get returns a cluster with AZ, class and status of each member instance
+func (d *ClusterDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe db cluster %s", id)
+	}
+	if len(output.DBClusters) == 0 {
+		return nil, fmt.Errorf("db cluster not found: %s", id)
+	}
+
+	r := NewClusterResource(output.DBClusters[0])
+
+	r.Instances = map[string]types.DBInstance{}
+	paginator := rds.NewDescribeDBInstancesPaginator(d.client, &rds.DescribeDBInstancesInput{
+		Filters: []types.Filter{{Name: aws.String("db-cluster-id"), Values: []string{id}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe db instances for cluster %s", id)
+		}
+		for _, inst := range page.DBInstances {
+			r.Instances[appaws.Str(inst.DBInstanceIdentifier)] = inst
+		}
+	}
+
+	return r, nil
+}
+
+func (d *ClusterDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for db clusters")
+}
+
+// Supports returns supported operations
+func (d *ClusterDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClusterResource wraps an RDS DB cluster
+type ClusterResource struct {
+	dao.BaseResource
+	Item types.DBCluster
+
+	// Instances holds member instance details keyed by identifier (set by Get)
+	Instances map[string]types.DBInstance
+}
+
+// NewClusterResource creates a new ClusterResource
+func NewClusterResource(cluster types.DBCluster) *ClusterResource {
+	return &ClusterResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(cluster.DBClusterIdentifier),
+			Name: appaws.Str(cluster.DBClusterIdentifier),
+			ARN:  appaws.Str(cluster.DBClusterArn),
+			Tags: appaws.TagsToMap(cluster.TagList),
+			Data: cluster,
+		},
+		Item: cluster,
+	}
+}
+
+// LogGroups returns the CloudWatch log groups of the exported database logs
+func (r *ClusterResource) LogGroups() []string {
+	groups := make([]string, 0, len(r.Item.EnabledCloudwatchLogsExports))
+	for _, logType := range r.Item.EnabledCloudwatchLogsExports {
+		groups = append(groups, "/aws/rds/cluster/"+r.GetID()+"/"+logType)
+	}
+	return groups
+}
+
+// Status returns the cluster status
+func (r *ClusterResource) Status() string {
+	if r.Item.Status != nil {
+		return *r.Item.Status
+	}
+	return "unknown"
+}
+
+// Engine returns the database engine
+func (r *ClusterResource) Engine() string {
+	return appaws.Str(r.Item.Engine)
+}
+
+// EngineVersion returns the engine version
+func (r *ClusterResource) EngineVersion() string {
+	return appaws.Str(r.Item.EngineVersion)
+}
+
+// Member is a cluster member with its role and placement
+type Member struct {
+	Identifier    string
+	Writer        bool
+	PromotionTier int32
+	AZ            string
+	Class         string
+	Status        string
+}
+
+// Members returns the writer first, then readers ordered by promotion tier and identifier.
+// AZ, class and status are filled in only after Get.
+func (r *ClusterResource) Members() []Member {
+	members := make([]Member, 0, len(r.Item.DBClusterMembers))
+	for _, m := range r.Item.DBClusterMembers {
+		member := Member{
+			Identifier:    appaws.Str(m.DBInstanceIdentifier),
+			Writer:        appaws.Bool(m.IsClusterWriter),
+			PromotionTier: appaws.Int32(m.PromotionTier),
+		}
+		if inst, ok := r.Instances[member.Identifier]; ok {
+			member.AZ = appaws.Str(inst.AvailabilityZone)
+			member.Class = appaws.Str(inst.DBInstanceClass)
+			member.Status = appaws.Str(inst.DBInstanceStatus)
+		}
+		members = append(members, member)
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		if a.Writer != b.Writer {
+			if a.Writer {
+				return -1
+			}
+			return 1
+		}
+		if a.PromotionTier != b.PromotionTier {
+			return int(a.PromotionTier - b.PromotionTier)
+		}
+		return strings.Compare(a.Identifier, b.Identifier)
+	})
+	return members
+}
+
+// Writer returns the identifier of the writer instance, or "" if none
+func (r *ClusterResource) Writer() string {
+	for _, m := range r.Item.DBClusterMembers {
+		if appaws.Bool(m.IsClusterWriter) {
+			return appaws.Str(m.DBInstanceIdentifier)
+		}
+	}
+	return ""
+}
+
+// Readers returns reader identifiers in failover priority order
+func (r *ClusterResource) Readers() []string {
+	var readers []string
+	for _, m := range r.Members() {
+		if !m.Writer {
+			readers = append(readers, m.Identifier)
+		}
+	}
+	return readers
+}
@@ -0,0 +1,244 @@
+package clusters
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var (
+	_ render.Navigator = (*ClusterRenderer)(nil)
+	_ render.LogSource = (*ClusterRenderer)(nil)
+)
+
+// ClusterRenderer renders RDS DB clusters
+type ClusterRenderer struct {
+	render.BaseRenderer
+}
+
+// NewClusterRenderer creates a new ClusterRenderer
+func NewClusterRenderer() render.Renderer {
+	return &ClusterRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rds",
+			Resource: "clusters",
+			Cols: []render.Column{
+				{
+					Name:     "IDENTIFIER",
+					Width:    32,
+					Getter:   func(r dao.Resource) string { return r.GetID() },
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if cr, ok := r.(*ClusterResource); ok {
+							return cr.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "ENGINE",
+					Width: 18,
+					Getter: func(r dao.Resource) string {
+						if cr, ok := r.(*ClusterResource); ok {
+							return cr.Engine()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "WRITER",
+					Width: 28,
+					Getter: func(r dao.Resource) string {
+						if cr, ok := r.(*ClusterResource); ok {
+							return cr.Writer()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "READERS",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if cr, ok := r.(*ClusterResource); ok {
+							return fmt.Sprintf("%d", len(cr.Readers()))
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "MULTI-AZ",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if cr, ok := r.(*ClusterResource); ok {
+							if appaws.Bool(cr.Item.MultiAZ) {
+								return "Yes"
+							}
+							return "No"
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "AGE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if cr, ok := r.(*ClusterResource); ok && cr.Item.ClusterCreateTime != nil {
+							return render.FormatAge(*cr.Item.ClusterCreateTime)
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders cluster details with writer/reader topology
+func (r *ClusterRenderer) RenderDetail(resource dao.Resource) string {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	styles := d.Styles()
+
+	d.Title("RDS Cluster", cr.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Identifier", cr.GetID())
+	d.FieldStyled("Status", cr.Status(), render.StateColorer()(cr.Status()))
+	d.Field("Engine", cr.Engine())
+	d.Field("Engine Version", cr.EngineVersion())
+	d.FieldIf("Engine Mode", cr.Item.EngineMode)
+	if cr.Item.ClusterCreateTime != nil {
+		d.Field("Created", cr.Item.ClusterCreateTime.Format(time.RFC3339))
+		d.Field("Age", render.FormatAge(*cr.Item.ClusterCreateTime))
+	}
+
+	d.Section("Endpoints")
+	d.FieldIf("Writer Endpoint", cr.Item.Endpoint)
+	d.FieldIf("Reader Endpoint", cr.Item.ReaderEndpoint)
+	if cr.Item.Port != nil {
+		d.Field("Port", fmt.Sprintf("%d", *cr.Item.Port))
+	}
+
+	d.Section("Topology")
+	members := cr.Members()
+	if len(members) == 0 {
+		d.DimIndent("No member instances")
+	}
+	for _, m := range members {
+		role := styles.Dim.Render(fmt.Sprintf("reader (tier %d)", m.PromotionTier))
+		if m.Writer {
+			role = ui.SuccessStyle().Render("writer")
+		}
+		line := "  " + styles.Value.Render(m.Identifier) + "  " + role
+		if m.AZ != "" {
+			line += styles.Dim.Render("  " + m.AZ + "  " + m.Class + "  " + m.Status)
+		}
+		d.Line(line)
+	}
+
+	if azs := AZPlacement(members); len(azs) > 0 {
+		d.Section("AZ Placement")
+		for _, az := range slices.Sorted(maps.Keys(azs)) {
+			d.Field(az, strings.Join(azs[az], ", "))
+		}
+		if len(azs) == 1 && len(members) > 1 {
+			d.FieldStyled("Warning", "All instances are in a single AZ", ui.WarningStyle())
+		}
+	} else if len(members) > 0 && cr.Instances == nil {
+		d.DimIndent("Loading instance placement...")
+	}
+
+	d.Section("Storage & Backup")
+	d.Field("Storage Encrypted", fmt.Sprintf("%v", appaws.Bool(cr.Item.StorageEncrypted)))
+	d.FieldIf("KMS Key ID", cr.Item.KmsKeyId)
+	d.Field("Backup Retention Period", fmt.Sprintf("%d days", appaws.Int32(cr.Item.BackupRetentionPeriod)))
+	d.FieldIf("Preferred Backup Window", cr.Item.PreferredBackupWindow)
+	d.FieldIf("Preferred Maintenance Window", cr.Item.PreferredMaintenanceWindow)
+	d.FieldIf("Parameter Group", cr.Item.DBClusterParameterGroup)
+	d.Field("Deletion Protection", fmt.Sprintf("%v", appaws.Bool(cr.Item.DeletionProtection)))
+
+	d.Tags(appaws.TagsToMap(cr.Item.TagList))
+
+	return d.String()
+}
+
+// AZPlacement groups member identifiers by availability zone.
+// Members without a known AZ (before Get) are omitted.
+func AZPlacement(members []Member) map[string][]string {
+	azs := map[string][]string{}
+	for _, m := range members {
+		if m.AZ != "" {
+			azs[m.AZ] = append(azs[m.AZ], m.Identifier)
+		}
+	}
+	return azs
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ClusterRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Identifier", Value: cr.GetID()},
+		{Label: "Status", Value: cr.Status(), Style: render.StateColorer()(cr.Status())},
+		{Label: "Engine", Value: fmt.Sprintf("%s %s", cr.Engine(), cr.EngineVersion())},
+		{Label: "Writer", Value: cr.Writer()},
+		{Label: "Readers", Value: fmt.Sprintf("%d", len(cr.Readers()))},
+	}
+	if ep := appaws.Str(cr.Item.Endpoint); ep != "" {
+		fields = append(fields, render.SummaryField{Label: "Endpoint", Value: ep})
+	}
+	return fields
+}
+
+// LogTargets returns the log groups of the exported database logs
+func (r *ClusterRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil
+	}
+	var targets []render.LogTarget
+	for _, group := range cr.LogGroups() {
+		targets = append(targets, render.LogTarget{Group: group})
+	}
+	return targets
+}
+
+// Navigations returns navigation shortcuts for RDS clusters
+func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	cr, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "i", Label: "Instances", Service: "rds", Resource: "instances",
+			FilterField: "DBClusterIdentifier", FilterValue: cr.GetID(),
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package clusters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+func newTestCluster() *ClusterResource {
+	return NewClusterResource(types.DBCluster{
+		DBClusterIdentifier: aws.String("orders"),
+		Engine:              aws.String("aurora-postgresql"),
+		DBClusterMembers: []types.DBClusterMember{
+			{DBInstanceIdentifier: aws.String("orders-3"), PromotionTier: aws.Int32(1)},
+			{DBInstanceIdentifier: aws.String("orders-2"), PromotionTier: aws.Int32(0)},
+			{DBInstanceIdentifier: aws.String("orders-1"), IsClusterWriter: aws.Bool(true), PromotionTier: aws.Int32(1)},
+			{DBInstanceIdentifier: aws.String("orders-4"), PromotionTier: aws.Int32(0)},
+		},
+	})
+}
+
+func TestClusterResource_Topology(t *testing.T) {
+	cr := newTestCluster()
+
+	if got := cr.Writer(); got != "orders-1" {
+		t.Errorf("Writer() = %q, want orders-1", got)
+	}
+	want := []string{"orders-2", "orders-4", "orders-3"}
+	if got := cr.Readers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Readers() = %v, want %v", got, want)
+	}
+	if got := cr.Members()[0].Identifier; got != "orders-1" {
+		t.Errorf("Members()[0] = %q, want writer first", got)
+	}
+}
+
+func TestAZPlacement(t *testing.T) {
+	cr := newTestCluster()
+	if got := AZPlacement(cr.Members()); len(got) != 0 {
+		t.Errorf("AZPlacement() before Get = %v, want empty", got)
+	}
+
+	cr.Instances = map[string]types.DBInstance{
+		"orders-1": {AvailabilityZone: aws.String("us-east-1a")},
+		"orders-2": {AvailabilityZone: aws.String("us-east-1b")},
+		"orders-3": {AvailabilityZone: aws.String("us-east-1a")},
+	}
+	want := map[string][]string{
+		"us-east-1a": {"orders-1", "orders-3"},
+		"us-east-1b": {"orders-2"},
+	}
+	if got := AZPlacement(cr.Members()); !reflect.DeepEqual(got, want) {
+		t.Errorf("AZPlacement() = %v, want %v", got, want)
+	}
+}
@@ -32,6 +32,9 @@ func NewInstanceDAO(ctx context.Context) (dao.DAO, error) {
 
 func (d *InstanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	input := &rds.DescribeDBInstancesInput{}
+	if clusterID := dao.GetFilterFromContext(ctx, "DBClusterIdentifier"); clusterID != "" {
+		input.Filters = []types.Filter{{Name: appaws.StringPtr("db-cluster-id"), Values: []string{clusterID}}}
+	}
 	paginator := rds.NewDescribeDBInstancesPaginator(d.client, input)
 
 	var resources []dao.Resource
@@ -108,6 +111,15 @@ func NewInstanceResource(instance types.DBInstance) *InstanceResource {
 	}
 }
 
+// LogGroups returns the CloudWatch log groups of the exported database logs
+func (r *InstanceResource) LogGroups() []string {
+	groups := make([]string, 0, len(r.Item.EnabledCloudwatchLogsExports))
+	for _, logType := range r.Item.EnabledCloudwatchLogsExports {
+		groups = append(groups, "/aws/rds/instance/"+r.GetID()+"/"+logType)
+	}
+	return groups
+}
+
 // State returns the instance status
 func (r *InstanceResource) State() string {
 	if r.Item.DBInstanceStatus != nil {
@@ -11,6 +11,7 @@ import (
 
 var (
 	_ render.Navigator          = (*InstanceRenderer)(nil)
+	_ render.LogSource          = (*InstanceRenderer)(nil)
 	_ render.MetricSpecProvider = (*InstanceRenderer)(nil)
 )
 
@@ -264,6 +265,19 @@ func (r *InstanceRenderer) RenderSummary(resource dao.Resource) []render.Summary
 	return fields
 }
 
+// LogTargets returns the log groups of the exported database logs
+func (r *InstanceRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	ir, ok := resource.(*InstanceResource)
+	if !ok {
+		return nil
+	}
+	var targets []render.LogTarget
+	for _, group := range ir.LogGroups() {
+		targets = append(targets, render.LogTarget{Group: group})
+	}
+	return targets
+}
+
 // Navigations returns navigation shortcuts for RDS instances
 func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	ir, ok := resource.(*InstanceResource)
@@ -295,6 +309,14 @@ func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 		FilterField: "DBInstanceIdentifier", FilterValue: ir.GetID(),
 	})
 
+	// Parameter group navigation
+	if len(ir.Item.DBParameterGroups) > 0 && ir.Item.DBParameterGroups[0].DBParameterGroupName != nil {
+		navs = append(navs, render.Navigation{
+			Key: "e", Label: "Parameter Group", Service: "rds", Resource: "parameter-groups",
+			FilterField: "DBParameterGroupName", FilterValue: *ir.Item.DBParameterGroups[0].DBParameterGroupName,
+		})
+	}
+
 	// Cluster navigation (for Aurora instances)
 	if ir.Item.DBClusterIdentifier != nil {
 		navs = append(navs, render.Navigation{
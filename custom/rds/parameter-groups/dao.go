@@ -0,0 +1,229 @@
+package parametergroups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ParameterGroupDAO provides data access for RDS DB parameter groups
+type ParameterGroupDAO struct {
+	dao.BaseDAO
+	client *rds.Client
+}
+
+// NewParameterGroupDAO creates a new ParameterGroupDAO
+func NewParameterGroupDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ParameterGroupDAO{
+		BaseDAO: dao.NewBaseDAO("rds", "parameter-groups"),
+		client:  rds.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns DB parameter groups. Parameters are loaded by Get.
+func (d *ParameterGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &rds.DescribeDBParameterGroupsInput{}
+	if name := dao.GetFilterFromContext(ctx, "DBParameterGroupName"); name != "" {
+		input.DBParameterGroupName = &name
+	}
+
+	var resources []dao.Resource
+	paginator := rds.NewDescribeDBParameterGroupsPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe db parameter groups")
+		}
+		for _, pg := range output.DBParameterGroups {
+			resources = append(resources, NewParameterGroupResource(pg))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get returns a parameter group with its modified parameters and family defaults
+func (d *ParameterGroupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeDBParameterGroups(ctx, &rds.DescribeDBParameterGroupsInput{
+		DBParameterGroupName: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe db parameter group %s", id)
+	}
+	if len(output.DBParameterGroups) == 0 {
+		return nil, fmt.Errorf("db parameter group %s not found", id)
+	}
+
+	r := NewParameterGroupResource(output.DBParameterGroups[0])
+
+	// Source "user" returns only parameters changed from the family default
+	source := "user"
+	paginator := rds.NewDescribeDBParametersPaginator(d.client, &rds.DescribeDBParametersInput{
+		DBParameterGroupName: &id,
+		Source:               &source,
+	})
+	r.Modified = map[string]string{}
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe db parameters %s", id)
+		}
+		for _, p := range page.Parameters {
+			r.Modified[appaws.Str(p.ParameterName)] = appaws.Str(p.ParameterValue)
+		}
+	}
+
+	defaults, err := d.engineDefaults(ctx, r.Family())
+	if err != nil {
+		return nil, err
+	}
+	r.Defaults = defaults
+
+	return r, nil
+}
+
+// engineDefaults returns the default parameter values for a parameter group family
+func (d *ParameterGroupDAO) engineDefaults(ctx context.Context, family string) (map[string]string, error) {
+	params, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.Parameter, *string, error) {
+		output, err := d.client.DescribeEngineDefaultParameters(ctx, &rds.DescribeEngineDefaultParametersInput{
+			DBParameterGroupFamily: &family,
+			Marker:                 marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe engine default parameters %s", family)
+		}
+		if output.EngineDefaults == nil {
+			return nil, nil, nil
+		}
+		return output.EngineDefaults.Parameters, output.EngineDefaults.Marker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := make(map[string]string, len(params))
+	for _, p := range params {
+		defaults[appaws.Str(p.ParameterName)] = appaws.Str(p.ParameterValue)
+	}
+	return defaults, nil
+}
+
+func (d *ParameterGroupDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteDBParameterGroup(ctx, &rds.DeleteDBParameterGroupInput{
+		DBParameterGroupName: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete db parameter group %s", id)
+	}
+	return nil
+}
+
+// ParameterGroupResource wraps an RDS DB parameter group
+type ParameterGroupResource struct {
+	dao.BaseResource
+	Item types.DBParameterGroup
+
+	// Modified holds parameters changed from the family default (set by Get)
+	Modified map[string]string
+	// Defaults holds the family default values (set by Get)
+	Defaults map[string]string
+}
+
+// NewParameterGroupResource creates a new ParameterGroupResource
+func NewParameterGroupResource(pg types.DBParameterGroup) *ParameterGroupResource {
+	name := appaws.Str(pg.DBParameterGroupName)
+	return &ParameterGroupResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(pg.DBParameterGroupArn),
+			Data: pg,
+		},
+		Item: pg,
+	}
+}
+
+// Family returns the parameter group family (e.g., postgres16)
+func (r *ParameterGroupResource) Family() string {
+	return appaws.Str(r.Item.DBParameterGroupFamily)
+}
+
+// Description returns the parameter group description
+func (r *ParameterGroupResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// IsDefault returns whether this is an AWS-managed default group
+func (r *ParameterGroupResource) IsDefault() bool {
+	return strings.HasPrefix(r.GetName(), "default.")
+}
+
+// Loaded returns whether parameters were fetched via Get
+func (r *ParameterGroupResource) Loaded() bool {
+	return r.Modified != nil
+}
+
+// Value returns the effective value of a parameter: the modified value, else the family default
+func (r *ParameterGroupResource) Value(name string) string {
+	if v, ok := r.Modified[name]; ok {
+		return v
+	}
+	return r.Defaults[name]
+}
+
+// ParameterChange is a parameter whose value differs between two sides
+type ParameterChange struct {
+	Name  string
+	Left  string
+	Right string
+}
+
+// ModifiedParameters returns parameters that differ from the family default, sorted by name
+func (r *ParameterGroupResource) ModifiedParameters() []ParameterChange {
+	var changes []ParameterChange
+	for name, value := range r.Modified {
+		if def := r.Defaults[name]; def != value {
+			changes = append(changes, ParameterChange{Name: name, Left: def, Right: value})
+		}
+	}
+	sortChanges(changes)
+	return changes
+}
+
+// DiffParameters compares effective parameter values of two groups.
+// Only parameters modified in either group can differ, so only those are checked.
+func DiffParameters(left, right *ParameterGroupResource) []ParameterChange {
+	seen := map[string]bool{}
+	var changes []ParameterChange
+	for _, m := range []map[string]string{left.Modified, right.Modified} {
+		for name := range m {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if l, r := left.Value(name), right.Value(name); l != r {
+				changes = append(changes, ParameterChange{Name: name, Left: l, Right: r})
+			}
+		}
+	}
+	sortChanges(changes)
+	return changes
+}
+
+func sortChanges(changes []ParameterChange) {
+	slices.SortFunc(changes, func(a, b ParameterChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,164 @@
+package parametergroups
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure ParameterGroupRenderer implements render.DiffRenderer
+var _ render.DiffRenderer = (*ParameterGroupRenderer)(nil)
+
+// ParameterGroupRenderer renders RDS DB parameter groups
+type ParameterGroupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewParameterGroupRenderer creates a new ParameterGroupRenderer
+func NewParameterGroupRenderer() render.Renderer {
+	return &ParameterGroupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rds",
+			Resource: "parameter-groups",
+			Cols: []render.Column{
+				{
+					Name:     "NAME",
+					Width:    40,
+					Getter:   func(r dao.Resource) string { return r.GetName() },
+					Priority: 0,
+				},
+				{
+					Name:  "FAMILY",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if pg, ok := r.(*ParameterGroupResource); ok {
+							return pg.Family()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "TYPE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if pg, ok := r.(*ParameterGroupResource); ok {
+							if pg.IsDefault() {
+								return "default"
+							}
+							return "custom"
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "DESCRIPTION",
+					Width: 40,
+					Getter: func(r dao.Resource) string {
+						if pg, ok := r.(*ParameterGroupResource); ok {
+							return pg.Description()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders the parameter group with parameters modified from the family default
+func (r *ParameterGroupRenderer) RenderDetail(resource dao.Resource) string {
+	pg, ok := resource.(*ParameterGroupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DB Parameter Group", pg.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", pg.GetName())
+	d.Field("Family", pg.Family())
+	d.Field("Description", pg.Description())
+	d.Field("ARN", pg.GetARN())
+
+	d.Section(fmt.Sprintf("Modified Parameters (vs %s default)", pg.Family()))
+	if !pg.Loaded() {
+		d.DimIndent("Loading parameters...")
+		return d.String()
+	}
+	changes := pg.ModifiedParameters()
+	if len(changes) == 0 {
+		d.DimIndent("No parameters modified from the family default")
+	}
+	for _, c := range changes {
+		d.FieldStyled(c.Name, formatChange(c), ui.WarningStyle())
+	}
+	d.Line("")
+	d.DimIndent("Mark another group with m, then press d to compare parameters")
+
+	return d.String()
+}
+
+// RenderDiff renders only the parameters whose effective values differ between two groups
+func (r *ParameterGroupRenderer) RenderDiff(left, right dao.Resource) string {
+	lpg, lok := left.(*ParameterGroupResource)
+	rpg, rok := right.(*ParameterGroupResource)
+	if !lok || !rok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Section("Groups")
+	d.Field(lpg.GetName(), lpg.Family())
+	d.Field(rpg.GetName(), rpg.Family())
+	if lpg.Family() != rpg.Family() {
+		d.FieldStyled("Note", "Families differ; defaults are compared per family", ui.WarningStyle())
+	}
+
+	changes := DiffParameters(lpg, rpg)
+	d.Section(fmt.Sprintf("Differing Parameters (%d)", len(changes)))
+	if len(changes) == 0 {
+		d.DimIndent("Effective parameter values are identical")
+	}
+	for _, c := range changes {
+		d.FieldStyled(c.Name, formatChange(c), ui.WarningStyle())
+	}
+
+	return d.String()
+}
+
+// formatChange renders "left → right", showing unset values explicitly
+func formatChange(c ParameterChange) string {
+	return orUnset(c.Left) + " → " + orUnset(c.Right)
+}
+
+func orUnset(v string) string {
+	if v == "" {
+		return "(engine default)"
+	}
+	return v
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ParameterGroupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	pg, ok := resource.(*ParameterGroupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: pg.GetName()},
+		{Label: "Family", Value: pg.Family()},
+	}
+	if pg.Loaded() {
+		fields = append(fields, render.SummaryField{Label: "Modified", Value: fmt.Sprintf("%d", len(pg.ModifiedParameters()))})
+	}
+	return fields
+}
@@ -0,0 +1,56 @@
+package parametergroups
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+func newTestGroup(name string, modified, defaults map[string]string) *ParameterGroupResource {
+	r := NewParameterGroupResource(types.DBParameterGroup{
+		DBParameterGroupName:   aws.String(name),
+		DBParameterGroupFamily: aws.String("postgres16"),
+	})
+	r.Modified = modified
+	r.Defaults = defaults
+	return r
+}
+
+func TestParameterGroupResource_ModifiedParameters(t *testing.T) {
+	defaults := map[string]string{"work_mem": "4096", "log_min_duration_statement": "", "max_connections": ""}
+	pg := newTestGroup("app", map[string]string{
+		"work_mem":                   "65536",
+		"log_min_duration_statement": "500",
+		"max_connections":            "",
+	}, defaults)
+
+	got := pg.ModifiedParameters()
+	want := []ParameterChange{
+		{Name: "log_min_duration_statement", Left: "", Right: "500"},
+		{Name: "work_mem", Left: "4096", Right: "65536"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ModifiedParameters() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDiffParameters(t *testing.T) {
+	defaults := map[string]string{"work_mem": "4096", "shared_buffers": "128MB", "timezone": "UTC"}
+	left := newTestGroup("app", map[string]string{"work_mem": "65536", "timezone": "Asia/Tokyo"}, defaults)
+	right := newTestGroup("reporting", map[string]string{"work_mem": "65536", "shared_buffers": "1GB"}, defaults)
+
+	got := DiffParameters(left, right)
+	want := []ParameterChange{
+		{Name: "shared_buffers", Left: "128MB", Right: "1GB"},
+		{Name: "timezone", Left: "Asia/Tokyo", Right: "UTC"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffParameters() = %+v, want %+v", got, want)
+	}
+
+	if !newTestGroup("default.postgres16", nil, nil).IsDefault() || left.IsDefault() {
+		t.Error("IsDefault() should only match default.* groups")
+	}
+}
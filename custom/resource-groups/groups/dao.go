@@ -0,0 +1,205 @@
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// GroupDAO provides data access for resource groups
+type GroupDAO struct {
+	dao.BaseDAO
+	client *resourcegroups.Client
+}
+
+// NewGroupDAO creates a new GroupDAO
+func NewGroupDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &GroupDAO{
+		BaseDAO: dao.NewBaseDAO("resource-groups", "groups"),
+		client:  resourcegroups.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns all resource groups with their query definitions
+func (d *GroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	ids, err := appaws.Paginate(ctx, func(token *string) ([]types.GroupIdentifier, *string, error) {
+		output, err := d.client.ListGroups(ctx, &resourcegroups.ListGroupsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list resource groups")
+		}
+		return output.GroupIdentifiers, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(ids))
+	for _, id := range ids {
+		group := types.Group{
+			GroupArn:    id.GroupArn,
+			Name:        id.GroupName,
+			Description: id.Description,
+			DisplayName: id.DisplayName,
+			Owner:       id.Owner,
+			Criticality: id.Criticality,
+		}
+		resources = append(resources, NewGroupResource(group, d.query(ctx, appaws.Str(id.GroupName))))
+	}
+	return resources, nil
+}
+
+// Get returns a single resource group by name
+func (d *GroupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetGroup(ctx, &resourcegroups.GetGroupInput{
+		Group: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get resource group %s", id)
+	}
+	if output.Group == nil {
+		return nil, fmt.Errorf("resource group not found: %s", id)
+	}
+	return NewGroupResource(*output.Group, d.query(ctx, id)), nil
+}
+
+// query returns the group's resource query. Groups defined by a service
+// configuration instead of a query return nil.
+func (d *GroupDAO) query(ctx context.Context, name string) *types.ResourceQuery {
+	output, err := d.client.GetGroupQuery(ctx, &resourcegroups.GetGroupQueryInput{
+		Group: &name,
+	})
+	if err != nil || output.GroupQuery == nil {
+		return nil
+	}
+	return output.GroupQuery.ResourceQuery
+}
+
+func (d *GroupDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for resource groups")
+}
+
+// Supports returns supported operations
+func (d *GroupDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// GroupResource wraps a resource group and its query
+type GroupResource struct {
+	dao.BaseResource
+	Item  types.Group
+	Query *types.ResourceQuery
+}
+
+// NewGroupResource creates a new GroupResource
+func NewGroupResource(group types.Group, query *types.ResourceQuery) *GroupResource {
+	return &GroupResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(group.Name),
+			Name: appaws.Str(group.Name),
+			ARN:  appaws.Str(group.GroupArn),
+			Data: group,
+		},
+		Item:  group,
+		Query: query,
+	}
+}
+
+// QueryType returns TAG_FILTERS_1_0, CLOUDFORMATION_STACK_1_0 or "" without a query
+func (r *GroupResource) QueryType() string {
+	if r.Query == nil {
+		return ""
+	}
+	return string(r.Query.Type)
+}
+
+// QueryDefinition is the decoded JSON of a resource query
+type QueryDefinition struct {
+	ResourceTypeFilters []string    `json:"ResourceTypeFilters"`
+	TagFilters          []TagFilter `json:"TagFilters"`
+	StackIdentifier     string      `json:"StackIdentifier"`
+}
+
+// TagFilter matches resources with the key and any of the values
+type TagFilter struct {
+	Key    string   `json:"Key"`
+	Values []string `json:"Values"`
+}
+
+// String formats the filter as Key=v1|v2, or just Key for any value
+func (f TagFilter) String() string {
+	if len(f.Values) == 0 {
+		return f.Key
+	}
+	return f.Key + "=" + strings.Join(f.Values, "|")
+}
+
+// Definition decodes the query JSON; it returns nil without a query
+func (r *GroupResource) Definition() (*QueryDefinition, error) {
+	if r.Query == nil || r.Query.Query == nil {
+		return nil, nil
+	}
+	var def QueryDefinition
+	if err := json.Unmarshal([]byte(*r.Query.Query), &def); err != nil {
+		return nil, fmt.Errorf("parse resource query: %w", err)
+	}
+	return &def, nil
+}
+
+// StackName returns the CloudFormation stack of a stack-based group
+func (r *GroupResource) StackName() string {
+	def, err := r.Definition()
+	if err != nil || def == nil || def.StackIdentifier == "" {
+		return ""
+	}
+	if parsed := appaws.ParseARN(def.StackIdentifier); parsed != nil && parsed.ResourceID != "" {
+		name, _, _ := strings.Cut(parsed.ResourceID, "/")
+		return name
+	}
+	return def.StackIdentifier
+}
+
+// QuerySummary describes the query in one line, e.g. "tags Env=prod; AWS::AllSupported"
+func (r *GroupResource) QuerySummary() string {
+	def, err := r.Definition()
+	if err != nil {
+		return "invalid query"
+	}
+	if def == nil {
+		return ""
+	}
+
+	var parts []string
+	switch r.Query.Type {
+	case types.QueryTypeTagFilters10:
+		filters := make([]string, len(def.TagFilters))
+		for i, f := range def.TagFilters {
+			filters[i] = f.String()
+		}
+		parts = append(parts, "tags "+strings.Join(filters, ", "))
+	case types.QueryTypeCloudformationStack10:
+		parts = append(parts, "stack "+r.StackName())
+	}
+	if len(def.ResourceTypeFilters) > 0 {
+		parts = append(parts, strings.Join(def.ResourceTypeFilters, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
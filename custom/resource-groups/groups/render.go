@@ -0,0 +1,192 @@
+package groups
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// GroupRenderer renders resource groups
+type GroupRenderer struct {
+	render.BaseRenderer
+}
+
+var _ render.Navigator = (*GroupRenderer)(nil)
+
+// NewGroupRenderer creates a new GroupRenderer
+func NewGroupRenderer() render.Renderer {
+	return &GroupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "resource-groups",
+			Resource: "groups",
+			Cols: []render.Column{
+				{
+					Name:     "NAME",
+					Width:    32,
+					Priority: 0,
+					Getter:   func(r dao.Resource) string { return r.GetName() },
+				},
+				{
+					Name:     "TYPE",
+					Width:    8,
+					Priority: 1,
+					Getter: func(r dao.Resource) string {
+						if gr, ok := r.(*GroupResource); ok {
+							return queryTypeLabel(gr.QueryType())
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "QUERY",
+					Width:    50,
+					Priority: 2,
+					Getter: func(r dao.Resource) string {
+						if gr, ok := r.(*GroupResource); ok {
+							return gr.QuerySummary()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "DESCRIPTION",
+					Width:    40,
+					Priority: 3,
+					Getter: func(r dao.Resource) string {
+						if gr, ok := r.(*GroupResource); ok {
+							return appaws.Str(gr.Item.Description)
+						}
+						return ""
+					},
+				},
+			},
+		},
+	}
+}
+
+// queryTypeLabel shortens query types for the list view
+func queryTypeLabel(queryType string) string {
+	switch types.QueryType(queryType) {
+	case types.QueryTypeTagFilters10:
+		return "tags"
+	case types.QueryTypeCloudformationStack10:
+		return "stack"
+	case "":
+		return "config"
+	default:
+		return queryType
+	}
+}
+
+// RenderDetail renders the group and its query definition
+func (rnd *GroupRenderer) RenderDetail(resource dao.Resource) string {
+	gr, ok := resource.(*GroupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Resource Group", gr.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", gr.GetName())
+	d.Field("ARN", gr.GetARN())
+	d.FieldIf("Display Name", gr.Item.DisplayName)
+	d.FieldIf("Description", gr.Item.Description)
+	d.FieldIf("Owner", gr.Item.Owner)
+	if gr.Item.Criticality != nil {
+		d.Field("Criticality", fmt.Sprintf("%d", *gr.Item.Criticality))
+	}
+
+	d.Section("Query")
+	if gr.Query == nil {
+		d.DimIndent("No resource query (membership is managed by a service configuration)")
+		return d.String()
+	}
+	d.Field("Type", gr.QueryType())
+
+	def, err := gr.Definition()
+	if err != nil {
+		d.FieldStyled("Error", err.Error(), ui.DangerStyle())
+	} else if def != nil {
+		if len(def.ResourceTypeFilters) > 0 {
+			d.Field("Resource Types", strings.Join(def.ResourceTypeFilters, ", "))
+		}
+		for _, f := range def.TagFilters {
+			values := "(any value)"
+			if len(f.Values) > 0 {
+				values = strings.Join(f.Values, ", ")
+			}
+			d.Field("Tag "+f.Key, values)
+		}
+		if def.StackIdentifier != "" {
+			d.Field("Stack", def.StackIdentifier)
+		}
+	}
+	if q := appaws.Str(gr.Query.Query); q != "" {
+		d.Section("Query JSON")
+		d.Line(q)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (rnd *GroupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	gr, ok := resource.(*GroupResource)
+	if !ok {
+		return rnd.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: gr.GetName()},
+		{Label: "Type", Value: queryTypeLabel(gr.QueryType())},
+	}
+	if q := gr.QuerySummary(); q != "" {
+		fields = append(fields, render.SummaryField{Label: "Query", Value: q})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts for resource groups
+func (rnd *GroupRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	gr, ok := resource.(*GroupResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key:         "r",
+			Label:       "Members",
+			Service:     "resource-groups",
+			Resource:    "members",
+			FilterField: "GroupName",
+			FilterValue: gr.GetName(),
+		},
+		{
+			Key:      "o",
+			Label:    "Open Listing",
+			ViewType: render.ViewTypeResourceGroup,
+		},
+	}
+
+	if stack := gr.StackName(); stack != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "s",
+			Label:       "Stack",
+			Service:     "cloudformation",
+			Resource:    "stacks",
+			FilterField: "StackName",
+			FilterValue: stack,
+		})
+	}
+
+	return navs
+}
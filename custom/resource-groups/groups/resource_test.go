@@ -0,0 +1,67 @@
+package groups
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+)
+
+func newGroup(queryType types.QueryType, query string) *GroupResource {
+	return NewGroupResource(types.Group{
+		Name:     aws.String("web"),
+		GroupArn: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/web"),
+	}, &types.ResourceQuery{Type: queryType, Query: aws.String(query)})
+}
+
+func TestTagQuery(t *testing.T) {
+	r := newGroup(types.QueryTypeTagFilters10,
+		`{"ResourceTypeFilters":["AWS::EC2::Instance"],"TagFilters":[{"Key":"Env","Values":["prod","stage"]},{"Key":"Team"}]}`)
+
+	if got, want := r.QuerySummary(), "tags Env=prod|stage, Team; AWS::EC2::Instance"; got != want {
+		t.Errorf("QuerySummary() = %q, want %q", got, want)
+	}
+	if got := r.StackName(); got != "" {
+		t.Errorf("StackName() = %q, want empty", got)
+	}
+	if got := queryTypeLabel(r.QueryType()); got != "tags" {
+		t.Errorf("queryTypeLabel = %q, want tags", got)
+	}
+}
+
+func TestStackQuery(t *testing.T) {
+	r := newGroup(types.QueryTypeCloudformationStack10,
+		`{"ResourceTypeFilters":["AWS::AllSupported"],"StackIdentifier":"arn:aws:cloudformation:us-east-1:123456789012:stack/web-stack/1a2b3c"}`)
+
+	if got := r.StackName(); got != "web-stack" {
+		t.Errorf("StackName() = %q, want web-stack", got)
+	}
+	if got, want := r.QuerySummary(), "stack web-stack; AWS::AllSupported"; got != want {
+		t.Errorf("QuerySummary() = %q, want %q", got, want)
+	}
+}
+
+func TestNoQuery(t *testing.T) {
+	r := NewGroupResource(types.Group{Name: aws.String("svc")}, nil)
+
+	def, err := r.Definition()
+	if def != nil || err != nil {
+		t.Errorf("Definition() = %v, %v, want nil, nil", def, err)
+	}
+	if got := r.QuerySummary(); got != "" {
+		t.Errorf("QuerySummary() = %q, want empty", got)
+	}
+	if got := queryTypeLabel(r.QueryType()); got != "config" {
+		t.Errorf("queryTypeLabel = %q, want config", got)
+	}
+}
+
+func TestInvalidQuery(t *testing.T) {
+	r := newGroup(types.QueryTypeTagFilters10, "{")
+	if _, err := r.Definition(); err == nil {
+		t.Error("Definition() should fail on invalid JSON")
+	}
+	if got := r.QuerySummary(); got != "invalid query" {
+		t.Errorf("QuerySummary() = %q, want invalid query", got)
+	}
+}
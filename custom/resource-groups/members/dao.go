@@ -0,0 +1,126 @@
+package members
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// MemberDAO provides data access for the members of a resource group
+type MemberDAO struct {
+	dao.BaseDAO
+	client *resourcegroups.Client
+}
+
+// NewMemberDAO creates a new MemberDAO
+func NewMemberDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &MemberDAO{
+		BaseDAO: dao.NewBaseDAO("resource-groups", "members"),
+		client:  resourcegroups.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the resources of the group given by the "GroupName" filter
+func (d *MemberDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	groupName := dao.GetFilterFromContext(ctx, "GroupName")
+	if groupName == "" {
+		return nil, fmt.Errorf("GroupName filter required")
+	}
+
+	items, err := appaws.Paginate(ctx, func(token *string) ([]types.ListGroupResourcesItem, *string, error) {
+		output, err := d.client.ListGroupResources(ctx, &resourcegroups.ListGroupResourcesInput{
+			Group:     &groupName,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list resources of group %s", groupName)
+		}
+		for _, qe := range output.QueryErrors {
+			log.Warn("resource group query error", "group", groupName, "code", string(qe.ErrorCode), "message", appaws.Str(qe.Message))
+		}
+		return output.Resources, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(items))
+	for _, item := range items {
+		if item.Identifier == nil {
+			continue
+		}
+		resources = append(resources, NewMemberResource(item, groupName))
+	}
+	return resources, nil
+}
+
+func (d *MemberDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for resource group members")
+}
+
+func (d *MemberDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for resource group members")
+}
+
+// Supports returns supported operations
+func (d *MemberDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// MemberResource is a resource that belongs to a group
+type MemberResource struct {
+	dao.BaseResource
+	Item      types.ListGroupResourcesItem
+	GroupName string
+}
+
+// NewMemberResource creates a new MemberResource
+func NewMemberResource(item types.ListGroupResourcesItem, groupName string) *MemberResource {
+	arn := appaws.Str(item.Identifier.ResourceArn)
+	name := arn
+	if parsed := appaws.ParseARN(arn); parsed != nil {
+		name = parsed.ShortID()
+	}
+	return &MemberResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: name,
+			ARN:  arn,
+			Data: item,
+		},
+		Item:      item,
+		GroupName: groupName,
+	}
+}
+
+// ResourceType returns the CloudFormation-style type, e.g. AWS::EC2::Instance
+func (r *MemberResource) ResourceType() string {
+	return appaws.Str(r.Item.Identifier.ResourceType)
+}
+
+// Status returns PENDING while membership is being applied, otherwise ""
+func (r *MemberResource) Status() string {
+	if r.Item.Status == nil {
+		return ""
+	}
+	return string(r.Item.Status.Name)
+}
+
+// Service returns the ARN service of the member, e.g. ec2
+func (r *MemberResource) Service() string {
+	if parsed := appaws.ParseARN(r.GetARN()); parsed != nil {
+		return parsed.Service
+	}
+	return ""
+}
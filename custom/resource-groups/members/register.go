@@ -0,0 +1,20 @@
+package members
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("resource-groups", "members", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewMemberDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewMemberRenderer()
+		},
+	})
+}
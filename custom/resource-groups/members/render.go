@@ -0,0 +1,98 @@
+package members
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// MemberRenderer renders resource group members
+type MemberRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMemberRenderer creates a new MemberRenderer
+func NewMemberRenderer() render.Renderer {
+	return &MemberRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "resource-groups",
+			Resource: "members",
+			Cols: []render.Column{
+				{
+					Name:     "RESOURCE",
+					Width:    40,
+					Priority: 0,
+					Getter:   func(r dao.Resource) string { return r.GetName() },
+				},
+				{
+					Name:     "SERVICE",
+					Width:    16,
+					Priority: 2,
+					Getter: func(r dao.Resource) string {
+						if m, ok := r.(*MemberResource); ok {
+							return m.Service()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "TYPE",
+					Width:    36,
+					Priority: 1,
+					Getter: func(r dao.Resource) string {
+						if m, ok := r.(*MemberResource); ok {
+							return m.ResourceType()
+						}
+						return ""
+					},
+				},
+				{
+					Name:     "STATUS",
+					Width:    10,
+					Priority: 3,
+					Getter: func(r dao.Resource) string {
+						if m, ok := r.(*MemberResource); ok {
+							return m.Status()
+						}
+						return ""
+					},
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders a group member
+func (rnd *MemberRenderer) RenderDetail(resource dao.Resource) string {
+	m, ok := resource.(*MemberResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Resource Group Member", m.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Group", m.GroupName)
+	d.Field("ARN", m.GetARN())
+	d.Field("Service", m.Service())
+	d.Field("Type", m.ResourceType())
+	if status := m.Status(); status != "" {
+		d.Field("Status", status)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (rnd *MemberRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	m, ok := resource.(*MemberResource)
+	if !ok {
+		return rnd.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Resource", Value: m.GetName()},
+		{Label: "Type", Value: m.ResourceType()},
+		{Label: "Group", Value: m.GroupName},
+	}
+}
@@ -0,0 +1,267 @@
+package healthchecks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// Route 53 publishes HealthCheckStatus only in us-east-1
+const metricsRegion = "us-east-1"
+
+const (
+	StatusHealthy   = "HEALTHY"
+	StatusUnhealthy = "UNHEALTHY"
+	StatusUnknown   = "UNKNOWN"
+)
+
+const (
+	statusWindow          = 10 * time.Minute
+	maxQueriesPerRequest  = 500
+	healthCheckStatusStat = "Minimum"
+)
+
+// HealthCheckDAO provides data access for Route53 health checks
+type HealthCheckDAO struct {
+	dao.BaseDAO
+	client   *route53.Client
+	cwClient *cloudwatch.Client
+}
+
+// NewHealthCheckDAO creates a new HealthCheckDAO
+func NewHealthCheckDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	cwCfg, err := appaws.NewConfigWithRegion(ctx, metricsRegion)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &HealthCheckDAO{
+		BaseDAO:  dao.NewBaseDAO("route53", "health-checks"),
+		client:   route53.NewFromConfig(cfg),
+		cwClient: cloudwatch.NewFromConfig(cwCfg),
+	}, nil
+}
+
+// List returns all health checks with their current status from CloudWatch
+func (d *HealthCheckDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var checks []types.HealthCheck
+	paginator := route53.NewListHealthChecksPaginator(d.client, &route53.ListHealthChecksInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list health checks")
+		}
+		checks = append(checks, output.HealthChecks...)
+	}
+
+	ids := make([]string, 0, len(checks))
+	for _, hc := range checks {
+		ids = append(ids, appaws.Str(hc.Id))
+	}
+	statuses := d.fetchStatuses(ctx, ids)
+
+	resources := make([]dao.Resource, 0, len(checks))
+	for _, hc := range checks {
+		r := NewHealthCheckResource(hc)
+		r.Status = statusFromMetric(statuses, r.GetID())
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// Get returns a specific health check by ID
+func (d *HealthCheckDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetHealthCheck(ctx, &route53.GetHealthCheckInput{
+		HealthCheckId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get health check %s", id)
+	}
+	if output.HealthCheck == nil {
+		return nil, fmt.Errorf("health check not found: %s", id)
+	}
+
+	r := NewHealthCheckResource(*output.HealthCheck)
+	r.Status = statusFromMetric(d.fetchStatuses(ctx, []string{id}), id)
+	return r, nil
+}
+
+// Delete is not supported for health checks (may be referenced by record sets)
+func (d *HealthCheckDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for health checks")
+}
+
+// Supports returns supported operations
+func (d *HealthCheckDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// fetchStatuses returns the latest HealthCheckStatus value per health check ID.
+// Failures are logged and yield an empty map so the list still renders.
+func (d *HealthCheckDAO) fetchStatuses(ctx context.Context, ids []string) map[string]float64 {
+	statuses := make(map[string]float64, len(ids))
+	endTime := time.Now().Truncate(time.Minute)
+	startTime := endTime.Add(-statusWindow)
+
+	for i := 0; i < len(ids); i += maxQueriesPerRequest {
+		batch := ids[i:min(i+maxQueriesPerRequest, len(ids))]
+		queries := make([]cwtypes.MetricDataQuery, len(batch))
+		for j, id := range batch {
+			queries[j] = cwtypes.MetricDataQuery{
+				Id: aws.String(fmt.Sprintf("m%d", i+j)),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Namespace:  aws.String("AWS/Route53"),
+						MetricName: aws.String("HealthCheckStatus"),
+						Dimensions: []cwtypes.Dimension{
+							{Name: aws.String("HealthCheckId"), Value: aws.String(id)},
+						},
+					},
+					Period: aws.Int32(60),
+					Stat:   aws.String(healthCheckStatusStat),
+				},
+			}
+		}
+
+		output, err := d.cwClient.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(startTime),
+			EndTime:           aws.Time(endTime),
+			MetricDataQueries: queries,
+			ScanBy:            cwtypes.ScanByTimestampDescending,
+		})
+		if err != nil {
+			log.Warn("failed to get health check status metrics", "error", err)
+			return statuses
+		}
+		for _, result := range output.MetricDataResults {
+			if len(result.Values) == 0 {
+				continue
+			}
+			var idx int
+			if _, err := fmt.Sscanf(aws.ToString(result.Id), "m%d", &idx); err != nil || idx >= len(ids) {
+				continue
+			}
+			// Values are newest first (ScanByTimestampDescending)
+			statuses[ids[idx]] = result.Values[0]
+		}
+	}
+	return statuses
+}
+
+// statusFromMetric maps a HealthCheckStatus datapoint (1 healthy, 0 unhealthy) to a status
+func statusFromMetric(statuses map[string]float64, id string) string {
+	v, ok := statuses[id]
+	switch {
+	case !ok:
+		return StatusUnknown
+	case v >= 1:
+		return StatusHealthy
+	default:
+		return StatusUnhealthy
+	}
+}
+
+// HealthCheckResource wraps a Route53 health check
+type HealthCheckResource struct {
+	dao.BaseResource
+	Item types.HealthCheck
+
+	// Status is derived from the CloudWatch HealthCheckStatus metric
+	Status string
+}
+
+// NewHealthCheckResource creates a new HealthCheckResource
+func NewHealthCheckResource(hc types.HealthCheck) *HealthCheckResource {
+	return &HealthCheckResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(hc.Id),
+			Name: appaws.Str(hc.Id),
+			Data: hc,
+		},
+		Item:   hc,
+		Status: StatusUnknown,
+	}
+}
+
+// IsUnhealthy returns whether CloudWatch reports the check as failing
+func (r *HealthCheckResource) IsUnhealthy() bool {
+	return r.Status == StatusUnhealthy
+}
+
+// Type returns the health check type
+func (r *HealthCheckResource) Type() string {
+	if r.Item.HealthCheckConfig != nil {
+		return string(r.Item.HealthCheckConfig.Type)
+	}
+	return ""
+}
+
+// Endpoint returns what the health check monitors: host:port/path for endpoint
+// checks, child count for calculated checks, or alarm name for metric checks
+func (r *HealthCheckResource) Endpoint() string {
+	cfg := r.Item.HealthCheckConfig
+	if cfg == nil {
+		return ""
+	}
+
+	switch cfg.Type {
+	case types.HealthCheckTypeCalculated:
+		return fmt.Sprintf("%d child checks", len(cfg.ChildHealthChecks))
+	case types.HealthCheckTypeCloudwatchMetric:
+		if cfg.AlarmIdentifier != nil {
+			return "alarm:" + appaws.Str(cfg.AlarmIdentifier.Name)
+		}
+		return ""
+	case types.HealthCheckTypeRecoveryControl:
+		return appaws.ExtractResourceName(appaws.Str(cfg.RoutingControlArn))
+	}
+
+	host := appaws.Str(cfg.FullyQualifiedDomainName)
+	if host == "" {
+		host = appaws.Str(cfg.IPAddress)
+		if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+	}
+	if cfg.Port != nil {
+		host = fmt.Sprintf("%s:%d", host, *cfg.Port)
+	}
+	return host + appaws.Str(cfg.ResourcePath)
+}
+
+// FailureThreshold returns the number of consecutive failures before the check
+// changes state, or 0 for types that do not use one
+func (r *HealthCheckResource) FailureThreshold() int32 {
+	if r.Item.HealthCheckConfig != nil {
+		return appaws.Int32(r.Item.HealthCheckConfig.FailureThreshold)
+	}
+	return 0
+}
+
+// Disabled returns whether the health check is disabled
+func (r *HealthCheckResource) Disabled() bool {
+	if r.Item.HealthCheckConfig != nil {
+		return appaws.Bool(r.Item.HealthCheckConfig.Disabled)
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package healthchecks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*HealthCheckRenderer)(nil)
+
+// HealthCheckRenderer renders Route53 health checks
+type HealthCheckRenderer struct {
+	render.BaseRenderer
+}
+
+// NewHealthCheckRenderer creates a new HealthCheckRenderer
+func NewHealthCheckRenderer() render.Renderer {
+	return &HealthCheckRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "route53",
+			Resource: "health-checks",
+			Cols: []render.Column{
+				{
+					Name:     "ID",
+					Width:    38,
+					Getter:   func(r dao.Resource) string { return r.GetID() },
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if hc, ok := r.(*HealthCheckResource); ok {
+							return hc.Status
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "TYPE",
+					Width: 18,
+					Getter: func(r dao.Resource) string {
+						if hc, ok := r.(*HealthCheckResource); ok {
+							return hc.Type()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "ENDPOINT",
+					Width: 45,
+					Getter: func(r dao.Resource) string {
+						if hc, ok := r.(*HealthCheckResource); ok {
+							return hc.Endpoint()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "THRESHOLD",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if hc, ok := r.(*HealthCheckResource); ok {
+							if t := hc.FailureThreshold(); t > 0 {
+								return fmt.Sprintf("%d", t)
+							}
+							return "-"
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders health check configuration and status
+func (r *HealthCheckRenderer) RenderDetail(resource dao.Resource) string {
+	hc, ok := resource.(*HealthCheckResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Route53 Health Check", hc.GetID())
+
+	d.Section("Status")
+	d.FieldStyled("Status", hc.Status, render.StateColorer()(strings.ToLower(hc.Status)))
+	if hc.Status == StatusUnknown {
+		d.DimIndent("No HealthCheckStatus datapoints in us-east-1 for the last 10 minutes")
+	}
+	if hc.Disabled() {
+		d.FieldStyled("Disabled", "Yes (always reported healthy)", ui.WarningStyle())
+	}
+
+	cfg := hc.Item.HealthCheckConfig
+	if cfg != nil {
+		d.Section("Configuration")
+		d.Field("Type", hc.Type())
+		d.Field("Endpoint", hc.Endpoint())
+		d.FieldIf("Search String", cfg.SearchString)
+		if t := hc.FailureThreshold(); t > 0 {
+			d.Field("Failure Threshold", fmt.Sprintf("%d", t))
+		}
+		if cfg.RequestInterval != nil {
+			d.Field("Request Interval", fmt.Sprintf("%ds", *cfg.RequestInterval))
+		}
+		if cfg.HealthThreshold != nil {
+			d.Field("Health Threshold", fmt.Sprintf("%d of %d", *cfg.HealthThreshold, len(cfg.ChildHealthChecks)))
+		}
+		if appaws.Bool(cfg.Inverted) {
+			d.Field("Inverted", "Yes")
+		}
+		if appaws.Bool(cfg.EnableSNI) {
+			d.Field("SNI", "Enabled")
+		}
+		if appaws.Bool(cfg.MeasureLatency) {
+			d.Field("Measure Latency", "Yes")
+		}
+		if len(cfg.Regions) > 0 {
+			regions := make([]string, len(cfg.Regions))
+			for i, reg := range cfg.Regions {
+				regions[i] = string(reg)
+			}
+			d.Field("Checker Regions", strings.Join(regions, ", "))
+		}
+		if cfg.InsufficientDataHealthStatus != "" {
+			d.Field("Insufficient Data", string(cfg.InsufficientDataHealthStatus))
+		}
+		if len(cfg.ChildHealthChecks) > 0 {
+			d.Section("Child Health Checks")
+			for _, child := range cfg.ChildHealthChecks {
+				d.Line("  " + child)
+			}
+		}
+	}
+
+	if hc.Item.LinkedService != nil {
+		d.Section("Linked Service")
+		d.FieldIf("Service", hc.Item.LinkedService.ServicePrincipal)
+		d.FieldIf("Description", hc.Item.LinkedService.Description)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *HealthCheckRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	hc, ok := resource.(*HealthCheckResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "ID", Value: hc.GetID()},
+		{Label: "Status", Value: hc.Status, Style: render.StateColorer()(strings.ToLower(hc.Status))},
+		{Label: "Type", Value: hc.Type()},
+		{Label: "Endpoint", Value: hc.Endpoint()},
+	}
+	if t := hc.FailureThreshold(); t > 0 {
+		fields = append(fields, render.SummaryField{Label: "Failure Threshold", Value: fmt.Sprintf("%d", t)})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts for health checks
+func (r *HealthCheckRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	hc, ok := resource.(*HealthCheckResource)
+	if !ok {
+		return nil
+	}
+
+	cfg := hc.Item.HealthCheckConfig
+	if cfg != nil && cfg.Type == types.HealthCheckTypeCloudwatchMetric && cfg.AlarmIdentifier != nil {
+		return []render.Navigation{
+			{
+				Key: "A", Label: "Alarm", Service: "cloudwatch", Resource: "alarms",
+				FilterField: "AlarmName", FilterValue: appaws.Str(cfg.AlarmIdentifier.Name),
+			},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package healthchecks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func TestHealthCheckResource_Endpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *types.HealthCheckConfig
+		want string
+	}{
+		{
+			name: "https fqdn with path",
+			cfg: &types.HealthCheckConfig{
+				Type:                     types.HealthCheckTypeHttps,
+				FullyQualifiedDomainName: aws.String("api.example.com"),
+				Port:                     aws.Int32(443),
+				ResourcePath:             aws.String("/health"),
+			},
+			want: "api.example.com:443/health",
+		},
+		{
+			name: "tcp ipv4",
+			cfg: &types.HealthCheckConfig{
+				Type:      types.HealthCheckTypeTcp,
+				IPAddress: aws.String("192.0.2.10"),
+				Port:      aws.Int32(22),
+			},
+			want: "192.0.2.10:22",
+		},
+		{
+			name: "http ipv6",
+			cfg: &types.HealthCheckConfig{
+				Type:      types.HealthCheckTypeHttp,
+				IPAddress: aws.String("2001:db8::1"),
+				Port:      aws.Int32(80),
+			},
+			want: "[2001:db8::1]:80",
+		},
+		{
+			name: "calculated",
+			cfg: &types.HealthCheckConfig{
+				Type:              types.HealthCheckTypeCalculated,
+				ChildHealthChecks: []string{"a", "b", "c"},
+			},
+			want: "3 child checks",
+		},
+		{
+			name: "cloudwatch metric",
+			cfg: &types.HealthCheckConfig{
+				Type:            types.HealthCheckTypeCloudwatchMetric,
+				AlarmIdentifier: &types.AlarmIdentifier{Name: aws.String("high-5xx")},
+			},
+			want: "alarm:high-5xx",
+		},
+		{
+			name: "no config",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewHealthCheckResource(types.HealthCheck{Id: aws.String("hc-1"), HealthCheckConfig: tt.cfg})
+			if got := r.Endpoint(); got != tt.want {
+				t.Errorf("Endpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthCheckResource_FailureThreshold(t *testing.T) {
+	r := NewHealthCheckResource(types.HealthCheck{
+		Id: aws.String("hc-1"),
+		HealthCheckConfig: &types.HealthCheckConfig{
+			Type:             types.HealthCheckTypeHttp,
+			FailureThreshold: aws.Int32(3),
+		},
+	})
+	if got := r.FailureThreshold(); got != 3 {
+		t.Errorf("FailureThreshold() = %d, want 3", got)
+	}
+
+	empty := NewHealthCheckResource(types.HealthCheck{Id: aws.String("hc-2")})
+	if got := empty.FailureThreshold(); got != 0 {
+		t.Errorf("FailureThreshold() = %d, want 0", got)
+	}
+}
+
+func TestStatusFromMetric(t *testing.T) {
+	statuses := map[string]float64{"up": 1, "down": 0}
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"up", StatusHealthy},
+		{"down", StatusUnhealthy},
+		{"missing", StatusUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := statusFromMetric(statuses, tt.id); got != tt.want {
+			t.Errorf("statusFromMetric(%q) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestNewHealthCheckResource_DefaultsUnknown(t *testing.T) {
+	r := NewHealthCheckResource(types.HealthCheck{Id: aws.String("hc-1")})
+	if r.Status != StatusUnknown {
+		t.Errorf("Status = %q, want %q", r.Status, StatusUnknown)
+	}
+	if r.IsUnhealthy() {
+		t.Error("IsUnhealthy() = true, want false")
+	}
+}
@@ -0,0 +1,63 @@
+package hostedzones
+
+import (
+	"context"
+
+	acmClient "github.com/clawscli/claws/custom/acm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("route53", "hosted-zones", []action.Action{
+		{
+			Name:      "Request Certificate",
+			Shortcut:  "r",
+			Type:      action.ActionTypeAPI,
+			Operation: "RequestCertificate",
+			Confirm:   action.ConfirmSimple,
+			Prompts:   acmClient.RequestPrompts(zoneDomain, zoneWildcard),
+			Filter: func(r dao.Resource) bool {
+				hz, ok := r.(*HostedZoneResource)
+				return ok && !hz.IsPrivate()
+			},
+		},
+	})
+
+	action.RegisterExecutor("route53", "hosted-zones", executeHostedZoneAction)
+}
+
+func executeHostedZoneAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RequestCertificate":
+		return executeRequestCertificate(ctx, act, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// zoneDomain pre-fills the certificate domain with the zone apex
+func zoneDomain(r dao.Resource) string {
+	if hz, ok := r.(*HostedZoneResource); ok {
+		return hz.DomainName()
+	}
+	return ""
+}
+
+// zoneWildcard pre-fills the alternative names with the zone wildcard
+func zoneWildcard(r dao.Resource) string {
+	if domain := zoneDomain(r); domain != "" {
+		return "*." + domain
+	}
+	return ""
+}
+
+// executeRequestCertificate requests an ACM certificate for the entered names
+// and writes the DNS validation records into this zone
+func executeRequestCertificate(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	if _, ok := resource.(*HostedZoneResource); !ok {
+		return action.InvalidResourceResult()
+	}
+
+	return acmClient.ExecuteRequestCertificate(ctx, act)
+}
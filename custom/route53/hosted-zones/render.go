@@ -190,5 +190,14 @@ func (r *HostedZoneRenderer) Navigations(resource dao.Resource) []render.Navigat
 		FilterField: "HostedZoneId", FilterValue: hr.ZoneID(),
 	})
 
+	// Certificates for this domain; auto-reload to follow DNS validation
+	if !hr.IsPrivate() {
+		navs = append(navs, render.Navigation{
+			Key: "C", Label: "Certificates", Service: "acm", Resource: "certificates",
+			FilterField: "DomainName", FilterValue: hr.DomainName(),
+			AutoReload: true,
+		})
+	}
+
 	return navs
 }
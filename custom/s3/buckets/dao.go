@@ -35,9 +35,24 @@ func NewBucketDAO(ctx context.Context) (dao.DAO, error) {
 }
 
 func (d *BucketDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var resources []dao.Resource
+	err := d.ListStream(ctx, func(page []dao.Resource) bool {
+		resources = append(resources, page...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// ListStream emits buckets page by page so accounts with thousands of
+// buckets render the first page without waiting for the rest.
+// Implements dao.StreamingDAO interface.
+func (d *BucketDAO) ListStream(ctx context.Context, emit func([]dao.Resource) bool) error {
 	// Use MaxBuckets parameter to get BucketRegion in the response
 	// This avoids N+1 GetBucketLocation calls
-	buckets, err := appaws.Paginate(ctx, func(token *string) ([]types.Bucket, *string, error) {
+	return appaws.PaginateStream(ctx, func(token *string) ([]types.Bucket, *string, error) {
 		output, err := d.client.ListBuckets(ctx, &s3.ListBucketsInput{
 			ContinuationToken: token,
 			MaxBuckets:        appaws.Int32Ptr(1000),
@@ -46,22 +61,18 @@ func (d *BucketDAO) List(ctx context.Context) ([]dao.Resource, error) {
 			return nil, nil, apperrors.Wrap(err, "list buckets")
 		}
 		return output.Buckets, output.ContinuationToken, nil
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	resources := make([]dao.Resource, 0, len(buckets))
-	for _, bucket := range buckets {
-		r := NewBucketResource(bucket)
-		// BucketRegion is included when any parameter is set in ListBucketsInput
-		if bucket.BucketRegion != nil {
-			r.Region = *bucket.BucketRegion
+	}, func(buckets []types.Bucket) bool {
+		resources := make([]dao.Resource, 0, len(buckets))
+		for _, bucket := range buckets {
+			r := NewBucketResource(bucket)
+			// BucketRegion is included when any parameter is set in ListBucketsInput
+			if bucket.BucketRegion != nil {
+				r.Region = *bucket.BucketRegion
+			}
+			resources = append(resources, r)
 		}
-		resources = append(resources, r)
-	}
-
-	return resources, nil
+		return emit(resources)
+	})
 }
 
 func (d *BucketDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -101,6 +112,8 @@ func (d *BucketDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	d.fetchLifecycle(ctx, regionClient, id, resource)
 	d.fetchObjectLock(ctx, regionClient, id, resource)
 	d.fetchTags(ctx, regionClient, id, resource)
+	d.fetchReplication(ctx, regionClient, id, resource)
+	d.fetchReplicationMetrics(ctx, region, id, resource)
 
 	return resource, nil
 }
@@ -121,7 +134,8 @@ func isNotConfiguredError(err error) bool {
 		return false
 	}
 	switch apiErr.ErrorCode() {
-	case "ServerSideEncryptionConfigurationNotFoundError", "NoSuchPublicAccessBlockConfiguration":
+	case "ServerSideEncryptionConfigurationNotFoundError", "NoSuchPublicAccessBlockConfiguration",
+		"ReplicationConfigurationNotFoundError":
 		return true
 	default:
 		return false
@@ -296,6 +310,9 @@ type BucketResource struct {
 	ObjectLockEnabled       bool
 	ObjectLockMode          string
 	ObjectLockRetention     string
+	ReplicationRole         string
+	ReplicationRules        []ReplicationRule
+	ReplicationStatus       enrichment.Status
 }
 
 // PublicAccessBlockInfo holds public access block settings
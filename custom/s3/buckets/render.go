@@ -2,10 +2,12 @@ package buckets
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/enrichment"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // BucketRenderer renders S3 buckets
@@ -170,6 +172,25 @@ func (r *BucketRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Status", render.NotConfigured)
 	}
 
+	// Replication
+	d.Section("Replication")
+	switch {
+	case enrichment.IsFailure(b.ReplicationStatus):
+		d.Field("Status", enrichment.Display(b.ReplicationStatus))
+	case len(b.ReplicationRules) > 0:
+		d.Field("Status", b.ReplicationSummary())
+		if b.ReplicationRole != "" {
+			d.Field("IAM Role", b.ReplicationRole)
+		}
+		for _, rule := range b.ReplicationRules {
+			renderReplicationRule(d, rule)
+		}
+	case b.ReplicationStatus == enrichment.NotConfigured:
+		d.Field("Status", render.NotConfigured)
+	default:
+		d.Field("Status", enrichment.Display(enrichment.Unknown))
+	}
+
 	// Object Lock
 	if b.ObjectLockEnabled {
 		d.Section("Object Lock")
@@ -241,6 +262,11 @@ func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 		fields = append(fields, render.SummaryField{Label: "Public Access", Value: enrichment.Display(b.PublicAccessBlockStatus)})
 	}
 
+	// Replication (if configured)
+	if summary := b.ReplicationSummary(); summary != "" {
+		fields = append(fields, render.SummaryField{Label: "Replication", Value: summary})
+	}
+
 	// Object Lock (if enabled)
 	if b.ObjectLockEnabled {
 		fields = append(fields, render.SummaryField{Label: "Object Lock", Value: "Enabled"})
@@ -268,3 +294,53 @@ func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 	return fields
 }
+
+// rtcThreshold is the Replication Time Control SLA
+const rtcThreshold = 15 * time.Minute
+
+// renderReplicationRule renders a replication rule and its latest metrics
+func renderReplicationRule(d *render.DetailBuilder, rule ReplicationRule) {
+	label := rule.ID
+	if label == "" {
+		label = fmt.Sprintf("Priority %d", rule.Priority)
+	}
+	dest := rule.DestinationBucket
+	if rule.DestinationAccount != "" {
+		dest += " (" + rule.DestinationAccount + ")"
+	}
+	if rule.Enabled() {
+		d.Field(label, dest)
+	} else {
+		d.FieldStyled(label, dest+" ("+rule.Status+")", ui.DimStyle())
+	}
+	if rule.Prefix != "" {
+		d.Field("  Prefix", rule.Prefix)
+	}
+	if rule.StorageClass != "" {
+		d.Field("  Storage Class", rule.StorageClass)
+	}
+	if rule.RTCEnabled {
+		d.Field("  Time Control", "Enabled (15 minute SLA)")
+	}
+	if !rule.MetricsEnabled {
+		d.Field("  Lag", "Unknown (replication metrics disabled)")
+		return
+	}
+	if rule.Latency == nil {
+		d.Field("  Lag", "No data in the last hour")
+	} else {
+		lag := time.Duration(*rule.Latency * float64(time.Second))
+		if rule.RTCEnabled && lag > rtcThreshold {
+			d.FieldStyled("  Lag", render.FormatDuration(lag)+" (exceeds SLA)", ui.WarningStyle())
+		} else {
+			d.Field("  Lag", render.FormatDuration(lag))
+		}
+	}
+	if rule.PendingOps != nil {
+		pending := fmt.Sprintf("%.0f operations", *rule.PendingOps)
+		if rule.PendingBytes != nil {
+			pending += ", " + render.FormatSize(int64(*rule.PendingBytes))
+		}
+		d.Field("  Pending", pending)
+	}
+}
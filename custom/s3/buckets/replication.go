@@ -0,0 +1,201 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/enrichment"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// S3 publishes replication metrics every minute for rules with metrics
+	// enabled; the latest datapoint in the window is reported.
+	replicationMetricsWindow = time.Hour
+	replicationMetricsPeriod = 60
+)
+
+// ReplicationRule is a bucket replication rule with its latest metrics
+type ReplicationRule struct {
+	ID                 string
+	Status             string
+	Priority           int32
+	Prefix             string
+	DestinationBucket  string // bucket name, parsed from the destination ARN
+	DestinationAccount string
+	StorageClass       string
+	MetricsEnabled     bool
+	RTCEnabled         bool // Replication Time Control (15 minute SLA)
+
+	// Latest replication metrics, nil when metrics are disabled or no
+	// datapoint was published in the metrics window
+	Latency      *float64 // seconds behind the source
+	PendingOps   *float64
+	PendingBytes *float64
+}
+
+// Enabled returns whether the rule is active
+func (r ReplicationRule) Enabled() bool {
+	return r.Status == string(types.ReplicationRuleStatusEnabled)
+}
+
+// NewReplicationRules converts a bucket replication configuration
+func NewReplicationRules(cfg *types.ReplicationConfiguration) []ReplicationRule {
+	if cfg == nil {
+		return nil
+	}
+	rules := make([]ReplicationRule, 0, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		r := ReplicationRule{
+			ID:       appaws.Str(rule.ID),
+			Status:   string(rule.Status),
+			Priority: appaws.Int32(rule.Priority),
+			// Legacy (V1) rules set the deprecated Prefix instead of Filter
+			Prefix: appaws.Str(rule.Prefix),
+		}
+		if rule.Filter != nil && rule.Filter.Prefix != nil {
+			r.Prefix = *rule.Filter.Prefix
+		}
+		if dest := rule.Destination; dest != nil {
+			r.DestinationBucket = appaws.ExtractResourceName(appaws.Str(dest.Bucket))
+			r.DestinationAccount = appaws.Str(dest.Account)
+			r.StorageClass = string(dest.StorageClass)
+			r.MetricsEnabled = dest.Metrics != nil && dest.Metrics.Status == types.MetricsStatusEnabled
+			r.RTCEnabled = dest.ReplicationTime != nil && dest.ReplicationTime.Status == types.ReplicationTimeStatusEnabled
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// ReplicationSummary returns a one-line description of the replication
+// destinations, or "" when replication is not configured
+func (r *BucketResource) ReplicationSummary() string {
+	var enabled, dests int
+	seen := make(map[string]struct{})
+	for _, rule := range r.ReplicationRules {
+		if !rule.Enabled() {
+			continue
+		}
+		enabled++
+		if _, ok := seen[rule.DestinationBucket]; !ok {
+			seen[rule.DestinationBucket] = struct{}{}
+			dests++
+		}
+	}
+	if len(r.ReplicationRules) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d rules enabled, %d destination(s)", enabled, len(r.ReplicationRules), dests)
+}
+
+// fetchReplication fetches the bucket replication configuration
+func (d *BucketDAO) fetchReplication(ctx context.Context, client *s3.Client, bucket string, r *BucketResource) {
+	output, err := client.GetBucketReplication(ctx, &s3.GetBucketReplicationInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		r.ReplicationStatus = enrichmentFailureStatus(err)
+		return
+	}
+	if output.ReplicationConfiguration == nil || len(output.ReplicationConfiguration.Rules) == 0 {
+		r.ReplicationStatus = enrichment.NotConfigured
+		return
+	}
+	r.ReplicationStatus = enrichment.Configured
+	r.ReplicationRole = appaws.Str(output.ReplicationConfiguration.Role)
+	r.ReplicationRules = NewReplicationRules(output.ReplicationConfiguration)
+}
+
+// fetchReplicationMetrics fills in the latest replication latency and
+// backlog of rules with replication metrics enabled. Failures are logged
+// and leave the metrics unset.
+func (d *BucketDAO) fetchReplicationMetrics(ctx context.Context, region, bucket string, r *BucketResource) {
+	var queries []cwtypes.MetricDataQuery
+	for i, rule := range r.ReplicationRules {
+		if !rule.MetricsEnabled || rule.DestinationBucket == "" {
+			continue
+		}
+		queries = append(queries,
+			replicationQuery(fmt.Sprintf("l%d", i), bucket, rule, "ReplicationLatency"),
+			replicationQuery(fmt.Sprintf("o%d", i), bucket, rule, "OperationsPendingReplication"),
+			replicationQuery(fmt.Sprintf("b%d", i), bucket, rule, "BytesPendingReplication"),
+		)
+	}
+	if len(queries) == 0 {
+		return
+	}
+
+	cfg, err := appaws.NewConfigWithRegion(ctx, region)
+	if err != nil {
+		log.Warn("failed to create cloudwatch client for replication metrics", "bucket", bucket, "error", err)
+		return
+	}
+	client := cloudwatch.NewFromConfig(cfg)
+
+	endTime := time.Now()
+	output, err := client.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(endTime.Add(-replicationMetricsWindow)),
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: queries,
+		ScanBy:            cwtypes.ScanByTimestampDescending,
+	})
+	if err != nil {
+		log.Warn("failed to get replication metrics", "bucket", bucket, "error", err)
+		return
+	}
+	for _, result := range output.MetricDataResults {
+		addReplicationResult(r.ReplicationRules, result)
+	}
+}
+
+func replicationQuery(id, bucket string, rule ReplicationRule, metric string) cwtypes.MetricDataQuery {
+	return cwtypes.MetricDataQuery{
+		Id: aws.String(id),
+		MetricStat: &cwtypes.MetricStat{
+			Metric: &cwtypes.Metric{
+				Namespace:  aws.String("AWS/S3"),
+				MetricName: aws.String(metric),
+				Dimensions: []cwtypes.Dimension{
+					{Name: aws.String("SourceBucket"), Value: aws.String(bucket)},
+					{Name: aws.String("DestinationBucket"), Value: aws.String(rule.DestinationBucket)},
+					{Name: aws.String("RuleId"), Value: aws.String(rule.ID)},
+				},
+			},
+			Period: aws.Int32(replicationMetricsPeriod),
+			Stat:   aws.String("Maximum"),
+		},
+	}
+}
+
+// addReplicationResult sets the latest value of a query result, identified
+// as l<idx>, o<idx> or b<idx>, on rules[idx]
+func addReplicationResult(rules []ReplicationRule, result cwtypes.MetricDataResult) {
+	var kind rune
+	var idx int
+	if _, err := fmt.Sscanf(aws.ToString(result.Id), "%c%d", &kind, &idx); err != nil || idx >= len(rules) {
+		return
+	}
+	if len(result.Values) == 0 {
+		return
+	}
+
+	// Values are ordered newest first
+	latest := result.Values[0]
+	switch kind {
+	case 'l':
+		rules[idx].Latency = &latest
+	case 'o':
+		rules[idx].PendingOps = &latest
+	case 'b':
+		rules[idx].PendingBytes = &latest
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 
@@ -155,6 +156,7 @@ func TestS3EnrichmentFailureStatusClassifiesNotConfiguredErrors(t *testing.T) {
 	}{
 		{name: "encryption not configured", code: "ServerSideEncryptionConfigurationNotFoundError", want: enrichment.NotConfigured},
 		{name: "public access block not configured", code: "NoSuchPublicAccessBlockConfiguration", want: enrichment.NotConfigured},
+		{name: "replication not configured", code: "ReplicationConfigurationNotFoundError", want: enrichment.NotConfigured},
 		{name: "access denied", code: "AccessDeniedException", want: enrichment.AccessDenied},
 		{name: "other failure", code: "InternalError", want: enrichment.FetchFailed},
 	}
@@ -169,6 +171,101 @@ func TestS3EnrichmentFailureStatusClassifiesNotConfiguredErrors(t *testing.T) {
 	}
 }
 
+func TestNewReplicationRules(t *testing.T) {
+	cfg := &types.ReplicationConfiguration{
+		Role: aws.String("arn:aws:iam::123456789012:role/replication"),
+		Rules: []types.ReplicationRule{
+			{
+				ID:       aws.String("to-dr"),
+				Status:   types.ReplicationRuleStatusEnabled,
+				Priority: aws.Int32(1),
+				Filter:   &types.ReplicationRuleFilter{Prefix: aws.String("logs/")},
+				Destination: &types.Destination{
+					Bucket:          aws.String("arn:aws:s3:::dr-bucket"),
+					Account:         aws.String("210987654321"),
+					StorageClass:    types.StorageClassStandardIa,
+					Metrics:         &types.Metrics{Status: types.MetricsStatusEnabled},
+					ReplicationTime: &types.ReplicationTime{Status: types.ReplicationTimeStatusEnabled},
+				},
+			},
+			{
+				ID:          aws.String("legacy"),
+				Status:      types.ReplicationRuleStatusDisabled,
+				Prefix:      aws.String("old/"),
+				Destination: &types.Destination{Bucket: aws.String("arn:aws:s3:::dr-bucket")},
+			},
+		},
+	}
+
+	rules := NewReplicationRules(cfg)
+	if len(rules) != 2 {
+		t.Fatalf("got %d rules, want 2", len(rules))
+	}
+
+	r := rules[0]
+	if r.DestinationBucket != "dr-bucket" || r.DestinationAccount != "210987654321" {
+		t.Errorf("destination = %q (%q), want dr-bucket (210987654321)", r.DestinationBucket, r.DestinationAccount)
+	}
+	if r.Prefix != "logs/" || r.StorageClass != "STANDARD_IA" || r.Priority != 1 {
+		t.Errorf("rule = %+v", r)
+	}
+	if !r.Enabled() || !r.MetricsEnabled || !r.RTCEnabled {
+		t.Errorf("expected enabled rule with metrics and RTC, got %+v", r)
+	}
+	if rules[1].Enabled() || rules[1].Prefix != "old/" || rules[1].MetricsEnabled {
+		t.Errorf("legacy rule = %+v", rules[1])
+	}
+
+	b := &BucketResource{ReplicationRules: rules}
+	if got, want := b.ReplicationSummary(), "1/2 rules enabled, 1 destination(s)"; got != want {
+		t.Errorf("ReplicationSummary() = %q, want %q", got, want)
+	}
+	if got := (&BucketResource{}).ReplicationSummary(); got != "" {
+		t.Errorf("ReplicationSummary() without rules = %q, want empty", got)
+	}
+	if NewReplicationRules(nil) != nil {
+		t.Error("NewReplicationRules(nil) should return nil")
+	}
+}
+
+func TestAddReplicationResult(t *testing.T) {
+	rules := []ReplicationRule{{ID: "a"}, {ID: "b"}}
+
+	addReplicationResult(rules, cwtypes.MetricDataResult{Id: aws.String("l1"), Values: []float64{1200, 30}})
+	addReplicationResult(rules, cwtypes.MetricDataResult{Id: aws.String("o1"), Values: []float64{42}})
+	addReplicationResult(rules, cwtypes.MetricDataResult{Id: aws.String("b1"), Values: []float64{2048}})
+	addReplicationResult(rules, cwtypes.MetricDataResult{Id: aws.String("l0")})
+	addReplicationResult(rules, cwtypes.MetricDataResult{Id: aws.String("l5"), Values: []float64{1}})
+
+	if rules[0].Latency != nil {
+		t.Errorf("rule without datapoints should have no latency, got %v", *rules[0].Latency)
+	}
+	if rules[1].Latency == nil || *rules[1].Latency != 1200 {
+		t.Errorf("latency = %v, want newest value 1200", rules[1].Latency)
+	}
+	if rules[1].PendingOps == nil || *rules[1].PendingOps != 42 {
+		t.Errorf("pending ops = %v, want 42", rules[1].PendingOps)
+	}
+	if rules[1].PendingBytes == nil || *rules[1].PendingBytes != 2048 {
+		t.Errorf("pending bytes = %v, want 2048", rules[1].PendingBytes)
+	}
+
+	// Lag over the RTC SLA is flagged
+	rules[1].MetricsEnabled = true
+	rules[1].RTCEnabled = true
+	rules[1].Status = string(types.ReplicationRuleStatusEnabled)
+	detail := NewBucketRenderer().RenderDetail(&BucketResource{
+		BucketName:        "src",
+		ReplicationStatus: enrichment.Configured,
+		ReplicationRules:  rules,
+	})
+	for _, want := range []string{"Replication", "20m", "exceeds SLA", "42 operations"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected %q in detail, got %q", want, detail)
+		}
+	}
+}
+
 func TestBucketResource_NilName(t *testing.T) {
 	bucket := types.Bucket{
 		Name: nil,
@@ -0,0 +1,170 @@
+package provisionedproducts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ProvisionedProductDAO provides data access for Service Catalog provisioned products
+type ProvisionedProductDAO struct {
+	dao.BaseDAO
+	client *servicecatalog.Client
+}
+
+// NewProvisionedProductDAO creates a new ProvisionedProductDAO
+func NewProvisionedProductDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ProvisionedProductDAO{
+		BaseDAO: dao.NewBaseDAO("servicecatalog", "provisioned-products"),
+		client:  servicecatalog.NewFromConfig(cfg),
+	}, nil
+}
+
+// accountFilter covers every provisioned product in the account, not only
+// those launched by the caller
+var accountFilter = &types.AccessLevelFilter{
+	Key:   types.AccessLevelFilterKeyAccount,
+	Value: appaws.StringPtr("self"),
+}
+
+// List returns all provisioned products of the account
+func (d *ProvisionedProductDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var resources []dao.Resource
+	var pageToken *string
+
+	for {
+		output, err := d.client.SearchProvisionedProducts(ctx, &servicecatalog.SearchProvisionedProductsInput{
+			AccessLevelFilter: accountFilter,
+			PageToken:         pageToken,
+		})
+		if err != nil {
+			return nil, apperrors.Wrap(err, "search provisioned products")
+		}
+		for _, product := range output.ProvisionedProducts {
+			resources = append(resources, NewProvisionedProductResource(product))
+		}
+		if output.NextPageToken == nil || *output.NextPageToken == "" {
+			break
+		}
+		pageToken = output.NextPageToken
+	}
+
+	return resources, nil
+}
+
+// Get returns a provisioned product with its outputs
+func (d *ProvisionedProductDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.SearchProvisionedProducts(ctx, &servicecatalog.SearchProvisionedProductsInput{
+		AccessLevelFilter: accountFilter,
+		Filters: map[string][]string{
+			string(types.ProvisionedProductViewFilterBySearchQuery): {"id:" + id},
+		},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "search provisioned product %s", id)
+	}
+	if len(output.ProvisionedProducts) == 0 {
+		return nil, fmt.Errorf("provisioned product not found: %s", id)
+	}
+
+	res := NewProvisionedProductResource(output.ProvisionedProducts[0])
+	outputs, err := d.client.GetProvisionedProductOutputs(ctx, &servicecatalog.GetProvisionedProductOutputsInput{
+		ProvisionedProductId: &id,
+	})
+	if err != nil {
+		log.Warn("failed to get provisioned product outputs", "id", id, "error", err)
+	} else {
+		res.Outputs = outputs.Outputs
+	}
+	return res, nil
+}
+
+func (d *ProvisionedProductDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for provisioned products")
+}
+
+// Supports returns supported operations
+func (d *ProvisionedProductDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// ProvisionedProductResource wraps a Service Catalog provisioned product
+type ProvisionedProductResource struct {
+	dao.BaseResource
+	Item    types.ProvisionedProductAttribute
+	Outputs []types.RecordOutput
+}
+
+// NewProvisionedProductResource creates a new ProvisionedProductResource
+func NewProvisionedProductResource(product types.ProvisionedProductAttribute) *ProvisionedProductResource {
+	tags := make(map[string]string, len(product.Tags))
+	for _, tag := range product.Tags {
+		tags[appaws.Str(tag.Key)] = appaws.Str(tag.Value)
+	}
+	return &ProvisionedProductResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(product.Id),
+			Name: appaws.Str(product.Name),
+			ARN:  appaws.Str(product.Arn),
+			Tags: tags,
+			Data: product,
+		},
+		Item: product,
+	}
+}
+
+// Status returns the provisioned product status
+func (r *ProvisionedProductResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// StatusMessage returns the message of the last status change
+func (r *ProvisionedProductResource) StatusMessage() string {
+	return appaws.Str(r.Item.StatusMessage)
+}
+
+// ProductName returns the name of the product this was provisioned from
+func (r *ProvisionedProductResource) ProductName() string {
+	return appaws.Str(r.Item.ProductName)
+}
+
+// ArtifactName returns the provisioned version of the product
+func (r *ProvisionedProductResource) ArtifactName() string {
+	return appaws.Str(r.Item.ProvisioningArtifactName)
+}
+
+// Type returns the provisioned product type (CFN_STACK or CFN_STACKSET)
+func (r *ProvisionedProductResource) Type() string {
+	return appaws.Str(r.Item.Type)
+}
+
+// PhysicalID returns the ARN of the underlying stack or stack set
+func (r *ProvisionedProductResource) PhysicalID() string {
+	return appaws.Str(r.Item.PhysicalId)
+}
+
+// StackName returns the CloudFormation stack name behind a CFN_STACK
+// product, or "" for other types
+func (r *ProvisionedProductResource) StackName() string {
+	if r.Type() != "CFN_STACK" {
+		return ""
+	}
+	// arn:aws:cloudformation:region:account:stack/NAME/UUID
+	if a := appaws.ParseARN(r.PhysicalID()); a != nil && a.ResourceType == "stack" {
+		name, _, _ := strings.Cut(a.ResourceID, "/")
+		return name
+	}
+	return ""
+}
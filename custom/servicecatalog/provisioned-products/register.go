@@ -0,0 +1,20 @@
+package provisionedproducts
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("servicecatalog", "provisioned-products", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewProvisionedProductDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewProvisionedProductRenderer()
+		},
+	})
+}
@@ -0,0 +1,176 @@
+package provisionedproducts
+
+import (
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure ProvisionedProductRenderer implements render.Navigator
+var _ render.Navigator = (*ProvisionedProductRenderer)(nil)
+
+// ProvisionedProductRenderer renders Service Catalog provisioned products
+type ProvisionedProductRenderer struct {
+	render.BaseRenderer
+}
+
+// NewProvisionedProductRenderer creates a new ProvisionedProductRenderer
+func NewProvisionedProductRenderer() render.Renderer {
+	return &ProvisionedProductRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "servicecatalog",
+			Resource: "provisioned-products",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "STATUS", Width: 16, Getter: getStatus, Priority: 1},
+				{Name: "PRODUCT", Width: 28, Getter: getProduct, Priority: 2},
+				{Name: "VERSION", Width: 14, Getter: getVersion, Priority: 3},
+				{Name: "TYPE", Width: 12, Getter: getType, Priority: 5},
+				{Name: "AGE", Width: 10, Getter: getAge, Priority: 4},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if pp, ok := r.(*ProvisionedProductResource); ok {
+		return pp.Status()
+	}
+	return ""
+}
+
+func getProduct(r dao.Resource) string {
+	if pp, ok := r.(*ProvisionedProductResource); ok {
+		return pp.ProductName()
+	}
+	return ""
+}
+
+func getVersion(r dao.Resource) string {
+	if pp, ok := r.(*ProvisionedProductResource); ok {
+		return pp.ArtifactName()
+	}
+	return ""
+}
+
+func getType(r dao.Resource) string {
+	if pp, ok := r.(*ProvisionedProductResource); ok {
+		return pp.Type()
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if pp, ok := r.(*ProvisionedProductResource); ok && pp.Item.CreatedTime != nil {
+		return render.FormatAge(*pp.Item.CreatedTime)
+	}
+	return ""
+}
+
+// statusStyle maps provisioned product statuses onto the common state colors
+func statusStyle(status string) string {
+	switch status {
+	case "AVAILABLE":
+		return "available"
+	case "UNDER_CHANGE", "PLAN_IN_PROGRESS":
+		return "pending"
+	case "TAINTED", "ERROR":
+		return "failed"
+	default:
+		return strings.ToLower(status)
+	}
+}
+
+// RenderDetail renders detailed provisioned product information
+func (r *ProvisionedProductRenderer) RenderDetail(resource dao.Resource) string {
+	pp, ok := resource.(*ProvisionedProductResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Provisioned Product", pp.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", pp.GetName())
+	d.Field("ID", pp.GetID())
+	d.Field("ARN", pp.GetARN())
+	d.FieldStyled("Status", pp.Status(), render.StateColorer()(statusStyle(pp.Status())))
+	if msg := pp.StatusMessage(); msg != "" {
+		d.Field("Status Message", msg)
+	}
+	d.Field("Type", pp.Type())
+	if pp.Item.CreatedTime != nil {
+		d.Field("Created", pp.Item.CreatedTime.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Product")
+	d.Field("Product", pp.ProductName())
+	d.FieldIf("Product ID", pp.Item.ProductId)
+	d.Field("Version", pp.ArtifactName())
+	d.FieldIf("Version ID", pp.Item.ProvisioningArtifactId)
+
+	d.Section("Provisioning")
+	if id := pp.PhysicalID(); id != "" {
+		d.Field("Physical ID", id)
+	}
+	d.FieldIf("Launched By", pp.Item.UserArnSession)
+	d.FieldIf("Last Record", pp.Item.LastRecordId)
+	d.FieldIf("Last Successful Record", pp.Item.LastSuccessfulProvisioningRecordId)
+
+	if len(pp.Outputs) > 0 {
+		d.Section("Outputs")
+		for _, out := range pp.Outputs {
+			d.Field(appaws.Str(out.OutputKey), appaws.Str(out.OutputValue))
+		}
+	}
+
+	d.Tags(pp.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ProvisionedProductRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	pp, ok := resource.(*ProvisionedProductResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: pp.GetName()},
+		{Label: "Status", Value: pp.Status(), Style: render.StateColorer()(statusStyle(pp.Status()))},
+		{Label: "Product", Value: pp.ProductName()},
+		{Label: "Version", Value: pp.ArtifactName()},
+	}
+	if stack := pp.StackName(); stack != "" {
+		fields = append(fields, render.SummaryField{Label: "Stack", Value: stack})
+	}
+	return fields
+}
+
+// Navigations links CloudFormation-backed products to their stack
+func (r *ProvisionedProductRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	pp, ok := resource.(*ProvisionedProductResource)
+	if !ok {
+		return nil
+	}
+	stack := pp.StackName()
+	if stack == "" {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "e", Label: "Stack Events", Service: "cloudformation", Resource: "events",
+			FilterField: "StackName", FilterValue: stack,
+		},
+		{
+			Key: "r", Label: "Stack Resources", Service: "cloudformation", Resource: "resources",
+			FilterField: "StackName", FilterValue: stack,
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package provisionedproducts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+)
+
+func TestProvisionedProductStackName(t *testing.T) {
+	tests := []struct {
+		name       string
+		typ        string
+		physicalID string
+		want       string
+	}{
+		{
+			name:       "stack",
+			typ:        "CFN_STACK",
+			physicalID: "arn:aws:cloudformation:us-east-1:123456789012:stack/SC-123456789012-pp-abc/0a1b2c3d-1111-2222-3333-444455556666",
+			want:       "SC-123456789012-pp-abc",
+		},
+		{
+			name:       "stack set",
+			typ:        "CFN_STACKSET",
+			physicalID: "arn:aws:cloudformation:us-east-1:123456789012:stackset/SC-123456789012-pp-def:0a1b2c3d",
+			want:       "",
+		},
+		{
+			name: "not yet provisioned",
+			typ:  "CFN_STACK",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := types.ProvisionedProductAttribute{
+				Id:   aws.String("pp-abc"),
+				Name: aws.String("team-bucket"),
+				Type: aws.String(tt.typ),
+			}
+			if tt.physicalID != "" {
+				product.PhysicalId = aws.String(tt.physicalID)
+			}
+			r := NewProvisionedProductResource(product)
+			if got := r.StackName(); got != tt.want {
+				t.Errorf("StackName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusStyle(t *testing.T) {
+	tests := map[string]string{
+		"AVAILABLE":    "available",
+		"UNDER_CHANGE": "pending",
+		"TAINTED":      "failed",
+		"ERROR":        "failed",
+	}
+	for status, want := range tests {
+		if got := statusStyle(status); got != want {
+			t.Errorf("statusStyle(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
@@ -20,6 +20,11 @@ func init() {
 			Operation:    "Unsubscribe",
 			Confirm:      action.ConfirmDangerous,
 			ConfirmToken: action.ConfirmTokenName,
+			// Pending subscriptions have no ARN and cannot be unsubscribed
+			Filter: func(r dao.Resource) bool {
+				sr, ok := r.(*SubscriptionResource)
+				return ok && !sr.IsPending()
+			},
 		},
 	})
 
@@ -45,7 +50,12 @@ func executeUnsubscribe(ctx context.Context, resource dao.Resource) action.Actio
 		return action.ActionResult{Success: false, Error: err}
 	}
 
-	subscriptionArn := resource.GetARN()
+	sr, ok := resource.(*SubscriptionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	subscriptionArn := sr.ARN()
 	_, err = client.Unsubscribe(ctx, &sns.UnsubscribeInput{
 		SubscriptionArn: &subscriptionArn,
 	})
@@ -2,6 +2,7 @@ package subscriptions
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/sns"
@@ -30,7 +31,14 @@ func NewSubscriptionDAO(ctx context.Context) (dao.DAO, error) {
 	}, nil
 }
 
+// pendingConfirmation is the placeholder ARN SNS returns for unconfirmed subscriptions
+const pendingConfirmation = "PendingConfirmation"
+
 func (d *SubscriptionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if topicArn := dao.GetFilterFromContext(ctx, "TopicArn"); topicArn != "" {
+		return d.listByTopic(ctx, topicArn)
+	}
+
 	input := &sns.ListSubscriptionsInput{}
 
 	var resources []dao.Resource
@@ -50,7 +58,35 @@ func (d *SubscriptionDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	return resources, nil
 }
 
+// listByTopic lists only the subscriptions of the given topic
+func (d *SubscriptionDAO) listByTopic(ctx context.Context, topicArn string) ([]dao.Resource, error) {
+	subs, err := appaws.Paginate(ctx, func(token *string) ([]types.Subscription, *string, error) {
+		output, err := d.client.ListSubscriptionsByTopic(ctx, &sns.ListSubscriptionsByTopicInput{
+			TopicArn:  &topicArn,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list subscriptions by topic %s", topicArn)
+		}
+		return output.Subscriptions, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(subs))
+	for i, sub := range subs {
+		resources[i] = NewSubscriptionResource(sub)
+	}
+	return resources, nil
+}
+
 func (d *SubscriptionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	// Pending subscriptions have no ARN yet, so there are no attributes to fetch
+	if strings.HasPrefix(id, pendingConfirmation) {
+		return nil, fmt.Errorf("subscription %s is pending confirmation", id)
+	}
+
 	// id is the subscription ARN
 	attrs, err := d.client.GetSubscriptionAttributes(ctx, &sns.GetSubscriptionAttributesInput{
 		SubscriptionArn: &id,
@@ -109,9 +145,18 @@ func NewSubscriptionResourceWithAttrs(sub types.Subscription, attrs map[string]s
 	arn := appaws.Str(sub.SubscriptionArn)
 	name := appaws.Str(sub.Endpoint) // Use endpoint as display name
 
+	// All pending subscriptions share the same placeholder ARN, so derive
+	// a unique ID from the topic, protocol and endpoint instead.
+	id := arn
+	if arn == pendingConfirmation {
+		id = fmt.Sprintf("%s:%s:%s:%s", pendingConfirmation, appaws.Str(sub.TopicArn), appaws.Str(sub.Protocol), name)
+		arn = ""
+	}
+
 	return &SubscriptionResource{
 		BaseResource: dao.BaseResource{
-			ID:   arn,
+			ID:   id,
+			ARN:  arn,
 			Name: name,
 			Tags: nil,
 			Data: sub,
@@ -174,5 +219,13 @@ func (r *SubscriptionResource) Owner() string {
 
 // IsPending returns whether the subscription is pending confirmation
 func (r *SubscriptionResource) IsPending() bool {
-	return r.ARN() == "PendingConfirmation"
+	return appaws.Str(r.Item.SubscriptionArn) == pendingConfirmation
+}
+
+// Status returns a human-readable confirmation status
+func (r *SubscriptionResource) Status() string {
+	if r.IsPending() {
+		return "Pending Confirmation"
+	}
+	return "Confirmed"
 }
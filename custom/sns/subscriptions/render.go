@@ -7,6 +7,7 @@ import (
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // Ensure SubscriptionRenderer implements render.Navigator
@@ -59,13 +60,10 @@ func NewSubscriptionRenderer() render.Renderer {
 				},
 				{
 					Name:  "STATUS",
-					Width: 12,
+					Width: 22,
 					Getter: func(r dao.Resource) string {
 						if sr, ok := r.(*SubscriptionResource); ok {
-							if sr.IsPending() {
-								return "Pending"
-							}
-							return "Confirmed"
+							return sr.Status()
 						}
 						return ""
 					},
@@ -96,17 +94,12 @@ func (r *SubscriptionRenderer) RenderDetail(resource dao.Resource) string {
 
 	d := render.NewDetailBuilder()
 
-	status := "Confirmed"
-	if sr.IsPending() {
-		status = "Pending Confirmation"
-	}
-
 	d.Title("SNS Subscription", sr.Protocol()+" → "+sr.Endpoint())
 
 	// Basic Info
 	d.Section("Basic Information")
 	d.Field("ARN", sr.ARN())
-	d.Field("Status", status)
+	d.FieldStyled("Status", sr.Status(), statusStyle(sr))
 	d.Field("Owner", sr.Owner())
 
 	// Topic
@@ -137,6 +130,14 @@ func (r *SubscriptionRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// statusStyle highlights subscriptions still waiting for endpoint confirmation
+func statusStyle(sr *SubscriptionResource) render.Style {
+	if sr.IsPending() {
+		return ui.PendingStyle()
+	}
+	return ui.SuccessStyle()
+}
+
 // prettyJSON formats JSON string with indentation
 func prettyJSON(s string) string {
 	var buf bytes.Buffer
@@ -153,14 +154,9 @@ func (r *SubscriptionRenderer) RenderSummary(resource dao.Resource) []render.Sum
 		return nil
 	}
 
-	status := "Confirmed"
-	if sr.IsPending() {
-		status = "Pending"
-	}
-
 	fields := []render.SummaryField{
 		{Label: "ARN", Value: sr.ARN()},
-		{Label: "Status", Value: status},
+		{Label: "Status", Value: sr.Status(), Style: statusStyle(sr)},
 		{Label: "Topic", Value: sr.TopicName()},
 	}
 
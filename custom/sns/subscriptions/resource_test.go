@@ -0,0 +1,60 @@
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+func TestNewSubscriptionResource_Confirmed(t *testing.T) {
+	arn := "arn:aws:sns:us-east-1:123456789012:my-topic:1234"
+	resource := NewSubscriptionResource(types.Subscription{
+		SubscriptionArn: aws.String(arn),
+		TopicArn:        aws.String("arn:aws:sns:us-east-1:123456789012:my-topic"),
+		Protocol:        aws.String("sqs"),
+		Endpoint:        aws.String("arn:aws:sqs:us-east-1:123456789012:my-queue"),
+	})
+
+	if resource.GetID() != arn {
+		t.Errorf("GetID() = %q, want %q", resource.GetID(), arn)
+	}
+	if resource.GetARN() != arn {
+		t.Errorf("GetARN() = %q, want %q", resource.GetARN(), arn)
+	}
+	if resource.IsPending() {
+		t.Error("IsPending() = true, want false")
+	}
+	if resource.Status() != "Confirmed" {
+		t.Errorf("Status() = %q, want %q", resource.Status(), "Confirmed")
+	}
+}
+
+func TestNewSubscriptionResource_PendingHasUniqueID(t *testing.T) {
+	topic := aws.String("arn:aws:sns:us-east-1:123456789012:my-topic")
+	a := NewSubscriptionResource(types.Subscription{
+		SubscriptionArn: aws.String("PendingConfirmation"),
+		TopicArn:        topic,
+		Protocol:        aws.String("email"),
+		Endpoint:        aws.String("a@example.com"),
+	})
+	b := NewSubscriptionResource(types.Subscription{
+		SubscriptionArn: aws.String("PendingConfirmation"),
+		TopicArn:        topic,
+		Protocol:        aws.String("email"),
+		Endpoint:        aws.String("b@example.com"),
+	})
+
+	if !a.IsPending() || !b.IsPending() {
+		t.Fatal("IsPending() = false, want true")
+	}
+	if a.GetID() == b.GetID() {
+		t.Errorf("pending subscriptions share ID %q", a.GetID())
+	}
+	if a.GetARN() != "" {
+		t.Errorf("GetARN() = %q, want empty for pending subscription", a.GetARN())
+	}
+	if a.Status() != "Pending Confirmation" {
+		t.Errorf("Status() = %q, want %q", a.Status(), "Pending Confirmation")
+	}
+}
@@ -3,16 +3,54 @@ package topics
 import (
 	"context"
 	"fmt"
+	"net/mail"
 
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 
+	lambdaClient "github.com/clawscli/claws/custom/lambda"
 	snsClient "github.com/clawscli/claws/custom/sns"
+	sqsClient "github.com/clawscli/claws/custom/sqs"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
 func init() {
 	action.Global.Register("sns", "topics", []action.Action{
+		{
+			Name:      "Subscribe Email",
+			Shortcut:  "e",
+			Type:      action.ActionTypeAPI,
+			Operation: "SubscribeEmail",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{Label: "Email address", Validate: validateEmail},
+			},
+		},
+		{
+			Name:      "Subscribe SQS Queue",
+			Shortcut:  "q",
+			Type:      action.ActionTypeAPI,
+			Operation: "SubscribeSQS",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{Label: "Queue", Options: listQueueURLs},
+			},
+		},
+		{
+			Name:      "Subscribe Lambda",
+			Shortcut:  "l",
+			Type:      action.ActionTypeAPI,
+			Operation: "SubscribeLambda",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{Label: "Function", Options: listFunctionARNs},
+			},
+		},
 		{
 			Name:         "Delete",
 			Shortcut:     "D",
@@ -30,6 +68,12 @@ func executeTopicAction(ctx context.Context, act action.Action, resource dao.Res
 	switch act.Operation {
 	case "DeleteTopic":
 		return executeDeleteTopic(ctx, resource)
+	case "SubscribeEmail":
+		return executeSubscribe(ctx, resource, "email", act.Input(0))
+	case "SubscribeSQS":
+		return executeSubscribeSQS(ctx, resource, act.Input(0))
+	case "SubscribeLambda":
+		return executeSubscribe(ctx, resource, "lambda", act.Input(0))
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -58,3 +102,95 @@ func executeDeleteTopic(ctx context.Context, resource dao.Resource) action.Actio
 		Message: fmt.Sprintf("Deleted topic %s", resource.GetName()),
 	}
 }
+
+func validateEmail(value string) error {
+	if _, err := mail.ParseAddress(value); err != nil {
+		return fmt.Errorf("invalid email address")
+	}
+	return nil
+}
+
+// listQueueURLs lists SQS queue URLs for the subscribe picker
+func listQueueURLs(ctx context.Context, _ dao.Resource) ([]string, error) {
+	client, err := sqsClient.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []string
+	paginator := sqs.NewListQueuesPaginator(client, &sqs.ListQueuesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list queues")
+		}
+		urls = append(urls, output.QueueUrls...)
+	}
+	return urls, nil
+}
+
+// listFunctionARNs lists Lambda function ARNs for the subscribe picker
+func listFunctionARNs(ctx context.Context, _ dao.Resource) ([]string, error) {
+	client, err := lambdaClient.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var arns []string
+	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list functions")
+		}
+		for _, fn := range output.Functions {
+			arns = append(arns, appaws.Str(fn.FunctionArn))
+		}
+	}
+	return arns, nil
+}
+
+func executeSubscribeSQS(ctx context.Context, resource dao.Resource, queueURL string) action.ActionResult {
+	client, err := sqsClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	// SNS requires the queue ARN, while ListQueues only returns URLs
+	output, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       &queueURL,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn},
+	})
+	if err != nil {
+		return action.FailResultf(err, "get queue arn %s", queueURL)
+	}
+
+	queueArn := output.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
+	if queueArn == "" {
+		return action.FailResult(fmt.Errorf("queue %s has no ARN", queueURL))
+	}
+	return executeSubscribe(ctx, resource, "sqs", queueArn)
+}
+
+func executeSubscribe(ctx context.Context, resource dao.Resource, protocol, endpoint string) action.ActionResult {
+	client, err := getSNSClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	topicArn := resource.GetARN()
+	output, err := client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn:              &topicArn,
+		Protocol:              &protocol,
+		Endpoint:              &endpoint,
+		ReturnSubscriptionArn: true,
+	})
+	if err != nil {
+		return action.FailResultf(err, "subscribe %s to topic %s", endpoint, resource.GetName())
+	}
+
+	if protocol == "email" {
+		return action.SuccessResult(fmt.Sprintf("Subscribed %s (pending confirmation)", endpoint))
+	}
+	return action.SuccessResult(fmt.Sprintf("Subscribed %s as %s", endpoint, appaws.Str(output.SubscriptionArn)))
+}
@@ -2,7 +2,11 @@ package queues
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -11,6 +15,7 @@ import (
 	"github.com/clawscli/claws/internal/action"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
 func init() {
@@ -30,6 +35,35 @@ func init() {
 			Operation: "SendTestMessage",
 			Confirm:   action.ConfirmSimple,
 		},
+		{
+			Name:      "Edit Attributes",
+			Shortcut:  "e",
+			Type:      action.ActionTypeAPI,
+			Operation: "SetQueueAttributes",
+			Confirm:   action.ConfirmSimple,
+			Prompts: []action.Prompt{
+				{
+					Label:    "Visibility timeout (seconds)",
+					Default:  func(r dao.Resource) string { return queueAttr(r, "VisibilityTimeout") },
+					Validate: intRange(0, 43200),
+				},
+				{
+					Label:    "Message retention (seconds)",
+					Default:  func(r dao.Resource) string { return queueAttr(r, "MessageRetentionPeriod") },
+					Validate: intRange(60, 1209600),
+				},
+				{
+					Label:   "Dead-letter queue",
+					Options: listDeadLetterQueueOptions,
+				},
+				{
+					Label:    "Max receive count",
+					Default:  defaultMaxReceiveCount,
+					Validate: intRange(1, 1000),
+				},
+			},
+			Preview: previewAttributeEdit,
+		},
 		{
 			Name:      "Delete",
 			Shortcut:  "D",
@@ -52,6 +86,8 @@ func executeQueueAction(ctx context.Context, act action.Action, resource dao.Res
 		return executeSendTestMessage(ctx, resource)
 	case "DeleteQueue":
 		return executeDeleteQueue(ctx, resource)
+	case "SetQueueAttributes":
+		return executeSetQueueAttributes(ctx, resource, act.Inputs)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -157,3 +193,215 @@ func executeDeleteQueue(ctx context.Context, resource dao.Resource) action.Actio
 		Message: fmt.Sprintf("Deleted queue %s", queueName),
 	}
 }
+
+// noDeadLetterQueue is the picker option that removes the redrive policy
+const noDeadLetterQueue = "(none)"
+
+// defaultMaxReceiveCount is used when the queue has no redrive policy yet
+const initialMaxReceiveCount = 10
+
+// attributeChange is a single before/after value in an attribute edit
+type attributeChange struct {
+	Label string
+	Old   string
+	New   string
+}
+
+func queueAttr(resource dao.Resource, name string) string {
+	if q, ok := resource.(*QueueResource); ok {
+		return q.Attributes[name]
+	}
+	return ""
+}
+
+func defaultMaxReceiveCount(resource dao.Resource) string {
+	if q, ok := resource.(*QueueResource); ok {
+		if _, count := q.RedriveTarget(); count > 0 {
+			return strconv.Itoa(count)
+		}
+	}
+	return strconv.Itoa(initialMaxReceiveCount)
+}
+
+// intRange validates that the input is an integer within [minValue, maxValue]
+func intRange(minValue, maxValue int) func(string) error {
+	return func(value string) error {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < minValue || n > maxValue {
+			return fmt.Errorf("enter a number between %d and %d", minValue, maxValue)
+		}
+		return nil
+	}
+}
+
+// listDeadLetterQueueOptions lists queues usable as a DLQ for the resource.
+// The current DLQ (if any) comes first so Enter keeps it unchanged.
+func listDeadLetterQueueOptions(ctx context.Context, resource dao.Resource) ([]string, error) {
+	q, ok := resource.(*QueueResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+
+	client, err := getSQSClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []string
+	paginator := sqs.NewListQueuesPaginator(client, &sqs.ListQueuesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list queues")
+		}
+		urls = append(urls, output.QueueUrls...)
+	}
+
+	current, _ := q.RedriveTarget()
+	return deadLetterQueueOptions(q, current, urls), nil
+}
+
+// deadLetterQueueOptions builds DLQ ARNs from queue URLs. A DLQ must live in
+// the same account and region and match the FIFO type, so ARNs are derived
+// from the source queue's ARN instead of fetching attributes per queue.
+func deadLetterQueueOptions(q *QueueResource, current string, urls []string) []string {
+	arn := q.GetARN()
+	prefix := arn[:strings.LastIndex(arn, ":")+1]
+
+	var candidates []string
+	for _, u := range urls {
+		name := appaws.ExtractResourceName(u)
+		if name == q.GetName() || strings.HasSuffix(name, ".fifo") != q.IsFIFO() {
+			continue
+		}
+		if candidate := prefix + name; candidate != current {
+			candidates = append(candidates, candidate)
+		}
+	}
+	slices.Sort(candidates)
+
+	options := []string{noDeadLetterQueue}
+	if current != "" {
+		options = []string{current, noDeadLetterQueue}
+	}
+	return append(options, candidates...)
+}
+
+// planAttributeEdit compares the prompted values with the queue's current
+// attributes and returns the changes and the attributes to set.
+// Inputs: visibility timeout, retention period, DLQ ARN, max receive count.
+func planAttributeEdit(q *QueueResource, inputs []string) ([]attributeChange, map[string]string, error) {
+	if len(inputs) != 4 {
+		return nil, nil, action.ErrMissingInput
+	}
+
+	var changes []attributeChange
+	attrs := map[string]string{}
+
+	for i, attr := range []struct{ name, label string }{
+		{"VisibilityTimeout", "Visibility timeout"},
+		{"MessageRetentionPeriod", "Message retention"},
+	} {
+		value := strings.TrimSpace(inputs[i])
+		if old := q.Attributes[attr.name]; value != old {
+			changes = append(changes, attributeChange{Label: attr.label, Old: old, New: value})
+			attrs[attr.name] = value
+		}
+	}
+
+	oldTarget, oldCount := q.RedriveTarget()
+	newTarget := inputs[2]
+	if newTarget == noDeadLetterQueue {
+		newTarget = ""
+	}
+	newCount, err := strconv.Atoi(strings.TrimSpace(inputs[3]))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid max receive count %q", inputs[3])
+	}
+
+	switch {
+	case newTarget == "" && oldTarget != "":
+		changes = append(changes, attributeChange{Label: "Dead-letter queue", Old: oldTarget, New: noDeadLetterQueue})
+		attrs["RedrivePolicy"] = ""
+	case newTarget != "" && (newTarget != oldTarget || newCount != oldCount):
+		if newTarget != oldTarget {
+			changes = append(changes, attributeChange{Label: "Dead-letter queue", Old: displayTarget(oldTarget), New: newTarget})
+		}
+		if newCount != oldCount {
+			changes = append(changes, attributeChange{Label: "Max receive count", Old: displayCount(oldCount), New: strconv.Itoa(newCount)})
+		}
+		policy, err := json.Marshal(redrivePolicy{DeadLetterTargetArn: newTarget, MaxReceiveCount: newCount})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "marshal redrive policy")
+		}
+		attrs["RedrivePolicy"] = string(policy)
+	}
+
+	return changes, attrs, nil
+}
+
+func displayTarget(arn string) string {
+	if arn == "" {
+		return noDeadLetterQueue
+	}
+	return arn
+}
+
+func displayCount(count int) string {
+	if count == 0 {
+		return "-"
+	}
+	return strconv.Itoa(count)
+}
+
+// previewAttributeEdit renders the before/after diff shown in the confirmation
+func previewAttributeEdit(resource dao.Resource, inputs []string) string {
+	q, ok := resource.(*QueueResource)
+	if !ok {
+		return ""
+	}
+	changes, _, err := planAttributeEdit(q, inputs)
+	if err != nil {
+		return "Error: " + err.Error()
+	}
+	if len(changes) == 0 {
+		return "No changes"
+	}
+
+	lines := make([]string, len(changes))
+	for i, c := range changes {
+		lines[i] = fmt.Sprintf("%s: %s → %s", c.Label, c.Old, c.New)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func executeSetQueueAttributes(ctx context.Context, resource dao.Resource, inputs []string) action.ActionResult {
+	queue, ok := resource.(*QueueResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	changes, attrs, err := planAttributeEdit(queue, inputs)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if len(changes) == 0 {
+		return action.SuccessResult(fmt.Sprintf("No attribute changes for %s", queue.GetName()))
+	}
+
+	client, err := getSQSClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	queueUrl := queue.URL
+	_, err = client.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   &queueUrl,
+		Attributes: attrs,
+	})
+	if err != nil {
+		return action.FailResultf(err, "set attributes for queue %s", queue.GetName())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Updated %d attribute(s) on %s", len(changes), queue.GetName()))
+}
@@ -0,0 +1,133 @@
+package queues
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestQueue(name string, attrs map[string]string) *QueueResource {
+	attrs["QueueArn"] = "arn:aws:sqs:us-east-1:123456789012:" + name
+	return NewQueueResource("https://sqs.us-east-1.amazonaws.com/123456789012/"+name, attrs)
+}
+
+func TestPlanAttributeEdit(t *testing.T) {
+	const dlq = "arn:aws:sqs:us-east-1:123456789012:orders-dlq"
+	const otherDLQ = "arn:aws:sqs:us-east-1:123456789012:other-dlq"
+
+	base := map[string]string{
+		"VisibilityTimeout":      "30",
+		"MessageRetentionPeriod": "345600",
+		"RedrivePolicy":          `{"deadLetterTargetArn":"` + dlq + `","maxReceiveCount":5}`,
+	}
+
+	tests := []struct {
+		name        string
+		inputs      []string
+		wantChanges []attributeChange
+		wantAttrs   map[string]string
+	}{
+		{
+			name:        "no changes",
+			inputs:      []string{"30", "345600", dlq, "5"},
+			wantChanges: nil,
+			wantAttrs:   map[string]string{},
+		},
+		{
+			name:        "visibility timeout",
+			inputs:      []string{"60", "345600", dlq, "5"},
+			wantChanges: []attributeChange{{Label: "Visibility timeout", Old: "30", New: "60"}},
+			wantAttrs:   map[string]string{"VisibilityTimeout": "60"},
+		},
+		{
+			name:        "max receive count",
+			inputs:      []string{"30", "345600", dlq, "3"},
+			wantChanges: []attributeChange{{Label: "Max receive count", Old: "5", New: "3"}},
+			wantAttrs:   map[string]string{"RedrivePolicy": `{"deadLetterTargetArn":"` + dlq + `","maxReceiveCount":3}`},
+		},
+		{
+			name:        "change DLQ",
+			inputs:      []string{"30", "345600", otherDLQ, "5"},
+			wantChanges: []attributeChange{{Label: "Dead-letter queue", Old: dlq, New: otherDLQ}},
+			wantAttrs:   map[string]string{"RedrivePolicy": `{"deadLetterTargetArn":"` + otherDLQ + `","maxReceiveCount":5}`},
+		},
+		{
+			name:        "remove DLQ",
+			inputs:      []string{"30", "345600", noDeadLetterQueue, "5"},
+			wantChanges: []attributeChange{{Label: "Dead-letter queue", Old: dlq, New: noDeadLetterQueue}},
+			wantAttrs:   map[string]string{"RedrivePolicy": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := map[string]string{}
+			for k, v := range base {
+				attrs[k] = v
+			}
+			q := newTestQueue("orders", attrs)
+
+			changes, gotAttrs, err := planAttributeEdit(q, tt.inputs)
+			if err != nil {
+				t.Fatalf("planAttributeEdit() error = %v", err)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("changes = %+v, want %+v", changes, tt.wantChanges)
+			}
+			if !reflect.DeepEqual(gotAttrs, tt.wantAttrs) {
+				t.Errorf("attrs = %v, want %v", gotAttrs, tt.wantAttrs)
+			}
+		})
+	}
+}
+
+func TestPlanAttributeEdit_AddDLQ(t *testing.T) {
+	q := newTestQueue("orders", map[string]string{"VisibilityTimeout": "30", "MessageRetentionPeriod": "345600"})
+	dlq := "arn:aws:sqs:us-east-1:123456789012:orders-dlq"
+
+	changes, _, err := planAttributeEdit(q, []string{"30", "345600", dlq, "10"})
+	if err != nil {
+		t.Fatalf("planAttributeEdit() error = %v", err)
+	}
+	want := []attributeChange{
+		{Label: "Dead-letter queue", Old: noDeadLetterQueue, New: dlq},
+		{Label: "Max receive count", Old: "-", New: "10"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("changes = %+v, want %+v", changes, want)
+	}
+
+	if _, _, err := planAttributeEdit(q, []string{"30"}); err == nil {
+		t.Error("expected error for missing inputs")
+	}
+}
+
+func TestDeadLetterQueueOptions(t *testing.T) {
+	q := newTestQueue("orders", map[string]string{})
+	urls := []string{
+		"https://sqs.us-east-1.amazonaws.com/123456789012/orders",
+		"https://sqs.us-east-1.amazonaws.com/123456789012/zeta-dlq",
+		"https://sqs.us-east-1.amazonaws.com/123456789012/orders-dlq",
+		"https://sqs.us-east-1.amazonaws.com/123456789012/events.fifo",
+	}
+
+	got := deadLetterQueueOptions(q, "", urls)
+	want := []string{
+		noDeadLetterQueue,
+		"arn:aws:sqs:us-east-1:123456789012:orders-dlq",
+		"arn:aws:sqs:us-east-1:123456789012:zeta-dlq",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("options = %v, want %v", got, want)
+	}
+
+	// Current DLQ is listed first so Enter keeps it
+	got = deadLetterQueueOptions(q, "arn:aws:sqs:us-east-1:123456789012:zeta-dlq", urls)
+	want = []string{
+		"arn:aws:sqs:us-east-1:123456789012:zeta-dlq",
+		noDeadLetterQueue,
+		"arn:aws:sqs:us-east-1:123456789012:orders-dlq",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("options = %v, want %v", got, want)
+	}
+}
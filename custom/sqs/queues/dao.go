@@ -2,6 +2,7 @@ package queues
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -248,3 +249,19 @@ func (r *QueueResource) DeadLetterTargetArn() string {
 	}
 	return ""
 }
+
+// redrivePolicy is the JSON document stored in the RedrivePolicy attribute
+type redrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int    `json:"maxReceiveCount"`
+}
+
+// RedriveTarget returns the DLQ ARN and max receive count from the redrive policy.
+// Returns ("", 0) if no redrive policy is configured.
+func (r *QueueResource) RedriveTarget() (string, int) {
+	var policy redrivePolicy
+	if err := json.Unmarshal([]byte(r.RedrivePolicy()), &policy); err != nil {
+		return "", 0
+	}
+	return policy.DeadLetterTargetArn, policy.MaxReceiveCount
+}
@@ -0,0 +1,178 @@
+package automationexecutions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	ssmstatus "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ExecutionDAO provides data access for SSM Automation executions
+type ExecutionDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewExecutionDAO creates a new ExecutionDAO
+func NewExecutionDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ExecutionDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "automation-executions"),
+		client:  ssm.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns executions (first page only).
+// For paginated access, use ListPage instead.
+func (d *ExecutionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 50, "")
+	return resources, err
+}
+
+// ListPage returns a page of executions, newest first, optionally limited to
+// the document given by the "DocumentName" filter.
+// Implements dao.PaginatedDAO interface.
+func (d *ExecutionDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	maxResults := int32(min(pageSize, 50)) // AWS API max
+
+	input := &ssm.DescribeAutomationExecutionsInput{
+		MaxResults: &maxResults,
+	}
+	documentName := dao.GetFilterFromContext(ctx, "DocumentName")
+	if documentName != "" {
+		input.Filters = []types.AutomationExecutionFilter{
+			{Key: types.AutomationExecutionFilterKeyDocumentNamePrefix, Values: []string{documentName}},
+		}
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.DescribeAutomationExecutions(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "describe automation executions")
+	}
+
+	resources := make([]dao.Resource, 0, len(output.AutomationExecutionMetadataList))
+	for _, exec := range output.AutomationExecutionMetadataList {
+		// The API filters by prefix; keep only the exact document
+		if documentName != "" && appaws.Str(exec.DocumentName) != documentName {
+			continue
+		}
+		resources = append(resources, NewExecutionResource(exec))
+	}
+
+	return resources, appaws.Str(output.NextToken), nil
+}
+
+// Get returns an execution with its parameters, outputs and steps
+func (d *ExecutionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetAutomationExecution(ctx, &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get automation execution %s", id)
+	}
+	if output.AutomationExecution == nil {
+		return nil, fmt.Errorf("automation execution not found: %s", id)
+	}
+	return NewExecutionResourceFromExecution(*output.AutomationExecution), nil
+}
+
+func (d *ExecutionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for automation executions")
+}
+
+// Supports returns supported operations
+func (d *ExecutionDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExecutionResource wraps an SSM Automation execution
+type ExecutionResource struct {
+	dao.BaseResource
+	Item types.AutomationExecutionMetadata
+
+	// Execution is set by Get and carries parameters and step executions
+	Execution *types.AutomationExecution
+}
+
+// NewExecutionResource creates a new ExecutionResource
+func NewExecutionResource(exec types.AutomationExecutionMetadata) *ExecutionResource {
+	id := appaws.Str(exec.AutomationExecutionId)
+	return &ExecutionResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: exec,
+		},
+		Item: exec,
+	}
+}
+
+// NewExecutionResourceFromExecution creates an ExecutionResource from GetAutomationExecution output
+func NewExecutionResourceFromExecution(exec types.AutomationExecution) *ExecutionResource {
+	r := NewExecutionResource(types.AutomationExecutionMetadata{
+		AutomationExecutionId:       exec.AutomationExecutionId,
+		AutomationExecutionStatus:   exec.AutomationExecutionStatus,
+		CurrentAction:               exec.CurrentAction,
+		CurrentStepName:             exec.CurrentStepName,
+		DocumentName:                exec.DocumentName,
+		DocumentVersion:             exec.DocumentVersion,
+		ExecutedBy:                  exec.ExecutedBy,
+		ExecutionEndTime:            exec.ExecutionEndTime,
+		ExecutionStartTime:          exec.ExecutionStartTime,
+		FailureMessage:              exec.FailureMessage,
+		Mode:                        exec.Mode,
+		Outputs:                     exec.Outputs,
+		ParentAutomationExecutionId: exec.ParentAutomationExecutionId,
+		Target:                      exec.Target,
+		TargetParameterName:         exec.TargetParameterName,
+	})
+	r.Execution = &exec
+	r.Data = exec
+	return r
+}
+
+// Status returns the execution status
+func (r *ExecutionResource) Status() string {
+	return string(r.Item.AutomationExecutionStatus)
+}
+
+// InProgress reports whether the execution is still running
+func (r *ExecutionResource) InProgress() bool {
+	return ssmstatus.InProgress(r.Item.AutomationExecutionStatus)
+}
+
+// DocumentName returns the runbook that was executed
+func (r *ExecutionResource) DocumentName() string {
+	return appaws.Str(r.Item.DocumentName)
+}
+
+// Duration returns the run time so far, or 0 before the execution started
+func (r *ExecutionResource) Duration() time.Duration {
+	start := appaws.Time(r.Item.ExecutionStartTime)
+	if start.IsZero() {
+		return 0
+	}
+	end := appaws.Time(r.Item.ExecutionEndTime)
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return end.Sub(start)
+}
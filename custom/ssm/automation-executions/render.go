@@ -0,0 +1,194 @@
+package automationexecutions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ssmstatus "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ExecutionRenderer renders SSM Automation executions
+type ExecutionRenderer struct {
+	render.BaseRenderer
+}
+
+var _ render.Navigator = (*ExecutionRenderer)(nil)
+
+// NewExecutionRenderer creates a new ExecutionRenderer
+func NewExecutionRenderer() render.Renderer {
+	return &ExecutionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "automation-executions",
+			Cols: []render.Column{
+				{Name: "EXECUTION ID", Width: 38, Priority: 0, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "DOCUMENT", Width: 36, Priority: 1, Getter: getDocument},
+				{Name: "STATUS", Width: 14, Priority: 0, Getter: getStatus},
+				{Name: "CURRENT STEP", Width: 24, Priority: 3, Getter: getCurrentStep},
+				{Name: "STARTED", Width: 10, Priority: 2, Getter: getStarted},
+				{Name: "DURATION", Width: 10, Priority: 4, Getter: getDuration},
+			},
+		},
+	}
+}
+
+func getDocument(r dao.Resource) string {
+	if exec, ok := r.(*ExecutionResource); ok {
+		return exec.DocumentName()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if exec, ok := r.(*ExecutionResource); ok {
+		return exec.Status()
+	}
+	return ""
+}
+
+func getCurrentStep(r dao.Resource) string {
+	if exec, ok := r.(*ExecutionResource); ok && exec.InProgress() {
+		return appaws.Str(exec.Item.CurrentStepName)
+	}
+	return ""
+}
+
+func getStarted(r dao.Resource) string {
+	if exec, ok := r.(*ExecutionResource); ok {
+		return render.FormatAge(appaws.Time(exec.Item.ExecutionStartTime))
+	}
+	return ""
+}
+
+func getDuration(r dao.Resource) string {
+	if exec, ok := r.(*ExecutionResource); ok {
+		if d := exec.Duration(); d > 0 {
+			return render.FormatDuration(d)
+		}
+	}
+	return ""
+}
+
+// RenderDetail renders the execution, its parameters and step statuses
+func (rnd *ExecutionRenderer) RenderDetail(resource dao.Resource) string {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Automation Execution", exec.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Execution ID", exec.GetID())
+	d.Field("Document", exec.DocumentName())
+	d.FieldIf("Document Version", exec.Item.DocumentVersion)
+	d.FieldStyled("Status", exec.Status(), render.StateColorer()(ssmstatus.ColorStatus(exec.Item.AutomationExecutionStatus)))
+	d.Field("Mode", string(exec.Item.Mode))
+	d.FieldIf("Executed By", exec.Item.ExecutedBy)
+	d.FieldIf("Parent Execution", exec.Item.ParentAutomationExecutionId)
+	if exec.InProgress() {
+		d.FieldIf("Current Step", exec.Item.CurrentStepName)
+		d.FieldIf("Current Action", exec.Item.CurrentAction)
+	}
+
+	d.Section("Timing")
+	if start := appaws.Time(exec.Item.ExecutionStartTime); !start.IsZero() {
+		d.Field("Started", start.Format("2006-01-02 15:04:05"))
+	}
+	if end := appaws.Time(exec.Item.ExecutionEndTime); !end.IsZero() {
+		d.Field("Ended", end.Format("2006-01-02 15:04:05"))
+	}
+	if dur := exec.Duration(); dur > 0 {
+		d.Field("Duration", render.FormatDuration(dur))
+	}
+
+	if msg := appaws.Str(exec.Item.FailureMessage); msg != "" {
+		d.Section("Failure")
+		d.FieldStyled("Message", msg, ui.DangerStyle())
+	}
+
+	if exec.Execution != nil {
+		renderValues(d, "Parameters", exec.Execution.Parameters)
+
+		if steps := exec.Execution.StepExecutions; len(steps) > 0 {
+			d.Section("Steps")
+			for i, step := range steps {
+				label := fmt.Sprintf("%d. %s", i+1, appaws.Str(step.StepName))
+				value := fmt.Sprintf("%s (%s)", step.StepStatus, appaws.Str(step.Action))
+				d.FieldStyled(label, value, render.StateColorer()(ssmstatus.ColorStatus(step.StepStatus)))
+				if msg := appaws.Str(step.FailureMessage); msg != "" {
+					d.DimIndent(msg)
+				}
+			}
+			if exec.Execution.StepExecutionsTruncated {
+				d.DimIndent("Step list truncated; press s for all steps")
+			}
+		}
+	}
+
+	renderValues(d, "Outputs", exec.Item.Outputs)
+
+	return d.String()
+}
+
+// renderValues renders a parameter or output map in key order
+func renderValues(d *render.DetailBuilder, section string, values map[string][]string) {
+	if len(values) == 0 {
+		return
+	}
+	d.Section(section)
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		d.Field(k, strings.Join(values[k], ", "))
+	}
+}
+
+// RenderSummary returns summary fields for the header panel
+func (rnd *ExecutionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return rnd.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Execution", Value: exec.GetID()},
+		{Label: "Document", Value: exec.DocumentName()},
+		{Label: "Status", Value: exec.Status(), Style: render.StateColorer()(ssmstatus.ColorStatus(exec.Item.AutomationExecutionStatus))},
+	}
+	if exec.InProgress() {
+		if step := appaws.Str(exec.Item.CurrentStepName); step != "" {
+			fields = append(fields, render.SummaryField{Label: "Current Step", Value: step})
+		}
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (rnd *ExecutionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "s",
+			Label:       "Steps",
+			Service:     "ssm",
+			Resource:    "automation-steps",
+			FilterField: "AutomationExecutionId",
+			FilterValue: exec.GetID(),
+			AutoReload:  true,
+		},
+	}
+}
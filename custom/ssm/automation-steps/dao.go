@@ -0,0 +1,152 @@
+package automationsteps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	ssmstatus "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// StepDAO provides data access for the steps of an Automation execution
+type StepDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewStepDAO creates a new StepDAO
+func NewStepDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &StepDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "automation-steps"),
+		client:  ssm.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the steps of the execution given by the "AutomationExecutionId"
+// filter, in execution order
+func (d *StepDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	executionID := dao.GetFilterFromContext(ctx, "AutomationExecutionId")
+	if executionID == "" {
+		return nil, fmt.Errorf("AutomationExecutionId filter required")
+	}
+
+	steps, err := d.describe(ctx, executionID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(steps))
+	for i, step := range steps {
+		resources[i] = NewStepResource(step, executionID, i+1)
+	}
+	return resources, nil
+}
+
+// Get returns a single step of the filtered execution
+func (d *StepDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	executionID := dao.GetFilterFromContext(ctx, "AutomationExecutionId")
+	if executionID == "" {
+		return nil, fmt.Errorf("AutomationExecutionId filter required")
+	}
+
+	steps, err := d.describe(ctx, executionID, []types.StepExecutionFilter{
+		{Key: types.StepExecutionFilterKeyStepExecutionId, Values: []string{id}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("step execution not found: %s", id)
+	}
+	return NewStepResource(steps[0], executionID, 0), nil
+}
+
+func (d *StepDAO) describe(ctx context.Context, executionID string, filters []types.StepExecutionFilter) ([]types.StepExecution, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.StepExecution, *string, error) {
+		output, err := d.client.DescribeAutomationStepExecutions(ctx, &ssm.DescribeAutomationStepExecutionsInput{
+			AutomationExecutionId: &executionID,
+			Filters:               filters,
+			NextToken:             token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe steps of automation execution %s", executionID)
+		}
+		return output.StepExecutions, output.NextToken, nil
+	})
+}
+
+func (d *StepDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for automation steps")
+}
+
+// Supports returns supported operations
+func (d *StepDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// StepResource wraps a step of an Automation execution
+type StepResource struct {
+	dao.BaseResource
+	Item        types.StepExecution
+	ExecutionID string
+
+	// Index is the 1-based position in the execution, or 0 when unknown
+	Index int
+}
+
+// NewStepResource creates a new StepResource
+func NewStepResource(step types.StepExecution, executionID string, index int) *StepResource {
+	return &StepResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(step.StepExecutionId),
+			Name: appaws.Str(step.StepName),
+			Data: step,
+		},
+		Item:        step,
+		ExecutionID: executionID,
+		Index:       index,
+	}
+}
+
+// Status returns the step status
+func (r *StepResource) Status() string {
+	return string(r.Item.StepStatus)
+}
+
+// Action returns the automation action, e.g. aws:executeAwsApi
+func (r *StepResource) Action() string {
+	return appaws.Str(r.Item.Action)
+}
+
+// InProgress reports whether the step is still running
+func (r *StepResource) InProgress() bool {
+	return ssmstatus.InProgress(r.Item.StepStatus)
+}
+
+// Duration returns the run time so far, or 0 before the step started
+func (r *StepResource) Duration() time.Duration {
+	start := appaws.Time(r.Item.ExecutionStartTime)
+	if start.IsZero() {
+		return 0
+	}
+	end := appaws.Time(r.Item.ExecutionEndTime)
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return end.Sub(start)
+}
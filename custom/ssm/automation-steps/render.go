@@ -0,0 +1,147 @@
+package automationsteps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ssmstatus "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// StepRenderer renders Automation execution steps
+type StepRenderer struct {
+	render.BaseRenderer
+}
+
+// NewStepRenderer creates a new StepRenderer
+func NewStepRenderer() render.Renderer {
+	return &StepRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "automation-steps",
+			Cols: []render.Column{
+				{Name: "#", Width: 4, Priority: 0, Getter: getIndex},
+				{Name: "STEP", Width: 30, Priority: 0, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ACTION", Width: 24, Priority: 2, Getter: getAction},
+				{Name: "STATUS", Width: 14, Priority: 0, Getter: getStatus},
+				{Name: "DURATION", Width: 10, Priority: 3, Getter: getDuration},
+				{Name: "MESSAGE", Width: 50, Priority: 1, Getter: getMessage},
+			},
+		},
+	}
+}
+
+func getIndex(r dao.Resource) string {
+	if step, ok := r.(*StepResource); ok && step.Index > 0 {
+		return fmt.Sprintf("%d", step.Index)
+	}
+	return ""
+}
+
+func getAction(r dao.Resource) string {
+	if step, ok := r.(*StepResource); ok {
+		return step.Action()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if step, ok := r.(*StepResource); ok {
+		return step.Status()
+	}
+	return ""
+}
+
+func getDuration(r dao.Resource) string {
+	if step, ok := r.(*StepResource); ok {
+		if d := step.Duration(); d > 0 {
+			return render.FormatDuration(d)
+		}
+	}
+	return ""
+}
+
+func getMessage(r dao.Resource) string {
+	if step, ok := r.(*StepResource); ok {
+		return appaws.Str(step.Item.FailureMessage)
+	}
+	return ""
+}
+
+// RenderDetail renders a step with its inputs, outputs and failure details
+func (rnd *StepRenderer) RenderDetail(resource dao.Resource) string {
+	step, ok := resource.(*StepResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Automation Step", step.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Step", step.GetName())
+	d.Field("Step Execution ID", step.GetID())
+	d.Field("Execution ID", step.ExecutionID)
+	d.Field("Action", step.Action())
+	d.FieldStyled("Status", step.Status(), render.StateColorer()(ssmstatus.ColorStatus(step.Item.StepStatus)))
+	d.FieldIf("On Failure", step.Item.OnFailure)
+	d.FieldIf("Next Step", step.Item.NextStep)
+	if start := appaws.Time(step.Item.ExecutionStartTime); !start.IsZero() {
+		d.Field("Started", start.Format("2006-01-02 15:04:05"))
+	}
+	if dur := step.Duration(); dur > 0 {
+		d.Field("Duration", render.FormatDuration(dur))
+	}
+
+	if msg := appaws.Str(step.Item.FailureMessage); msg != "" {
+		d.Section("Failure")
+		d.FieldStyled("Message", msg, ui.DangerStyle())
+		if fd := step.Item.FailureDetails; fd != nil {
+			d.FieldIf("Stage", fd.FailureStage)
+			d.FieldIf("Type", fd.FailureType)
+		}
+	}
+
+	if len(step.Item.Inputs) > 0 {
+		d.Section("Inputs")
+		for _, k := range sortedKeys(step.Item.Inputs) {
+			d.Field(k, step.Item.Inputs[k])
+		}
+	}
+	if len(step.Item.Outputs) > 0 {
+		d.Section("Outputs")
+		for _, k := range sortedKeys(step.Item.Outputs) {
+			d.Field(k, strings.Join(step.Item.Outputs[k], ", "))
+		}
+	}
+
+	return d.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RenderSummary returns summary fields for the header panel
+func (rnd *StepRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	step, ok := resource.(*StepResource)
+	if !ok {
+		return rnd.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Step", Value: step.GetName()},
+		{Label: "Action", Value: step.Action()},
+		{Label: "Status", Value: step.Status(), Style: render.StateColorer()(ssmstatus.ColorStatus(step.Item.StepStatus))},
+		{Label: "Execution", Value: step.ExecutionID},
+	}
+}
@@ -0,0 +1,18 @@
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an SSM client configured for the current context
+func GetClient(ctx context.Context) (*ssm.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ssm.NewFromConfig(cfg), nil
+}
@@ -0,0 +1,163 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	ssmclient "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ssm", "documents", []action.Action{
+		{
+			Name:      "Start Automation",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartAutomationExecution",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				dr, ok := r.(*DocumentResource)
+				return ok && dr.IsAutomation()
+			},
+			PromptsFunc: parameterPrompts,
+		},
+	})
+
+	action.RegisterExecutor("ssm", "documents", executeDocumentAction)
+}
+
+func executeDocumentAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	dr, ok := resource.(*DocumentResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "StartAutomationExecution":
+		return executeStartAutomation(ctx, dr, act.Inputs)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// documentParameters returns the parameters of the document, describing it
+// when the resource came from a list
+func documentParameters(ctx context.Context, client *ssm.Client, dr *DocumentResource) ([]types.DocumentParameter, error) {
+	if dr.Description != nil {
+		return dr.Description.Parameters, nil
+	}
+	desc, err := Describe(ctx, client, dr.GetID())
+	if err != nil {
+		return nil, err
+	}
+	dr.Description = desc
+	return desc.Parameters, nil
+}
+
+// parameterPrompts builds one prompt per document parameter, pre-filled with its default
+func parameterPrompts(ctx context.Context, r dao.Resource) ([]action.Prompt, error) {
+	dr, ok := r.(*DocumentResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+	client, err := ssmclient.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := documentParameters(ctx, client, dr)
+	if err != nil {
+		return nil, err
+	}
+
+	prompts := make([]action.Prompt, len(params))
+	for i, p := range params {
+		prompts[i] = parameterPrompt(p)
+	}
+	return prompts, nil
+}
+
+func parameterPrompt(p types.DocumentParameter) action.Prompt {
+	name := appaws.Str(p.Name)
+	required := p.DefaultValue == nil
+
+	label := fmt.Sprintf("%s (%s)", name, p.Type)
+	if required {
+		label = fmt.Sprintf("%s (%s, required)", name, p.Type)
+	}
+
+	prompt := action.Prompt{
+		Label: label,
+		Default: func(dao.Resource) string {
+			return appaws.Str(p.DefaultValue)
+		},
+	}
+	if required {
+		prompt.Validate = func(value string) error {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("%s is required", name)
+			}
+			return nil
+		}
+	}
+	return prompt
+}
+
+// ParameterValues maps prompt inputs onto parameter names. Empty inputs are
+// omitted so the document default applies; StringList values are comma-separated.
+func ParameterValues(params []types.DocumentParameter, inputs []string) map[string][]string {
+	values := make(map[string][]string, len(params))
+	for i, p := range params {
+		if i >= len(inputs) {
+			break
+		}
+		input := strings.TrimSpace(inputs[i])
+		if input == "" {
+			continue
+		}
+		if p.Type == types.DocumentParameterTypeStringList {
+			var list []string
+			for _, v := range strings.Split(input, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					list = append(list, v)
+				}
+			}
+			values[appaws.Str(p.Name)] = list
+			continue
+		}
+		values[appaws.Str(p.Name)] = []string{input}
+	}
+	return values
+}
+
+func executeStartAutomation(ctx context.Context, dr *DocumentResource, inputs []string) action.ActionResult {
+	client, err := ssmclient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	params, err := documentParameters(ctx, client, dr)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if len(inputs) != len(params) {
+		return action.FailResult(action.ErrMissingInput)
+	}
+
+	name := dr.GetID()
+	output, err := client.StartAutomationExecution(ctx, &ssm.StartAutomationExecutionInput{
+		DocumentName: &name,
+		Parameters:   ParameterValues(params, inputs),
+	})
+	if err != nil {
+		return action.FailResultf(err, "start automation %s", name)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Started automation %s (execution %s); press e to follow its steps",
+		name, appaws.Str(output.AutomationExecutionId)))
+}
@@ -0,0 +1,194 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DocumentDAO provides data access for SSM documents
+type DocumentDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewDocumentDAO creates a new DocumentDAO
+func NewDocumentDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DocumentDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "documents"),
+		client:  ssm.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns documents (first page only).
+// For paginated access, use ListPage instead.
+func (d *DocumentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 50, "")
+	return resources, err
+}
+
+// ListPage returns a page of documents owned by the account, or of all
+// documents (including Amazon-owned runbooks) when "AllOwners" is set.
+// Implements dao.PaginatedDAO interface.
+func (d *DocumentDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	maxResults := int32(min(pageSize, 50)) // AWS API max
+
+	owner := "Self"
+	if dao.GetFilterFromContext(ctx, "AllOwners") == "true" {
+		owner = "All"
+	}
+
+	input := &ssm.ListDocumentsInput{
+		MaxResults: &maxResults,
+		Filters: []types.DocumentKeyValuesFilter{
+			{Key: appaws.StringPtr("Owner"), Values: []string{owner}},
+		},
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.ListDocuments(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "list documents")
+	}
+
+	resources := make([]dao.Resource, len(output.DocumentIdentifiers))
+	for i, doc := range output.DocumentIdentifiers {
+		resources[i] = NewDocumentResource(doc)
+	}
+
+	return resources, appaws.Str(output.NextToken), nil
+}
+
+// Get returns a document with its description and parameters
+func (d *DocumentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	desc, err := Describe(ctx, d.client, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewDocumentResourceFromDescription(*desc), nil
+}
+
+func (d *DocumentDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for SSM documents")
+}
+
+// Supports returns supported operations
+func (d *DocumentDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// Describe fetches the default version of a document
+func Describe(ctx context.Context, client *ssm.Client, name string) (*types.DocumentDescription, error) {
+	output, err := client.DescribeDocument(ctx, &ssm.DescribeDocumentInput{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe document %s", name)
+	}
+	if output.Document == nil {
+		return nil, fmt.Errorf("document not found: %s", name)
+	}
+	return output.Document, nil
+}
+
+// DocumentResource wraps an SSM document
+type DocumentResource struct {
+	dao.BaseResource
+	Item types.DocumentIdentifier
+
+	// Description is set by Get; list results only carry the identifier
+	Description *types.DocumentDescription
+}
+
+// NewDocumentResource creates a new DocumentResource
+func NewDocumentResource(doc types.DocumentIdentifier) *DocumentResource {
+	name := appaws.Str(doc.Name)
+	return &DocumentResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Tags: tagsToMap(doc.Tags),
+			Data: doc,
+		},
+		Item: doc,
+	}
+}
+
+// NewDocumentResourceFromDescription creates a DocumentResource from DescribeDocument output
+func NewDocumentResourceFromDescription(desc types.DocumentDescription) *DocumentResource {
+	r := NewDocumentResource(types.DocumentIdentifier{
+		Name:            desc.Name,
+		DisplayName:     desc.DisplayName,
+		Author:          desc.Author,
+		CreatedDate:     desc.CreatedDate,
+		DocumentFormat:  desc.DocumentFormat,
+		DocumentType:    desc.DocumentType,
+		DocumentVersion: desc.DocumentVersion,
+		Owner:           desc.Owner,
+		PlatformTypes:   desc.PlatformTypes,
+		SchemaVersion:   desc.SchemaVersion,
+		TargetType:      desc.TargetType,
+		VersionName:     desc.VersionName,
+		Tags:            desc.Tags,
+	})
+	r.Description = &desc
+	r.Data = desc
+	return r
+}
+
+// DocumentType returns Command, Automation, Session, ...
+func (r *DocumentResource) DocumentType() string {
+	return string(r.Item.DocumentType)
+}
+
+// IsAutomation reports whether the document is an Automation runbook
+func (r *DocumentResource) IsAutomation() bool {
+	return r.Item.DocumentType == types.DocumentTypeAutomation
+}
+
+// Owner returns the account ID or "Amazon"
+func (r *DocumentResource) Owner() string {
+	return appaws.Str(r.Item.Owner)
+}
+
+// Version returns the document version listed or described
+func (r *DocumentResource) Version() string {
+	return appaws.Str(r.Item.DocumentVersion)
+}
+
+// Platforms returns the supported platform types
+func (r *DocumentResource) Platforms() []string {
+	platforms := make([]string, len(r.Item.PlatformTypes))
+	for i, p := range r.Item.PlatformTypes {
+		platforms[i] = string(p)
+	}
+	return platforms
+}
+
+func tagsToMap(tags []types.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[appaws.Str(t.Key)] = appaws.Str(t.Value)
+	}
+	return m
+}
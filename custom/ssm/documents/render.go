@@ -0,0 +1,164 @@
+package documents
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// DocumentRenderer renders SSM documents
+type DocumentRenderer struct {
+	render.BaseRenderer
+}
+
+var _ render.Navigator = (*DocumentRenderer)(nil)
+
+// NewDocumentRenderer creates a new DocumentRenderer
+func NewDocumentRenderer() render.Renderer {
+	return &DocumentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "documents",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 45, Priority: 0, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TYPE", Width: 14, Priority: 1, Getter: getType},
+				{Name: "OWNER", Width: 14, Priority: 2, Getter: getOwner},
+				{Name: "VER", Width: 5, Priority: 3, Getter: getVersion},
+				{Name: "FORMAT", Width: 8, Priority: 4, Getter: getFormat},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.DocumentType()
+	}
+	return ""
+}
+
+func getOwner(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.Owner()
+	}
+	return ""
+}
+
+func getVersion(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.Version()
+	}
+	return ""
+}
+
+func getFormat(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return string(doc.Item.DocumentFormat)
+	}
+	return ""
+}
+
+// RenderDetail renders the document and its parameters
+func (rnd *DocumentRenderer) RenderDetail(resource dao.Resource) string {
+	doc, ok := resource.(*DocumentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("SSM Document", doc.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", doc.GetName())
+	d.FieldIf("Display Name", doc.Item.DisplayName)
+	d.Field("Type", doc.DocumentType())
+	d.Field("Owner", doc.Owner())
+	d.Field("Format", string(doc.Item.DocumentFormat))
+	d.FieldIf("Schema Version", doc.Item.SchemaVersion)
+	d.FieldIf("Target Type", doc.Item.TargetType)
+	if platforms := doc.Platforms(); len(platforms) > 0 {
+		d.Field("Platforms", strings.Join(platforms, ", "))
+	}
+	if created := appaws.Time(doc.Item.CreatedDate); !created.IsZero() {
+		d.Field("Created", created.Format("2006-01-02 15:04:05"))
+	}
+
+	if desc := doc.Description; desc != nil {
+		d.Section("Versions")
+		d.FieldIf("Default", desc.DefaultVersion)
+		d.FieldIf("Latest", desc.LatestVersion)
+		d.FieldIf("Version Name", desc.VersionName)
+		d.Field("Status", string(desc.Status))
+
+		if text := appaws.Str(desc.Description); text != "" {
+			d.Section("Description")
+			d.Line(text)
+		}
+
+		d.Section("Parameters")
+		if len(desc.Parameters) == 0 {
+			d.DimIndent("No parameters")
+		}
+		for _, p := range desc.Parameters {
+			value := string(p.Type)
+			if p.DefaultValue == nil {
+				value += ", required"
+			} else if def := appaws.Str(p.DefaultValue); def != "" {
+				value += fmt.Sprintf(", default %q", def)
+			}
+			d.Field(appaws.Str(p.Name), value)
+			if text := appaws.Str(p.Description); text != "" {
+				d.DimIndent(text)
+			}
+		}
+	}
+
+	d.Tags(doc.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (rnd *DocumentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	doc, ok := resource.(*DocumentResource)
+	if !ok {
+		return rnd.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: doc.GetName()},
+		{Label: "Type", Value: doc.DocumentType()},
+		{Label: "Owner", Value: doc.Owner()},
+		{Label: "Version", Value: doc.Version()},
+	}
+}
+
+// Navigations returns navigation shortcuts for automation runbooks
+func (rnd *DocumentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	doc, ok := resource.(*DocumentResource)
+	if !ok || !doc.IsAutomation() {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "e",
+			Label:       "Executions",
+			Service:     "ssm",
+			Resource:    "automation-executions",
+			FilterField: "DocumentName",
+			FilterValue: doc.GetName(),
+			AutoReload:  true,
+		},
+	}
+}
+
+// ListToggles switches between account-owned and all documents
+func (rnd *DocumentRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "o", ContextKey: "AllOwners", LabelOn: "all owners", LabelOff: "owned by me"},
+	}
+}
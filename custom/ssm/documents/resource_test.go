@@ -0,0 +1,68 @@
+package documents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestParameterValues(t *testing.T) {
+	params := []types.DocumentParameter{
+		{Name: aws.String("InstanceId"), Type: types.DocumentParameterTypeStringList},
+		{Name: aws.String("AutomationAssumeRole"), Type: types.DocumentParameterTypeString, DefaultValue: aws.String("")},
+		{Name: aws.String("Force"), Type: types.DocumentParameterTypeString, DefaultValue: aws.String("false")},
+	}
+
+	got := ParameterValues(params, []string{"i-1, i-2,", "", " true "})
+	want := map[string][]string{
+		"InstanceId": {"i-1", "i-2"},
+		"Force":      {"true"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParameterValues() = %v, want %v", got, want)
+	}
+}
+
+func TestParameterPrompt(t *testing.T) {
+	required := parameterPrompt(types.DocumentParameter{Name: aws.String("InstanceId"), Type: types.DocumentParameterTypeString})
+	if required.Label != "InstanceId (String, required)" {
+		t.Errorf("Label = %q", required.Label)
+	}
+	if required.Validate == nil || required.Validate(" ") == nil {
+		t.Error("required parameter should reject empty input")
+	}
+
+	optional := parameterPrompt(types.DocumentParameter{
+		Name:         aws.String("Force"),
+		Type:         types.DocumentParameterTypeString,
+		DefaultValue: aws.String("false"),
+	})
+	if optional.Validate != nil {
+		t.Error("optional parameter should not validate")
+	}
+	if got := optional.Default(nil); got != "false" {
+		t.Errorf("Default() = %q, want false", got)
+	}
+}
+
+func TestNewDocumentResourceFromDescription(t *testing.T) {
+	r := NewDocumentResourceFromDescription(types.DocumentDescription{
+		Name:            aws.String("AWS-RestartEC2Instance"),
+		DocumentType:    types.DocumentTypeAutomation,
+		DocumentVersion: aws.String("3"),
+		Owner:           aws.String("Amazon"),
+		Tags:            []types.Tag{{Key: aws.String("team"), Value: aws.String("ops")}},
+	})
+
+	if !r.IsAutomation() || r.Version() != "3" || r.Owner() != "Amazon" {
+		t.Errorf("unexpected resource: %+v", r.Item)
+	}
+	if r.Description == nil {
+		t.Error("Description should be kept")
+	}
+	if r.GetTags()["team"] != "ops" {
+		t.Errorf("tags = %v", r.GetTags())
+	}
+}
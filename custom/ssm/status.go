@@ -0,0 +1,39 @@
+package ssm
+
+import "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+// ColorStatus maps automation execution and step statuses onto the shared
+// state colors (see render.StateColorer)
+func ColorStatus(status types.AutomationExecutionStatus) string {
+	switch status {
+	case types.AutomationExecutionStatusSuccess,
+		types.AutomationExecutionStatusCompletedWithSuccess,
+		types.AutomationExecutionStatusApproved:
+		return "active"
+	case types.AutomationExecutionStatusPending,
+		types.AutomationExecutionStatusInprogress,
+		types.AutomationExecutionStatusWaiting,
+		types.AutomationExecutionStatusPendingApproval,
+		types.AutomationExecutionStatusScheduled,
+		types.AutomationExecutionStatusRunbookInprogress,
+		types.AutomationExecutionStatusPendingChangeCalendarOverride:
+		return "pending"
+	case types.AutomationExecutionStatusFailed,
+		types.AutomationExecutionStatusTimedout,
+		types.AutomationExecutionStatusRejected,
+		types.AutomationExecutionStatusCompletedWithFailure,
+		types.AutomationExecutionStatusChangeCalendarOverrideRejected:
+		return "failed"
+	case types.AutomationExecutionStatusCancelling,
+		types.AutomationExecutionStatusCancelled,
+		types.AutomationExecutionStatusExited:
+		return "stopped"
+	default:
+		return ""
+	}
+}
+
+// InProgress reports whether an execution or step has not finished yet
+func InProgress(status types.AutomationExecutionStatus) bool {
+	return ColorStatus(status) == "pending" || status == types.AutomationExecutionStatusCancelling
+}
@@ -0,0 +1,31 @@
+package ssm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestColorStatus(t *testing.T) {
+	tests := []struct {
+		status     types.AutomationExecutionStatus
+		want       string
+		inProgress bool
+	}{
+		{types.AutomationExecutionStatusSuccess, "active", false},
+		{types.AutomationExecutionStatusInprogress, "pending", true},
+		{types.AutomationExecutionStatusWaiting, "pending", true},
+		{types.AutomationExecutionStatusFailed, "failed", false},
+		{types.AutomationExecutionStatusTimedout, "failed", false},
+		{types.AutomationExecutionStatusCancelling, "stopped", true},
+		{types.AutomationExecutionStatusCancelled, "stopped", false},
+	}
+	for _, tt := range tests {
+		if got := ColorStatus(tt.status); got != tt.want {
+			t.Errorf("ColorStatus(%s) = %q, want %q", tt.status, got, tt.want)
+		}
+		if got := InProgress(tt.status); got != tt.inProgress {
+			t.Errorf("InProgress(%s) = %v, want %v", tt.status, got, tt.inProgress)
+		}
+	}
+}
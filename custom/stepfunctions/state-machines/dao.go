@@ -151,6 +151,23 @@ func (r *StateMachineResource) RoleName() string {
 	return ""
 }
 
+// LogGroups returns the CloudWatch log groups execution history is sent to.
+// Only known once the detail has been fetched.
+func (r *StateMachineResource) LogGroups() []string {
+	if r.Detail == nil || r.Detail.LoggingConfiguration == nil {
+		return nil
+	}
+	var groups []string
+	for _, dest := range r.Detail.LoggingConfiguration.Destinations {
+		if dest.CloudWatchLogsLogGroup != nil {
+			if arn := appaws.Str(dest.CloudWatchLogsLogGroup.LogGroupArn); arn != "" {
+				groups = append(groups, appaws.LogGroupNameFromARN(arn))
+			}
+		}
+	}
+	return groups
+}
+
 // Definition returns the state machine definition
 func (r *StateMachineResource) Definition() string {
 	if r.Detail != nil && r.Detail.Definition != nil {
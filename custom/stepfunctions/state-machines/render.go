@@ -10,8 +10,10 @@ import (
 	"github.com/clawscli/claws/internal/ui"
 )
 
-// Ensure StateMachineRenderer implements render.Navigator
-var _ render.Navigator = (*StateMachineRenderer)(nil)
+var (
+	_ render.Navigator = (*StateMachineRenderer)(nil)
+	_ render.LogSource = (*StateMachineRenderer)(nil)
+)
 
 // StateMachineRenderer renders Step Functions state machines with custom columns
 type StateMachineRenderer struct {
@@ -203,6 +205,19 @@ func (r *StateMachineRenderer) Navigations(resource dao.Resource) []render.Navig
 	return navs
 }
 
+// LogTargets returns the log groups of the logging configuration
+func (r *StateMachineRenderer) LogTargets(resource dao.Resource) []render.LogTarget {
+	sr, ok := resource.(*StateMachineResource)
+	if !ok {
+		return nil
+	}
+	var targets []render.LogTarget
+	for _, group := range sr.LogGroups() {
+		targets = append(targets, render.LogTarget{Group: group})
+	}
+	return targets
+}
+
 func formatBool(b bool) string {
 	if b {
 		return "Yes"
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
@@ -15,7 +16,8 @@ import (
 // NatGatewayDAO provides data access for NAT Gateways
 type NatGatewayDAO struct {
 	dao.BaseDAO
-	client *ec2.Client
+	client   *ec2.Client
+	cwClient *cloudwatch.Client
 }
 
 // NewNatGatewayDAO creates a new NatGatewayDAO
@@ -25,27 +27,26 @@ func NewNatGatewayDAO(ctx context.Context) (dao.DAO, error) {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &NatGatewayDAO{
-		BaseDAO: dao.NewBaseDAO("vpc", "nat-gateways"),
-		client:  ec2.NewFromConfig(cfg),
+		BaseDAO:  dao.NewBaseDAO("vpc", "nat-gateways"),
+		client:   ec2.NewFromConfig(cfg),
+		cwClient: cloudwatch.NewFromConfig(cfg),
 	}, nil
 }
 
+// List returns all NAT gateways with their recent traffic and cross-AZ routing
 func (d *NatGatewayDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	paginator := ec2.NewDescribeNatGatewaysPaginator(d.client, &ec2.DescribeNatGatewaysInput{})
 
-	var resources []dao.Resource
+	var ngws []types.NatGateway
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, apperrors.Wrap(err, "describe nat gateways")
 		}
-
-		for _, ngw := range output.NatGateways {
-			resources = append(resources, NewNatGatewayResource(ngw))
-		}
+		ngws = append(ngws, output.NatGateways...)
 	}
 
-	return resources, nil
+	return d.newResources(ctx, ngws), nil
 }
 
 func (d *NatGatewayDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -60,7 +61,30 @@ func (d *NatGatewayDAO) Get(ctx context.Context, id string) (dao.Resource, error
 		return nil, fmt.Errorf("nat gateway not found: %s", id)
 	}
 
-	return NewNatGatewayResource(output.NatGateways[0]), nil
+	return d.newResources(ctx, output.NatGateways[:1])[0], nil
+}
+
+// newResources wraps the gateways, adding traffic for available gateways
+// and the subnets routing to them across AZs
+func (d *NatGatewayDAO) newResources(ctx context.Context, ngws []types.NatGateway) []dao.Resource {
+	var ids []string
+	for _, ngw := range ngws {
+		if ngw.State == types.NatGatewayStateAvailable {
+			ids = append(ids, appaws.Str(ngw.NatGatewayId))
+		}
+	}
+	traffic := fetchTraffic(ctx, d.cwClient, ids)
+	azs, cross := fetchRouting(ctx, d.client, ngws)
+
+	resources := make([]dao.Resource, len(ngws))
+	for i, ngw := range ngws {
+		r := NewNatGatewayResource(ngw)
+		r.Traffic = traffic[r.GetID()]
+		r.AZ = azs[r.GetID()]
+		r.CrossAZSubnets = cross[r.GetID()]
+		resources[i] = r
+	}
+	return resources
 }
 
 func (d *NatGatewayDAO) Delete(ctx context.Context, id string) error {
@@ -83,6 +107,13 @@ func (d *NatGatewayDAO) Delete(ctx context.Context, id string) error {
 type NatGatewayResource struct {
 	dao.BaseResource
 	Item types.NatGateway
+
+	// Traffic is the data processed over the last 7 days, nil without metrics
+	Traffic *Traffic
+	// AZ is the availability zone of the gateway's subnet
+	AZ string
+	// CrossAZSubnets route through this gateway from another AZ
+	CrossAZSubnets []CrossAZSubnet
 }
 
 // NewNatGatewayResource creates a new NatGatewayResource
@@ -103,6 +134,11 @@ func (r *NatGatewayResource) State() string {
 	return string(r.Item.State)
 }
 
+// Billed returns true if the gateway incurs hourly charges
+func (r *NatGatewayResource) Billed() bool {
+	return r.Item.State == types.NatGatewayStateAvailable
+}
+
 // ConnectivityType returns the connectivity type (public/private)
 func (r *NatGatewayResource) ConnectivityType() string {
 	return string(r.Item.ConnectivityType)
@@ -1,11 +1,13 @@
 package natgateways
 
 import (
+	"fmt"
 	"time"
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // Ensure NatGatewayRenderer implements render.Navigator
@@ -61,6 +63,30 @@ func NewNatGatewayRenderer() render.Renderer {
 					},
 					Priority: 3,
 				},
+				{
+					Name:     "AZ",
+					Width:    12,
+					Getter:   getAZ,
+					Priority: 4,
+				},
+				{
+					Name:     "TRAFFIC 7D",
+					Width:    11,
+					Getter:   getTraffic,
+					Priority: 5,
+				},
+				{
+					Name:     "EST/MO",
+					Width:    10,
+					Getter:   getMonthlyCost,
+					Priority: 6,
+				},
+				{
+					Name:     "CROSS-AZ",
+					Width:    10,
+					Getter:   getCrossAZ,
+					Priority: 7,
+				},
 				{
 					Name:  "SUBNET",
 					Width: 26,
@@ -70,7 +96,7 @@ func NewNatGatewayRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 4,
+					Priority: 8,
 				},
 				{
 					Name:  "PUBLIC IP",
@@ -81,7 +107,7 @@ func NewNatGatewayRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 5,
+					Priority: 9,
 				},
 				{
 					Name:  "PRIVATE IP",
@@ -92,13 +118,45 @@ func NewNatGatewayRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 6,
+					Priority: 10,
 				},
 			},
 		},
 	}
 }
 
+func getAZ(r dao.Resource) string {
+	if ngwr, ok := r.(*NatGatewayResource); ok {
+		return ngwr.AZ
+	}
+	return ""
+}
+
+func getTraffic(r dao.Resource) string {
+	if ngwr, ok := r.(*NatGatewayResource); ok && ngwr.Traffic != nil {
+		return render.FormatSize(int64(ngwr.Traffic.Processed()))
+	}
+	return ""
+}
+
+func getMonthlyCost(r dao.Resource) string {
+	if ngwr, ok := r.(*NatGatewayResource); ok && ngwr.Billed() {
+		return formatCost(ngwr.Traffic.MonthlyCost())
+	}
+	return ""
+}
+
+func getCrossAZ(r dao.Resource) string {
+	if ngwr, ok := r.(*NatGatewayResource); ok && len(ngwr.CrossAZSubnets) > 0 {
+		return fmt.Sprintf("%d subnets", len(ngwr.CrossAZSubnets))
+	}
+	return ""
+}
+
+func formatCost(v float64) string {
+	return fmt.Sprintf("$%.2f", v)
+}
+
 // RenderDetail renders detailed NAT gateway information
 func (r *NatGatewayRenderer) RenderDetail(resource dao.Resource) string {
 	ngwr, ok := resource.(*NatGatewayResource)
@@ -142,6 +200,32 @@ func (r *NatGatewayRenderer) RenderDetail(resource dao.Resource) string {
 		}
 	}
 
+	if ngwr.Billed() {
+		d.Section("Traffic (last 7 days)")
+		if ngwr.Traffic == nil {
+			d.DimIndent("No CloudWatch data")
+		} else {
+			d.Field("Processed", render.FormatSize(int64(ngwr.Traffic.Processed())))
+			d.Field("From VPC", render.FormatSize(int64(ngwr.Traffic.FromSource)))
+			d.Field("From Destinations", render.FormatSize(int64(ngwr.Traffic.FromDestination)))
+		}
+
+		d.Section("Estimated Monthly Cost")
+		d.Field("Hourly Charge", formatCost(hourlyPrice*hoursPerMonth))
+		d.Field("Data Processing", formatCost(ngwr.Traffic.MonthlyProcessingCost()))
+		d.Field("Total", formatCost(ngwr.Traffic.MonthlyCost()))
+		d.DimIndent("Extrapolated from the last 7 days at us-east-1 rates; data transfer is billed separately")
+	}
+
+	if len(ngwr.CrossAZSubnets) > 0 {
+		d.Section("Cross-AZ Routing")
+		d.FieldStyled("Warning", fmt.Sprintf("%d subnets outside %s route through this gateway", len(ngwr.CrossAZSubnets), ngwr.AZ), ui.WarningStyle())
+		for _, sub := range ngwr.CrossAZSubnets {
+			d.Field(sub.SubnetID, sub.AZ)
+		}
+		d.DimIndent("Traffic from these subnets also pays cross-AZ data transfer; consider a NAT gateway per AZ")
+	}
+
 	// Failure info
 	if ngwr.Item.FailureCode != nil && *ngwr.Item.FailureCode != "" {
 		d.Section("Failure Information")
@@ -177,6 +261,16 @@ func (r *NatGatewayRenderer) RenderSummary(resource dao.Resource) []render.Summa
 		{Label: "Private IP", Value: ngwr.PrivateIp()},
 	}
 
+	if ngwr.Traffic != nil {
+		fields = append(fields, render.SummaryField{Label: "Traffic 7d", Value: render.FormatSize(int64(ngwr.Traffic.Processed()))})
+	}
+	if ngwr.Billed() {
+		fields = append(fields, render.SummaryField{Label: "Est. Cost/mo", Value: formatCost(ngwr.Traffic.MonthlyCost())})
+	}
+	if n := len(ngwr.CrossAZSubnets); n > 0 {
+		fields = append(fields, render.SummaryField{Label: "Cross-AZ", Value: fmt.Sprintf("%d subnets", n), Style: ui.WarningStyle()})
+	}
+
 	return fields
 }
 
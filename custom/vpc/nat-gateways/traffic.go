@@ -0,0 +1,245 @@
+package natgateways
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	trafficWindow = 7 * 24 * time.Hour
+	trafficPeriod = 24 * 60 * 60
+	// Two queries per gateway, GetMetricData accepts 500
+	gatewaysPerRequest = 250
+)
+
+// Public us-east-1 on-demand rates. Most regions charge the same or slightly
+// more, so estimates are a lower bound outside of us-east-1.
+const (
+	hourlyPrice          = 0.045
+	processingPricePerGB = 0.045
+	hoursPerMonth        = 730
+	daysPerMonth         = 30.4
+	bytesPerGB           = 1 << 30
+)
+
+// Traffic holds the bytes processed by a NAT gateway over the traffic window
+type Traffic struct {
+	// FromSource is traffic sent by clients in the VPC (BytesInFromSource)
+	FromSource float64
+	// FromDestination is traffic returned by destinations (BytesInFromDestination)
+	FromDestination float64
+}
+
+// Processed returns the total bytes processed, which is what AWS bills for
+func (t *Traffic) Processed() float64 {
+	if t == nil {
+		return 0
+	}
+	return t.FromSource + t.FromDestination
+}
+
+// MonthlyProcessingCost extrapolates the data processing charge to a month
+func (t *Traffic) MonthlyProcessingCost() float64 {
+	days := trafficWindow.Hours() / 24
+	return t.Processed() / bytesPerGB * processingPricePerGB / days * daysPerMonth
+}
+
+// MonthlyCost estimates the hourly plus data processing charge for a month.
+// Cross-AZ and internet data transfer are billed separately and not included.
+func (t *Traffic) MonthlyCost() float64 {
+	return hourlyPrice*hoursPerMonth + t.MonthlyProcessingCost()
+}
+
+// CrossAZSubnet is a subnet routing through a NAT gateway in another AZ
+type CrossAZSubnet struct {
+	SubnetID string
+	AZ       string
+}
+
+// fetchTraffic returns the bytes processed per NAT gateway ID. Failures are
+// logged and yield a partial map so the list still renders.
+func fetchTraffic(ctx context.Context, client *cloudwatch.Client, ids []string) map[string]*Traffic {
+	traffic := make(map[string]*Traffic, len(ids))
+	endTime := time.Now().Truncate(time.Hour)
+	startTime := endTime.Add(-trafficWindow)
+
+	for i := 0; i < len(ids); i += gatewaysPerRequest {
+		batch := ids[i:min(i+gatewaysPerRequest, len(ids))]
+		queries := make([]cwtypes.MetricDataQuery, 0, 2*len(batch))
+		for j, id := range batch {
+			queries = append(queries,
+				bytesQuery(fmt.Sprintf("s%d", i+j), id, "BytesInFromSource"),
+				bytesQuery(fmt.Sprintf("d%d", i+j), id, "BytesInFromDestination"),
+			)
+		}
+
+		paginator := cloudwatch.NewGetMetricDataPaginator(client, &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(startTime),
+			EndTime:           aws.Time(endTime),
+			MetricDataQueries: queries,
+			ScanBy:            cwtypes.ScanByTimestampDescending,
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				log.Warn("failed to get nat gateway traffic metrics", "error", err)
+				return traffic
+			}
+			for _, result := range output.MetricDataResults {
+				addResult(traffic, ids, result)
+			}
+		}
+	}
+	return traffic
+}
+
+func bytesQuery(id, natGatewayID, metric string) cwtypes.MetricDataQuery {
+	return cwtypes.MetricDataQuery{
+		Id: aws.String(id),
+		MetricStat: &cwtypes.MetricStat{
+			Metric: &cwtypes.Metric{
+				Namespace:  aws.String("AWS/NATGateway"),
+				MetricName: aws.String(metric),
+				Dimensions: []cwtypes.Dimension{
+					{Name: aws.String("NatGatewayId"), Value: aws.String(natGatewayID)},
+				},
+			},
+			Period: aws.Int32(trafficPeriod),
+			Stat:   aws.String("Sum"),
+		},
+	}
+}
+
+// addResult adds a query result, identified as s<idx> or d<idx>, to the traffic of ids[idx]
+func addResult(traffic map[string]*Traffic, ids []string, result cwtypes.MetricDataResult) {
+	var kind rune
+	var idx int
+	if _, err := fmt.Sscanf(aws.ToString(result.Id), "%c%d", &kind, &idx); err != nil || idx >= len(ids) {
+		return
+	}
+	if len(result.Values) == 0 {
+		return
+	}
+
+	t := traffic[ids[idx]]
+	if t == nil {
+		t = &Traffic{}
+		traffic[ids[idx]] = t
+	}
+	var sum float64
+	for _, v := range result.Values {
+		sum += v
+	}
+	if kind == 's' {
+		t.FromSource += sum
+	} else {
+		t.FromDestination += sum
+	}
+}
+
+// fetchRouting returns the AZ of each NAT gateway and the subnets in other
+// AZs whose route table sends traffic through it. Failures are logged.
+func fetchRouting(ctx context.Context, client *ec2.Client, ngws []types.NatGateway) (map[string]string, map[string][]CrossAZSubnet) {
+	vpcSet := make(map[string]struct{})
+	var vpcIDs []string
+	for _, ngw := range ngws {
+		vpcID := appaws.Str(ngw.VpcId)
+		if _, ok := vpcSet[vpcID]; vpcID != "" && !ok {
+			vpcSet[vpcID] = struct{}{}
+			vpcIDs = append(vpcIDs, vpcID)
+		}
+	}
+	if len(vpcIDs) == 0 {
+		return nil, nil
+	}
+	filters := []types.Filter{{Name: aws.String("vpc-id"), Values: vpcIDs}}
+
+	var subnets []types.Subnet
+	subnetPages := ec2.NewDescribeSubnetsPaginator(client, &ec2.DescribeSubnetsInput{Filters: filters})
+	for subnetPages.HasMorePages() {
+		output, err := subnetPages.NextPage(ctx)
+		if err != nil {
+			log.Warn("failed to describe subnets for nat gateway routing", "error", err)
+			return nil, nil
+		}
+		subnets = append(subnets, output.Subnets...)
+	}
+
+	var tables []types.RouteTable
+	tablePages := ec2.NewDescribeRouteTablesPaginator(client, &ec2.DescribeRouteTablesInput{Filters: filters})
+	for tablePages.HasMorePages() {
+		output, err := tablePages.NextPage(ctx)
+		if err != nil {
+			log.Warn("failed to describe route tables for nat gateway routing", "error", err)
+			return nil, nil
+		}
+		tables = append(tables, output.RouteTables...)
+	}
+
+	return crossAZRouting(ngws, subnets, tables)
+}
+
+// crossAZRouting resolves each subnet's effective route table (its explicit
+// association or the VPC main table) and flags subnets routing through a
+// NAT gateway outside their AZ, which adds cross-AZ data transfer charges.
+func crossAZRouting(ngws []types.NatGateway, subnets []types.Subnet, tables []types.RouteTable) (map[string]string, map[string][]CrossAZSubnet) {
+	subnetAZ := make(map[string]string, len(subnets))
+	for _, s := range subnets {
+		subnetAZ[appaws.Str(s.SubnetId)] = appaws.Str(s.AvailabilityZone)
+	}
+
+	natAZ := make(map[string]string, len(ngws))
+	for _, ngw := range ngws {
+		natAZ[appaws.Str(ngw.NatGatewayId)] = subnetAZ[appaws.Str(ngw.SubnetId)]
+	}
+
+	// NAT gateways targeted by each route table, and subnet/main associations
+	tableNATs := make(map[string][]string)
+	explicit := make(map[string]string)
+	mainTable := make(map[string]string)
+	for _, rt := range tables {
+		tableID := appaws.Str(rt.RouteTableId)
+		for _, route := range rt.Routes {
+			if natID := appaws.Str(route.NatGatewayId); natID != "" {
+				tableNATs[tableID] = append(tableNATs[tableID], natID)
+			}
+		}
+		for _, assoc := range rt.Associations {
+			if appaws.Bool(assoc.Main) {
+				mainTable[appaws.Str(rt.VpcId)] = tableID
+			} else if subnetID := appaws.Str(assoc.SubnetId); subnetID != "" {
+				explicit[subnetID] = tableID
+			}
+		}
+	}
+
+	cross := make(map[string][]CrossAZSubnet)
+	for _, s := range subnets {
+		subnetID := appaws.Str(s.SubnetId)
+		tableID, ok := explicit[subnetID]
+		if !ok {
+			tableID = mainTable[appaws.Str(s.VpcId)]
+		}
+		for _, natID := range tableNATs[tableID] {
+			az, known := natAZ[natID]
+			if !known || az == "" || az == subnetAZ[subnetID] {
+				continue
+			}
+			if !slices.ContainsFunc(cross[natID], func(c CrossAZSubnet) bool { return c.SubnetID == subnetID }) {
+				cross[natID] = append(cross[natID], CrossAZSubnet{SubnetID: subnetID, AZ: subnetAZ[subnetID]})
+			}
+		}
+	}
+	return natAZ, cross
+}
@@ -0,0 +1,93 @@
+package natgateways
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestTrafficCost(t *testing.T) {
+	var none *Traffic
+	if got := none.MonthlyCost(); math.Abs(got-32.85) > 0.001 {
+		t.Errorf("idle MonthlyCost = %.3f, want hourly charge 32.85", got)
+	}
+
+	// 70 GiB a week is 10 GiB a day, ~304 GiB a month
+	traffic := &Traffic{FromSource: 50 * bytesPerGB, FromDestination: 20 * bytesPerGB}
+	if got := traffic.MonthlyProcessingCost(); math.Abs(got-13.68) > 0.001 {
+		t.Errorf("MonthlyProcessingCost = %.3f, want 13.68", got)
+	}
+}
+
+func TestAddResult(t *testing.T) {
+	ids := []string{"nat-a", "nat-b"}
+	traffic := make(map[string]*Traffic)
+
+	addResult(traffic, ids, cwtypes.MetricDataResult{Id: aws.String("s1"), Values: []float64{100, 200}})
+	addResult(traffic, ids, cwtypes.MetricDataResult{Id: aws.String("d1"), Values: []float64{50}})
+	addResult(traffic, ids, cwtypes.MetricDataResult{Id: aws.String("s0")})
+	addResult(traffic, ids, cwtypes.MetricDataResult{Id: aws.String("s9"), Values: []float64{1}})
+
+	if _, ok := traffic["nat-a"]; ok {
+		t.Error("gateway without datapoints should have no traffic")
+	}
+	if got := traffic["nat-b"]; got == nil || got.FromSource != 300 || got.Processed() != 350 {
+		t.Errorf("nat-b traffic = %+v", got)
+	}
+}
+
+func TestCrossAZRouting(t *testing.T) {
+	subnet := func(id, az string) types.Subnet {
+		return types.Subnet{SubnetId: aws.String(id), AvailabilityZone: aws.String(az), VpcId: aws.String("vpc-1")}
+	}
+	natRoute := func(natID string) []types.Route {
+		return []types.Route{{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String(natID)}}
+	}
+
+	ngws := []types.NatGateway{
+		{NatGatewayId: aws.String("nat-a"), SubnetId: aws.String("public-a"), VpcId: aws.String("vpc-1")},
+	}
+	subnets := []types.Subnet{
+		subnet("public-a", "us-east-1a"),
+		subnet("private-a", "us-east-1a"),
+		subnet("private-b", "us-east-1b"),
+		subnet("private-c", "us-east-1c"),
+	}
+	tables := []types.RouteTable{
+		{
+			// Main table routes implicitly associated subnets (private-c) through nat-a
+			RouteTableId: aws.String("rtb-main"),
+			VpcId:        aws.String("vpc-1"),
+			Routes:       natRoute("nat-a"),
+			Associations: []types.RouteTableAssociation{{Main: aws.Bool(true)}},
+		},
+		{
+			RouteTableId: aws.String("rtb-private"),
+			VpcId:        aws.String("vpc-1"),
+			Routes:       natRoute("nat-a"),
+			Associations: []types.RouteTableAssociation{
+				{SubnetId: aws.String("private-a")},
+				{SubnetId: aws.String("private-b")},
+			},
+		},
+		{
+			RouteTableId: aws.String("rtb-public"),
+			VpcId:        aws.String("vpc-1"),
+			Routes:       []types.Route{{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")}},
+			Associations: []types.RouteTableAssociation{{SubnetId: aws.String("public-a")}},
+		},
+	}
+
+	azs, cross := crossAZRouting(ngws, subnets, tables)
+	if azs["nat-a"] != "us-east-1a" {
+		t.Errorf("nat AZ = %q", azs["nat-a"])
+	}
+	want := []CrossAZSubnet{{SubnetID: "private-b", AZ: "us-east-1b"}, {SubnetID: "private-c", AZ: "us-east-1c"}}
+	if !reflect.DeepEqual(cross["nat-a"], want) {
+		t.Errorf("cross-AZ subnets = %+v, want %+v", cross["nat-a"], want)
+	}
+}
@@ -31,7 +31,19 @@ func NewRouteTableDAO(ctx context.Context) (dao.DAO, error) {
 }
 
 func (d *RouteTableDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	output, err := d.client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{})
+	input := &ec2.DescribeRouteTablesInput{}
+
+	// Filter by destination prefix list if provided
+	if plID := dao.GetFilterFromContext(ctx, "PrefixListId"); plID != "" {
+		input.Filters = []types.Filter{
+			{
+				Name:   appaws.StringPtr("route.destination-prefix-list-id"),
+				Values: []string{plID},
+			},
+		}
+	}
+
+	output, err := d.client.DescribeRouteTables(ctx, input)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "describe route tables")
 	}
@@ -0,0 +1,125 @@
+package improvements
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ImprovementDAO provides data access for the improvement plan of a lens review
+type ImprovementDAO struct {
+	dao.BaseDAO
+	client *wellarchitected.Client
+}
+
+// NewImprovementDAO creates a new ImprovementDAO
+func NewImprovementDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ImprovementDAO{
+		BaseDAO: dao.NewBaseDAO("wellarchitected", "improvements"),
+		client:  wellarchitected.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the improvement items of the lens review given by the
+// "LensReview" filter in workloadId/lensAlias form, high risks first.
+func (d *ImprovementDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	workloadID, lensAlias, ok := strings.Cut(dao.GetFilterFromContext(ctx, "LensReview"), "/")
+	if !ok || workloadID == "" || lensAlias == "" {
+		return nil, fmt.Errorf("lens review filter required (workloadId/lensAlias)")
+	}
+
+	items, err := appaws.Paginate(ctx, func(token *string) ([]types.ImprovementSummary, *string, error) {
+		output, err := d.client.ListLensReviewImprovements(ctx, &wellarchitected.ListLensReviewImprovementsInput{
+			WorkloadId: &workloadID,
+			LensAlias:  &lensAlias,
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list improvements for %s", lensAlias)
+		}
+		return output.ImprovementSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(items, func(a, b types.ImprovementSummary) int {
+		return riskRank(a.Risk) - riskRank(b.Risk)
+	})
+
+	resources := make([]dao.Resource, 0, len(items))
+	for _, item := range items {
+		resources = append(resources, NewImprovementResource(item))
+	}
+	return resources, nil
+}
+
+// riskRank orders improvement items by risk, highest first
+func riskRank(risk types.Risk) int {
+	switch risk {
+	case types.RiskHigh:
+		return 0
+	case types.RiskMedium:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (d *ImprovementDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for improvements")
+}
+
+func (d *ImprovementDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for improvements")
+}
+
+// Supports returns supported operations
+func (d *ImprovementDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// ImprovementResource wraps an improvement plan item (one risky question)
+type ImprovementResource struct {
+	dao.BaseResource
+	Item types.ImprovementSummary
+}
+
+// NewImprovementResource creates a new ImprovementResource
+func NewImprovementResource(item types.ImprovementSummary) *ImprovementResource {
+	return &ImprovementResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(item.QuestionId),
+			Name: appaws.Str(item.QuestionTitle),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// Risk returns the risk of the question (HIGH or MEDIUM)
+func (r *ImprovementResource) Risk() string {
+	return string(r.Item.Risk)
+}
+
+// PillarID returns the pillar the question belongs to
+func (r *ImprovementResource) PillarID() string {
+	return appaws.Str(r.Item.PillarId)
+}
+
+// PlanURL returns the link to the improvement guidance for the question
+func (r *ImprovementResource) PlanURL() string {
+	return appaws.Str(r.Item.ImprovementPlanUrl)
+}
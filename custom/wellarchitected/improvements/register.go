@@ -0,0 +1,20 @@
+package improvements
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("wellarchitected", "improvements", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewImprovementDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewImprovementRenderer()
+		},
+	})
+}
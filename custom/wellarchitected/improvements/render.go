@@ -0,0 +1,90 @@
+package improvements
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ImprovementRenderer renders Well-Architected improvement plan items
+type ImprovementRenderer struct {
+	render.BaseRenderer
+}
+
+// NewImprovementRenderer creates a new ImprovementRenderer
+func NewImprovementRenderer() render.Renderer {
+	return &ImprovementRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "wellarchitected",
+			Resource: "improvements",
+			Cols: []render.Column{
+				{Name: "QUESTION", Width: 60, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "RISK", Width: 8, Getter: getRisk, Priority: 1},
+				{Name: "PILLAR", Width: 22, Getter: getPillar, Priority: 2},
+				{Name: "ID", Width: 28, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 3},
+			},
+		},
+	}
+}
+
+func getRisk(r dao.Resource) string {
+	if imp, ok := r.(*ImprovementResource); ok {
+		return imp.Risk()
+	}
+	return ""
+}
+
+func getPillar(r dao.Resource) string {
+	if imp, ok := r.(*ImprovementResource); ok {
+		return imp.PillarID()
+	}
+	return ""
+}
+
+// RenderDetail renders the question and its improvement plan
+func (r *ImprovementRenderer) RenderDetail(resource dao.Resource) string {
+	imp, ok := resource.(*ImprovementResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Improvement", imp.GetName())
+
+	d.Section("Question")
+	d.Field("Question ID", imp.GetID())
+	d.Field("Pillar", imp.PillarID())
+	if imp.Risk() == "HIGH" {
+		d.FieldStyled("Risk", imp.Risk(), ui.DangerStyle())
+	} else {
+		d.FieldStyled("Risk", imp.Risk(), ui.WarningStyle())
+	}
+	if url := imp.PlanURL(); url != "" {
+		d.Field("Guidance", url)
+	}
+
+	if plans := imp.Item.ImprovementPlans; len(plans) > 0 {
+		d.Section("Improvement Plan")
+		for _, plan := range plans {
+			d.Field(appaws.Str(plan.DisplayText), appaws.Str(plan.ImprovementPlanUrl))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ImprovementRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	imp, ok := resource.(*ImprovementResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.SummaryField{
+		{Label: "Question", Value: imp.GetName()},
+		{Label: "Pillar", Value: imp.PillarID()},
+		{Label: "Risk", Value: imp.Risk()},
+	}
+}
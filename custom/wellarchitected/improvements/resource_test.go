@@ -0,0 +1,20 @@
+package improvements
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+)
+
+func TestRiskRank(t *testing.T) {
+	risks := []types.Risk{types.RiskMedium, types.RiskNone, types.RiskHigh, types.RiskMedium}
+	slices.SortStableFunc(risks, func(a, b types.Risk) int {
+		return riskRank(a) - riskRank(b)
+	})
+
+	want := []types.Risk{types.RiskHigh, types.RiskMedium, types.RiskMedium, types.RiskNone}
+	if !slices.Equal(risks, want) {
+		t.Errorf("sorted risks = %v, want %v", risks, want)
+	}
+}
@@ -0,0 +1,166 @@
+package lensreviews
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// LensReviewDAO provides data access for the lens reviews of a workload
+type LensReviewDAO struct {
+	dao.BaseDAO
+	client *wellarchitected.Client
+}
+
+// NewLensReviewDAO creates a new LensReviewDAO
+func NewLensReviewDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &LensReviewDAO{
+		BaseDAO: dao.NewBaseDAO("wellarchitected", "lens-reviews"),
+		client:  wellarchitected.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the lens reviews of the workload given by the WorkloadId filter.
+// Pillar risk counts are only returned by GetLensReview, so each lens is fetched.
+func (d *LensReviewDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	workloadID := dao.GetFilterFromContext(ctx, "WorkloadId")
+	if workloadID == "" {
+		return nil, fmt.Errorf("workload ID filter required")
+	}
+
+	summaries, err := appaws.Paginate(ctx, func(token *string) ([]types.LensReviewSummary, *string, error) {
+		output, err := d.client.ListLensReviews(ctx, &wellarchitected.ListLensReviewsInput{
+			WorkloadId: &workloadID,
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list lens reviews for %s", workloadID)
+		}
+		return output.LensReviewSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(summaries))
+	for _, s := range summaries {
+		r, err := d.getLensReview(ctx, workloadID, appaws.Str(s.LensAlias))
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// Get returns a lens review by lens alias
+func (d *LensReviewDAO) Get(ctx context.Context, alias string) (dao.Resource, error) {
+	workloadID := dao.GetFilterFromContext(ctx, "WorkloadId")
+	if workloadID == "" {
+		return nil, fmt.Errorf("workload ID filter required")
+	}
+	return d.getLensReview(ctx, workloadID, alias)
+}
+
+func (d *LensReviewDAO) getLensReview(ctx context.Context, workloadID, alias string) (*LensReviewResource, error) {
+	output, err := d.client.GetLensReview(ctx, &wellarchitected.GetLensReviewInput{
+		WorkloadId: &workloadID,
+		LensAlias:  &alias,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get lens review %s", alias)
+	}
+	if output.LensReview == nil {
+		return nil, fmt.Errorf("lens review not found: %s", alias)
+	}
+	return NewLensReviewResource(workloadID, *output.LensReview), nil
+}
+
+func (d *LensReviewDAO) Delete(ctx context.Context, alias string) error {
+	return fmt.Errorf("delete not supported for lens reviews")
+}
+
+// Supports returns supported operations
+func (d *LensReviewDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// LensReviewResource wraps the review of one lens on a workload
+type LensReviewResource struct {
+	dao.BaseResource
+	WorkloadID string
+	Item       types.LensReview
+}
+
+// NewLensReviewResource creates a new LensReviewResource
+func NewLensReviewResource(workloadID string, review types.LensReview) *LensReviewResource {
+	return &LensReviewResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(review.LensAlias),
+			Name: appaws.Str(review.LensName),
+			ARN:  appaws.Str(review.LensArn),
+			Data: review,
+		},
+		WorkloadID: workloadID,
+		Item:       review,
+	}
+}
+
+// Status returns the lens status (CURRENT, NOT_CURRENT, DEPRECATED, ...)
+func (r *LensReviewResource) Status() string {
+	return string(r.Item.LensStatus)
+}
+
+// Version returns the lens version the review was done against
+func (r *LensReviewResource) Version() string {
+	return appaws.Str(r.Item.LensVersion)
+}
+
+// RiskCount returns the number of questions with the given risk in the lens
+func (r *LensReviewResource) RiskCount(risk types.Risk) int32 {
+	return r.Item.RiskCounts[string(risk)]
+}
+
+// Pillars returns the per-pillar review summaries
+func (r *LensReviewResource) Pillars() []types.PillarReviewSummary {
+	return r.Item.PillarReviewSummaries
+}
+
+// pillarAbbrevs shortens the pillars of the AWS Well-Architected Framework lens
+var pillarAbbrevs = map[string]string{
+	"operationalExcellence": "OPS",
+	"security":              "SEC",
+	"reliability":           "REL",
+	"performance":           "PERF",
+	"costOptimization":      "COST",
+	"sustainability":        "SUS",
+}
+
+// HighRisksByPillar returns the high-risk count of each pillar that has any,
+// e.g. "SEC 3 REL 1". Pillars of other lenses use their name.
+func (r *LensReviewResource) HighRisksByPillar() string {
+	var parts []string
+	for _, p := range r.Item.PillarReviewSummaries {
+		n := p.RiskCounts[string(types.RiskHigh)]
+		if n == 0 {
+			continue
+		}
+		label, ok := pillarAbbrevs[appaws.Str(p.PillarId)]
+		if !ok {
+			label = appaws.Str(p.PillarName)
+		}
+		parts = append(parts, fmt.Sprintf("%s %d", label, n))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,20 @@
+package lensreviews
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("wellarchitected", "lens-reviews", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewLensReviewDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewLensReviewRenderer()
+		},
+	})
+}
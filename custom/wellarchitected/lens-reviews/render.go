@@ -0,0 +1,163 @@
+package lensreviews
+
+import (
+	"fmt"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure LensReviewRenderer implements render.Navigator
+var _ render.Navigator = (*LensReviewRenderer)(nil)
+
+// LensReviewRenderer renders Well-Architected lens reviews
+type LensReviewRenderer struct {
+	render.BaseRenderer
+}
+
+// NewLensReviewRenderer creates a new LensReviewRenderer
+func NewLensReviewRenderer() render.Renderer {
+	return &LensReviewRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "wellarchitected",
+			Resource: "lens-reviews",
+			Cols: []render.Column{
+				{Name: "LENS", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "HIGH", Width: 6, Getter: getHigh, Priority: 1},
+				{Name: "MEDIUM", Width: 8, Getter: getMedium, Priority: 2},
+				{Name: "HIGH BY PILLAR", Width: 32, Getter: getHighByPillar, Priority: 3},
+				{Name: "STATUS", Width: 12, Getter: getStatus, Priority: 4},
+				{Name: "UPDATED", Width: 10, Getter: getUpdated, Priority: 5},
+			},
+		},
+	}
+}
+
+func riskGetter(risk types.Risk) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if lr, ok := r.(*LensReviewResource); ok {
+			return fmt.Sprintf("%d", lr.RiskCount(risk))
+		}
+		return ""
+	}
+}
+
+var (
+	getHigh   = riskGetter(types.RiskHigh)
+	getMedium = riskGetter(types.RiskMedium)
+)
+
+func getHighByPillar(r dao.Resource) string {
+	if lr, ok := r.(*LensReviewResource); ok {
+		return lr.HighRisksByPillar()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if lr, ok := r.(*LensReviewResource); ok {
+		return lr.Status()
+	}
+	return ""
+}
+
+func getUpdated(r dao.Resource) string {
+	if lr, ok := r.(*LensReviewResource); ok && lr.Item.UpdatedAt != nil {
+		return render.FormatAge(*lr.Item.UpdatedAt)
+	}
+	return ""
+}
+
+// RenderDetail renders the lens review with risk counts per pillar
+func (r *LensReviewRenderer) RenderDetail(resource dao.Resource) string {
+	lr, ok := resource.(*LensReviewResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Lens Review", lr.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Lens Alias", lr.GetID())
+	if lr.GetARN() != "" {
+		d.Field("Lens ARN", lr.GetARN())
+	}
+	d.Field("Workload ID", lr.WorkloadID)
+	d.Field("Version", lr.Version())
+	d.Field("Status", lr.Status())
+	if lr.Item.UpdatedAt != nil {
+		d.Field("Updated", lr.Item.UpdatedAt.Format("2006-01-02 15:04:05"))
+	}
+	d.FieldIf("Notes", lr.Item.Notes)
+
+	d.Section("Risks")
+	high := lr.RiskCount(types.RiskHigh)
+	d.FieldStyled("High", fmt.Sprintf("%d", high), highStyle(high))
+	d.Field("Medium", fmt.Sprintf("%d", lr.RiskCount(types.RiskMedium)))
+	d.Field("None", fmt.Sprintf("%d", lr.RiskCount(types.RiskNone)))
+	d.Field("Not Applicable", fmt.Sprintf("%d", lr.RiskCount(types.RiskNotApplicable)))
+	d.Field("Unanswered", fmt.Sprintf("%d", lr.RiskCount(types.RiskUnanswered)))
+
+	if pillars := lr.Pillars(); len(pillars) > 0 {
+		d.Section("Pillars (high / medium / unanswered)")
+		for _, p := range pillars {
+			high := p.RiskCounts[string(types.RiskHigh)]
+			d.FieldStyled(appaws.Str(p.PillarName), fmt.Sprintf("%d / %d / %d",
+				high,
+				p.RiskCounts[string(types.RiskMedium)],
+				p.RiskCounts[string(types.RiskUnanswered)],
+			), highStyle(high))
+		}
+	}
+
+	return d.String()
+}
+
+// highStyle highlights non-zero high-risk counts
+func highStyle(n int32) lipgloss.Style {
+	if n > 0 {
+		return ui.DangerStyle()
+	}
+	return ui.NoStyle()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *LensReviewRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	lr, ok := resource.(*LensReviewResource)
+	if !ok {
+		return nil
+	}
+
+	high := lr.RiskCount(types.RiskHigh)
+	fields := []render.SummaryField{
+		{Label: "Lens", Value: lr.GetName()},
+		{Label: "High Risks", Value: fmt.Sprintf("%d", high), Style: highStyle(high)},
+		{Label: "Medium Risks", Value: fmt.Sprintf("%d", lr.RiskCount(types.RiskMedium))},
+	}
+	if byPillar := lr.HighRisksByPillar(); byPillar != "" {
+		fields = append(fields, render.SummaryField{Label: "By Pillar", Value: byPillar})
+	}
+	return fields
+}
+
+// Navigations links a lens review to its improvement plan
+func (r *LensReviewRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	lr, ok := resource.(*LensReviewResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "i", Label: "Improvement Plan", Service: "wellarchitected", Resource: "improvements",
+			FilterField: "LensReview", FilterValue: lr.WorkloadID + "/" + lr.GetID(),
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package lensreviews
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+)
+
+func TestHighRisksByPillar(t *testing.T) {
+	review := types.LensReview{
+		LensAlias: aws.String("wellarchitected"),
+		LensName:  aws.String("AWS Well-Architected Framework"),
+		RiskCounts: map[string]int32{
+			"HIGH":   4,
+			"MEDIUM": 2,
+		},
+		PillarReviewSummaries: []types.PillarReviewSummary{
+			{PillarId: aws.String("operationalExcellence"), PillarName: aws.String("Operational Excellence"), RiskCounts: map[string]int32{"MEDIUM": 2}},
+			{PillarId: aws.String("security"), PillarName: aws.String("Security"), RiskCounts: map[string]int32{"HIGH": 3}},
+			{PillarId: aws.String("customPillar"), PillarName: aws.String("Custom"), RiskCounts: map[string]int32{"HIGH": 1}},
+		},
+	}
+
+	r := NewLensReviewResource("wl-1", review)
+	if got, want := r.HighRisksByPillar(), "SEC 3 Custom 1"; got != want {
+		t.Errorf("HighRisksByPillar() = %q, want %q", got, want)
+	}
+	if got := r.RiskCount(types.RiskHigh); got != 4 {
+		t.Errorf("RiskCount(HIGH) = %d, want 4", got)
+	}
+	if r.GetID() != "wellarchitected" || r.WorkloadID != "wl-1" {
+		t.Errorf("unexpected ID %q / workload %q", r.GetID(), r.WorkloadID)
+	}
+}
@@ -0,0 +1,137 @@
+package workloads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// WorkloadDAO provides data access for Well-Architected workloads
+type WorkloadDAO struct {
+	dao.BaseDAO
+	client *wellarchitected.Client
+}
+
+// NewWorkloadDAO creates a new WorkloadDAO
+func NewWorkloadDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &WorkloadDAO{
+		BaseDAO: dao.NewBaseDAO("wellarchitected", "workloads"),
+		client:  wellarchitected.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns all workloads
+func (d *WorkloadDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	summaries, err := appaws.Paginate(ctx, func(token *string) ([]types.WorkloadSummary, *string, error) {
+		output, err := d.client.ListWorkloads(ctx, &wellarchitected.ListWorkloadsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list workloads")
+		}
+		return output.WorkloadSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(summaries))
+	for _, s := range summaries {
+		resources = append(resources, NewWorkloadResource(s))
+	}
+	return resources, nil
+}
+
+// Get returns a workload with its review settings and tags
+func (d *WorkloadDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetWorkload(ctx, &wellarchitected.GetWorkloadInput{
+		WorkloadId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get workload %s", id)
+	}
+	if output.Workload == nil {
+		return nil, fmt.Errorf("workload not found: %s", id)
+	}
+	return NewWorkloadResourceFromDetail(*output.Workload), nil
+}
+
+func (d *WorkloadDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for workloads")
+}
+
+// Supports returns supported operations
+func (d *WorkloadDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// WorkloadResource wraps a Well-Architected workload
+type WorkloadResource struct {
+	dao.BaseResource
+	Item   types.WorkloadSummary
+	Detail *types.Workload
+}
+
+// NewWorkloadResource creates a new WorkloadResource from a list summary
+func NewWorkloadResource(w types.WorkloadSummary) *WorkloadResource {
+	return &WorkloadResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(w.WorkloadId),
+			Name: appaws.Str(w.WorkloadName),
+			ARN:  appaws.Str(w.WorkloadArn),
+			Data: w,
+		},
+		Item: w,
+	}
+}
+
+// NewWorkloadResourceFromDetail creates a new WorkloadResource from GetWorkload
+func NewWorkloadResourceFromDetail(w types.Workload) *WorkloadResource {
+	r := NewWorkloadResource(types.WorkloadSummary{
+		WorkloadId:        w.WorkloadId,
+		WorkloadArn:       w.WorkloadArn,
+		WorkloadName:      w.WorkloadName,
+		Owner:             w.Owner,
+		UpdatedAt:         w.UpdatedAt,
+		Lenses:            w.Lenses,
+		RiskCounts:        w.RiskCounts,
+		ImprovementStatus: w.ImprovementStatus,
+	})
+	r.Detail = &w
+	r.Data = w
+	r.Tags = w.Tags
+	return r
+}
+
+// RiskCount returns the number of questions with the given risk across all lenses
+func (r *WorkloadResource) RiskCount(risk types.Risk) int32 {
+	return r.Item.RiskCounts[string(risk)]
+}
+
+// ImprovementStatus returns the improvement plan status
+func (r *WorkloadResource) ImprovementStatus() string {
+	return string(r.Item.ImprovementStatus)
+}
+
+// Lenses returns the lenses applied to the workload
+func (r *WorkloadResource) Lenses() []string {
+	return r.Item.Lenses
+}
+
+// Environment returns PRODUCTION or PREPRODUCTION (only known after Get)
+func (r *WorkloadResource) Environment() string {
+	if r.Detail != nil {
+		return string(r.Detail.Environment)
+	}
+	return ""
+}
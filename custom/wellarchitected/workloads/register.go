@@ -0,0 +1,20 @@
+package workloads
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("wellarchitected", "workloads", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewWorkloadDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewWorkloadRenderer()
+		},
+	})
+}
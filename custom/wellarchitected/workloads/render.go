@@ -0,0 +1,172 @@
+package workloads
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure WorkloadRenderer implements render.Navigator
+var _ render.Navigator = (*WorkloadRenderer)(nil)
+
+// WorkloadRenderer renders Well-Architected workloads
+type WorkloadRenderer struct {
+	render.BaseRenderer
+}
+
+// NewWorkloadRenderer creates a new WorkloadRenderer
+func NewWorkloadRenderer() render.Renderer {
+	return &WorkloadRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "wellarchitected",
+			Resource: "workloads",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "HIGH", Width: 6, Getter: getHigh, Priority: 1},
+				{Name: "MEDIUM", Width: 8, Getter: getMedium, Priority: 2},
+				{Name: "UNANSWERED", Width: 11, Getter: getUnanswered, Priority: 4},
+				{Name: "IMPROVEMENT", Width: 18, Getter: getImprovement, Priority: 3},
+				{Name: "LENSES", Width: 6, Getter: getLenses, Priority: 5},
+				{Name: "UPDATED", Width: 10, Getter: getUpdated, Priority: 6},
+			},
+		},
+	}
+}
+
+func riskGetter(risk types.Risk) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if w, ok := r.(*WorkloadResource); ok {
+			return fmt.Sprintf("%d", w.RiskCount(risk))
+		}
+		return ""
+	}
+}
+
+var (
+	getHigh       = riskGetter(types.RiskHigh)
+	getMedium     = riskGetter(types.RiskMedium)
+	getUnanswered = riskGetter(types.RiskUnanswered)
+)
+
+func getImprovement(r dao.Resource) string {
+	if w, ok := r.(*WorkloadResource); ok {
+		return w.ImprovementStatus()
+	}
+	return ""
+}
+
+func getLenses(r dao.Resource) string {
+	if w, ok := r.(*WorkloadResource); ok {
+		return fmt.Sprintf("%d", len(w.Lenses()))
+	}
+	return ""
+}
+
+func getUpdated(r dao.Resource) string {
+	if w, ok := r.(*WorkloadResource); ok && w.Item.UpdatedAt != nil {
+		return render.FormatAge(*w.Item.UpdatedAt)
+	}
+	return ""
+}
+
+// RenderDetail renders detailed workload information
+func (r *WorkloadRenderer) RenderDetail(resource dao.Resource) string {
+	w, ok := resource.(*WorkloadResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Well-Architected Workload", w.GetName())
+
+	d.Section("Basic Information")
+	d.Field("ID", w.GetID())
+	d.Field("ARN", w.GetARN())
+	d.FieldIf("Owner", w.Item.Owner)
+	if w.Item.UpdatedAt != nil {
+		d.Field("Updated", w.Item.UpdatedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	if w.Detail != nil {
+		wl := w.Detail
+		d.FieldIf("Description", wl.Description)
+		d.Field("Environment", w.Environment())
+		d.FieldIf("Review Owner", wl.ReviewOwner)
+		d.FieldIf("Architectural Design", wl.ArchitecturalDesign)
+		d.FieldIf("Industry Type", wl.IndustryType)
+		if len(wl.AccountIds) > 0 {
+			d.Field("Accounts", strings.Join(wl.AccountIds, ", "))
+		}
+		if len(wl.AwsRegions) > 0 {
+			d.Field("Regions", strings.Join(wl.AwsRegions, ", "))
+		}
+		if len(wl.PillarPriorities) > 0 {
+			d.Field("Pillar Priorities", strings.Join(wl.PillarPriorities, ", "))
+		}
+	}
+
+	d.Section("Risks")
+	d.FieldStyled("High", fmt.Sprintf("%d", w.RiskCount(types.RiskHigh)), riskStyle(w.RiskCount(types.RiskHigh)))
+	d.Field("Medium", fmt.Sprintf("%d", w.RiskCount(types.RiskMedium)))
+	d.Field("None", fmt.Sprintf("%d", w.RiskCount(types.RiskNone)))
+	d.Field("Not Applicable", fmt.Sprintf("%d", w.RiskCount(types.RiskNotApplicable)))
+	d.Field("Unanswered", fmt.Sprintf("%d", w.RiskCount(types.RiskUnanswered)))
+	d.Field("Improvement Status", w.ImprovementStatus())
+
+	if lenses := w.Lenses(); len(lenses) > 0 {
+		d.Section("Lenses")
+		for _, lens := range lenses {
+			d.Field("Lens", lens)
+		}
+	}
+
+	d.Tags(w.GetTags())
+
+	return d.String()
+}
+
+// riskStyle highlights non-zero high-risk counts
+func riskStyle(n int32) lipgloss.Style {
+	if n > 0 {
+		return ui.DangerStyle()
+	}
+	return ui.NoStyle()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *WorkloadRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	w, ok := resource.(*WorkloadResource)
+	if !ok {
+		return nil
+	}
+
+	high := w.RiskCount(types.RiskHigh)
+	return []render.SummaryField{
+		{Label: "Name", Value: w.GetName()},
+		{Label: "High Risks", Value: fmt.Sprintf("%d", high), Style: riskStyle(high)},
+		{Label: "Medium Risks", Value: fmt.Sprintf("%d", w.RiskCount(types.RiskMedium))},
+		{Label: "Improvement", Value: w.ImprovementStatus()},
+	}
+}
+
+// Navigations returns navigation shortcuts for workloads
+func (r *WorkloadRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	w, ok := resource.(*WorkloadResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "l", Label: "Lens Reviews", Service: "wellarchitected", Resource: "lens-reviews",
+			FilterField: "WorkloadId", FilterValue: w.GetID(),
+		},
+	}
+}
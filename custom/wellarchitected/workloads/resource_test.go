@@ -0,0 +1,35 @@
+package workloads
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+)
+
+func TestNewWorkloadResourceFromDetail(t *testing.T) {
+	r := NewWorkloadResourceFromDetail(types.Workload{
+		WorkloadId:        aws.String("wl-1"),
+		WorkloadName:      aws.String("payments"),
+		Environment:       types.WorkloadEnvironmentProduction,
+		RiskCounts:        map[string]int32{"HIGH": 5, "MEDIUM": 3},
+		ImprovementStatus: types.WorkloadImprovementStatusInProgress,
+		Tags:              map[string]string{"team": "payments"},
+	})
+
+	if r.GetID() != "wl-1" || r.GetName() != "payments" {
+		t.Errorf("unexpected ID %q / name %q", r.GetID(), r.GetName())
+	}
+	if got := r.RiskCount(types.RiskHigh); got != 5 {
+		t.Errorf("RiskCount(HIGH) = %d, want 5", got)
+	}
+	if got := r.RiskCount(types.RiskUnanswered); got != 0 {
+		t.Errorf("RiskCount(UNANSWERED) = %d, want 0", got)
+	}
+	if r.Environment() != "PRODUCTION" {
+		t.Errorf("Environment() = %q, want PRODUCTION", r.Environment())
+	}
+	if r.GetTags()["team"] != "payments" {
+		t.Errorf("tags not carried over: %v", r.GetTags())
+	}
+}
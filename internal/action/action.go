@@ -22,6 +22,7 @@ var (
 	ErrEmptyOperation      = errors.New("API action has no Operation defined")
 	ErrInvalidResourceType = errors.New("invalid resource type")
 	ErrReadOnlyDenied      = errors.New("action denied in read-only mode")
+	ErrMissingInput        = errors.New("action is missing prompted input")
 )
 
 // UnknownOperationError creates an error for unknown operations
@@ -76,6 +77,10 @@ type Action struct {
 	// If nil, the action is always shown.
 	Filter func(resource dao.Resource) bool
 
+	// Standalone marks actions that do not act on the selected resource
+	// (e.g., requesting a new one), so they are also offered on empty lists.
+	Standalone bool
+
 	// PostExecFollowUp generates a tea.Msg after successful exec completion.
 	// Called by ActionMenu when an exec action returns success.
 	// If nil, no follow-up message is sent.
@@ -86,6 +91,45 @@ type Action struct {
 	// If nil, defaults to resource.GetID().
 	// Use when the action operates on a different identifier (e.g., Name vs ARN).
 	ConfirmToken func(resource dao.Resource) string
+
+	// Prompts collects user input before confirmation, one prompt at a time.
+	// Entered values are passed to the executor in Inputs, in the same order.
+	Prompts []Prompt
+
+	// PromptsFunc, if set, builds Prompts when the action is chosen, for forms
+	// that depend on the resource (e.g., one prompt per document parameter).
+	// It runs asynchronously, so it may call AWS APIs.
+	PromptsFunc func(ctx context.Context, resource dao.Resource) ([]Prompt, error)
+
+	// Inputs holds the values entered for Prompts. Set by ActionMenu before execution.
+	Inputs []string
+
+	// Preview, if set, summarizes the pending change in the confirmation
+	// (e.g., a before/after diff of edited attributes) instead of listing raw inputs.
+	Preview func(resource dao.Resource, inputs []string) string
+}
+
+// Prompt asks the user for a single value before an action runs.
+type Prompt struct {
+	Label string
+
+	// Options, if set, turns the prompt into a picker. Typing filters the list.
+	// Called when the prompt opens (e.g., to list queues or regions).
+	Options func(ctx context.Context, resource dao.Resource) ([]string, error)
+
+	// Default pre-fills free-text input (e.g., the current attribute value).
+	Default func(resource dao.Resource) string
+
+	// Validate checks free-text input before moving to the next step.
+	Validate func(value string) error
+}
+
+// Input returns the value entered for the i-th prompt, or "" if not set.
+func (a Action) Input(i int) string {
+	if i < 0 || i >= len(a.Inputs) {
+		return ""
+	}
+	return a.Inputs[i]
 }
 
 // ActionResult represents the result of an action
@@ -162,6 +206,8 @@ var ReadOnlyAllowlist = map[string]bool{
 	"DetectStackDrift": true,
 	// InvokeFunctionDryRun: Validation mode, function is not actually invoked
 	"InvokeFunctionDryRun": true,
+	// TestFunction: Runs a CloudFront Function against a sample event, no deployment changes
+	"TestFunction": true,
 }
 
 var ReadOnlyExecAllowlist = map[string]bool{
@@ -267,6 +313,11 @@ func ExecuteWithDAO(ctx context.Context, action Action, resource dao.Resource, s
 		return ActionResult{Success: false, Error: ErrReadOnlyDenied}
 	}
 
+	if len(action.Inputs) != len(action.Prompts) {
+		log.Error("action input count mismatch", "action", action.Name, "prompts", len(action.Prompts), "inputs", len(action.Inputs))
+		return ActionResult{Success: false, Error: ErrMissingInput}
+	}
+
 	var result ActionResult
 	switch action.Type {
 	case ActionTypeExec:
@@ -325,6 +325,7 @@ func TestReadOnlyAllowlist(t *testing.T) {
 	expected := []string{
 		"DetectStackDrift",     // CloudFormation: read-only drift detection
 		"InvokeFunctionDryRun", // Lambda: validation only
+		"TestFunction",         // CloudFront Functions: isolated test run
 	}
 
 	for _, op := range expected {
@@ -1234,3 +1235,32 @@ func TestExecWithHeader_SetIO(t *testing.T) {
 		t.Error("SetStderr did not set stderr")
 	}
 }
+
+func TestExecuteWithDAO_MissingPromptInput(t *testing.T) {
+	config.Global().SetReadOnly(false)
+
+	action := Action{
+		Name:      "Prompted Action",
+		Type:      ActionTypeAPI,
+		Operation: "Prompted",
+		Prompts:   []Prompt{{Label: "Value"}},
+	}
+
+	result := ExecuteWithDAO(context.Background(), action, &mockResource{id: "test"}, "test", "resource")
+	if result.Success {
+		t.Error("action without prompted input should fail")
+	}
+	if result.Error != ErrMissingInput {
+		t.Errorf("Error = %v, want %v", result.Error, ErrMissingInput)
+	}
+}
+
+func TestActionInput(t *testing.T) {
+	act := Action{Inputs: []string{"a", "b"}}
+	if got := act.Input(1); got != "b" {
+		t.Errorf("Input(1) = %q, want %q", got, "b")
+	}
+	if got := act.Input(2); got != "" {
+		t.Errorf("Input(2) = %q, want empty", got)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
 	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/recording"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
 	"github.com/clawscli/claws/internal/view"
@@ -115,6 +116,13 @@ type App struct {
 	clipboardFlash   string
 	clipboardWarning bool
 
+	// Performance HUD
+	showPerfHUD bool
+	renderTime  time.Duration
+
+	// Session recording (--record); nil when not recording
+	recorder *recording.Recorder
+
 	styles appStyles
 }
 
@@ -339,6 +347,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return a, cmd
 				}
 			}
+			a.captureCurrentView()
 			return a, tea.Quit
 
 		case key.Matches(msg, a.keys.Help):
@@ -392,6 +401,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			return a, func() tea.Msg { return view.CompactHeaderChangedMsg{} }
+
+		case key.Matches(msg, a.keys.PerfHUD):
+			a.showPerfHUD = !a.showPerfHUD
+			return a, nil
 		}
 
 	case view.ShowModalMsg:
@@ -431,6 +444,13 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return clearFlashMsg{}
 		})
 
+	case clipboard.UnsupportedMsg:
+		a.clipboardFlash = msg.Reason
+		a.clipboardWarning = true
+		return a, tea.Tick(flashDuration, func(t time.Time) tea.Msg {
+			return clearFlashMsg{}
+		})
+
 	case clearFlashMsg:
 		a.clipboardFlash = ""
 		return a, nil
@@ -461,6 +481,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			log.Warn("failed to get DAO for startup resource", "error", err)
 		}
 		detailView := view.NewDetailView(a.ctx, msg.resource, renderer, a.startupPath.Service, a.startupPath.ResourceType, a.registry, d)
+		a.leaveCurrentView()
 		a.viewStack = append(a.viewStack, a.currentView)
 		a.currentView = detailView
 		return a, tea.Batch(detailView.Init(), detailView.SetSize(a.width, a.height-2))
@@ -471,6 +492,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case navmsg.ProfilesChangedMsg:
 		return a.handleProfilesChanged(msg)
 
+	case navmsg.FollowResourcesMsg:
+		return a.handleNavigate(view.NavigateMsg{View: view.NewResourceBrowserFollowing(a.ctx, a.registry, msg)})
+
 	case view.SortMsg:
 		// Delegate sort command to current view
 		if a.currentView != nil {
@@ -510,7 +534,9 @@ func (a *App) View() tea.View {
 
 	var content string
 	if a.currentView != nil {
+		start := time.Now()
 		content = a.currentView.ViewString()
+		a.renderTime = time.Since(start)
 	}
 
 	var statusContent string
@@ -534,6 +560,11 @@ func (a *App) View() tea.View {
 			statusContent = roIndicator + " " + statusContent
 		}
 
+		if config.Global().Sandbox() {
+			sandboxIndicator := a.styles.readOnly.Render("SANDBOX")
+			statusContent = sandboxIndicator + " " + statusContent
+		}
+
 		if a.awsInitializing {
 			statusContent = ui.DimStyle().Render("AWS initializing...") + " • " + statusContent
 		}
@@ -552,8 +583,16 @@ func (a *App) View() tea.View {
 	if contentHeight < 1 {
 		contentHeight = 1
 	}
-	paddedContent := ui.NoStyle().Height(contentHeight).Render(content)
-	mainView := paddedContent + "\n" + status
+	var mainView string
+	if a.showPerfHUD && a.currentView != nil && contentHeight > 1 {
+		// Views are sized to leave a spare line above the status line; the HUD uses it
+		paddedContent := ui.NoStyle().Height(contentHeight - 1).MaxHeight(contentHeight - 1).Render(content)
+		hud := ui.NoStyle().MaxWidth(a.width).Render(view.RenderPerfHUD(a.currentView, a.renderTime))
+		mainView = paddedContent + "\n" + hud + "\n" + status
+	} else {
+		paddedContent := ui.NoStyle().Height(contentHeight).Render(content)
+		mainView = paddedContent + "\n" + status
+	}
 
 	if a.modal != nil {
 		return newAltScreenView(a.modalRenderer.Render(a.modal, mainView, a.width, a.height))
@@ -655,6 +694,10 @@ func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.clearModalState()
 		return a.handleProfilesChanged(msg)
 
+	case navmsg.FollowResourcesMsg:
+		a.clearModalState()
+		return a.handleNavigate(view.NavigateMsg{View: view.NewResourceBrowserFollowing(a.ctx, a.registry, msg)})
+
 	case tea.KeyPressMsg:
 		if view.IsEscKey(msg) || msg.Code == tea.KeyBackspace || msg.String() == "q" || msg.String() == "ctrl+c" {
 			if ic, ok := a.modal.Content.(view.InputCapture); ok && ic.HasActiveInput() {
@@ -732,6 +775,7 @@ func (a *App) navigateBack() tea.Cmd {
 	if v == nil {
 		return nil
 	}
+	a.leaveCurrentView()
 	a.currentView = v
 	log.Debug("navigating back", "view", a.currentView.StatusLine(), "stackDepth", len(a.viewStack))
 	return tea.Batch(
@@ -744,6 +788,7 @@ func (a *App) navigateBack() tea.Cmd {
 // pushes the current view onto the stack (for drill-down navigation).
 // Enforces max stack size from config.
 func (a *App) pushOrClearStack(clearStack bool) {
+	a.leaveCurrentView()
 	if clearStack {
 		a.viewStack = nil
 	} else if a.currentView != nil {
@@ -758,6 +803,47 @@ func (a *App) pushOrClearStack(clearStack bool) {
 	}
 }
 
+// SetRecorder enables session recording. Each view is captured as it is
+// left and once more on quit.
+func (a *App) SetRecorder(r *recording.Recorder) {
+	a.recorder = r
+}
+
+// leaveCurrentView records the view being navigated away from and stops its
+// background fetches.
+func (a *App) leaveCurrentView() {
+	a.captureCurrentView()
+	cancelLoads(a.currentView)
+}
+
+func (a *App) captureCurrentView() {
+	if a.recorder == nil || a.currentView == nil {
+		return
+	}
+	step := recording.Step{
+		Title:  a.currentView.StatusLine(),
+		Screen: a.currentView.ViewString(),
+	}
+	switch v := a.currentView.(type) {
+	case *view.ResourceBrowser:
+		step.Service, step.ResourceType = v.Service(), v.ResourceType()
+	case *view.DetailView:
+		step.Service, step.ResourceType = v.Service(), v.ResourceType()
+		if r := v.Resource(); r != nil {
+			step.ResourceID = r.GetID()
+		}
+	}
+	a.recorder.Capture(step)
+}
+
+// cancelLoads stops background fetches of a view that is being left.
+// Views pushed on the stack reload in Init when navigated back to.
+func cancelLoads(v view.View) {
+	if c, ok := v.(view.LoadCanceler); ok {
+		c.CancelLoads()
+	}
+}
+
 func (a *App) fetchStartupResource() tea.Msg {
 	if a.startupPath == nil || a.startupPath.ResourceID == "" {
 		return noOpMsg{}
@@ -841,6 +927,7 @@ type keyMap struct {
 	Profile       key.Binding
 	AI            key.Binding
 	CompactHeader key.Binding
+	PerfHUD       key.Binding
 	Help          key.Binding
 	Quit          key.Binding
 }
@@ -887,6 +974,10 @@ func defaultKeyMap() keyMap {
 			key.WithKeys("ctrl+e"),
 			key.WithHelp("ctrl+e", "compact header"),
 		),
+		PerfHUD: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "performance HUD"),
+		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp("?", "help"),
@@ -3,11 +3,13 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
 	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/recording"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/view"
 )
@@ -44,6 +46,13 @@ func (m *RefreshableMockView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+type CancelableMockView struct {
+	MockView
+	cancelled int
+}
+
+func (m *CancelableMockView) CancelLoads() { m.cancelled++ }
+
 func newTestApp(t *testing.T) *App {
 	t.Helper()
 	ctx := context.Background()
@@ -742,6 +751,46 @@ func TestNavigateBackWithEmptyStack(t *testing.T) {
 	}
 }
 
+func TestNavigationCancelsLoadsOfLeftView(t *testing.T) {
+	app := newTestApp(t)
+	list := &CancelableMockView{MockView: MockView{name: "List"}}
+	app.currentView = list
+
+	app.pushOrClearStack(false)
+	app.currentView = &MockView{name: "Detail"}
+	if list.cancelled != 1 {
+		t.Errorf("expected drill-down to cancel loads once, got %d", list.cancelled)
+	}
+
+	detail := &CancelableMockView{MockView: MockView{name: "Detail"}}
+	app.currentView = detail
+	app.navigateBack()
+	if detail.cancelled != 1 {
+		t.Errorf("expected back navigation to cancel loads of the left view, got %d", detail.cancelled)
+	}
+	if app.currentView != list {
+		t.Errorf("expected to return to list view, got %T", app.currentView)
+	}
+}
+
+func TestRecorderCapturesLeftViewsAndQuit(t *testing.T) {
+	app := newTestApp(t)
+	rec := recording.NewRecorder("unused", "dev")
+	app.SetRecorder(rec)
+
+	app.currentView = &MockView{name: "List 123456789012"}
+	app.pushOrClearStack(false)
+	app.currentView = &MockView{name: "Detail"}
+	app.navigateBack()
+
+	app.Update(tea.KeyPressMsg{Code: 'q', Text: "q"})
+
+	// List, Detail, then List again on quit
+	if rec.Len() != 3 {
+		t.Fatalf("expected 3 captured steps, got %d", rec.Len())
+	}
+}
+
 func TestRefreshCurrentViewWithNilView(t *testing.T) {
 	app := newTestApp(t)
 	app.currentView = nil
@@ -775,3 +824,23 @@ func TestRefreshCurrentViewKeepsNonRefreshableViewUnchanged(t *testing.T) {
 		t.Errorf("Expected currentView unchanged, got %T", app.currentView)
 	}
 }
+
+func TestPerfHUDToggle(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "Dashboard"}
+
+	ctrlT := tea.KeyPressMsg{Code: 't', Mod: tea.ModCtrl}
+	app.Update(ctrlT)
+	if !app.showPerfHUD {
+		t.Fatal("expected HUD after ctrl+t")
+	}
+	content := app.View().Content
+	if !strings.Contains(content, "PERF") || !strings.Contains(content, "no fetch stats") {
+		t.Errorf("HUD missing from view:\n%s", content)
+	}
+
+	app.Update(ctrlT)
+	if app.showPerfHUD || strings.Contains(app.View().Content, "PERF") {
+		t.Error("expected HUD hidden after second ctrl+t")
+	}
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/recording"
+	"github.com/clawscli/claws/internal/ui"
+	"github.com/clawscli/claws/internal/view"
+)
+
+// Replay is the top-level model for `claws --replay`. It needs no AWS
+// credentials and only steps through the recorded screens.
+type Replay struct {
+	view   *view.ReplayView
+	width  int
+	height int
+	styles appStyles
+}
+
+// NewReplay creates a replay model for a loaded recording
+func NewReplay(rec *recording.Recording) *Replay {
+	return &Replay{
+		view:   view.NewReplayView(rec),
+		styles: newAppStyles(0),
+	}
+}
+
+// Init implements tea.Model
+func (r *Replay) Init() tea.Cmd {
+	return r.view.Init()
+}
+
+// Update implements tea.Model
+func (r *Replay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		r.width = msg.Width
+		r.height = msg.Height
+		r.styles = newAppStyles(msg.Width)
+		return r, r.view.SetSize(msg.Width, msg.Height-1)
+	case tea.KeyPressMsg:
+		if msg.String() == "q" || msg.String() == "ctrl+c" || view.IsEscKey(msg) {
+			return r, tea.Quit
+		}
+	}
+	_, cmd := r.view.Update(msg)
+	return r, cmd
+}
+
+// View implements tea.Model
+func (r *Replay) View() tea.View {
+	contentHeight := max(r.height-1, 1)
+	content := ui.NoStyle().Height(contentHeight).MaxHeight(contentHeight).Render(r.view.ViewString())
+	return newAltScreenView(content + "\n" + r.styles.status.Render(r.view.StatusLine()))
+}
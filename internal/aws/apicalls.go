@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// totalAPICalls counts every AWS API operation made by this process
+var totalAPICalls atomic.Int64
+
+// APICallCounter counts the AWS API operations made with a context carrying it.
+// Retries of an operation are not counted separately.
+type APICallCounter struct {
+	n atomic.Int64
+}
+
+// Count returns the number of operations counted so far
+func (c *APICallCounter) Count() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.n.Load()
+}
+
+type apiCallCounterKey struct{}
+
+// WithAPICallCounter returns a context whose AWS API operations are counted by c
+func WithAPICallCounter(ctx context.Context, c *APICallCounter) context.Context {
+	return context.WithValue(ctx, apiCallCounterKey{}, c)
+}
+
+// TotalAPICalls returns the number of AWS API operations made by this process
+func TotalAPICalls() int64 {
+	return totalAPICalls.Load()
+}
+
+// addAPICallCounter registers the middleware counting operations on a client stack
+func addAPICallCounter(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("ClawsAPICallCounter",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			totalAPICalls.Add(1)
+			if c, ok := ctx.Value(apiCallCounterKey{}).(*APICallCounter); ok {
+				c.n.Add(1)
+			}
+			return next.HandleInitialize(ctx, in)
+		}), middleware.Before)
+}
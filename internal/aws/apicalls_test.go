@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestAPICallCounter(t *testing.T) {
+	stack := middleware.NewStack("test", func() any { return nil })
+	if err := addAPICallCounter(stack); err != nil {
+		t.Fatal(err)
+	}
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	counter := &APICallCounter{}
+	ctx := WithAPICallCounter(context.Background(), counter)
+	total := TotalAPICalls()
+
+	for range 3 {
+		if _, _, err := handler.Handle(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Calls without a counter only count towards the session total
+	if _, _, err := handler.Handle(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := counter.Count(); got != 3 {
+		t.Errorf("counter = %d, want 3", got)
+	}
+	if got := TotalAPICalls() - total; got != 4 {
+		t.Errorf("total delta = %d, want 4", got)
+	}
+
+	var nilCounter *APICallCounter
+	if nilCounter.Count() != 0 {
+		t.Error("nil counter should count 0")
+	}
+}
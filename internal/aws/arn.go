@@ -198,6 +198,7 @@ var arnToRegistryType = map[string]string{
 	"ec2/snapshot":                      "snapshots",
 	"ec2/launch-template":               "launch-templates",
 	"ec2/capacity-reservation":          "capacity-reservations",
+	"ec2/prefix-list":                   "prefix-lists",
 	"ec2/vpc":                           "vpcs",
 	"ec2/subnet":                        "subnets",
 	"ec2/route-table":                   "route-tables",
@@ -245,13 +246,16 @@ var arnToRegistryType = map[string]string{
 	"glue/job":                          "jobs",
 	"bedrock/foundation-model":          "foundation-models",
 	"bedrock/inference-profile":         "inference-profiles",
+	"bedrock/provisioned-model":         "provisioned-throughputs",
 	"bedrock/guardrail":                 "guardrails",
 	"bedrock-agent/agent":               "agents",
 	"bedrock-agent/knowledge-base":      "knowledge-bases",
 	"bedrock-agent/flow":                "flows",
 	"bedrock-agentcore/runtime":         "runtimes",
 	"route53/hostedzone":                "hosted-zones",
+	"route53/healthcheck":               "health-checks",
 	"cloudfront/distribution":           "distributions",
+	"cloudfront/function":               "functions",
 	"acm/certificate":                   "certificates",
 	"ssm/parameter":                     "parameters",
 	"cognito-idp/userpool":              "user-pools",
@@ -403,3 +407,14 @@ func (a *ARN) ExtractParentFilter() (key, value string) {
 
 	return "", ""
 }
+
+// LogGroupNameFromARN returns the log group name of a CloudWatch Logs
+// log-group ARN, dropping the ":*" suffix some services append.
+// Anything that is not a log-group ARN is returned unchanged.
+func LogGroupNameFromARN(arn string) string {
+	a := ParseARN(arn)
+	if a == nil || a.ResourceType != "log-group" {
+		return arn
+	}
+	return strings.TrimSuffix(a.ResourceID, ":*")
+}
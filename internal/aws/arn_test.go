@@ -540,3 +540,21 @@ func TestARN_ExtractParentFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestLogGroupNameFromARN(t *testing.T) {
+	tests := []struct {
+		arn  string
+		want string
+	}{
+		{"arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/fn", "/aws/lambda/fn"},
+		{"arn:aws:logs:us-east-1:123456789012:log-group:/aws/vendedlogs/states/sm:*", "/aws/vendedlogs/states/sm"},
+		{"arn:aws:firehose:us-east-1:123456789012:deliverystream/access", "arn:aws:firehose:us-east-1:123456789012:deliverystream/access"},
+		{"/already/a/name", "/already/a/name"},
+	}
+
+	for _, tt := range tests {
+		if got := LogGroupNameFromARN(tt.arn); got != tt.want {
+			t.Errorf("LogGroupNameFromARN(%q) = %q, want %q", tt.arn, got, tt.want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/smithy-go/middleware"
 
 	appconfig "github.com/clawscli/claws/internal/config"
 )
@@ -52,11 +53,15 @@ func NewConfig(ctx context.Context) (aws.Config, error) {
 	if region != "" {
 		opts = append(opts, config.WithRegion(region))
 	}
+	opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{addAPICallCounter}))
 
 	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return aws.Config{}, fmt.Errorf("load AWS config: %w", err)
 	}
+	if err := applySandbox(ctx, &cfg, sel); err != nil {
+		return aws.Config{}, err
+	}
 	return cfg, nil
 }
 
@@ -67,10 +72,14 @@ func NewConfigWithRegion(ctx context.Context, region string) (aws.Config, error)
 	}
 	opts := SelectionLoadOptions(sel)
 	opts = append(opts, config.WithRegion(region))
+	opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{addAPICallCounter}))
 
 	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return aws.Config{}, fmt.Errorf("load AWS config for region %s: %w", region, err)
 	}
+	if err := applySandbox(ctx, &cfg, sel); err != nil {
+		return aws.Config{}, err
+	}
 	return cfg, nil
 }
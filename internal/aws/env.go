@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"context"
 	"os"
 	"strings"
 
 	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // BuildSubprocessEnv constructs environment variables for AWS CLI subprocesses.
@@ -13,6 +15,13 @@ import (
 //   - SDKDefault: preserve existing AWS_PROFILE (don't modify)
 //   - EnvOnly: remove AWS_PROFILE, set config/credentials files to /dev/null
 //   - NamedProfile: set AWS_PROFILE to the profile name
+//   - SSORole: remove AWS_PROFILE, inject the role's temporary credentials
+//
+// In sandbox mode the profile handling is replaced: AWS_PROFILE and any
+// credentials are removed and the sandboxed temporary credentials are
+// injected, so subprocesses are bound by the same session policy as claws.
+// If those credentials cannot be retrieved, config/credentials files are set
+// to /dev/null and IMDS is disabled so the subprocess has no credentials.
 //
 // Region behavior:
 //   - If region is non-empty, inject both AWS_REGION and AWS_DEFAULT_REGION
@@ -24,14 +33,17 @@ func BuildSubprocessEnv(baseEnv []string, sel config.ProfileSelection, region st
 
 	// Build set of keys to remove
 	keysToRemove := map[string]bool{}
+	sandbox := config.Global().Sandbox()
 
-	switch sel.Mode {
-	case config.ModeEnvOnly:
-		keysToRemove["AWS_PROFILE"] = true
-		keysToRemove["AWS_CONFIG_FILE"] = true
-		keysToRemove["AWS_SHARED_CREDENTIALS_FILE"] = true
-	case config.ModeNamedProfile:
-		keysToRemove["AWS_PROFILE"] = true
+	if sandbox {
+		for _, key := range []string{
+			"AWS_PROFILE", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN",
+			"AWS_CONFIG_FILE", "AWS_SHARED_CREDENTIALS_FILE", "AWS_EC2_METADATA_DISABLED",
+		} {
+			keysToRemove[key] = true
+		}
+	} else {
+		removeSelectionKeys(keysToRemove, sel)
 	}
 
 	if region != "" {
@@ -54,21 +66,78 @@ func BuildSubprocessEnv(baseEnv []string, sel config.ProfileSelection, region st
 		}
 	}
 
-	// Add profile-related env vars based on mode
+	if sandbox {
+		env = appendSandboxEnv(env, sel)
+	} else {
+		env = appendSelectionEnv(env, sel)
+	}
+
+	// Add region if set
+	if region != "" {
+		env = append(env, "AWS_REGION="+region)
+		env = append(env, "AWS_DEFAULT_REGION="+region)
+	}
+
+	return env
+}
+
+// removeSelectionKeys marks the env vars replaced for the credential mode of sel
+func removeSelectionKeys(keysToRemove map[string]bool, sel config.ProfileSelection) {
+	switch sel.Mode {
+	case config.ModeEnvOnly:
+		keysToRemove["AWS_PROFILE"] = true
+		keysToRemove["AWS_CONFIG_FILE"] = true
+		keysToRemove["AWS_SHARED_CREDENTIALS_FILE"] = true
+	case config.ModeNamedProfile:
+		keysToRemove["AWS_PROFILE"] = true
+	case config.ModeSSORole:
+		keysToRemove["AWS_PROFILE"] = true
+		keysToRemove["AWS_ACCESS_KEY_ID"] = true
+		keysToRemove["AWS_SECRET_ACCESS_KEY"] = true
+		keysToRemove["AWS_SESSION_TOKEN"] = true
+	}
+}
+
+// appendSelectionEnv adds profile-related env vars based on the mode of sel
+func appendSelectionEnv(env []string, sel config.ProfileSelection) []string {
 	switch sel.Mode {
 	case config.ModeNamedProfile:
 		env = append(env, "AWS_PROFILE="+sel.ProfileName)
+	case config.ModeSSORole:
+		// The CLI has no profile for the role, so pass its credentials
+		creds, err := ssoRoleCredentials(sel).Retrieve(context.Background())
+		if err != nil {
+			log.Warn("failed to retrieve sso role credentials", "selection", sel.DisplayName(), "error", err)
+			break
+		}
+		env = append(env,
+			"AWS_ACCESS_KEY_ID="+creds.AccessKeyID,
+			"AWS_SECRET_ACCESS_KEY="+creds.SecretAccessKey,
+			"AWS_SESSION_TOKEN="+creds.SessionToken,
+		)
 	case config.ModeEnvOnly:
 		// Force CLI to ignore config files, use IMDS/env only
 		env = append(env, "AWS_CONFIG_FILE="+os.DevNull)
 		env = append(env, "AWS_SHARED_CREDENTIALS_FILE="+os.DevNull)
 	}
+	return env
+}
 
-	// Add region if set
-	if region != "" {
-		env = append(env, "AWS_REGION="+region)
-		env = append(env, "AWS_DEFAULT_REGION="+region)
+// appendSandboxEnv adds the sandboxed credentials of sel. Never falls back to
+// the unrestricted credentials of the profile.
+func appendSandboxEnv(env []string, sel config.ProfileSelection) []string {
+	creds, err := sandboxCredentials(context.Background(), sel)
+	if err != nil {
+		log.Warn("failed to retrieve sandbox credentials", "selection", sel.DisplayName(), "error", err)
+		return append(env,
+			"AWS_CONFIG_FILE="+os.DevNull,
+			"AWS_SHARED_CREDENTIALS_FILE="+os.DevNull,
+			"AWS_EC2_METADATA_DISABLED=true",
+		)
 	}
-
-	return env
+	return append(env,
+		"AWS_ACCESS_KEY_ID="+creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY="+creds.SecretAccessKey,
+		"AWS_SESSION_TOKEN="+creds.SessionToken,
+	)
 }
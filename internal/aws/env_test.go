@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/credentials"
+
 	"github.com/clawscli/claws/internal/config"
 )
 
@@ -126,3 +128,50 @@ func TestBuildSubprocessEnv_NilBaseEnv(t *testing.T) {
 		t.Error("BuildSubprocessEnv should return non-nil slice")
 	}
 }
+
+func TestBuildSubprocessEnv_Sandbox(t *testing.T) {
+	sel := config.NamedProfile("production")
+	config.Global().EnableSandbox("")
+	sandboxMu.Lock()
+	sandboxProviders[sel.ID()] = credentials.NewStaticCredentialsProvider("ASIASANDBOX", "sandbox-secret", "sandbox-token")
+	sandboxMu.Unlock()
+	t.Cleanup(func() {
+		config.Global().DisableSandbox()
+		sandboxMu.Lock()
+		delete(sandboxProviders, sel.ID())
+		sandboxMu.Unlock()
+	})
+
+	baseEnv := []string{
+		"HOME=/home/user",
+		"AWS_PROFILE=existing",
+		"AWS_ACCESS_KEY_ID=AKIAUNRESTRICTED",
+		"AWS_SECRET_ACCESS_KEY=unrestricted-secret",
+	}
+	result := BuildSubprocessEnv(baseEnv, sel, "us-east-1")
+
+	envMap := make(map[string]string)
+	for _, e := range result {
+		key, value, _ := strings.Cut(e, "=")
+		if _, dup := envMap[key]; dup {
+			t.Errorf("%s is set more than once", key)
+		}
+		envMap[key] = value
+	}
+
+	want := map[string]string{
+		"AWS_ACCESS_KEY_ID":     "ASIASANDBOX",
+		"AWS_SECRET_ACCESS_KEY": "sandbox-secret",
+		"AWS_SESSION_TOKEN":     "sandbox-token",
+		"AWS_REGION":            "us-east-1",
+		"HOME":                  "/home/user",
+	}
+	for key, value := range want {
+		if envMap[key] != value {
+			t.Errorf("%s = %q, want %q", key, envMap[key], value)
+		}
+	}
+	if profile, ok := envMap["AWS_PROFILE"]; ok {
+		t.Errorf("AWS_PROFILE = %q, want unset in sandbox mode", profile)
+	}
+}
@@ -20,7 +20,7 @@ func InitContext(ctx context.Context) error {
 		if appconfig.Global().Region() == "" {
 			appconfig.Global().SetRegion(cfg.Region)
 		}
-		accountID := FetchAccountID(ctx, cfg)
+		accountID := resolveAccountID(ctx, selections[0], cfg)
 		appconfig.Global().SetAccountID(accountID)
 		return nil
 	}
@@ -67,7 +67,7 @@ func RefreshContextData(ctx context.Context) (region string, accountIDs map[stri
 				errChan <- cfgErr
 				return
 			}
-			id := FetchAccountID(ctx, cfg)
+			id := resolveAccountID(ctx, s, cfg)
 			mu.Lock()
 			accountIDs[s.ID()] = id
 			mu.Unlock()
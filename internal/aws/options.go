@@ -11,7 +11,20 @@ import (
 //   - ModeSDKDefault: no extra options, let SDK use standard chain
 //   - ModeEnvOnly: ignore ~/.aws files, use IMDS/environment only
 //   - ModeNamedProfile: explicitly use that profile from ~/.aws files
+//   - ModeSSORole: use the role through the SSO session of the profile
+//
+// Named profiles and SSO roles use credentials of the warm source when set.
 func SelectionLoadOptions(sel appconfig.ProfileSelection) []func(*config.LoadOptions) error {
+	opts := localLoadOptions(sel)
+	if provider := warmCredentials(sel); provider != nil {
+		opts = append(opts, config.WithCredentialsProvider(provider))
+	}
+	return opts
+}
+
+// localLoadOptions returns the load options that resolve credentials in
+// this process
+func localLoadOptions(sel appconfig.ProfileSelection) []func(*config.LoadOptions) error {
 	opts := []func(*config.LoadOptions) error{
 		config.WithEC2IMDSRegion(),
 	}
@@ -23,6 +36,11 @@ func SelectionLoadOptions(sel appconfig.ProfileSelection) []func(*config.LoadOpt
 		)
 	case appconfig.ModeNamedProfile:
 		opts = append(opts, config.WithSharedConfigProfile(sel.ProfileName))
+	case appconfig.ModeSSORole:
+		opts = append(opts,
+			config.WithSharedConfigProfile(sel.ProfileName),
+			config.WithCredentialsProvider(ssoRoleCredentials(sel)),
+		)
 	case appconfig.ModeSDKDefault:
 		// No extra options - let SDK use standard chain
 	}
@@ -27,6 +27,11 @@ func TestSelectionLoadOptions(t *testing.T) {
 			sel:     config.NamedProfile("production"),
 			wantLen: 2, // IMDS region + profile option
 		},
+		{
+			name:    "sso role",
+			sel:     config.SSORole("sso-admin", "123456789012", "ReadOnly"),
+			wantLen: 3, // IMDS region + session profile + credentials provider
+		},
 	}
 
 	for _, tt := range tests {
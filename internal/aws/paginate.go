@@ -39,6 +39,33 @@ func PaginateMarker[T any](ctx context.Context, fetch func(marker *string) (item
 	return Paginate(ctx, fetch)
 }
 
+// PaginateStream is like Paginate but hands each page to emit as it arrives
+// instead of collecting all items. Stops early when emit returns false.
+func PaginateStream[T any](ctx context.Context, fetch func(token *string) (items []T, nextToken *string, err error), emit func([]T) bool) error {
+	var token *string
+
+	for {
+		items, nextToken, err := fetch(token)
+		if err != nil {
+			return err
+		}
+
+		if !emit(items) {
+			return nil
+		}
+
+		if nextToken == nil || *nextToken == "" {
+			return nil
+		}
+		token = nextToken
+
+		// Check context cancellation between pages
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
 // PaginateIter returns an iterator that yields items one at a time from paginated results.
 // This is memory-efficient for large result sets and supports early termination.
 // Uses Go 1.23+ range over function feature.
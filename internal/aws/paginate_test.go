@@ -236,3 +236,47 @@ func TestPaginateMarker(t *testing.T) {
 		}
 	})
 }
+
+func TestPaginateStream(t *testing.T) {
+	fetch := func(token *string) ([]int, *string, error) {
+		if token == nil {
+			next := "page2"
+			return []int{1, 2}, &next, nil
+		}
+		return []int{3}, nil, nil
+	}
+
+	t.Run("emits each page", func(t *testing.T) {
+		var pages [][]int
+		err := PaginateStream(context.Background(), fetch, func(items []int) bool {
+			pages = append(pages, items)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pages) != 2 || len(pages[0]) != 2 || pages[1][0] != 3 {
+			t.Errorf("pages = %v", pages)
+		}
+	})
+
+	t.Run("stops when emit returns false", func(t *testing.T) {
+		calls := 0
+		err := PaginateStream(context.Background(), fetch, func(items []int) bool {
+			calls++
+			return false
+		})
+		if err != nil || calls != 1 {
+			t.Errorf("calls = %d, err = %v", calls, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := PaginateStream(context.Background(), func(token *string) ([]int, *string, error) {
+			return nil, nil, errors.New("boom")
+		}, func([]int) bool { return true })
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
 	appconfig "github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // CommonRegions is a fallback list of common AWS regions
@@ -26,19 +27,37 @@ var CommonRegions = []string{
 	"sa-east-1",
 }
 
-// FetchAvailableRegions fetches available regions from AWS using the current profile.
-// Falls back to CommonRegions on error.
+// FetchAvailableRegions fetches available regions from AWS using the current profile,
+// from the warm source if it has them. Falls back to CommonRegions on error.
 func FetchAvailableRegions(ctx context.Context) ([]string, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, SelectionLoadOptions(appconfig.Global().Selection())...)
+	sel := appconfig.Global().Selection()
+	if source := currentWarmSource(); source != nil && Warmable(sel) {
+		regions, err := source.Regions(ctx, sel)
+		if err == nil {
+			return regions, nil
+		}
+		log.Debug("warm regions unavailable", "selection", sel.DisplayName(), "error", err)
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, SelectionLoadOptions(sel)...)
 	if err != nil {
 		return CommonRegions, nil // Fallback to common regions
 	}
 
-	client := ec2.NewFromConfig(cfg)
-	output, err := client.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+	regions, err := DescribeRegionNames(ctx, ec2.NewFromConfig(cfg))
 	if err != nil {
 		return CommonRegions, nil // Fallback to common regions
 	}
+	return regions, nil
+}
+
+// DescribeRegionNames returns the names of the regions enabled for the
+// account of client
+func DescribeRegionNames(ctx context.Context, client *ec2.Client) ([]string, error) {
+	output, err := client.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, err
+	}
 
 	regions := make([]string, 0, len(output.Regions))
 	for _, r := range output.Regions {
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+const (
+	sandboxSessionName = "claws-sandbox"
+	// Role chaining caps the session at one hour; credentials are refreshed
+	// by the credentials cache before they expire
+	sandboxDuration = time.Hour
+	// sandboxRegion is used for STS when the profile has no region
+	sandboxRegion = "us-east-1"
+)
+
+var (
+	sandboxMu        sync.Mutex
+	sandboxProviders = make(map[string]aws.CredentialsProvider)
+)
+
+// applySandbox replaces the credentials of cfg with credentials of the same
+// role restricted by the sandbox session policy. Providers are cached per
+// profile selection. Errors are returned rather than falling back to the
+// unrestricted credentials.
+func applySandbox(ctx context.Context, cfg *aws.Config, sel appconfig.ProfileSelection) error {
+	if !appconfig.Global().Sandbox() {
+		return nil
+	}
+
+	sandboxMu.Lock()
+	defer sandboxMu.Unlock()
+
+	provider, ok := sandboxProviders[sel.ID()]
+	if !ok {
+		var err error
+		provider, err = newSandboxProvider(ctx, *cfg, appconfig.Global().SandboxPolicy())
+		if err != nil {
+			return fmt.Errorf("sandbox %s: %w", sel.DisplayName(), err)
+		}
+		sandboxProviders[sel.ID()] = provider
+	}
+	cfg.Credentials = provider
+	return nil
+}
+
+// sandboxCredentials returns the sandboxed credentials of sel, assuming the
+// role with the session policy first if no SDK config has done so yet
+func sandboxCredentials(ctx context.Context, sel appconfig.ProfileSelection) (aws.Credentials, error) {
+	sandboxMu.Lock()
+	provider, ok := sandboxProviders[sel.ID()]
+	sandboxMu.Unlock()
+	if !ok {
+		cfg, err := NewConfig(WithSelectionOverride(ctx, sel))
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+		provider = cfg.Credentials
+	}
+	return provider.Retrieve(ctx)
+}
+
+// newSandboxProvider re-assumes the role of cfg's credentials with policy as
+// an inline session policy, or ReadOnlyAccess if policy is empty
+func newSandboxProvider(ctx context.Context, cfg aws.Config, policy string) (aws.CredentialsProvider, error) {
+	if cfg.Region == "" {
+		cfg.Region = sandboxRegion
+	}
+	stsClient := sts.NewFromConfig(cfg)
+
+	identity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("get caller identity: %w", err)
+	}
+	roleARN, partition, err := SandboxRoleARN(aws.ToString(identity.Arn))
+	if err != nil {
+		return nil, err
+	}
+	roleARN = roleARNWithPath(ctx, cfg, roleARN)
+
+	provider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = sandboxSessionName
+		o.Duration = sandboxDuration
+		if policy != "" {
+			o.Policy = aws.String(policy)
+		} else {
+			o.PolicyARNs = []ststypes.PolicyDescriptorType{
+				{Arn: aws.String(ReadOnlyPolicyARN(partition))},
+			}
+		}
+	}))
+
+	// Assume the role now so a role that does not trust itself fails at
+	// startup instead of on every request
+	if _, err := provider.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("assume %s with session policy: %w", roleARN, err)
+	}
+	return provider, nil
+}
+
+// SandboxRoleARN returns the ARN of the role behind an assumed-role session
+// ARN, without the role path, and the ARN partition. Other identities cannot
+// be re-assumed.
+func SandboxRoleARN(identityARN string) (roleARN, partition string, err error) {
+	parsed, err := arn.Parse(identityARN)
+	if err != nil {
+		return "", "", fmt.Errorf("parse caller identity %q: %w", identityARN, err)
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if parsed.Service != "sts" || len(parts) < 3 || parts[0] != "assumed-role" {
+		return "", "", fmt.Errorf("sandbox requires role credentials, current identity is %s", identityARN)
+	}
+	role := arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "iam",
+		AccountID: parsed.AccountID,
+		Resource:  "role/" + parts[1],
+	}
+	return role.String(), parsed.Partition, nil
+}
+
+// ReadOnlyPolicyARN returns the ARN of the AWS managed ReadOnlyAccess policy
+func ReadOnlyPolicyARN(partition string) string {
+	return "arn:" + partition + ":iam::aws:policy/ReadOnlyAccess"
+}
+
+// roleARNWithPath looks up the full ARN of a role, which includes its path.
+// Assumed-role ARNs omit the path, so roles outside "/" cannot be assumed
+// without it. Falls back to roleARN if the role cannot be read.
+func roleARNWithPath(ctx context.Context, cfg aws.Config, roleARN string) string {
+	name := roleARN[strings.LastIndex(roleARN, "/")+1:]
+	output, err := iam.NewFromConfig(cfg).GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(name)})
+	if err != nil || output.Role == nil || output.Role.Arn == nil {
+		return roleARN
+	}
+	return *output.Role.Arn
+}
@@ -0,0 +1,59 @@
+package aws
+
+import "testing"
+
+func TestSandboxRoleARN(t *testing.T) {
+	tests := []struct {
+		name          string
+		identity      string
+		wantRole      string
+		wantPartition string
+		wantErr       bool
+	}{
+		{
+			name:          "assumed role",
+			identity:      "arn:aws:sts::123456789012:assumed-role/Developer/alice",
+			wantRole:      "arn:aws:iam::123456789012:role/Developer",
+			wantPartition: "aws",
+		},
+		{
+			name:          "china partition",
+			identity:      "arn:aws-cn:sts::123456789012:assumed-role/Ops/session",
+			wantRole:      "arn:aws-cn:iam::123456789012:role/Ops",
+			wantPartition: "aws-cn",
+		},
+		{
+			name:     "iam user",
+			identity: "arn:aws:iam::123456789012:user/alice",
+			wantErr:  true,
+		},
+		{
+			name:     "federated user",
+			identity: "arn:aws:sts::123456789012:federated-user/alice",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid",
+			identity: "not-an-arn",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, partition, err := SandboxRoleARN(tt.identity)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SandboxRoleARN(%q) error = %v, wantErr %v", tt.identity, err, tt.wantErr)
+			}
+			if role != tt.wantRole || partition != tt.wantPartition {
+				t.Errorf("SandboxRoleARN(%q) = %q, %q, want %q, %q", tt.identity, role, partition, tt.wantRole, tt.wantPartition)
+			}
+		})
+	}
+}
+
+func TestReadOnlyPolicyARN(t *testing.T) {
+	if got, want := ReadOnlyPolicyARN("aws-us-gov"), "arn:aws-us-gov:iam::aws:policy/ReadOnlyAccess"; got != want {
+		t.Errorf("ReadOnlyPolicyARN() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	ssotypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssooidc"
+	"golang.org/x/sync/errgroup"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ssoListConcurrency bounds the concurrent ListAccountRoles calls
+const ssoListConcurrency = 8
+
+var (
+	ssoRoleMu        sync.Mutex
+	ssoRoleProviders = make(map[string]aws.CredentialsProvider)
+)
+
+// SSOAccountRole is a role the SSO session of a profile can use in an account
+type SSOAccountRole struct {
+	AccountID   string
+	AccountName string
+	Email       string
+	RoleName    string
+}
+
+// Selection returns the profile selection that uses this role through the
+// SSO session of profile
+func (r SSOAccountRole) Selection(profile string) appconfig.ProfileSelection {
+	return appconfig.SSORole(profile, r.AccountID, r.RoleName)
+}
+
+// SSOSession is the SSO portal session configured for a profile. Its clients
+// can be reused across calls; the access token is reread on every call.
+type SSOSession struct {
+	profile string
+	client  *sso.Client
+	tokens  *ssocreds.SSOTokenProvider
+}
+
+// LoadSSOSession reads the SSO settings of profile, either from its
+// sso-session section or the legacy sso_start_url keys. The access token is
+// read from the cache written by `aws sso login`.
+func LoadSSOSession(ctx context.Context, profile string) (*SSOSession, error) {
+	shared, err := config.LoadSharedConfigProfile(ctx, profile)
+	if err != nil {
+		return nil, fmt.Errorf("load profile %s: %w", profile, err)
+	}
+
+	cacheKey, region := shared.SSOStartURL, shared.SSORegion
+	if shared.SSOSession != nil {
+		cacheKey, region = shared.SSOSessionName, shared.SSOSession.SSORegion
+	}
+	if cacheKey == "" || region == "" {
+		return nil, fmt.Errorf("profile %s is not an SSO profile", profile)
+	}
+	tokenPath, err := ssocreds.StandardCachedTokenFilepath(cacheKey)
+	if err != nil {
+		return nil, fmt.Errorf("sso token cache for %s: %w", profile, err)
+	}
+
+	cfg := aws.Config{Region: region}
+	return &SSOSession{
+		profile: profile,
+		client:  sso.NewFromConfig(cfg),
+		tokens:  ssocreds.NewSSOTokenProvider(ssooidc.NewFromConfig(cfg), tokenPath),
+	}, nil
+}
+
+// ListSSOAccountRoles lists the accounts and roles available to the SSO
+// session of profile, sorted by account name and role. Roles kept warm by
+// the warm source are used if there is one.
+func ListSSOAccountRoles(ctx context.Context, profile string) ([]SSOAccountRole, error) {
+	return listSSOAccountRoles(ctx, profile, false)
+}
+
+// ReloadSSOAccountRoles is ListSSOAccountRoles, bypassing roles cached by the
+// warm source
+func ReloadSSOAccountRoles(ctx context.Context, profile string) ([]SSOAccountRole, error) {
+	return listSSOAccountRoles(ctx, profile, true)
+}
+
+func listSSOAccountRoles(ctx context.Context, profile string, refresh bool) ([]SSOAccountRole, error) {
+	if source := currentWarmSource(); source != nil {
+		roles, err := source.SSOAccountRoles(ctx, profile, refresh)
+		if !errors.Is(err, ErrWarmSourceUnavailable) {
+			return roles, err
+		}
+		log.Debug("listing sso roles in-process", "profile", profile, "error", err)
+	}
+
+	session, err := LoadSSOSession(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	return session.AccountRoles(ctx)
+}
+
+// AccountRoles lists the accounts and roles available to the session, sorted
+// by account name and role
+func (s *SSOSession) AccountRoles(ctx context.Context) ([]SSOAccountRole, error) {
+	token, err := s.tokens.RetrieveBearerToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sso session for %s (run aws sso login): %w", s.profile, err)
+	}
+
+	accounts, err := Paginate(ctx, func(next *string) ([]ssotypes.AccountInfo, *string, error) {
+		output, err := s.client.ListAccounts(ctx, &sso.ListAccountsInput{
+			AccessToken: &token.Value,
+			NextToken:   next,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("list sso accounts: %w", err)
+		}
+		return output.AccountList, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([][]SSOAccountRole, len(accounts))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(ssoListConcurrency)
+	for i, account := range accounts {
+		g.Go(func() error {
+			accountRoles, err := Paginate(gctx, func(next *string) ([]ssotypes.RoleInfo, *string, error) {
+				output, err := s.client.ListAccountRoles(gctx, &sso.ListAccountRolesInput{
+					AccessToken: &token.Value,
+					AccountId:   account.AccountId,
+					NextToken:   next,
+				})
+				if err != nil {
+					return nil, nil, fmt.Errorf("list sso roles for %s: %w", Str(account.AccountId), err)
+				}
+				return output.RoleList, output.NextToken, nil
+			})
+			if err != nil {
+				return err
+			}
+			for _, role := range accountRoles {
+				roles[i] = append(roles[i], SSOAccountRole{
+					AccountID:   Str(account.AccountId),
+					AccountName: Str(account.AccountName),
+					Email:       Str(account.EmailAddress),
+					RoleName:    Str(role.RoleName),
+				})
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := slices.Concat(roles...)
+	slices.SortFunc(result, func(a, b SSOAccountRole) int {
+		if c := strings.Compare(strings.ToLower(a.AccountName), strings.ToLower(b.AccountName)); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.AccountID, b.AccountID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.RoleName, b.RoleName)
+	})
+	return result, nil
+}
+
+// ssoRoleCredentials returns the cached credentials provider of an SSO role
+// selection. The SSO session is resolved on first use, so selections restored
+// at startup do not read the token cache until they are used.
+func ssoRoleCredentials(sel appconfig.ProfileSelection) aws.CredentialsProvider {
+	ssoRoleMu.Lock()
+	defer ssoRoleMu.Unlock()
+
+	if provider, ok := ssoRoleProviders[sel.ID()]; ok {
+		return provider
+	}
+
+	var (
+		once     sync.Once
+		provider *ssocreds.Provider
+		initErr  error
+	)
+	cache := aws.NewCredentialsCache(aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		once.Do(func() {
+			session, err := LoadSSOSession(ctx, sel.ProfileName)
+			if err != nil {
+				initErr = err
+				return
+			}
+			provider = ssocreds.New(session.client, sel.AccountID, sel.RoleName, "", func(o *ssocreds.Options) {
+				o.SSOTokenProvider = session.tokens
+			})
+		})
+		if initErr != nil {
+			return aws.Credentials{}, initErr
+		}
+		return provider.Retrieve(ctx)
+	}))
+	ssoRoleProviders[sel.ID()] = cache
+	return cache
+}
@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+func TestSSORoleCredentialsCached(t *testing.T) {
+	a := ssoRoleCredentials(config.SSORole("sso-admin", "123456789012", "ReadOnly"))
+	b := ssoRoleCredentials(config.SSORole("sso-admin", "123456789012", "ReadOnly"))
+	c := ssoRoleCredentials(config.SSORole("sso-admin", "123456789012", "Admin"))
+
+	if a != b {
+		t.Error("expected the same provider for the same selection")
+	}
+	if a == c {
+		t.Error("expected a different provider for a different role")
+	}
+}
+
+func TestSSOAccountRoleSelection(t *testing.T) {
+	role := SSOAccountRole{AccountID: "123456789012", AccountName: "prod", RoleName: "ReadOnly"}
+	sel := role.Selection("sso-admin")
+
+	if want := "sso:sso-admin/123456789012/ReadOnly"; sel.ID() != want {
+		t.Errorf("ID() = %q, want %q", sel.ID(), want)
+	}
+	if sel.DisplayName() != "ReadOnly@123456789012" {
+		t.Errorf("DisplayName() = %q", sel.DisplayName())
+	}
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ErrWarmSourceUnavailable is returned by a WarmSource that cannot be
+// reached; callers then resolve credentials in-process
+var ErrWarmSourceUnavailable = errors.New("warm source unavailable")
+
+// WarmSource supplies credentials, account IDs, region lists and SSO account
+// roles that are kept warm outside this process, by `claws daemon`. If
+// refresh is set, SSOAccountRoles bypasses cached roles.
+type WarmSource interface {
+	Credentials(ctx context.Context, sel appconfig.ProfileSelection) (aws.Credentials, error)
+	AccountID(ctx context.Context, sel appconfig.ProfileSelection) (string, error)
+	Regions(ctx context.Context, sel appconfig.ProfileSelection) ([]string, error)
+	SSOAccountRoles(ctx context.Context, profile string, refresh bool) ([]SSOAccountRole, error)
+}
+
+var (
+	warmMu        sync.Mutex
+	warmSource    WarmSource
+	warmProviders = make(map[string]aws.CredentialsProvider)
+)
+
+// SetWarmSource sets the source used for named profiles and SSO roles.
+// nil resolves all credentials in-process.
+func SetWarmSource(s WarmSource) {
+	warmMu.Lock()
+	defer warmMu.Unlock()
+	warmSource = s
+	clear(warmProviders)
+}
+
+// currentWarmSource returns the warm source, or nil if there is none
+func currentWarmSource() WarmSource {
+	warmMu.Lock()
+	defer warmMu.Unlock()
+	return warmSource
+}
+
+// Warmable reports whether sel can be served by a warm source. SDK default
+// and env-only selections depend on the environment of this process.
+func Warmable(sel appconfig.ProfileSelection) bool {
+	return sel.IsNamedProfile() || sel.IsSSORole()
+}
+
+// warmCredentials returns the cached credentials provider of sel backed by
+// the warm source, or nil if there is no source or sel is not warmable. If the
+// source becomes unreachable, credentials are resolved in-process instead.
+func warmCredentials(sel appconfig.ProfileSelection) aws.CredentialsProvider {
+	warmMu.Lock()
+	defer warmMu.Unlock()
+
+	if warmSource == nil || !Warmable(sel) {
+		return nil
+	}
+	if provider, ok := warmProviders[sel.ID()]; ok {
+		return provider
+	}
+
+	source := warmSource
+	provider := aws.NewCredentialsCache(aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		creds, err := source.Credentials(ctx, sel)
+		if !errors.Is(err, ErrWarmSourceUnavailable) {
+			return creds, err
+		}
+		log.Debug("resolving credentials in-process", "selection", sel.DisplayName(), "error", err)
+		cfg, err := config.LoadDefaultConfig(ctx, localLoadOptions(sel)...)
+		if err != nil {
+			return aws.Credentials{}, fmt.Errorf("load AWS config: %w", err)
+		}
+		return cfg.Credentials.Retrieve(ctx)
+	}))
+	warmProviders[sel.ID()] = provider
+	return provider
+}
+
+// resolveAccountID returns the account ID of sel, from the warm source if it has
+// one, otherwise with STS using cfg
+func resolveAccountID(ctx context.Context, sel appconfig.ProfileSelection, cfg aws.Config) string {
+	if source := currentWarmSource(); source != nil && Warmable(sel) {
+		id, err := source.AccountID(ctx, sel)
+		if err == nil {
+			return id
+		}
+		log.Debug("warm account ID unavailable", "selection", sel.DisplayName(), "error", err)
+	}
+	return FetchAccountID(ctx, cfg)
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+type fakeWarmSource struct {
+	err error
+}
+
+func (f fakeWarmSource) Credentials(context.Context, appconfig.ProfileSelection) (aws.Credentials, error) {
+	return aws.Credentials{AccessKeyID: "AKIDWARM", SecretAccessKey: "secret"}, f.err
+}
+
+func (f fakeWarmSource) AccountID(context.Context, appconfig.ProfileSelection) (string, error) {
+	return "123456789012", f.err
+}
+
+func (f fakeWarmSource) Regions(context.Context, appconfig.ProfileSelection) ([]string, error) {
+	return []string{"eu-north-1"}, f.err
+}
+
+func (f fakeWarmSource) SSOAccountRoles(context.Context, string, bool) ([]SSOAccountRole, error) {
+	return []SSOAccountRole{{AccountID: "123456789012", RoleName: "Admin"}}, f.err
+}
+
+func loadAccessKey(t *testing.T, sel appconfig.ProfileSelection) string {
+	t.Helper()
+	cfg, err := config.LoadDefaultConfig(context.Background(), SelectionLoadOptions(sel)...)
+	if err != nil {
+		t.Fatalf("LoadDefaultConfig() error = %v", err)
+	}
+	creds, err := cfg.Credentials.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("Retrieve() error = %v", err)
+	}
+	return creds.AccessKeyID
+}
+
+func TestWarmSource(t *testing.T) {
+	dir := t.TempDir()
+	credsFile := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(credsFile, []byte("[local]\naws_access_key_id = AKIDLOCAL\naws_secret_access_key = secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credsFile)
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_REGION", "us-east-1")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDENV")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Cleanup(func() { SetWarmSource(nil) })
+
+	SetWarmSource(fakeWarmSource{})
+	if got := loadAccessKey(t, appconfig.NamedProfile("local")); got != "AKIDWARM" {
+		t.Errorf("named profile access key = %q, want warm credentials", got)
+	}
+	if got := loadAccessKey(t, appconfig.EnvOnly()); got != "AKIDENV" {
+		t.Errorf("env-only access key = %q, want environment credentials", got)
+	}
+	if got := resolveAccountID(context.Background(), appconfig.NamedProfile("local"), aws.Config{}); got != "123456789012" {
+		t.Errorf("resolveAccountID() = %q, want warm account ID", got)
+	}
+
+	original := appconfig.Global().Selections()
+	t.Cleanup(func() { appconfig.Global().SetSelections(original) })
+	appconfig.Global().SetSelection(appconfig.NamedProfile("local"))
+	if regions, _ := FetchAvailableRegions(context.Background()); len(regions) != 1 || regions[0] != "eu-north-1" {
+		t.Errorf("FetchAvailableRegions() = %v, want warm regions", regions)
+	}
+	if roles, err := ListSSOAccountRoles(context.Background(), "sso"); err != nil || len(roles) != 1 || roles[0].RoleName != "Admin" {
+		t.Errorf("ListSSOAccountRoles() = %v, %v, want warm roles", roles, err)
+	}
+
+	// An unreachable source falls back to in-process resolution
+	SetWarmSource(fakeWarmSource{err: fmt.Errorf("%w: dial", ErrWarmSourceUnavailable)})
+	if got := loadAccessKey(t, appconfig.NamedProfile("local")); got != "AKIDLOCAL" {
+		t.Errorf("fallback access key = %q, want local credentials", got)
+	}
+	if _, err := ListSSOAccountRoles(context.Background(), "local"); err == nil || errors.Is(err, ErrWarmSourceUnavailable) {
+		t.Errorf("fallback ListSSOAccountRoles() error = %v, want in-process error", err)
+	}
+}
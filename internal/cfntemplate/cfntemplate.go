@@ -0,0 +1,231 @@
+// Package cfntemplate generates CloudFormation templates for existing
+// resources with the CloudFormation IaC generator.
+package cfntemplate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// pollInterval is how often the generated template status is checked;
+// generation usually takes tens of seconds
+const pollInterval = 3 * time.Second
+
+// mapping maps a claws resource type to a CloudFormation resource type and
+// the primary identifier the IaC generator expects
+type mapping struct {
+	Type string
+	Key  string
+	ID   func(dao.Resource) string // nil uses GetID
+}
+
+func byName(r dao.Resource) string { return r.GetName() }
+func byARN(r dao.Resource) string  { return r.GetARN() }
+
+// mappings covers resource types whose primary identifier is a single
+// property that can be read from the listed resource
+var mappings = map[string]mapping{
+	"apigateway/http-apis":         {Type: "AWS::ApiGatewayV2::Api", Key: "ApiId"},
+	"apigateway/rest-apis":         {Type: "AWS::ApiGateway::RestApi", Key: "RestApiId"},
+	"athena/workgroups":            {Type: "AWS::Athena::WorkGroup", Key: "Name"},
+	"autoscaling/groups":           {Type: "AWS::AutoScaling::AutoScalingGroup", Key: "AutoScalingGroupName"},
+	"backup/plans":                 {Type: "AWS::Backup::BackupPlan", Key: "BackupPlanId"},
+	"backup/vaults":                {Type: "AWS::Backup::BackupVault", Key: "BackupVaultName"},
+	"cloudfront/distributions":     {Type: "AWS::CloudFront::Distribution", Key: "Id"},
+	"cloudtrail/trails":            {Type: "AWS::CloudTrail::Trail", Key: "TrailName"},
+	"cloudwatch/alarms":            {Type: "AWS::CloudWatch::Alarm", Key: "AlarmName"},
+	"cloudwatch/log-groups":        {Type: "AWS::Logs::LogGroup", Key: "LogGroupName", ID: logGroupName},
+	"cognito-idp/user-pools":       {Type: "AWS::Cognito::UserPool", Key: "UserPoolId"},
+	"dynamodb/tables":              {Type: "AWS::DynamoDB::Table", Key: "TableName"},
+	"ec2/instances":                {Type: "AWS::EC2::Instance", Key: "InstanceId"},
+	"ec2/key-pairs":                {Type: "AWS::EC2::KeyPair", Key: "KeyName", ID: byName},
+	"ec2/launch-templates":         {Type: "AWS::EC2::LaunchTemplate", Key: "LaunchTemplateId"},
+	"ec2/security-groups":          {Type: "AWS::EC2::SecurityGroup", Key: "Id"},
+	"ec2/volumes":                  {Type: "AWS::EC2::Volume", Key: "VolumeId"},
+	"ecr/repositories":             {Type: "AWS::ECR::Repository", Key: "RepositoryName"},
+	"ecs/clusters":                 {Type: "AWS::ECS::Cluster", Key: "ClusterName"},
+	"eks/clusters":                 {Type: "AWS::EKS::Cluster", Key: "Name"},
+	"elbv2/load-balancers":         {Type: "AWS::ElasticLoadBalancingV2::LoadBalancer", Key: "LoadBalancerArn", ID: byARN},
+	"elbv2/target-groups":          {Type: "AWS::ElasticLoadBalancingV2::TargetGroup", Key: "TargetGroupArn", ID: byARN},
+	"events/buses":                 {Type: "AWS::Events::EventBus", Key: "Name"},
+	"iam/groups":                   {Type: "AWS::IAM::Group", Key: "GroupName"},
+	"iam/instance-profiles":        {Type: "AWS::IAM::InstanceProfile", Key: "InstanceProfileName", ID: byName},
+	"iam/policies":                 {Type: "AWS::IAM::ManagedPolicy", Key: "PolicyArn", ID: byARN},
+	"iam/roles":                    {Type: "AWS::IAM::Role", Key: "RoleName"},
+	"iam/users":                    {Type: "AWS::IAM::User", Key: "UserName"},
+	"kinesis/streams":              {Type: "AWS::Kinesis::Stream", Key: "Name"},
+	"kms/keys":                     {Type: "AWS::KMS::Key", Key: "KeyId"},
+	"lambda/functions":             {Type: "AWS::Lambda::Function", Key: "FunctionName"},
+	"opensearch/domains":           {Type: "AWS::OpenSearchService::Domain", Key: "DomainName"},
+	"rds/clusters":                 {Type: "AWS::RDS::DBCluster", Key: "DBClusterIdentifier"},
+	"rds/instances":                {Type: "AWS::RDS::DBInstance", Key: "DBInstanceIdentifier"},
+	"route53/hosted-zones":         {Type: "AWS::Route53::HostedZone", Key: "Id"},
+	"s3/buckets":                   {Type: "AWS::S3::Bucket", Key: "BucketName"},
+	"secretsmanager/secrets":       {Type: "AWS::SecretsManager::Secret", Key: "Id", ID: byARN},
+	"sns/topics":                   {Type: "AWS::SNS::Topic", Key: "TopicArn", ID: byARN},
+	"ssm/parameters":               {Type: "AWS::SSM::Parameter", Key: "Name"},
+	"stepfunctions/state-machines": {Type: "AWS::StepFunctions::StateMachine", Key: "Arn", ID: byARN},
+	"vpc/endpoints":                {Type: "AWS::EC2::VPCEndpoint", Key: "Id"},
+	"vpc/internet-gateways":        {Type: "AWS::EC2::InternetGateway", Key: "InternetGatewayId"},
+	"vpc/nat-gateways":             {Type: "AWS::EC2::NatGateway", Key: "NatGatewayId"},
+	"vpc/route-tables":             {Type: "AWS::EC2::RouteTable", Key: "RouteTableId"},
+	"vpc/subnets":                  {Type: "AWS::EC2::Subnet", Key: "SubnetId"},
+	"vpc/transit-gateways":         {Type: "AWS::EC2::TransitGateway", Key: "Id"},
+	"vpc/vpcs":                     {Type: "AWS::EC2::VPC", Key: "VpcId"},
+}
+
+// Supported returns whether templates can be generated for a resource type
+func Supported(service, resourceType string) bool {
+	_, ok := mappings[service+"/"+resourceType]
+	return ok
+}
+
+// ResourceDefinition returns the IaC generator definition of res. ok is
+// false if the resource type has no mapping or res has no identifier.
+func ResourceDefinition(service, resourceType string, res dao.Resource) (types.ResourceDefinition, bool) {
+	m, ok := mappings[service+"/"+resourceType]
+	if !ok {
+		return types.ResourceDefinition{}, false
+	}
+	res = dao.UnwrapResource(res)
+	id := res.GetID()
+	if m.ID != nil {
+		id = m.ID(res)
+	}
+	if id == "" {
+		return types.ResourceDefinition{}, false
+	}
+	return types.ResourceDefinition{
+		ResourceType:       aws.String(m.Type),
+		ResourceIdentifier: map[string]string{m.Key: id},
+	}, true
+}
+
+// Result is a generated template
+type Result struct {
+	Template string // YAML template body
+	Warnings []string
+}
+
+// Generate creates a generated template for resources, waits for it to
+// complete and returns its YAML body. The generated template is deleted
+// afterwards, since accounts have a small quota of them.
+func Generate(ctx context.Context, resources []types.ResourceDefinition) (*Result, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new aws config")
+	}
+	client := cloudformation.NewFromConfig(cfg)
+
+	name := "claws-" + time.Now().Format("20060102-150405")
+	_, err = client.CreateGeneratedTemplate(ctx, &cloudformation.CreateGeneratedTemplateInput{
+		GeneratedTemplateName: aws.String(name),
+		Resources:             resources,
+		TemplateConfiguration: &types.TemplateConfiguration{
+			DeletionPolicy:      types.GeneratedTemplateDeletionPolicyRetain,
+			UpdateReplacePolicy: types.GeneratedTemplateUpdateReplacePolicyRetain,
+		},
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "create generated template")
+	}
+	defer func() {
+		// Use a fresh context so the template is cleaned up on cancellation
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		if _, err := client.DeleteGeneratedTemplate(cleanupCtx, &cloudformation.DeleteGeneratedTemplateInput{
+			GeneratedTemplateName: aws.String(name),
+		}); err != nil {
+			log.Warn("failed to delete generated template", "name", name, "error", err)
+		}
+	}()
+
+	desc, err := waitForTemplate(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	if desc.Status == types.GeneratedTemplateStatusFailed {
+		return nil, fmt.Errorf("template generation failed: %s", failureReason(desc))
+	}
+
+	output, err := client.GetGeneratedTemplate(ctx, &cloudformation.GetGeneratedTemplateInput{
+		GeneratedTemplateName: aws.String(name),
+		Format:                types.TemplateFormatYaml,
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get generated template")
+	}
+	return &Result{
+		Template: appaws.Str(output.TemplateBody),
+		Warnings: Warnings(desc.Resources),
+	}, nil
+}
+
+func waitForTemplate(ctx context.Context, client *cloudformation.Client, name string) (*cloudformation.DescribeGeneratedTemplateOutput, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+		desc, err := client.DescribeGeneratedTemplate(ctx, &cloudformation.DescribeGeneratedTemplateInput{
+			GeneratedTemplateName: aws.String(name),
+		})
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe generated template")
+		}
+		if desc.Status == types.GeneratedTemplateStatusComplete || desc.Status == types.GeneratedTemplateStatusFailed {
+			return desc, nil
+		}
+	}
+}
+
+// failureReason returns the template status reason followed by the reasons
+// of failed resources
+func failureReason(desc *cloudformation.DescribeGeneratedTemplateOutput) string {
+	reasons := []string{appaws.Str(desc.StatusReason)}
+	for _, res := range desc.Resources {
+		if res.ResourceStatus == types.GeneratedTemplateResourceStatusFailed {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", appaws.Str(res.ResourceType), appaws.Str(res.ResourceStatusReason)))
+		}
+	}
+	return strings.Join(slices.DeleteFunc(reasons, func(r string) bool { return r == "" }), "; ")
+}
+
+// Warnings returns the property warnings of generated resources, such as
+// write-only properties that must be filled in before deploying
+func Warnings(resources []types.ResourceDetail) []string {
+	var warnings []string
+	for _, res := range resources {
+		for _, w := range res.Warnings {
+			for _, p := range w.Properties {
+				warnings = append(warnings, fmt.Sprintf("%s %s: %s (%s)",
+					appaws.Str(res.LogicalResourceId), appaws.Str(p.PropertyPath), appaws.Str(p.Description), w.Type))
+			}
+		}
+	}
+	return warnings
+}
+
+// logGroupName returns the log group name; the listed ID is the ARN for
+// log groups shared from other accounts
+func logGroupName(r dao.Resource) string {
+	if lg, ok := r.(interface{ LogGroupName() string }); ok {
+		return lg.LogGroupName()
+	}
+	return r.GetID()
+}
@@ -0,0 +1,75 @@
+package cfntemplate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestResourceDefinition(t *testing.T) {
+	tests := []struct {
+		service, resource string
+		res               dao.Resource
+		wantType, wantKey string
+		wantID            string
+	}{
+		{"ec2", "instances", &dao.BaseResource{ID: "i-0123"}, "AWS::EC2::Instance", "InstanceId", "i-0123"},
+		{"s3", "buckets", dao.WrapWithRegion(&dao.BaseResource{ID: "logs"}, "eu-west-1"), "AWS::S3::Bucket", "BucketName", "logs"},
+		{"sns", "topics", &dao.BaseResource{ID: "alerts", ARN: "arn:aws:sns:us-east-1:123456789012:alerts"}, "AWS::SNS::Topic", "TopicArn", "arn:aws:sns:us-east-1:123456789012:alerts"},
+		{"ec2", "key-pairs", &dao.BaseResource{ID: "key-0123", Name: "deploy"}, "AWS::EC2::KeyPair", "KeyName", "deploy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.service+"/"+tt.resource, func(t *testing.T) {
+			def, ok := ResourceDefinition(tt.service, tt.resource, tt.res)
+			if !ok {
+				t.Fatalf("%s/%s should be supported", tt.service, tt.resource)
+			}
+			if got := aws.ToString(def.ResourceType); got != tt.wantType {
+				t.Errorf("ResourceType = %q, want %q", got, tt.wantType)
+			}
+			if got := def.ResourceIdentifier[tt.wantKey]; got != tt.wantID {
+				t.Errorf("ResourceIdentifier[%s] = %q, want %q", tt.wantKey, got, tt.wantID)
+			}
+		})
+	}
+
+	if _, ok := ResourceDefinition("ce", "costs", &dao.BaseResource{ID: "x"}); ok {
+		t.Error("ce/costs should not be supported")
+	}
+	if _, ok := ResourceDefinition("sns", "topics", &dao.BaseResource{ID: "alerts"}); ok {
+		t.Error("topic without ARN should have no definition")
+	}
+}
+
+func TestFailureReason(t *testing.T) {
+	desc := &cloudformation.DescribeGeneratedTemplateOutput{
+		StatusReason: aws.String("1 resource failed"),
+		Resources: []types.ResourceDetail{
+			{ResourceType: aws.String("AWS::S3::Bucket"), ResourceStatus: types.GeneratedTemplateResourceStatusComplete},
+			{ResourceType: aws.String("AWS::EC2::Instance"), ResourceStatus: types.GeneratedTemplateResourceStatusFailed, ResourceStatusReason: aws.String("not found")},
+		},
+	}
+	if got, want := failureReason(desc), "1 resource failed; AWS::EC2::Instance: not found"; got != want {
+		t.Errorf("failureReason() = %q, want %q", got, want)
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	got := Warnings([]types.ResourceDetail{{
+		LogicalResourceId: aws.String("DBInstance"),
+		Warnings: []types.WarningDetail{{
+			Type: types.WarningTypeExcludedProperties,
+			Properties: []types.WarningProperty{
+				{PropertyPath: aws.String("/MasterUserPassword"), Description: aws.String("write-only property")},
+			},
+		}},
+	}})
+	if len(got) != 1 || !strings.Contains(got[0], "DBInstance /MasterUserPassword") || !strings.Contains(got[0], "EXCLUDED_PROPERTIES") {
+		t.Errorf("Warnings() = %v", got)
+	}
+}
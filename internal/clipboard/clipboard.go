@@ -23,6 +23,12 @@ type CopiedMsg struct {
 // NoARNMsg is sent when attempting to copy an ARN for a resource that has no ARN.
 type NoARNMsg struct{}
 
+// UnsupportedMsg is sent when there is nothing to copy for the current
+// resources, with the reason shown to the user.
+type UnsupportedMsg struct {
+	Reason string
+}
+
 // Copy copies the given value to the clipboard and returns a tea.Cmd that sends a CopiedMsg.
 // It writes to both OSC52 (terminal clipboard) and native system clipboard for maximum compatibility.
 func Copy(label, value string) tea.Cmd {
@@ -68,3 +74,8 @@ func CopyARN(arn string) tea.Cmd {
 func NoARN() tea.Cmd {
 	return func() tea.Msg { return NoARNMsg{} }
 }
+
+// Unsupported returns a tea.Cmd that sends an UnsupportedMsg with the given reason.
+func Unsupported(reason string) tea.Cmd {
+	return func() tea.Msg { return UnsupportedMsg{Reason: reason} }
+}
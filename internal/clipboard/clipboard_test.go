@@ -83,3 +83,14 @@ func TestNoARN(t *testing.T) {
 		t.Errorf("expected NoARNMsg, got %T", msg)
 	}
 }
+
+func TestUnsupported(t *testing.T) {
+	msg := Unsupported("No Terraform mapping for ce/costs")()
+	unsupported, ok := msg.(UnsupportedMsg)
+	if !ok {
+		t.Fatalf("expected UnsupportedMsg, got %T", msg)
+	}
+	if unsupported.Reason != "No Terraform mapping for ce/costs" {
+		t.Errorf("unexpected Reason %q", unsupported.Reason)
+	}
+}
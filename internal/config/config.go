@@ -5,6 +5,7 @@ import (
 	"os"
 	"regexp"
 	"slices"
+	"strings"
 	"sync"
 )
 
@@ -63,6 +64,9 @@ const (
 	ProfileIDSDKDefault = "__sdk_default__"
 	// ProfileIDEnvOnly is the resource ID for env/IMDS-only credential mode
 	ProfileIDEnvOnly = "__env_only__"
+	// ProfileIDSSORolePrefix prefixes the resource ID of SSO account roles,
+	// "sso:<profile>/<account-id>/<role-name>"
+	ProfileIDSSORolePrefix = "sso:"
 )
 
 // ProfileSelectionFromID returns ProfileSelection for a resource ID.
@@ -72,9 +76,14 @@ func ProfileSelectionFromID(id string) ProfileSelection {
 		return SDKDefault()
 	case ProfileIDEnvOnly:
 		return EnvOnly()
-	default:
-		return NamedProfile(id)
 	}
+	// Profile names cannot contain ":" or "/", so the prefix is unambiguous
+	if rest, ok := strings.CutPrefix(id, ProfileIDSSORolePrefix); ok {
+		if parts := strings.Split(rest, "/"); len(parts) == 3 {
+			return SSORole(parts[0], parts[1], parts[2])
+		}
+	}
+	return NamedProfile(id)
 }
 
 // CredentialMode represents how AWS credentials are resolved
@@ -90,6 +99,10 @@ const (
 
 	// ModeEnvOnly ignores ~/.aws files, uses IMDS/environment/ECS/Lambda creds only.
 	ModeEnvOnly
+
+	// ModeSSORole uses role credentials of an SSO account role, obtained with
+	// the SSO session of a named profile. The role need not have a profile.
+	ModeSSORole
 )
 
 // String returns a display string for the credential mode
@@ -101,6 +114,8 @@ func (m CredentialMode) String() string {
 		return "" // Profile name is shown separately
 	case ModeEnvOnly:
 		return "Env/IMDS Only"
+	case ModeSSORole:
+		return "SSO Role"
 	default:
 		return "Unknown"
 	}
@@ -109,7 +124,9 @@ func (m CredentialMode) String() string {
 // ProfileSelection represents the selected credential mode and optional profile name
 type ProfileSelection struct {
 	Mode        CredentialMode
-	ProfileName string // Only used when Mode == ModeNamedProfile
+	ProfileName string // Used when Mode == ModeNamedProfile or ModeSSORole (SSO session profile)
+	AccountID   string // Only used when Mode == ModeSSORole
+	RoleName    string // Only used when Mode == ModeSSORole
 }
 
 // SDKDefault returns a selection for SDK default credential chain
@@ -127,6 +144,12 @@ func NamedProfile(name string) ProfileSelection {
 	return ProfileSelection{Mode: ModeNamedProfile, ProfileName: name}
 }
 
+// SSORole returns a selection for an SSO account role, using the SSO
+// session of the named profile
+func SSORole(profile, accountID, roleName string) ProfileSelection {
+	return ProfileSelection{Mode: ModeSSORole, ProfileName: profile, AccountID: accountID, RoleName: roleName}
+}
+
 // DisplayName returns the display name for this selection.
 // For SDKDefault mode, includes AWS_PROFILE value if set.
 func (s ProfileSelection) DisplayName() string {
@@ -140,6 +163,8 @@ func (s ProfileSelection) DisplayName() string {
 		return "Env/IMDS Only"
 	case ModeNamedProfile:
 		return s.ProfileName
+	case ModeSSORole:
+		return s.RoleName + "@" + s.AccountID
 	default:
 		return "Unknown"
 	}
@@ -160,6 +185,11 @@ func (s ProfileSelection) IsNamedProfile() bool {
 	return s.Mode == ModeNamedProfile
 }
 
+// IsSSORole returns true if this is an SSO account role
+func (s ProfileSelection) IsSSORole() bool {
+	return s.Mode == ModeSSORole
+}
+
 // ID returns the stable resource ID for this selection.
 // This is the inverse of ProfileSelectionFromID.
 func (s ProfileSelection) ID() string {
@@ -170,6 +200,8 @@ func (s ProfileSelection) ID() string {
 		return ProfileIDEnvOnly
 	case ModeNamedProfile:
 		return s.ProfileName
+	case ModeSSORole:
+		return ProfileIDSSORolePrefix + s.ProfileName + "/" + s.AccountID + "/" + s.RoleName
 	default:
 		return ""
 	}
@@ -180,8 +212,11 @@ type Config struct {
 	regions       []string
 	selections    []ProfileSelection
 	accountIDs    map[string]string
+	access        map[accessKey]bool
 	warnings      []string
 	readOnly      bool
+	sandbox       bool
+	sandboxPolicy string
 	compactHeader bool
 }
 
@@ -335,6 +370,68 @@ func (c *Config) GetAccountIDForProfile(profileID string) string {
 	})
 }
 
+// accessKey identifies a resource type listed with one profile in one region
+type accessKey struct {
+	profile, region, service, resource string
+}
+
+// RecordAccess records whether listing service/resource with the profile in
+// the region was denied. Only AccessDenied and successful lists should be
+// recorded; other failures say nothing about permissions.
+func (c *Config) RecordAccess(profileID, region, service, resource string, denied bool) {
+	doWithLock(&c.mu, func() {
+		if c.access == nil {
+			c.access = make(map[accessKey]bool)
+		}
+		c.access[accessKey{profileID, region, service, resource}] = denied
+	})
+}
+
+// AccessDenied reports whether listing service/resource was denied and never
+// allowed for the current profiles and regions
+func (c *Config) AccessDenied(service, resource string) bool {
+	return c.accessDenied(func(k accessKey) bool {
+		return k.service == service && k.resource == resource
+	})
+}
+
+// ServiceAccessDenied reports whether every resource type of the service
+// listed so far was denied for the current profiles and regions
+func (c *Config) ServiceAccessDenied(service string) bool {
+	return c.accessDenied(func(k accessKey) bool {
+		return k.service == service
+	})
+}
+
+func (c *Config) accessDenied(match func(accessKey) bool) bool {
+	return withRLock(&c.mu, func() bool {
+		sels, regions := c.selections, c.regions
+		if len(sels) == 0 {
+			sels = []ProfileSelection{SDKDefault()}
+		}
+		if len(regions) == 0 {
+			regions = []string{""}
+		}
+		current := make(map[[2]string]bool)
+		for _, sel := range sels {
+			for _, region := range regions {
+				current[[2]string{sel.ID(), region}] = true
+			}
+		}
+		denied := false
+		for k, d := range c.access {
+			if !match(k) || !current[[2]string{k.profile, k.region}] {
+				continue
+			}
+			if !d {
+				return false
+			}
+			denied = true
+		}
+		return denied
+	})
+}
+
 func (c *Config) Warnings() []string {
 	return withRLock(&c.mu, func() []string { return append([]string(nil), c.warnings...) })
 }
@@ -347,6 +444,34 @@ func (c *Config) SetReadOnly(readOnly bool) {
 	doWithLock(&c.mu, func() { c.readOnly = readOnly })
 }
 
+// Sandbox returns whether AWS credentials are restricted by a session policy
+func (c *Config) Sandbox() bool {
+	return withRLock(&c.mu, func() bool { return c.sandbox })
+}
+
+// SandboxPolicy returns the inline session policy of sandbox mode, or "" for
+// the AWS managed ReadOnlyAccess policy
+func (c *Config) SandboxPolicy() string {
+	return withRLock(&c.mu, func() string { return c.sandboxPolicy })
+}
+
+// EnableSandbox restricts AWS credentials with the given inline session
+// policy, or with ReadOnlyAccess if policy is empty
+func (c *Config) EnableSandbox(policy string) {
+	doWithLock(&c.mu, func() {
+		c.sandbox = true
+		c.sandboxPolicy = policy
+	})
+}
+
+// DisableSandbox stops restricting AWS credentials with a session policy
+func (c *Config) DisableSandbox() {
+	doWithLock(&c.mu, func() {
+		c.sandbox = false
+		c.sandboxPolicy = ""
+	})
+}
+
 func (c *Config) CompactHeader() bool {
 	return withRLock(&c.mu, func() bool { return c.compactHeader })
 }
@@ -89,6 +89,30 @@ func TestConfig_ReadOnlyGetSet(t *testing.T) {
 	}
 }
 
+func TestConfig_Sandbox(t *testing.T) {
+	cfg := &Config{}
+
+	if cfg.Sandbox() || cfg.SandboxPolicy() != "" {
+		t.Errorf("Sandbox() = %v, SandboxPolicy() = %q, want disabled", cfg.Sandbox(), cfg.SandboxPolicy())
+	}
+
+	cfg.EnableSandbox("")
+	if !cfg.Sandbox() || cfg.SandboxPolicy() != "" {
+		t.Errorf("Sandbox() = %v, SandboxPolicy() = %q, want enabled with default policy", cfg.Sandbox(), cfg.SandboxPolicy())
+	}
+
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	cfg.EnableSandbox(policy)
+	if !cfg.Sandbox() || cfg.SandboxPolicy() != policy {
+		t.Errorf("SandboxPolicy() = %q, want %q", cfg.SandboxPolicy(), policy)
+	}
+
+	cfg.DisableSandbox()
+	if cfg.Sandbox() || cfg.SandboxPolicy() != "" {
+		t.Errorf("Sandbox() = %v, SandboxPolicy() = %q, want disabled", cfg.Sandbox(), cfg.SandboxPolicy())
+	}
+}
+
 func TestConfig_CompactHeaderGetSet(t *testing.T) {
 	cfg := &Config{}
 
@@ -179,6 +203,8 @@ func TestProfileSelectionFromID(t *testing.T) {
 		{ProfileIDEnvOnly, ModeEnvOnly, ""},
 		{"my-profile", ModeNamedProfile, "my-profile"},
 		{"production", ModeNamedProfile, "production"},
+		{"sso:org/123456789012/ReadOnly", ModeSSORole, "org"},
+		{"sso:org/123456789012", ModeNamedProfile, "sso:org/123456789012"},
 	}
 
 	for _, tt := range tests {
@@ -202,6 +228,7 @@ func TestCredentialMode_String(t *testing.T) {
 		{ModeSDKDefault, "SDK Default"},
 		{ModeNamedProfile, ""},
 		{ModeEnvOnly, "Env/IMDS Only"},
+		{ModeSSORole, "SSO Role"},
 		{CredentialMode(99), "Unknown"},
 	}
 
@@ -230,6 +257,11 @@ func TestProfileSelection_DisplayName(t *testing.T) {
 		t.Errorf("NamedProfile(production).DisplayName() = %q, want %q", got, "production")
 	}
 
+	sel = SSORole("org", "123456789012", "ReadOnly")
+	if got := sel.DisplayName(); got != "ReadOnly@123456789012" {
+		t.Errorf("SSORole().DisplayName() = %q, want %q", got, "ReadOnly@123456789012")
+	}
+
 	// Unknown mode
 	sel = ProfileSelection{Mode: CredentialMode(99)}
 	if got := sel.DisplayName(); got != "Unknown" {
@@ -258,10 +290,14 @@ func TestProfileSelection_ID(t *testing.T) {
 		{SDKDefault(), ProfileIDSDKDefault},
 		{EnvOnly(), ProfileIDEnvOnly},
 		{NamedProfile("production"), "production"},
+		{SSORole("org", "123456789012", "ReadOnly"), "sso:org/123456789012/ReadOnly"},
 		{ProfileSelection{Mode: CredentialMode(99)}, ""},
 	}
 
 	for _, tt := range tests {
+		if tt.sel.Mode != CredentialMode(99) && ProfileSelectionFromID(tt.want) != tt.sel {
+			t.Errorf("ProfileSelectionFromID(%q) = %+v, want %+v", tt.want, ProfileSelectionFromID(tt.want), tt.sel)
+		}
 		got := tt.sel.ID()
 		if got != tt.want {
 			t.Errorf("ProfileSelection.ID() = %q, want %q", got, tt.want)
@@ -330,6 +366,43 @@ func TestConfig_AccountIDs(t *testing.T) {
 	}
 }
 
+func TestConfig_AccessDenied(t *testing.T) {
+	cfg := &Config{}
+	cfg.SetSelection(NamedProfile("dev"))
+	cfg.SetRegions([]string{"us-east-1"})
+
+	if cfg.ServiceAccessDenied("iam") {
+		t.Error("ServiceAccessDenied() should be false before any list")
+	}
+
+	cfg.RecordAccess("dev", "us-east-1", "iam", "roles", true)
+	if !cfg.AccessDenied("iam", "roles") || !cfg.ServiceAccessDenied("iam") {
+		t.Error("expected iam to be denied after AccessDenied on roles")
+	}
+
+	// Another profile's result does not apply to the current selection
+	cfg.RecordAccess("prod", "us-east-1", "iam", "users", false)
+	if !cfg.ServiceAccessDenied("iam") {
+		t.Error("prod access should not affect dev")
+	}
+
+	// Any allowed resource type means the service is usable
+	cfg.RecordAccess("dev", "us-east-1", "iam", "users", false)
+	if cfg.ServiceAccessDenied("iam") {
+		t.Error("ServiceAccessDenied() should be false once a resource type was listed")
+	}
+	if !cfg.AccessDenied("iam", "roles") {
+		t.Error("roles should still be denied")
+	}
+
+	// A region that was allowed keeps the resource type usable
+	cfg.AddRegion("eu-west-1")
+	cfg.RecordAccess("dev", "eu-west-1", "iam", "roles", false)
+	if cfg.AccessDenied("iam", "roles") {
+		t.Error("roles should not be denied when allowed in another selected region")
+	}
+}
+
 func TestIsValidRegion(t *testing.T) {
 	tests := []struct {
 		region string
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -27,6 +28,8 @@ const (
 	DefaultMaxConcurrentFetches    = 50
 	DefaultMaxStackSize            = 100
 	DefaultAIMaxToolCallsPerQuery  = 50
+	DefaultAthenaScanLimit         = 10 << 30 // 10 GiB
+	DefaultPageSize                = 100
 )
 
 var (
@@ -111,7 +114,27 @@ type TimeoutConfig struct {
 }
 
 type CloudWatchConfig struct {
-	Window Duration `yaml:"window,omitempty"`
+	Window       Duration `yaml:"window,omitempty"`
+	AnomalyBands bool     `yaml:"anomaly_bands,omitempty"`
+}
+
+type AthenaConfig struct {
+	ScanLimit ByteSize `yaml:"scan_limit,omitempty"`
+}
+
+// PaginationConfig sets the page size requested from paginated list APIs and
+// optional per-resource overrides keyed by "service/resource"
+// (e.g., "cloudtrail/events").
+type PaginationConfig struct {
+	PageSize  int                           `yaml:"page_size,omitempty"`
+	Resources map[string]ResourcePagination `yaml:"resources,omitempty"`
+}
+
+// ResourcePagination overrides pagination for one resource type. MaxItems
+// stops loading further pages once that many items are listed (0 = no cap).
+type ResourcePagination struct {
+	PageSize int `yaml:"page_size,omitempty"`
+	MaxItems int `yaml:"max_items,omitempty"`
 }
 
 type ConcurrencyConfig struct {
@@ -122,6 +145,11 @@ type PersistenceConfig struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+// DaemonConfig controls whether claws attaches to a running `claws daemon`
+type DaemonConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
 type StartupConfig struct {
 	View     string   `yaml:"view,omitempty"` // "dashboard", "services", or "service/resource" (e.g., "ec2", "rds/snapshots")
 	Regions  []string `yaml:"regions,omitempty"`
@@ -143,6 +171,12 @@ func (s StartupConfig) GetProfiles() []string {
 	return nil
 }
 
+// RegionsConfig lists the regions the region selector shows first, in order.
+// The full region list stays one toggle away.
+type RegionsConfig struct {
+	Favorites []string `yaml:"favorites,omitempty"`
+}
+
 type NavigationConfig struct {
 	MaxStackSize int `yaml:"max_stack_size,omitempty"`
 }
@@ -205,11 +239,15 @@ type FileConfig struct {
 	persistenceOverride *bool             `yaml:"-"`
 	Timeouts            TimeoutConfig     `yaml:"timeouts,omitempty"`
 	Concurrency         ConcurrencyConfig `yaml:"concurrency,omitempty"`
+	Pagination          PaginationConfig  `yaml:"pagination,omitempty"`
 	CloudWatch          CloudWatchConfig  `yaml:"cloudwatch,omitempty"`
+	Athena              AthenaConfig      `yaml:"athena,omitempty"`
 	Autosave            PersistenceConfig `yaml:"autosave,omitempty"`
+	Daemon              DaemonConfig      `yaml:"daemon,omitempty"`
 	Startup             StartupConfig     `yaml:"startup,omitempty"`
 	Theme               ThemeConfig       `yaml:"theme,omitempty"`
 	Navigation          NavigationConfig  `yaml:"navigation,omitempty"`
+	Regions             RegionsConfig     `yaml:"regions,omitempty"`
 	AI                  AIConfig          `yaml:"ai,omitempty"`
 	CompactHeader       bool              `yaml:"compact_header,omitempty"`
 }
@@ -242,6 +280,63 @@ func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
 	return nil
 }
 
+// ByteSize is a byte count for YAML marshal/unmarshal as a size string
+// (e.g., "500MB", "10GB"). Units are binary, so 1GB is 1024^3 bytes.
+type ByteSize int64
+
+var byteSizeUnits = []struct {
+	suffix string
+	mult   int64
+}{
+	{"TIB", 1 << 40}, {"GIB", 1 << 30}, {"MIB", 1 << 20}, {"KIB", 1 << 10},
+	{"TB", 1 << 40}, {"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10},
+	{"T", 1 << 40}, {"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10},
+	{"B", 1},
+}
+
+// ParseByteSize parses a size string such as "512MB", "10 GiB" or "1048576".
+func ParseByteSize(s string) (ByteSize, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+	mult := int64(1)
+	for _, u := range byteSizeUnits {
+		if rest, ok := strings.CutSuffix(str, u.suffix); ok {
+			str, mult = strings.TrimSpace(rest), u.mult
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(str, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return ByteSize(n * float64(mult)), nil
+}
+
+func (b ByteSize) MarshalYAML() (interface{}, error) {
+	for _, u := range byteSizeUnits[4:8] {
+		if b != 0 && int64(b)%u.mult == 0 {
+			return fmt.Sprintf("%d%s", int64(b)/u.mult, u.suffix), nil
+		}
+	}
+	return int64(b), nil
+}
+
+func (b *ByteSize) UnmarshalYAML(node *yaml.Node) error {
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return err
+	}
+	if s == "" {
+		*b = 0
+		return nil
+	}
+	size, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+	*b = size
+	return nil
+}
+
 func DefaultFileConfig() *FileConfig {
 	return &FileConfig{
 		Timeouts: TimeoutConfig{
@@ -255,9 +350,15 @@ func DefaultFileConfig() *FileConfig {
 		Concurrency: ConcurrencyConfig{
 			MaxFetches: DefaultMaxConcurrentFetches,
 		},
+		Pagination: PaginationConfig{
+			PageSize: DefaultPageSize,
+		},
 		CloudWatch: CloudWatchConfig{
 			Window: Duration(DefaultMetricsWindow),
 		},
+		Athena: AthenaConfig{
+			ScanLimit: DefaultAthenaScanLimit,
+		},
 		Navigation: NavigationConfig{
 			MaxStackSize: DefaultMaxStackSize,
 		},
@@ -325,9 +426,15 @@ func (c *FileConfig) applyDefaults() {
 	if c.CloudWatch.Window <= 0 {
 		c.CloudWatch.Window = Duration(DefaultMetricsWindow)
 	}
+	if c.Athena.ScanLimit <= 0 {
+		c.Athena.ScanLimit = DefaultAthenaScanLimit
+	}
 	if c.Concurrency.MaxFetches <= 0 {
 		c.Concurrency.MaxFetches = DefaultMaxConcurrentFetches
 	}
+	if c.Pagination.PageSize <= 0 {
+		c.Pagination.PageSize = DefaultPageSize
+	}
 	if c.Navigation.MaxStackSize <= 0 {
 		c.Navigation.MaxStackSize = DefaultMaxStackSize
 	}
@@ -396,6 +503,28 @@ func (c *FileConfig) MaxConcurrentFetches() int {
 	})
 }
 
+// PageSize returns the page size requested when listing service/resourceType,
+// preferring a per-resource override over the global page size.
+func (c *FileConfig) PageSize(service, resourceType string) int {
+	return withRLock(&c.mu, func() int {
+		if r, ok := c.Pagination.Resources[service+"/"+resourceType]; ok && r.PageSize > 0 {
+			return r.PageSize
+		}
+		if c.Pagination.PageSize <= 0 {
+			return DefaultPageSize
+		}
+		return c.Pagination.PageSize
+	})
+}
+
+// MaxItems returns the cap on items listed for service/resourceType, or 0 if
+// pages are loaded without limit.
+func (c *FileConfig) MaxItems(service, resourceType string) int {
+	return withRLock(&c.mu, func() int {
+		return max(c.Pagination.Resources[service+"/"+resourceType].MaxItems, 0)
+	})
+}
+
 func (c *FileConfig) MetricsWindow() time.Duration {
 	return withRLock(&c.mu, func() time.Duration {
 		if c.CloudWatch.Window == 0 {
@@ -405,6 +534,33 @@ func (c *FileConfig) MetricsWindow() time.Duration {
 	})
 }
 
+// MetricsAnomalyBands reports whether inline metrics fetch CloudWatch anomaly
+// detection bands. Off by default since each band costs an extra metric query.
+func (c *FileConfig) MetricsAnomalyBands() bool {
+	return withRLock(&c.mu, func() bool {
+		return c.CloudWatch.AnomalyBands
+	})
+}
+
+// AthenaScanLimit returns the estimated bytes scanned above which Athena
+// queries started from claws need an explicit confirmation.
+func (c *FileConfig) AthenaScanLimit() int64 {
+	return withRLock(&c.mu, func() int64 {
+		if c.Athena.ScanLimit <= 0 {
+			return DefaultAthenaScanLimit
+		}
+		return int64(c.Athena.ScanLimit)
+	})
+}
+
+// FavoriteRegions returns the configured favorite regions in order.
+// Returns a copy to prevent race conditions with concurrent writes.
+func (c *FileConfig) FavoriteRegions() []string {
+	return withRLock(&c.mu, func() []string {
+		return append([]string(nil), c.Regions.Favorites...)
+	})
+}
+
 // MaxStackSize returns the maximum navigation stack size.
 func (c *FileConfig) MaxStackSize() int {
 	return withRLock(&c.mu, func() int {
@@ -428,6 +584,14 @@ func (c *FileConfig) SetPersistenceEnabled(enabled bool) {
 	doWithLock(&c.mu, func() { c.persistenceOverride = &enabled })
 }
 
+// DaemonEnabled reports whether claws should use the warm clients and caches
+// of a running `claws daemon`
+func (c *FileConfig) DaemonEnabled() bool {
+	return withRLock(&c.mu, func() bool {
+		return c.Daemon.Enabled
+	})
+}
+
 func (c *FileConfig) GetStartup() ([]string, []string) {
 	type result struct {
 		regions  []string
@@ -64,6 +64,122 @@ func TestDuration_UnmarshalInvalid(t *testing.T) {
 	}
 }
 
+func TestByteSize_MarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ByteSize
+		out  string
+	}{
+		{"10GB", 10 << 30, "10GB"},
+		{"512mb", 512 << 20, "512MB"},
+		{"1.5 GiB", 3 << 29, "1536MB"},
+		{"2T", 2 << 40, "2TB"},
+		{"1000", 1000, "1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var b ByteSize
+			if err := yaml.Unmarshal([]byte(`"`+tt.in+`"`), &b); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if b != tt.want {
+				t.Errorf("Unmarshal = %d, want %d", b, tt.want)
+			}
+
+			data, err := yaml.Marshal(b)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if got := string(data); got != tt.out+"\n" {
+				t.Errorf("Marshal = %q, want %q", got, tt.out+"\n")
+			}
+		})
+	}
+}
+
+func TestByteSize_UnmarshalInvalid(t *testing.T) {
+	for _, in := range []string{`"lots"`, `"-1GB"`, `"GB"`} {
+		var b ByteSize
+		if err := yaml.Unmarshal([]byte(in), &b); err == nil {
+			t.Errorf("Unmarshal %s should fail", in)
+		}
+	}
+}
+
+func TestAthenaScanLimit(t *testing.T) {
+	cfg := &FileConfig{}
+	if got := cfg.AthenaScanLimit(); got != DefaultAthenaScanLimit {
+		t.Errorf("AthenaScanLimit() = %d, want default %d", got, DefaultAthenaScanLimit)
+	}
+
+	if err := yaml.Unmarshal([]byte("athena:\n  scan_limit: 500MB\n"), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := cfg.AthenaScanLimit(); got != 500<<20 {
+		t.Errorf("AthenaScanLimit() = %d, want %d", got, 500<<20)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	cfg := &FileConfig{}
+	if got := cfg.PageSize("s3", "objects"); got != DefaultPageSize {
+		t.Errorf("PageSize() = %d, want default %d", got, DefaultPageSize)
+	}
+	if got := cfg.MaxItems("s3", "objects"); got != 0 {
+		t.Errorf("MaxItems() = %d, want 0", got)
+	}
+
+	data := `pagination:
+  page_size: 200
+  resources:
+    cloudtrail/events:
+      page_size: 25
+      max_items: 500
+    s3/objects:
+      max_items: 1000
+`
+	if err := yaml.Unmarshal([]byte(data), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	tests := []struct {
+		service, resource  string
+		pageSize, maxItems int
+	}{
+		{"cloudtrail", "events", 25, 500},
+		{"s3", "objects", 200, 1000},
+		{"ec2", "instances", 200, 0},
+	}
+	for _, tt := range tests {
+		if got := cfg.PageSize(tt.service, tt.resource); got != tt.pageSize {
+			t.Errorf("PageSize(%s/%s) = %d, want %d", tt.service, tt.resource, got, tt.pageSize)
+		}
+		if got := cfg.MaxItems(tt.service, tt.resource); got != tt.maxItems {
+			t.Errorf("MaxItems(%s/%s) = %d, want %d", tt.service, tt.resource, got, tt.maxItems)
+		}
+	}
+}
+
+func TestFavoriteRegions(t *testing.T) {
+	cfg := &FileConfig{}
+	if got := cfg.FavoriteRegions(); len(got) != 0 {
+		t.Errorf("FavoriteRegions() = %v, want empty", got)
+	}
+
+	if err := yaml.Unmarshal([]byte("regions:\n  favorites: [ap-northeast-1, us-east-1]\n"), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	got := cfg.FavoriteRegions()
+	if len(got) != 2 || got[0] != "ap-northeast-1" || got[1] != "us-east-1" {
+		t.Errorf("FavoriteRegions() = %v, want [ap-northeast-1 us-east-1]", got)
+	}
+	got[0] = "changed"
+	if cfg.FavoriteRegions()[0] != "ap-northeast-1" {
+		t.Error("FavoriteRegions() should return a copy")
+	}
+}
+
 func TestDefaultFileConfig(t *testing.T) {
 	cfg := DefaultFileConfig()
 
@@ -0,0 +1,121 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+// Client talks to a running daemon. It implements appaws.WarmSource.
+type Client struct {
+	path    string
+	version string
+}
+
+var _ appaws.WarmSource = (*Client)(nil)
+
+// Connect returns a Client for the daemon listening on path, or an error if
+// none is running
+func Connect(ctx context.Context, path string) (*Client, error) {
+	c := &Client{path: path}
+	resp, err := c.call(ctx, request{Op: opPing})
+	if err != nil {
+		return nil, err
+	}
+	c.version = resp.Version
+	return c, nil
+}
+
+// Version returns the version of the connected daemon
+func (c *Client) Version() string {
+	return c.version
+}
+
+// Credentials returns the warm credentials of sel
+func (c *Client) Credentials(ctx context.Context, sel appconfig.ProfileSelection) (aws.Credentials, error) {
+	resp, err := c.call(ctx, request{Op: opCredentials, Selection: sel.ID()})
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	if resp.Credentials == nil {
+		return aws.Credentials{}, fmt.Errorf("claws daemon returned no credentials for %s", sel.DisplayName())
+	}
+	creds := *resp.Credentials
+	creds.Source = "ClawsDaemon"
+	return creds, nil
+}
+
+// AccountID returns the cached account ID of sel
+func (c *Client) AccountID(ctx context.Context, sel appconfig.ProfileSelection) (string, error) {
+	resp, err := c.call(ctx, request{Op: opAccountID, Selection: sel.ID()})
+	if err != nil {
+		return "", err
+	}
+	return resp.AccountID, nil
+}
+
+// Regions returns the cached region list of sel
+func (c *Client) Regions(ctx context.Context, sel appconfig.ProfileSelection) ([]string, error) {
+	resp, err := c.call(ctx, request{Op: opRegions, Selection: sel.ID()})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Regions, nil
+}
+
+// SSOAccountRoles returns the cached account roles of the SSO session of
+// profile, or fetches them again if refresh is set
+func (c *Client) SSOAccountRoles(ctx context.Context, profile string, refresh bool) ([]appaws.SSOAccountRole, error) {
+	resp, err := c.call(ctx, request{Op: opSSORoles, Profile: profile, Refresh: refresh})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Roles, nil
+}
+
+// call sends req on a new connection. Connection failures wrap
+// appaws.ErrWarmSourceUnavailable; errors reported by the daemon keep their
+// AWS error code.
+func (c *Client) call(ctx context.Context, req request) (response, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "unix", c.path)
+	if err != nil {
+		return response{}, fmt.Errorf("%w: %v", appaws.ErrWarmSourceUnavailable, err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(requestTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return response{}, fmt.Errorf("%w: %v", appaws.ErrWarmSourceUnavailable, err)
+	}
+	var resp response
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err == nil {
+		err = json.Unmarshal(line, &resp)
+	}
+	if err != nil {
+		return response{}, fmt.Errorf("%w: %v", appaws.ErrWarmSourceUnavailable, err)
+	}
+
+	if resp.Error != "" {
+		if resp.ErrorCode != "" {
+			return resp, fmt.Errorf("claws daemon: %w", &smithy.GenericAPIError{Code: resp.ErrorCode, Message: resp.Error})
+		}
+		return resp, fmt.Errorf("claws daemon: %s", resp.Error)
+	}
+	return resp, nil
+}
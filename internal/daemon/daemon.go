@@ -0,0 +1,93 @@
+// Package daemon implements `claws daemon`, a background process that keeps
+// SDK clients, credentials, account IDs, region lists and SSO account roles
+// of named profiles and SSO sessions warm and serves them to claws over a
+// local Unix socket, so repeated launches skip SSO token exchange, role
+// assumption, STS lookups and the listing calls behind the region and SSO
+// role selectors.
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+)
+
+const (
+	socketDir  = "daemon"
+	socketName = "claws.sock"
+
+	opPing        = "ping"
+	opCredentials = "credentials"
+	opAccountID   = "account-id"
+	opRegions     = "regions"
+	opSSORoles    = "sso-roles"
+
+	// dialTimeout bounds connecting to the socket, so a launch without a
+	// daemon is not slowed down
+	dialTimeout = 200 * time.Millisecond
+)
+
+// request is one newline-terminated JSON request per connection
+type request struct {
+	Op        string `json:"op"`
+	Selection string `json:"selection,omitempty"` // ProfileSelection ID
+	Profile   string `json:"profile,omitempty"`   // SSO session profile
+	Refresh   bool   `json:"refresh,omitempty"`   // bypass cached SSO roles
+}
+
+type response struct {
+	Version     string                  `json:"version,omitempty"`
+	Credentials *aws.Credentials        `json:"credentials,omitempty"`
+	AccountID   string                  `json:"accountId,omitempty"`
+	Regions     []string                `json:"regions,omitempty"`
+	Roles       []appaws.SSOAccountRole `json:"roles,omitempty"`
+	Error       string                  `json:"error,omitempty"`
+	ErrorCode   string                  `json:"errorCode,omitempty"`
+}
+
+// SocketPath returns the daemon socket path under the claws config directory
+func SocketPath() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, socketDir, socketName), nil
+}
+
+// Listen creates the daemon socket at path. The socket serves credentials,
+// so its directory is restricted to the current user. A stale socket left by
+// a crashed daemon is replaced; a live one is an error.
+func Listen(path string) (net.Listener, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create socket dir: %w", err)
+	}
+	if err := os.Chmod(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("restrict socket dir: %w", err)
+	}
+	if conn, err := net.DialTimeout("unix", path, dialTimeout); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("daemon already running on %s", path)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("remove stale socket: %w", err)
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		l.Close()
+		return nil, fmt.Errorf("restrict socket permissions: %w", err)
+	}
+	return l, nil
+}
@@ -0,0 +1,156 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+)
+
+// startServer serves srv on a socket in a short temp dir, since socket paths
+// are limited to ~100 bytes
+func startServer(t *testing.T, srv *Server) string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "claws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, socketDir, socketName)
+	l, err := Listen(path)
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, l) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Serve() error = %v", err)
+		}
+	})
+	return path
+}
+
+func TestListenRestrictsAndRejectsLiveSocket(t *testing.T) {
+	path := startServer(t, NewServer("test"))
+
+	info, err := os.Stat(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o700 {
+		t.Errorf("socket dir permissions = %o, want 700", perm)
+	}
+	if _, err := Listen(path); err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("Listen() on live socket error = %v, want already running", err)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	dir := t.TempDir()
+	credsFile := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(credsFile, []byte("[warm]\naws_access_key_id = AKIDWARM\naws_secret_access_key = secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credsFile)
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_REGION", "us-east-1")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	path := startServer(t, NewServer("test"))
+	client, err := Connect(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if client.Version() != "test" {
+		t.Errorf("Version() = %q, want test", client.Version())
+	}
+
+	creds, err := client.Credentials(context.Background(), config.NamedProfile("warm"))
+	if err != nil {
+		t.Fatalf("Credentials() error = %v", err)
+	}
+	if creds.AccessKeyID != "AKIDWARM" || creds.SecretAccessKey != "secret" {
+		t.Errorf("Credentials() = %+v", creds)
+	}
+
+	// SDK default credentials depend on the caller's environment
+	if _, err := client.Credentials(context.Background(), config.SDKDefault()); err == nil || errors.Is(err, appaws.ErrWarmSourceUnavailable) {
+		t.Errorf("Credentials(SDKDefault) error = %v, want daemon error", err)
+	}
+}
+
+func TestClientCachedListings(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+
+	srv := NewServer("test")
+	sel := config.NamedProfile("warm")
+	srv.entries[sel.ID()] = &entry{
+		sel:     sel,
+		regions: cached[[]string]{value: []string{"eu-north-1"}, fetchedAt: time.Now()},
+	}
+	srv.sso["sso"] = &ssoEntry{
+		profile: "sso",
+		roles:   cached[[]appaws.SSOAccountRole]{value: []appaws.SSOAccountRole{{AccountID: "123456789012", RoleName: "Admin"}}, fetchedAt: time.Now()},
+	}
+
+	path := startServer(t, srv)
+	client, err := Connect(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	regions, err := client.Regions(context.Background(), sel)
+	if err != nil || len(regions) != 1 || regions[0] != "eu-north-1" {
+		t.Errorf("Regions() = %v, %v, want cached regions", regions, err)
+	}
+	roles, err := client.SSOAccountRoles(context.Background(), "sso", false)
+	if err != nil || len(roles) != 1 || roles[0].RoleName != "Admin" {
+		t.Errorf("SSOAccountRoles() = %v, %v, want cached roles", roles, err)
+	}
+
+	// Profiles without an SSO session are reported by the daemon
+	if _, err := client.SSOAccountRoles(context.Background(), "missing", false); err == nil || errors.Is(err, appaws.ErrWarmSourceUnavailable) {
+		t.Errorf("SSOAccountRoles(missing) error = %v, want daemon error", err)
+	}
+}
+
+func TestCachedFreshness(t *testing.T) {
+	var empty cached[[]string]
+	if empty.fresh(regionsTTL) || empty.due(regionsTTL) {
+		t.Error("empty cache is fresh or due")
+	}
+
+	recent := cached[[]string]{fetchedAt: time.Now()}
+	if !recent.fresh(regionsTTL) || recent.due(regionsTTL) {
+		t.Error("recent cache is not fresh or is due")
+	}
+
+	aging := cached[[]string]{fetchedAt: time.Now().Add(-regionsTTL + expiryWindow/2)}
+	if !aging.fresh(regionsTTL) || !aging.due(regionsTTL) {
+		t.Error("cache within the expiry window is not fresh and due")
+	}
+
+	stale := cached[[]string]{fetchedAt: time.Now().Add(-regionsTTL)}
+	if stale.fresh(regionsTTL) {
+		t.Error("stale cache is fresh")
+	}
+}
+
+func TestConnectWithoutDaemon(t *testing.T) {
+	_, err := Connect(context.Background(), filepath.Join(t.TempDir(), "missing.sock"))
+	if !errors.Is(err, appaws.ErrWarmSourceUnavailable) {
+		t.Errorf("Connect() error = %v, want ErrWarmSourceUnavailable", err)
+	}
+}
@@ -0,0 +1,373 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	appconfig "github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// refreshInterval is how often cached credentials are checked; those
+	// within expiryWindow of expiring are refreshed ahead of use
+	refreshInterval = time.Minute
+	expiryWindow    = 5 * time.Minute
+
+	// requestTimeout bounds a single request, including SSO and STS calls
+	requestTimeout = 30 * time.Second
+
+	// regionsTTL and ssoRolesTTL bound how long listings are served from the
+	// cache; loaded listings are refetched in the background before then
+	regionsTTL  = time.Hour
+	ssoRolesTTL = 10 * time.Minute
+)
+
+// cached is a listing and when it was fetched
+type cached[T any] struct {
+	value     T
+	fetchedAt time.Time
+}
+
+// fresh reports whether the listing was fetched within ttl
+func (c cached[T]) fresh(ttl time.Duration) bool {
+	return !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < ttl
+}
+
+// due reports whether a loaded listing is within expiryWindow of ttl, so the
+// refresh loop refetches it before a client finds it stale
+func (c cached[T]) due(ttl time.Duration) bool {
+	return !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) >= ttl-expiryWindow
+}
+
+// entry holds the warm state of one profile selection. Its SDK clients are
+// created once and reused, so connections and endpoint resolution stay warm.
+type entry struct {
+	sel       appconfig.ProfileSelection
+	creds     aws.CredentialsProvider
+	sts       *sts.Client
+	ec2       *ec2.Client
+	accountID string
+	regions   cached[[]string]
+}
+
+// ssoEntry holds the warm state of the SSO session of one profile
+type ssoEntry struct {
+	profile string
+	session *appaws.SSOSession
+	roles   cached[[]appaws.SSOAccountRole]
+}
+
+// Server keeps SDK clients, credentials, account IDs, region lists and SSO
+// account roles warm and serves them over a Unix socket. Selections and SSO
+// sessions are loaded on first request.
+type Server struct {
+	version string
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	sso     map[string]*ssoEntry
+}
+
+// NewServer creates a Server reporting version to clients
+func NewServer(version string) *Server {
+	return &Server{
+		version: version,
+		entries: make(map[string]*entry),
+		sso:     make(map[string]*ssoEntry),
+	}
+}
+
+// Serve accepts connections on l until ctx is done, refreshing credentials
+// and cached listings in the background
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+	go s.refreshLoop(ctx)
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("accept: %w", err)
+		}
+		go s.handle(ctx, conn)
+	}
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(requestTimeout))
+
+	var req request
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err == nil {
+		err = json.Unmarshal(line, &req)
+	}
+	var resp response
+	if err != nil {
+		resp = errorResponse(fmt.Errorf("invalid request: %w", err))
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		resp = s.respond(ctx, req)
+		cancel()
+	}
+
+	if err := json.NewEncoder(conn).Encode(resp); err != nil {
+		log.Debug("failed to write daemon response", "error", err)
+	}
+}
+
+func (s *Server) respond(ctx context.Context, req request) response {
+	switch req.Op {
+	case opPing:
+		return response{Version: s.version}
+	case opSSORoles:
+		roles, err := s.ssoRoles(ctx, req.Profile, req.Refresh)
+		if err != nil {
+			return errorResponse(err)
+		}
+		return response{Roles: roles}
+	}
+
+	sel := appconfig.ProfileSelectionFromID(req.Selection)
+	if req.Selection == "" || !appaws.Warmable(sel) {
+		return errorResponse(fmt.Errorf("selection %q is not served by the daemon", req.Selection))
+	}
+	e, err := s.entry(ctx, sel)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	switch req.Op {
+	case opCredentials:
+		creds, err := e.creds.Retrieve(ctx)
+		if err != nil {
+			return errorResponse(err)
+		}
+		return response{Credentials: &creds}
+	case opAccountID:
+		id, err := s.accountID(ctx, e)
+		if err != nil {
+			return errorResponse(err)
+		}
+		return response{AccountID: id}
+	case opRegions:
+		regions, err := s.regions(ctx, e)
+		if err != nil {
+			return errorResponse(err)
+		}
+		return response{Regions: regions}
+	default:
+		return errorResponse(fmt.Errorf("unknown op %q", req.Op))
+	}
+}
+
+// entry returns the warm state of sel, loading its config on first use
+func (s *Server) entry(ctx context.Context, sel appconfig.ProfileSelection) (*entry, error) {
+	s.mu.Lock()
+	e, ok := s.entries[sel.ID()]
+	s.mu.Unlock()
+	if ok {
+		return e, nil
+	}
+
+	opts := append(appaws.SelectionLoadOptions(sel), config.WithCredentialsCacheOptions(func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = expiryWindow
+	}))
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config for %s: %w", sel.DisplayName(), err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[sel.ID()]; ok {
+		return e, nil
+	}
+	e = &entry{
+		sel:   sel,
+		creds: cfg.Credentials,
+		sts:   sts.NewFromConfig(cfg),
+		ec2:   ec2.NewFromConfig(cfg),
+	}
+	s.entries[sel.ID()] = e
+	log.Info("daemon loaded selection", "selection", sel.DisplayName())
+	return e, nil
+}
+
+// accountID returns the cached account ID of e, looking it up with STS once
+func (s *Server) accountID(ctx context.Context, e *entry) (string, error) {
+	s.mu.Lock()
+	id := e.accountID
+	s.mu.Unlock()
+	if id != "" {
+		return id, nil
+	}
+
+	output, err := e.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get caller identity for %s", e.sel.DisplayName())
+	}
+	id = appaws.Str(output.Account)
+	s.mu.Lock()
+	e.accountID = id
+	s.mu.Unlock()
+	return id, nil
+}
+
+// regions returns the region list of e, fetching it if it is not fresh
+func (s *Server) regions(ctx context.Context, e *entry) ([]string, error) {
+	s.mu.Lock()
+	c := e.regions
+	s.mu.Unlock()
+	if c.fresh(regionsTTL) {
+		return c.value, nil
+	}
+	return s.fetchRegions(ctx, e)
+}
+
+func (s *Server) fetchRegions(ctx context.Context, e *entry) ([]string, error) {
+	regions, err := appaws.DescribeRegionNames(ctx, e.ec2)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe regions for %s", e.sel.DisplayName())
+	}
+	s.mu.Lock()
+	e.regions = cached[[]string]{value: regions, fetchedAt: time.Now()}
+	s.mu.Unlock()
+	return regions, nil
+}
+
+// ssoRoles returns the account roles of the SSO session of profile, fetching
+// them if they are not fresh or refresh is set
+func (s *Server) ssoRoles(ctx context.Context, profile string, refresh bool) ([]appaws.SSOAccountRole, error) {
+	if profile == "" {
+		return nil, fmt.Errorf("no SSO profile given")
+	}
+	e, err := s.ssoEntry(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	c := e.roles
+	s.mu.Unlock()
+	if !refresh && c.fresh(ssoRolesTTL) {
+		return c.value, nil
+	}
+	return s.fetchSSORoles(ctx, e)
+}
+
+func (s *Server) fetchSSORoles(ctx context.Context, e *ssoEntry) ([]appaws.SSOAccountRole, error) {
+	roles, err := e.session.AccountRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	e.roles = cached[[]appaws.SSOAccountRole]{value: roles, fetchedAt: time.Now()}
+	s.mu.Unlock()
+	return roles, nil
+}
+
+// ssoEntry returns the warm SSO session of profile, loading it on first use
+func (s *Server) ssoEntry(ctx context.Context, profile string) (*ssoEntry, error) {
+	s.mu.Lock()
+	e, ok := s.sso[profile]
+	s.mu.Unlock()
+	if ok {
+		return e, nil
+	}
+
+	session, err := appaws.LoadSSOSession(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.sso[profile]; ok {
+		return e, nil
+	}
+	e = &ssoEntry{profile: profile, session: session}
+	s.sso[profile] = e
+	log.Info("daemon loaded SSO session", "profile", profile)
+	return e, nil
+}
+
+// refreshLoop retrieves the credentials of every loaded selection, so those
+// about to expire, and the SSO tokens behind them, are renewed before a
+// client needs them. Region lists and SSO roles that were requested before
+// are refetched before they go stale.
+func (s *Server) refreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		s.refresh(ctx)
+	}
+}
+
+func (s *Server) refresh(ctx context.Context) {
+	s.mu.Lock()
+	entries := make([]*entry, 0, len(s.entries))
+	var staleRegions []*entry
+	for _, e := range s.entries {
+		entries = append(entries, e)
+		if e.regions.due(regionsTTL) {
+			staleRegions = append(staleRegions, e)
+		}
+	}
+	var staleRoles []*ssoEntry
+	for _, e := range s.sso {
+		if e.roles.due(ssoRolesTTL) {
+			staleRoles = append(staleRoles, e)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, e := range entries {
+		rctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		if _, err := e.creds.Retrieve(rctx); err != nil {
+			log.Warn("daemon credential refresh failed", "selection", e.sel.DisplayName(), "error", err)
+		}
+		cancel()
+	}
+	for _, e := range staleRegions {
+		rctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		if _, err := s.fetchRegions(rctx, e); err != nil {
+			log.Warn("daemon region refresh failed", "selection", e.sel.DisplayName(), "error", err)
+		}
+		cancel()
+	}
+	for _, e := range staleRoles {
+		rctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		if _, err := s.fetchSSORoles(rctx, e); err != nil {
+			log.Warn("daemon SSO role refresh failed", "profile", e.profile, "error", err)
+		}
+		cancel()
+	}
+}
+
+func errorResponse(err error) response {
+	return response{Error: err.Error(), ErrorCode: apperrors.GetErrorCode(err)}
+}
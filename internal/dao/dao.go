@@ -102,6 +102,36 @@ type PaginatedDAO interface {
 	ListPage(ctx context.Context, pageSize int, pageToken string) ([]Resource, string, error)
 }
 
+// StreamingDAO extends DAO with incremental delivery for listings that take
+// many seconds (e.g., accounts with thousands of S3 buckets).
+// ResourceBrowser renders the first page as soon as it arrives and appends
+// later pages while the listing continues.
+type StreamingDAO interface {
+	DAO
+	// ListStream calls emit with each page of resources as it arrives.
+	// It returns when all pages were emitted, emit returns false, or ctx is done.
+	ListStream(ctx context.Context, emit func([]Resource) bool) error
+}
+
+// StreamPages implements ListStream for a PaginatedDAO by following page
+// tokens until the last page.
+func StreamPages(ctx context.Context, d PaginatedDAO, pageSize int, emit func([]Resource) bool) error {
+	token := ""
+	for {
+		resources, next, err := d.ListPage(ctx, pageSize, token)
+		if err != nil {
+			return err
+		}
+		if !emit(resources) || next == "" {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		token = next
+	}
+}
+
 // Mergeable is an optional interface for resources that need to preserve
 // fields from List() when refreshed via Get(). This is useful when Get()
 // returns a new resource that lacks some fields only available from List()
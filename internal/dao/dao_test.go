@@ -420,3 +420,49 @@ func TestRegionalResourcePreservesData(t *testing.T) {
 		}
 	}
 }
+
+type pagedDAO struct {
+	BaseDAO
+	pages map[string][]Resource
+	next  map[string]string
+}
+
+func (d *pagedDAO) List(ctx context.Context) ([]Resource, error)         { return nil, nil }
+func (d *pagedDAO) Get(ctx context.Context, id string) (Resource, error) { return nil, nil }
+func (d *pagedDAO) Delete(ctx context.Context, id string) error          { return nil }
+func (d *pagedDAO) ListPage(ctx context.Context, pageSize int, token string) ([]Resource, string, error) {
+	return d.pages[token], d.next[token], nil
+}
+
+func TestStreamPages(t *testing.T) {
+	d := &pagedDAO{
+		pages: map[string][]Resource{
+			"":   {&BaseResource{ID: "a"}, &BaseResource{ID: "b"}},
+			"t2": {&BaseResource{ID: "c"}},
+		},
+		next: map[string]string{"": "t2"},
+	}
+
+	var ids []string
+	err := StreamPages(context.Background(), d, 2, func(page []Resource) bool {
+		for _, r := range page {
+			ids = append(ids, r.GetID())
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("StreamPages() error = %v", err)
+	}
+	if len(ids) != 3 || ids[2] != "c" {
+		t.Errorf("ids = %v, want [a b c]", ids)
+	}
+
+	pages := 0
+	_ = StreamPages(context.Background(), d, 2, func([]Resource) bool {
+		pages++
+		return false
+	})
+	if pages != 1 {
+		t.Errorf("expected StreamPages to stop after emit returned false, got %d pages", pages)
+	}
+}
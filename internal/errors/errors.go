@@ -4,6 +4,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/aws/smithy-go"
@@ -21,6 +22,8 @@ const (
 	NotFound               // Resource not found errors
 	InUse                  // Resource in use / dependency errors
 	Validation             // Input validation errors
+	Expired                // Expired credentials (SSO session, session token)
+	Network                // Endpoint unreachable (DNS, connection refused, timeouts)
 )
 
 // String returns the string representation of the error kind.
@@ -36,17 +39,27 @@ func (k Kind) String() string {
 		return "InUse"
 	case Validation:
 		return "Validation"
+	case Expired:
+		return "Expired"
+	case Network:
+		return "Network"
 	default:
 		return "Unknown"
 	}
 }
 
-// Classify returns the Kind of the given error.
+// Classify returns the Kind of the given error. Credential and network
+// failures are checked first since their messages often embed HTTP codes or
+// "not found" wording from the credential provider chain.
 func Classify(err error) Kind {
 	if err == nil {
 		return Unknown
 	}
 	switch {
+	case IsExpired(err):
+		return Expired
+	case IsNetwork(err):
+		return Network
 	case IsNotFound(err):
 		return NotFound
 	case IsAccessDenied(err):
@@ -77,6 +90,7 @@ const (
 	ErrCodeDependencyViolation  = "DependencyViolation"
 	ErrCodeValidationError      = "ValidationError"
 	ErrCodeInvalidParameter     = "InvalidParameterException"
+	ErrCodeExpiredToken         = "ExpiredToken"
 )
 
 // IsNotFound returns true if the error indicates the resource was not found.
@@ -107,6 +121,39 @@ func IsAccessDenied(err error) bool {
 	)
 }
 
+// IsExpired returns true if the error indicates expired credentials: an
+// expired session token or an SSO token that could not be refreshed. Other
+// credential provider failures (missing or invalid credentials) are not
+// matched, so they keep their own classification.
+func IsExpired(err error) bool {
+	return hasErrorCode(err,
+		ErrCodeExpiredToken,
+		"ExpiredTokenException",
+		"token has expired",
+		"refresh cached SSO token failed",
+	)
+}
+
+// IsNetwork returns true if the error indicates AWS could not be reached.
+func IsNetwork(err error) bool {
+	if err == nil {
+		return false
+	}
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || errors.As(err, &opErr) {
+		return true
+	}
+	return hasErrorCode(err,
+		"no such host",
+		"connection refused",
+		"connection reset by peer",
+		"network is unreachable",
+		"i/o timeout",
+		"TLS handshake timeout",
+	)
+}
+
 // IsThrottling returns true if the error indicates rate limiting.
 func IsThrottling(err error) bool {
 	return hasErrorCode(err,
@@ -2,6 +2,8 @@ package errors
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"testing"
 
 	"github.com/aws/smithy-go"
@@ -48,6 +50,12 @@ func TestClassify(t *testing.T) {
 		{"throttling", &mockAPIError{code: "Throttling"}, Throttling},
 		{"in use", &mockAPIError{code: "ResourceInUseException"}, InUse},
 		{"validation", &mockAPIError{code: "ValidationError"}, Validation},
+		{"expired token", &mockAPIError{code: "ExpiredTokenException"}, Expired},
+		{"expired sso", errors.New("get identity: failed to refresh cached credentials, refresh cached SSO token failed"), Expired},
+		{"wrapped access denied", fmt.Errorf("list: failed to refresh cached credentials: %w", &mockAPIError{code: "AccessDenied"}), Auth},
+		{"no credentials", errors.New("failed to retrieve credentials: no EC2 IMDS role found"), Unknown},
+		{"network", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, Network},
+		{"dns", fmt.Errorf("list: %w", &net.DNSError{Err: "no such host", Name: "ec2.example"}), Network},
 		{"unknown code", &mockAPIError{code: "SomeOtherError"}, Unknown},
 		{"plain error", errors.New("some error"), Unknown},
 	}
@@ -95,6 +103,29 @@ func TestIsAccessDenied(t *testing.T) {
 	}
 }
 
+func TestIsExpired(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"expired token", &mockAPIError{code: "ExpiredToken"}, true},
+		{"expired token exception", &mockAPIError{code: "ExpiredTokenException"}, true},
+		{"token has expired", errors.New("operation error STS: GetCallerIdentity, token has expired"), true},
+		{"sso refresh", errors.New("refresh cached SSO token failed, unable to refresh SSO token"), true},
+		{"wrapped access denied", fmt.Errorf("failed to refresh cached credentials: %w", &mockAPIError{code: "AccessDenied", message: "not authorized to perform sts:AssumeRole"}), false},
+		{"no credentials", fmt.Errorf("list: %w", errors.New("failed to retrieve credentials: no valid providers in chain")), false},
+		{"access denied", &mockAPIError{code: "AccessDenied"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExpired(tt.err); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsThrottling(t *testing.T) {
 	tests := []struct {
 		code string
@@ -0,0 +1,165 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// bufferSize is the number of recent entries kept in memory for the in-app log view
+const bufferSize = 1000
+
+// Entry is a log record captured in the in-memory buffer
+type Entry struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// AttrString formats the entry attributes as key=value pairs
+func (e Entry) AttrString() string {
+	parts := make([]string, 0, len(e.Attrs))
+	for _, a := range e.Attrs {
+		parts = append(parts, formatAttr("", a)...)
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatAttr(prefix string, a slog.Attr) []string {
+	a.Value = a.Value.Resolve()
+	key := a.Key
+	if prefix != "" {
+		key = prefix + "." + key
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		var parts []string
+		for _, ga := range a.Value.Group() {
+			parts = append(parts, formatAttr(key, ga)...)
+		}
+		return parts
+	}
+	return []string{fmt.Sprintf("%s=%v", key, a.Value.Any())}
+}
+
+// ring is a fixed-size buffer of recent entries
+type ring struct {
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	seq     uint64
+}
+
+var buffer = &ring{entries: make([]Entry, 0, bufferSize)}
+
+func (r *ring) add(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.entries) < cap(r.entries) {
+		r.entries = append(r.entries, e)
+	} else {
+		r.entries[r.next] = e
+		r.next = (r.next + 1) % len(r.entries)
+	}
+	r.seq++
+}
+
+func (r *ring) snapshot() ([]Entry, uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Entry, 0, len(r.entries))
+	out = append(out, r.entries[r.next:]...)
+	out = append(out, r.entries[:r.next]...)
+	return out, r.seq
+}
+
+func (r *ring) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = r.entries[:0]
+	r.next = 0
+	r.seq++
+}
+
+// Recent returns the buffered entries, oldest first, and a sequence number
+// that changes whenever an entry is added. Debug and above are always
+// captured, whether or not logging to a file is enabled.
+func Recent() ([]Entry, uint64) {
+	return buffer.snapshot()
+}
+
+// Seq returns the buffer sequence number without copying entries
+func Seq() uint64 {
+	buffer.mu.Lock()
+	defer buffer.mu.Unlock()
+	return buffer.seq
+}
+
+// ClearRecent empties the in-memory buffer
+func ClearRecent() {
+	buffer.reset()
+}
+
+// teeHandler sends records to the configured handler and to the buffer
+type teeHandler struct {
+	next   slog.Handler
+	buf    *ring
+	attrs  []slog.Attr
+	groups []string
+}
+
+func newTeeHandler(next slog.Handler) *teeHandler {
+	return &teeHandler{next: next, buf: buffer}
+}
+
+func (h *teeHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *teeHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	var recAttrs []slog.Attr
+	r.Attrs(func(a slog.Attr) bool {
+		recAttrs = append(recAttrs, a)
+		return true
+	})
+	attrs = append(attrs, groupAttrs(h.groups, recAttrs)...)
+	h.buf.add(Entry{Time: r.Time, Level: r.Level, Message: r.Message, Attrs: attrs})
+
+	if h.next.Enabled(ctx, r.Level) {
+		return h.next.Handle(ctx, r)
+	}
+	return nil
+}
+
+func (h *teeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.next = h.next.WithAttrs(attrs)
+	c.attrs = append(append([]slog.Attr(nil), h.attrs...), groupAttrs(h.groups, attrs)...)
+	return &c
+}
+
+func (h *teeHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	c := *h
+	c.next = h.next.WithGroup(name)
+	c.groups = append(append([]string(nil), h.groups...), name)
+	return &c
+}
+
+// groupAttrs nests attrs under the open groups, innermost last
+func groupAttrs(groups []string, attrs []slog.Attr) []slog.Attr {
+	if len(groups) == 0 || len(attrs) == 0 {
+		return attrs
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		attrs = []slog.Attr{{Key: groups[i], Value: slog.GroupValue(attrs...)}}
+	}
+	return attrs
+}
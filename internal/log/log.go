@@ -18,8 +18,8 @@ var (
 )
 
 func init() {
-	// Default to disabled (no-op logger)
-	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	// Default to disabled (no-op logger); entries are still kept in the buffer
+	logger = slog.New(newTeeHandler(slog.NewTextHandler(io.Discard, nil)))
 }
 
 // Enable enables logging to the specified writer (typically a file).
@@ -29,9 +29,9 @@ func Enable(w io.Writer) {
 	defer mu.Unlock()
 
 	level.Set(slog.LevelDebug)
-	logger = slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+	logger = slog.New(newTeeHandler(slog.NewTextHandler(w, &slog.HandlerOptions{
 		Level: level,
-	}))
+	})))
 	enabled = true
 }
 
@@ -51,7 +51,7 @@ func Disable() {
 	mu.Lock()
 	defer mu.Unlock()
 
-	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	logger = slog.New(newTeeHandler(slog.NewTextHandler(io.Discard, nil)))
 	enabled = false
 }
 
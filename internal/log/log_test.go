@@ -3,6 +3,7 @@ package log
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"log/slog"
 	"strings"
 	"testing"
@@ -150,3 +151,60 @@ func TestContextFunctions(t *testing.T) {
 		t.Error("expected error context message")
 	}
 }
+
+func TestRecentCapturesWhenDisabled(t *testing.T) {
+	Disable()
+	ClearRecent()
+
+	Debug("buffered debug", "region", "us-east-1")
+	With("service", "ec2").WithGroup("req").Warn("buffered warn", "attempt", 2)
+
+	entries, seq := Recent()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 buffered entries, got %d", len(entries))
+	}
+	if entries[0].Message != "buffered debug" || entries[0].Level != slog.LevelDebug {
+		t.Errorf("entries[0] = %+v", entries[0])
+	}
+	if got := entries[1].AttrString(); got != "service=ec2 req.attempt=2" {
+		t.Errorf("AttrString() = %q", got)
+	}
+	if Seq() != seq {
+		t.Errorf("Seq() = %d, want %d", Seq(), seq)
+	}
+}
+
+func TestRecentWrapsAround(t *testing.T) {
+	ClearRecent()
+	for i := range bufferSize + 5 {
+		Info("msg", "i", i)
+	}
+
+	entries, _ := Recent()
+	if len(entries) != bufferSize {
+		t.Fatalf("expected %d entries, got %d", bufferSize, len(entries))
+	}
+	if got := entries[0].AttrString(); got != "i=5" {
+		t.Errorf("oldest entry = %q, want i=5", got)
+	}
+	if got := entries[len(entries)-1].AttrString(); got != fmt.Sprintf("i=%d", bufferSize+4) {
+		t.Errorf("newest entry = %q", got)
+	}
+}
+
+func TestSetLevelDoesNotFilterBuffer(t *testing.T) {
+	var buf bytes.Buffer
+	Enable(&buf)
+	defer Disable()
+	SetLevel(slog.LevelWarn)
+	ClearRecent()
+
+	Debug("hidden from file")
+
+	if strings.Contains(buf.String(), "hidden from file") {
+		t.Error("debug message should not reach the file at Warn level")
+	}
+	if entries, _ := Recent(); len(entries) != 1 {
+		t.Errorf("expected debug entry in buffer, got %d entries", len(entries))
+	}
+}
@@ -4,6 +4,8 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -18,6 +20,9 @@ import (
 const (
 	metricPeriod         = 60
 	maxQueriesPerRequest = 500
+
+	// anomalyBandWidth is the number of standard deviations of the expected range
+	anomalyBandWidth = 2
 )
 
 type Fetcher struct {
@@ -112,6 +117,7 @@ func (f *Fetcher) processResults(results []types.MetricDataResult, resourceIDs [
 		metricResult := &MetricResult{
 			ResourceID: resourceID,
 			Values:     result.Values,
+			Timestamps: result.Timestamps,
 			HasData:    len(result.Values) > 0,
 		}
 		if metricResult.HasData {
@@ -120,3 +126,121 @@ func (f *Fetcher) processResults(results []types.MetricDataResult, resourceIDs [
 		data.Results[resourceID] = metricResult
 	}
 }
+
+// FetchBands adds the CloudWatch anomaly detection band to the results in
+// data. Resources without a trained model get no band.
+func (f *Fetcher) FetchBands(ctx context.Context, data *MetricData) error {
+	if data == nil || data.Spec == nil || len(data.Results) == 0 {
+		return nil
+	}
+
+	var resourceIDs []string
+	for id, result := range data.Results {
+		if result.HasData {
+			resourceIDs = append(resourceIDs, id)
+		}
+	}
+	queries := f.buildBandQueries(resourceIDs, data.Spec)
+	endTime := time.Now().Truncate(time.Minute)
+	startTime := endTime.Add(-config.File().MetricsWindow())
+
+	// Each band needs its metric query in the same request
+	for i := 0; i < len(queries); i += maxQueriesPerRequest {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		end := min(i+maxQueriesPerRequest, len(queries))
+		output, err := f.client.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(startTime),
+			EndTime:           aws.Time(endTime),
+			MetricDataQueries: queries[i:end],
+			ScanBy:            types.ScanByTimestampAscending,
+		})
+		if err != nil {
+			return fmt.Errorf("GetMetricData (anomaly bands) failed: %w", err)
+		}
+
+		processBandResults(output.MetricDataResults, resourceIDs, data)
+	}
+
+	return nil
+}
+
+func (f *Fetcher) buildBandQueries(resourceIDs []string, spec *render.MetricSpec) []types.MetricDataQuery {
+	metricQueries := f.buildQueries(resourceIDs, spec)
+	queries := make([]types.MetricDataQuery, 0, 2*len(metricQueries))
+	for i, q := range metricQueries {
+		q.ReturnData = aws.Bool(false)
+		queries = append(queries, q, types.MetricDataQuery{
+			Id:         aws.String(fmt.Sprintf("b%d", i)),
+			Expression: aws.String(fmt.Sprintf("ANOMALY_DETECTION_BAND(m%d, %d)", i, anomalyBandWidth)),
+		})
+	}
+	return queries
+}
+
+// processBandResults aligns band series with the metric values by timestamp.
+// The band comes back as two series for the same query, told apart by label.
+func processBandResults(results []types.MetricDataResult, resourceIDs []string, data *MetricData) {
+	series := make(map[int][]types.MetricDataResult)
+	for _, result := range results {
+		var idx int
+		if _, err := fmt.Sscanf(aws.ToString(result.Id), "b%d", &idx); err != nil || idx < 0 || idx >= len(resourceIDs) {
+			continue
+		}
+		series[idx] = append(series[idx], result)
+	}
+
+	for idx, bounds := range series {
+		metric := data.Get(resourceIDs[idx])
+		if metric == nil || len(bounds) != 2 {
+			continue
+		}
+		lower, upper := bounds[0], bounds[1]
+		if isLowerBound(upper, lower) {
+			lower, upper = upper, lower
+		}
+		metric.Lower = alignSeries(metric.Timestamps, lower)
+		metric.Upper = alignSeries(metric.Timestamps, upper)
+	}
+}
+
+// isLowerBound reports whether a is the lower bound of the band a/b. Labels
+// are used when only one of them names a bound, otherwise values decide.
+func isLowerBound(a, b types.MetricDataResult) bool {
+	for _, bound := range []string{"low", "high"} {
+		inA := strings.Contains(strings.ToLower(aws.ToString(a.Label)), bound)
+		inB := strings.Contains(strings.ToLower(aws.ToString(b.Label)), bound)
+		if inA != inB {
+			return inA == (bound == "low")
+		}
+	}
+	return sum(a.Values) < sum(b.Values)
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func alignSeries(timestamps []time.Time, result types.MetricDataResult) []float64 {
+	byTime := make(map[int64]float64, len(result.Timestamps))
+	for i, ts := range result.Timestamps {
+		if i < len(result.Values) {
+			byTime[ts.Unix()] = result.Values[i]
+		}
+	}
+	aligned := make([]float64, len(timestamps))
+	for i, ts := range timestamps {
+		v, ok := byTime[ts.Unix()]
+		if !ok {
+			v = math.NaN()
+		}
+		aligned[i] = v
+	}
+	return aligned
+}
@@ -1,7 +1,9 @@
 package metrics
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
@@ -171,3 +173,61 @@ func TestProcessResults_UnknownQueryID(t *testing.T) {
 		t.Errorf("expected 0 results for unknown query ID, got %d", len(data.Results))
 	}
 }
+
+func TestBuildBandQueries(t *testing.T) {
+	f := &Fetcher{}
+	spec := &render.MetricSpec{Namespace: "AWS/EC2", MetricName: "CPUUtilization", DimensionName: "InstanceId", Stat: "Average"}
+
+	queries := f.buildBandQueries([]string{"i-1", "i-2"}, spec)
+	if len(queries) != 4 {
+		t.Fatalf("expected metric and band query per resource, got %d", len(queries))
+	}
+	if aws.ToBool(queries[0].ReturnData) {
+		t.Error("metric query should not return data")
+	}
+	if got := aws.ToString(queries[3].Expression); got != "ANOMALY_DETECTION_BAND(m1, 2)" {
+		t.Errorf("band expression = %q", got)
+	}
+}
+
+func TestProcessBandResults(t *testing.T) {
+	t0 := time.Unix(1_700_000_000, 0)
+	t1, t2 := t0.Add(time.Minute), t0.Add(2*time.Minute)
+	data := NewMetricData(nil)
+	data.Results["i-1"] = &MetricResult{
+		ResourceID: "i-1",
+		Values:     []float64{10, 50, 12},
+		Timestamps: []time.Time{t0, t1, t2},
+		HasData:    true,
+	}
+
+	// Upper bound first and unlabelled, lower bound missing the middle point
+	results := []types.MetricDataResult{
+		{Id: aws.String("b0"), Values: []float64{20, 20, 20}, Timestamps: []time.Time{t0, t1, t2}},
+		{Id: aws.String("b0"), Values: []float64{5, 5}, Timestamps: []time.Time{t0, t2}},
+		{Id: aws.String("b7"), Values: []float64{1}, Timestamps: []time.Time{t0}},
+	}
+	processBandResults(results, []string{"i-1"}, data)
+
+	r := data.Get("i-1")
+	if !r.HasBand() {
+		t.Fatal("expected band")
+	}
+	if r.Upper[1] != 20 || !math.IsNaN(r.Lower[1]) {
+		t.Errorf("band not aligned: lower=%v upper=%v", r.Lower, r.Upper)
+	}
+	if r.Deviation(1) != 1 || r.Deviation(0) != 0 {
+		t.Errorf("Deviation = %d, %d; want 1, 0", r.Deviation(1), r.Deviation(0))
+	}
+	if lo, hi, ok := r.LatestRange(); !ok || lo != 5 || hi != 20 {
+		t.Errorf("LatestRange = %v, %v, %v", lo, hi, ok)
+	}
+}
+
+func TestIsLowerBound_Labels(t *testing.T) {
+	low := types.MetricDataResult{Label: aws.String("LowLatency Low"), Values: []float64{100}}
+	high := types.MetricDataResult{Label: aws.String("LowLatency High"), Values: []float64{1}}
+	if !isLowerBound(low, high) || isLowerBound(high, low) {
+		t.Error("expected labels to decide over values")
+	}
+}
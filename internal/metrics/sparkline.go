@@ -7,6 +7,12 @@ import (
 
 var sparkBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
 
+// Points outside the anomaly detection band replace their block
+const (
+	aboveBandMark = '▲'
+	belowBandMark = '▼'
+)
+
 const (
 	SparklineWidth    = 7
 	ColumnWidth       = 13
@@ -14,6 +20,7 @@ const (
 )
 
 // RenderSparkline renders a sparkline with the latest value and optional unit suffix.
+// When an anomaly band was fetched, points above or below it are marked.
 func RenderSparkline(result *MetricResult, unit string) string {
 	if result == nil || !result.HasData || len(result.Values) == 0 {
 		return fmt.Sprintf("%s  -", noDataPlaceholder)
@@ -23,6 +30,7 @@ func RenderSparkline(result *MetricResult, unit string) string {
 	if len(values) > SparklineWidth {
 		values = values[len(values)-SparklineWidth:]
 	}
+	offset := len(result.Values) - len(values)
 
 	minVal, maxVal := values[0], values[0]
 	for _, v := range values {
@@ -36,7 +44,15 @@ func RenderSparkline(result *MetricResult, unit string) string {
 
 	var spark string
 	valRange := maxVal - minVal
-	for _, v := range values {
+	for i, v := range values {
+		switch result.Deviation(offset + i) {
+		case 1:
+			spark += string(aboveBandMark)
+			continue
+		case -1:
+			spark += string(belowBandMark)
+			continue
+		}
 		idx := 0
 		if valRange > 0 {
 			normalized := (v - minVal) / valRange
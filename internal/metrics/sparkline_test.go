@@ -92,3 +92,19 @@ func TestRenderSparkline_EmptyUnit(t *testing.T) {
 		t.Errorf("RenderSparkline(empty unit) = %q, should not have %%", result)
 	}
 }
+
+func TestRenderSparkline_MarksOutOfBand(t *testing.T) {
+	result := RenderSparkline(&MetricResult{
+		HasData: true,
+		Values:  []float64{10, 12, 90, 1},
+		Latest:  1,
+		Lower:   []float64{5, 5, 5, 5},
+		Upper:   []float64{20, 20, 20, 20},
+	}, "%")
+	if !strings.Contains(result, "▲▼") {
+		t.Errorf("RenderSparkline(band) = %q, want above/below marks", result)
+	}
+	if strings.Count(result, "▲")+strings.Count(result, "▼") != 2 {
+		t.Errorf("RenderSparkline(band) = %q, want only out-of-band points marked", result)
+	}
+}
@@ -1,13 +1,56 @@
 package metrics
 
-import "github.com/clawscli/claws/internal/render"
+import (
+	"math"
+	"time"
+
+	"github.com/clawscli/claws/internal/render"
+)
 
 // MetricResult holds metric data for a single resource.
 type MetricResult struct {
 	ResourceID string
 	Values     []float64
+	Timestamps []time.Time
 	Latest     float64
 	HasData    bool
+
+	// Lower and Upper are the anomaly detection band aligned with Values,
+	// NaN where the band has no point. Nil unless bands were fetched.
+	Lower []float64
+	Upper []float64
+}
+
+// HasBand reports whether an anomaly detection band was fetched.
+func (m *MetricResult) HasBand() bool {
+	return m != nil && len(m.Values) > 0 && len(m.Lower) == len(m.Values) && len(m.Upper) == len(m.Values)
+}
+
+// Deviation reports whether Values[i] lies above (1) or below (-1) the
+// expected range, or 0 if it is within it or the band has no point there.
+func (m *MetricResult) Deviation(i int) int {
+	if !m.HasBand() || i < 0 || i >= len(m.Values) {
+		return 0
+	}
+	switch v := m.Values[i]; {
+	case !math.IsNaN(m.Upper[i]) && v > m.Upper[i]:
+		return 1
+	case !math.IsNaN(m.Lower[i]) && v < m.Lower[i]:
+		return -1
+	}
+	return 0
+}
+
+// LatestRange returns the expected range for the latest value.
+func (m *MetricResult) LatestRange() (lower, upper float64, ok bool) {
+	if !m.HasBand() {
+		return 0, 0, false
+	}
+	i := len(m.Values) - 1
+	if math.IsNaN(m.Lower[i]) || math.IsNaN(m.Upper[i]) {
+		return 0, 0, false
+	}
+	return m.Lower[i], m.Upper[i], true
 }
 
 // MetricData holds metric results for multiple resources.
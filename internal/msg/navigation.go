@@ -2,7 +2,10 @@
 // These messages are sent between views and handled by the app layer.
 package msg
 
-import "github.com/clawscli/claws/internal/config"
+import (
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
 
 type ProfilesChangedMsg struct {
 	Selections []config.ProfileSelection
@@ -11,3 +14,14 @@ type ProfilesChangedMsg struct {
 type RegionChangedMsg struct {
 	Regions []string
 }
+
+// FollowResourcesMsg opens the ResourceType listing of Service filtered by
+// FilterField, auto-reloading it to follow a resource an action created until
+// Done reports true for the listed resources.
+type FollowResourcesMsg struct {
+	Service      string
+	ResourceType string
+	FilterField  string
+	FilterValue  string
+	Done         func(resources []dao.Resource) bool
+}
@@ -0,0 +1,130 @@
+// Package recording captures the views visited during a session into a
+// sanitized, replayable file for handoffs and runbooks.
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/sanitize"
+)
+
+// FormatVersion is bumped when the file layout changes incompatibly
+const FormatVersion = 1
+
+// Recording is the file written by `claws --record` and read by `--replay`
+type Recording struct {
+	Version      int       `json:"version"`
+	ClawsVersion string    `json:"claws_version,omitempty"`
+	Recorded     time.Time `json:"recorded"`
+	Steps        []Step    `json:"steps"`
+}
+
+// Step is one view as the user saw it when navigating away from it
+type Step struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Title        string    `json:"title"`
+	Screen       string    `json:"screen"`
+}
+
+// Path returns "service/resource" or "" for non-resource views
+func (s Step) Path() string {
+	if s.Service == "" {
+		return ""
+	}
+	if s.ResourceType == "" {
+		return s.Service
+	}
+	return s.Service + "/" + s.ResourceType
+}
+
+// Load reads a recording file
+func Load(path string) (*Recording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "read recording")
+	}
+	var rec Recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, apperrors.Wrapf(err, "parse recording %s", path)
+	}
+	if rec.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported recording version %d (expected %d)", rec.Version, FormatVersion)
+	}
+	if len(rec.Steps) == 0 {
+		return nil, fmt.Errorf("recording %s has no steps", path)
+	}
+	return &rec, nil
+}
+
+// Recorder collects steps during a session. Every captured field passes
+// through sanitize.DemoText before it is kept, so the file never holds raw
+// account IDs, addresses or secrets.
+type Recorder struct {
+	mu   sync.Mutex
+	path string
+	rec  Recording
+}
+
+// NewRecorder returns a recorder that saves to path
+func NewRecorder(path, clawsVersion string) *Recorder {
+	return &Recorder{
+		path: path,
+		rec: Recording{
+			Version:      FormatVersion,
+			ClawsVersion: clawsVersion,
+			Recorded:     time.Now(),
+		},
+	}
+}
+
+// Capture adds a step, skipping it when the screen is unchanged from the
+// previous step (e.g. navigating back and forth without new data)
+func (r *Recorder) Capture(step Step) {
+	step.Title = sanitize.DemoText(step.Title)
+	step.Screen = sanitize.DemoText(step.Screen)
+	step.ResourceID = sanitize.DemoText(step.ResourceID)
+	if step.Time.IsZero() {
+		step.Time = time.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n := len(r.rec.Steps); n > 0 && r.rec.Steps[n-1].Screen == step.Screen {
+		return
+	}
+	r.rec.Steps = append(r.rec.Steps, step)
+}
+
+// Len returns the number of captured steps
+func (r *Recorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.rec.Steps)
+}
+
+// Path returns the file the recorder saves to
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// Save writes the recording file
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.rec, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return apperrors.Wrap(err, "encode recording")
+	}
+	if err := os.WriteFile(r.path, data, 0o600); err != nil {
+		return apperrors.Wrap(err, "write recording")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package recording
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderCaptureSanitizes(t *testing.T) {
+	r := NewRecorder("unused", "dev")
+	r.Capture(Step{
+		Service:      "iam",
+		ResourceType: "roles",
+		ResourceID:   "arn:aws:iam::123456789012:role/app",
+		Title:        "\x1b[1miam/roles\x1b[0m",
+		Screen:       "Account 123456789012  10.1.2.3  password=hunter2",
+	})
+
+	s := r.rec.Steps[0]
+	if strings.Contains(s.ResourceID, "123456789012") {
+		t.Errorf("ResourceID not masked: %q", s.ResourceID)
+	}
+	if s.Title != "iam/roles" {
+		t.Errorf("Title = %q, want escapes stripped", s.Title)
+	}
+	for _, leaked := range []string{"123456789012", "10.1.2.3", "hunter2"} {
+		if strings.Contains(s.Screen, leaked) {
+			t.Errorf("Screen leaked %q: %q", leaked, s.Screen)
+		}
+	}
+	if s.Time.IsZero() {
+		t.Error("Time not set")
+	}
+}
+
+func TestRecorderCaptureSkipsUnchangedScreen(t *testing.T) {
+	r := NewRecorder("unused", "dev")
+	r.Capture(Step{Title: "a", Screen: "same"})
+	r.Capture(Step{Title: "b", Screen: "same"})
+	r.Capture(Step{Title: "c", Screen: "other"})
+	r.Capture(Step{Title: "d", Screen: "same"})
+
+	if r.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", r.Len())
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	r := NewRecorder(path, "v1.2.3")
+	r.Capture(Step{Service: "ec2", ResourceType: "instances", Title: "ec2/instances", Screen: "row"})
+	if err := r.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	rec, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if rec.ClawsVersion != "v1.2.3" || len(rec.Steps) != 1 {
+		t.Fatalf("loaded %+v", rec)
+	}
+	if got := rec.Steps[0].Path(); got != "ec2/instances" {
+		t.Errorf("Path() = %q", got)
+	}
+}
+
+func TestLoadRejectsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	tests := map[string]string{
+		"garbage":     "not json",
+		"version":     `{"version": 99, "steps": [{"title": "x"}]}`,
+		"empty steps": `{"version": 1, "steps": []}`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(name, " ", "_"))
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(path); err == nil {
+				t.Error("Load() succeeded, want error")
+			}
+		})
+	}
+
+	if _, err := Load(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Load() of missing file succeeded, want error")
+	}
+}
@@ -100,6 +100,10 @@ func defaultAliases() map[string]string {
 		"sfn":              "stepfunctions",
 		"sq":               "service-quotas",
 		"quotas":           "service-quotas",
+		"rg":               "resource-groups",
+		"sc":               "servicecatalog",
+		"wa":               "wellarchitected",
+		"pca":              "acmpca",
 		"apigw":            "apigateway",
 		"api":              "apigateway",
 		"elb":              "elbv2",
@@ -128,6 +132,7 @@ func defaultAliases() map[string]string {
 		"ri":               "risp/reserved-instances",
 		"sp":               "risp/savings-plans",
 		"odcr":             "ec2/capacity-reservations",
+		"pl":               "ec2/prefix-lists",
 		"tgw":              "vpc/transit-gateways",
 		"cognito":          "cognito-idp",
 		"config":           "configservice",
@@ -140,7 +145,9 @@ func defaultAliases() map[string]string {
 func defaultDisplayNames() map[string]string {
 	return map[string]string{
 		"accessanalyzer":    "IAM Access Analyzer",
+		"account":           "Account",
 		"acm":               "ACM",
+		"acmpca":            "ACM PCA",
 		"apigateway":        "API Gateway",
 		"apprunner":         "App Runner",
 		"appsync":           "AppSync",
@@ -188,13 +195,16 @@ func defaultDisplayNames() map[string]string {
 		"network-firewall":  "Network Firewall",
 		"opensearch":        "OpenSearch",
 		"organizations":     "Organizations",
+		"pipes":             "EventBridge Pipes",
 		"rds":               "RDS",
 		"redshift":          "Redshift",
 		"risp":              "RI/SP",
+		"resource-groups":   "Resource Groups",
 		"route53":           "Route 53",
 		"s3":                "S3",
 		"sagemaker":         "SageMaker",
 		"s3vectors":         "S3 Vectors",
+		"servicecatalog":    "Service Catalog",
 		"secretsmanager":    "Secrets Manager",
 		"securityhub":       "Security Hub",
 		"service-quotas":    "Service Quotas",
@@ -206,6 +216,7 @@ func defaultDisplayNames() map[string]string {
 		"transfer":          "Transfer Family",
 		"vpc":               "VPC",
 		"wafv2":             "WAF",
+		"wellarchitected":   "Well-Architected",
 		"xray":              "X-Ray",
 		"trustedadvisor":    "Trusted Advisor",
 		"compute-optimizer": "Compute Optimizer",
@@ -243,11 +254,11 @@ func defaultCategories() []ServiceCategory {
 		},
 		{
 			Name:     "Security & Identity",
-			Services: []string{"iam", "kms", "acm", "secretsmanager", "ssm", "cognito-idp", "guardduty", "wafv2", "inspector2", "securityhub", "fms", "accessanalyzer", "detective", "macie2"},
+			Services: []string{"iam", "kms", "acm", "acmpca", "secretsmanager", "ssm", "cognito-idp", "guardduty", "wafv2", "inspector2", "securityhub", "fms", "accessanalyzer", "detective", "macie2"},
 		},
 		{
 			Name:     "Integration",
-			Services: []string{"sqs", "sns", "events", "stepfunctions", "kinesis", "transfer", "datasync"},
+			Services: []string{"sqs", "sns", "events", "pipes", "stepfunctions", "kinesis", "transfer", "datasync"},
 		},
 		{
 			Name:     "DevOps",
@@ -259,7 +270,7 @@ func defaultCategories() []ServiceCategory {
 		},
 		{
 			Name:     "Governance",
-			Services: []string{"configservice", "organizations", "service-quotas", "license-manager", "backup", "trustedadvisor", "compute-optimizer"},
+			Services: []string{"configservice", "organizations", "account", "service-quotas", "license-manager", "resource-groups", "servicecatalog", "backup", "trustedadvisor", "compute-optimizer", "wellarchitected"},
 		},
 		{
 			Name:     "Cost Management",
@@ -568,6 +579,7 @@ var defaultResources = map[string]string{
 	"rds":               "instances",
 	"redshift":          "clusters",
 	"risp":              "reserved-instances",
+	"resource-groups":   "groups",
 	"route53":           "hosted-zones",
 	"sagemaker":         "endpoints",
 	"service-quotas":    "services",
@@ -575,6 +587,7 @@ var defaultResources = map[string]string{
 	"stepfunctions":     "state-machines",
 	"transfer":          "servers",
 	"vpc":               "vpcs",
+	"wellarchitected":   "workloads",
 }
 
 // DefaultResource returns the preferred default resource type for a service.
@@ -636,11 +649,15 @@ var subResourceSet = map[string]struct{}{
 	"backup/selections":                {},
 	"ecr/images":                       {},
 	"autoscaling/activities":           {},
+	"autoscaling/instance-refreshes":   {},
+	"ec2/launch-template-versions":     {},
+	"ec2/prefix-list-entries":          {},
 	"bedrock-agent/data-sources":       {},
 	"bedrock-agentcore/endpoints":      {},
 	"bedrock-agentcore/versions":       {},
 	"glue/tables":                      {},
 	"glue/job-runs":                    {},
+	"glue/partitions":                  {},
 	"athena/query-executions":          {},
 	"apprunner/operations":             {},
 	"budgets/notifications":            {},
@@ -660,7 +677,13 @@ var subResourceSet = map[string]struct{}{
 	"eks/fargate-profiles":             {},
 	"eks/addons":                       {},
 	"eks/access-entries":               {},
+	"eks/updates":                      {},
+	"resource-groups/members":          {},
+	"ssm/automation-steps":             {},
 	"redshift/snapshots":               {},
+	"elasticache/events":               {},
+	"wellarchitected/lens-reviews":     {},
+	"wellarchitected/improvements":     {},
 }
 
 // isSubResource returns true if the resource is only accessible via navigation
@@ -0,0 +1,96 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// JSONChange describes a single leaf-level difference between two JSON documents.
+// Old is empty for added paths and New is empty for removed paths.
+type JSONChange struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// DiffJSON compares two values by their JSON encoding and returns the changed
+// leaf paths (e.g. "BlockDeviceMappings[0].Ebs.VolumeSize"), sorted by path.
+func DiffJSON(oldValue, newValue any) ([]JSONChange, error) {
+	oldLeaves, err := flattenJSON(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newLeaves, err := flattenJSON(newValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []JSONChange
+	for path, ov := range oldLeaves {
+		nv, ok := newLeaves[path]
+		if !ok {
+			changes = append(changes, JSONChange{Path: path, Old: ov})
+		} else if nv != ov {
+			changes = append(changes, JSONChange{Path: path, Old: ov, New: nv})
+		}
+	}
+	for path, nv := range newLeaves {
+		if _, ok := oldLeaves[path]; !ok {
+			changes = append(changes, JSONChange{Path: path, New: nv})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+// flattenJSON round-trips v through JSON and returns its leaves keyed by path.
+func flattenJSON(v any) (map[string]string, error) {
+	leaves := make(map[string]string)
+	if v == nil {
+		return leaves, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal json: %w", err)
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("unmarshal json: %w", err)
+	}
+
+	walkJSON("", generic, leaves)
+	return leaves, nil
+}
+
+func walkJSON(path string, v any, leaves map[string]string) {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 && path != "" {
+			leaves[path] = "{}"
+			return
+		}
+		for k, child := range val {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			walkJSON(childPath, child, leaves)
+		}
+	case []any:
+		if len(val) == 0 {
+			leaves[path] = "[]"
+			return
+		}
+		for i, child := range val {
+			walkJSON(fmt.Sprintf("%s[%d]", path, i), child, leaves)
+		}
+	case nil:
+		// Treat explicit nulls the same as absent fields
+	default:
+		b, _ := json.Marshal(val)
+		leaves[path] = string(b)
+	}
+}
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	type ebs struct {
+		VolumeSize int    `json:"VolumeSize,omitempty"`
+		VolumeType string `json:"VolumeType,omitempty"`
+	}
+	type doc struct {
+		InstanceType string   `json:"InstanceType,omitempty"`
+		ImageId      string   `json:"ImageId,omitempty"`
+		Groups       []string `json:"Groups,omitempty"`
+		Ebs          *ebs     `json:"Ebs,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		old, new any
+		want     []JSONChange
+	}{
+		{
+			name: "identical",
+			old:  doc{InstanceType: "t3.micro"},
+			new:  doc{InstanceType: "t3.micro"},
+			want: nil,
+		},
+		{
+			name: "changed scalar",
+			old:  doc{InstanceType: "t3.micro"},
+			new:  doc{InstanceType: "t3.large"},
+			want: []JSONChange{{Path: "InstanceType", Old: `"t3.micro"`, New: `"t3.large"`}},
+		},
+		{
+			name: "added and removed",
+			old:  doc{ImageId: "ami-1"},
+			new:  doc{Ebs: &ebs{VolumeSize: 20}},
+			want: []JSONChange{
+				{Path: "Ebs.VolumeSize", New: "20"},
+				{Path: "ImageId", Old: `"ami-1"`},
+			},
+		},
+		{
+			name: "array element",
+			old:  doc{Groups: []string{"sg-1", "sg-2"}},
+			new:  doc{Groups: []string{"sg-1", "sg-3"}},
+			want: []JSONChange{{Path: "Groups[1]", Old: `"sg-2"`, New: `"sg-3"`}},
+		},
+		{
+			name: "nil old",
+			old:  nil,
+			new:  doc{InstanceType: "t3.micro"},
+			want: []JSONChange{{Path: "InstanceType", New: `"t3.micro"`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffJSON(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("DiffJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffJSON() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
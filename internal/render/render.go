@@ -2,6 +2,8 @@ package render
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"charm.land/lipgloss/v2"
@@ -29,6 +31,9 @@ type SummaryField struct {
 // ViewTypeLogView indicates navigation should open a LogView instead of ResourceBrowser
 const ViewTypeLogView = "log-view"
 
+// ViewTypeResourceGroup indicates navigation should list a resource group's members across services
+const ViewTypeResourceGroup = "resource-group"
+
 // Navigation defines a navigation shortcut to related resources or custom views
 type Navigation struct {
 	Key            string
@@ -71,6 +76,35 @@ type Navigator interface {
 	Navigations(resource dao.Resource) []Navigation
 }
 
+// LogSourceKey is the key that opens a LogSource resource's logs in every view.
+// LogSourceAliasKey, the key the per-service log navigations used, does the
+// same unless one of the renderer's navigations binds it.
+const (
+	LogSourceKey      = "L"
+	LogSourceAliasKey = "l"
+)
+
+// LogTarget is a CloudWatch Logs location a resource writes to
+type LogTarget struct {
+	Group  string
+	Stream string // Optional: tail only this stream
+}
+
+// LogSource is an optional interface for renderers whose resources write to
+// CloudWatch Logs. Views bind LogSourceKey and LogSourceAliasKey to open the
+// targets in LogView, so renderers should not add their own log navigation.
+type LogSource interface {
+	// LogTargets returns the log groups of a resource, most relevant first
+	LogTargets(resource dao.Resource) []LogTarget
+}
+
+// DiffRenderer is an optional interface for renderers that compare two resources
+// themselves (e.g., only differing parameters) instead of the side-by-side detail.
+// DiffView refreshes both resources via DAO.Get before calling RenderDiff.
+type DiffRenderer interface {
+	RenderDiff(left, right dao.Resource) string
+}
+
 // Toggle defines a list-level toggle for filtering or view modes
 type Toggle struct {
 	Key        string // Key to press (e.g., "r")
@@ -297,3 +331,11 @@ func FormatSize(bytes int64) string {
 		return fmt.Sprintf("%d B", bytes)
 	}
 }
+
+// FormatPercentBar formats a percentage as a fixed-width bar followed by the
+// value (e.g., "████░░░░░░  42%"). Values outside 0-100 fill the bar to its
+// bounds but are printed as-is.
+func FormatPercentBar(percent float64, width int) string {
+	filled := min(max(int(math.Round(percent/100*float64(width))), 0), width)
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled) + fmt.Sprintf(" %3.0f%%", percent)
+}
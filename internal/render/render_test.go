@@ -36,6 +36,25 @@ func TestFormatAge(t *testing.T) {
 	}
 }
 
+func TestFormatPercentBar(t *testing.T) {
+	tests := []struct {
+		percent float64
+		want    string
+	}{
+		{0, "░░░░░░░░░░   0%"},
+		{42, "████░░░░░░  42%"},
+		{100, "██████████ 100%"},
+		{130, "██████████ 130%"},
+		{-5, "░░░░░░░░░░  -5%"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatPercentBar(tt.percent, 10); got != tt.want {
+			t.Errorf("FormatPercentBar(%v) = %q, want %q", tt.percent, got, tt.want)
+		}
+	}
+}
+
 func TestFormatSize(t *testing.T) {
 	tests := []struct {
 		bytes int64
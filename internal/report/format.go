@@ -0,0 +1,167 @@
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+// Format is an output format for a report
+type Format string
+
+const (
+	FormatMarkdown Format = "md"
+	FormatHTML     Format = "html"
+)
+
+// ParseFormat parses a --format value
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "md", "markdown":
+		return FormatMarkdown, nil
+	case "html":
+		return FormatHTML, nil
+	}
+	return "", fmt.Errorf("unknown format %q (expected md or html)", s)
+}
+
+// FormatForPath infers the format from an output file extension,
+// defaulting to markdown
+func FormatForPath(path string) Format {
+	lower := strings.ToLower(path)
+	if strings.HasSuffix(lower, ".html") || strings.HasSuffix(lower, ".htm") {
+		return FormatHTML
+	}
+	return FormatMarkdown
+}
+
+// Write renders the report in the given format
+func Write(w io.Writer, rep *Report, format Format) error {
+	if format == FormatHTML {
+		return HTML(w, rep)
+	}
+	return Markdown(w, rep)
+}
+
+// Markdown renders the report as GitHub-flavored markdown
+func Markdown(w io.Writer, rep *Report) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", rep.Title)
+	for _, line := range metaLines(rep) {
+		fmt.Fprintf(&b, "- %s\n", line)
+	}
+
+	for _, s := range rep.Sections {
+		fmt.Fprintf(&b, "\n## %s\n\n", s.Title)
+		switch {
+		case s.Err != nil:
+			fmt.Fprintf(&b, "> Error: %s\n", escapeMarkdown(s.Err.Error()))
+		case len(s.Table.Rows) == 0:
+			b.WriteString("None.\n")
+		default:
+			writeMarkdownRow(&b, s.Table.Headers)
+			seps := make([]string, len(s.Table.Headers))
+			for i := range seps {
+				seps[i] = "---"
+			}
+			writeMarkdownRow(&b, seps)
+			for _, row := range s.Table.Rows {
+				writeMarkdownRow(&b, row)
+			}
+			if note := s.Table.Note(); note != "" {
+				fmt.Fprintf(&b, "\n_%s_\n", note)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string) {
+	b.WriteString("|")
+	for _, c := range cells {
+		b.WriteString(" " + escapeMarkdown(c) + " |")
+	}
+	b.WriteString("\n")
+}
+
+func escapeMarkdown(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func metaLines(rep *Report) []string {
+	lines := []string{"Generated: " + rep.Generated.UTC().Format(time.RFC3339)}
+	if rep.Profile != "" {
+		lines = append(lines, "Profile: "+rep.Profile)
+	}
+	if rep.AccountID != "" {
+		lines = append(lines, "Account: "+rep.AccountID)
+	}
+	if len(rep.Regions) > 0 {
+		lines = append(lines, "Regions: "+strings.Join(rep.Regions, ", "))
+	}
+	return lines
+}
+
+// Note describes rows dropped by the section limit
+func (t Table) Note() string {
+	if more := t.Total - len(t.Rows); more > 0 {
+		return fmt.Sprintf("%d more not shown", more)
+	}
+	return ""
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Report.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 14px; color: #24292f; }
+table { border-collapse: collapse; margin-bottom: 8px; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; }
+th { background: #f6f8fa; }
+.error { color: #cf222e; }
+.meta, .note { color: #57606a; }
+</style>
+</head>
+<body>
+<h1>{{.Report.Title}}</h1>
+<ul class="meta">
+{{- range .Meta}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- range .Report.Sections}}
+<h2>{{.Title}}</h2>
+{{- if .Err}}
+<p class="error">Error: {{.Err}}</p>
+{{- else if not .Table.Rows}}
+<p>None.</p>
+{{- else}}
+<table>
+<tr>{{range .Table.Headers}}<th>{{.}}</th>{{end}}</tr>
+{{- range .Table.Rows}}
+<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- with .Table.Note}}
+<p class="note">{{.}}</p>
+{{- end}}
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML renders the report as a standalone HTML page suitable for email
+func HTML(w io.Writer, rep *Report) error {
+	return htmlTemplate.Execute(w, struct {
+		Report *Report
+		Meta   []string
+	}{rep, metaLines(rep)})
+}
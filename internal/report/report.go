@@ -0,0 +1,252 @@
+// Package report renders predefined reports (cost, security findings, idle
+// resources) from the registered DAOs for `claws report`.
+package report
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// defaultSectionLimit caps the rows of a section when Section.Limit is unset
+const defaultSectionLimit = 50
+
+// Template is a predefined report
+type Template struct {
+	Name        string
+	Title       string
+	Description string
+	Sections    []Section
+}
+
+// Section is one table of a report. It either lists a registered resource
+// type, keeping the named renderer columns, or runs Fetch.
+type Section struct {
+	Title    string
+	Service  string
+	Resource string
+	Columns  []string // Renderer column names; empty keeps all
+
+	// Filters are passed to the DAO via dao.WithFilter
+	Filters map[string]string
+
+	// Parent lists another resource type first and filters by each parent ID,
+	// for sub-resources such as GuardDuty findings under a detector
+	Parent *Parent
+
+	// Keep drops resources that do not belong in the report
+	Keep func(dao.Resource) bool
+
+	// Global lists once in the current region instead of every region
+	Global bool
+
+	// Limit caps the rows shown; 0 uses defaultSectionLimit
+	Limit int
+
+	// Fetch builds the table directly instead of listing Service/Resource
+	Fetch func(ctx context.Context) (Table, error)
+}
+
+// Parent names the resource type whose IDs scope a sub-resource listing
+type Parent struct {
+	Service  string
+	Resource string
+	Filter   string // Filter key the parent ID is passed as
+
+	// ID picks the value passed as Filter; nil uses GetID
+	ID func(dao.Resource) string
+}
+
+func (p *Parent) id(res dao.Resource) string {
+	if p.ID != nil {
+		return p.ID(res)
+	}
+	return res.GetID()
+}
+
+// Table is the rendered content of a section
+type Table struct {
+	Headers []string
+	Rows    [][]string
+	Total   int // Rows before the limit was applied
+}
+
+// SectionResult is a section after it ran. Err is kept per section so one
+// missing permission does not fail the whole report.
+type SectionResult struct {
+	Title string
+	Table Table
+	Err   error
+}
+
+// Report is a generated report, ready to be written as markdown or HTML
+type Report struct {
+	Title     string
+	Template  string
+	Generated time.Time
+	Profile   string
+	AccountID string
+	Regions   []string
+	Sections  []SectionResult
+}
+
+// Failed reports whether every section failed
+func (r *Report) Failed() bool {
+	for _, s := range r.Sections {
+		if s.Err == nil {
+			return false
+		}
+	}
+	return len(r.Sections) > 0
+}
+
+// Generate runs the template's sections for the current profile and regions
+func Generate(ctx context.Context, reg *registry.Registry, tmpl *Template) *Report {
+	cfg := config.Global()
+	regions := cfg.Regions()
+	if len(regions) == 0 {
+		regions = []string{cfg.Region()}
+	}
+
+	rep := &Report{
+		Title:     tmpl.Title,
+		Template:  tmpl.Name,
+		Generated: time.Now(),
+		Profile:   cfg.Selection().DisplayName(),
+		AccountID: cfg.AccountID(),
+		Regions:   regions,
+	}
+	for _, s := range tmpl.Sections {
+		result := SectionResult{Title: s.Title}
+		result.Table, result.Err = runSection(ctx, reg, s, regions)
+		if result.Err != nil {
+			log.Warn("report section failed", "template", tmpl.Name, "section", s.Title, "error", result.Err)
+		}
+		rep.Sections = append(rep.Sections, result)
+	}
+	return rep
+}
+
+func runSection(ctx context.Context, reg *registry.Registry, s Section, regions []string) (Table, error) {
+	var table Table
+	var err error
+	if s.Fetch != nil {
+		table, err = s.Fetch(ctx)
+	} else {
+		table, err = listSection(ctx, reg, s, regions)
+	}
+	if err != nil {
+		return Table{}, err
+	}
+
+	limit := s.Limit
+	if limit == 0 {
+		limit = defaultSectionLimit
+	}
+	if table.Total == 0 {
+		table.Total = len(table.Rows)
+	}
+	if len(table.Rows) > limit {
+		table.Rows = table.Rows[:limit]
+	}
+	return table, nil
+}
+
+// listSection lists the section's resource type in each region and renders
+// the selected columns, adding a REGION column for multi-region reports
+func listSection(ctx context.Context, reg *registry.Registry, s Section, regions []string) (Table, error) {
+	renderer, err := reg.GetRenderer(s.Service, s.Resource)
+	if err != nil {
+		return Table{}, err
+	}
+	var cols []int
+	var table Table
+	for i, col := range renderer.Columns() {
+		if len(s.Columns) == 0 || slices.Contains(s.Columns, col.Name) {
+			cols = append(cols, i)
+			table.Headers = append(table.Headers, col.Name)
+		}
+	}
+
+	if s.Global {
+		regions = regions[:1]
+	}
+	multiRegion := len(regions) > 1
+	if multiRegion {
+		table.Headers = append(table.Headers, "REGION")
+	}
+
+	var errs []string
+	for _, region := range regions {
+		regionCtx := ctx
+		if multiRegion {
+			regionCtx = aws.WithRegionOverride(ctx, region)
+		}
+		resources, err := listResources(regionCtx, reg, s)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", region, err))
+			continue
+		}
+
+		allCols := renderer.Columns()
+		for _, res := range resources {
+			unwrapped := dao.UnwrapResource(res)
+			if s.Keep != nil && !s.Keep(unwrapped) {
+				continue
+			}
+			full := renderer.RenderRow(unwrapped, allCols)
+			row := make([]string, 0, len(table.Headers))
+			for _, i := range cols {
+				row = append(row, full[i])
+			}
+			if multiRegion {
+				row = append(row, region)
+			}
+			table.Rows = append(table.Rows, row)
+		}
+	}
+
+	if len(errs) == len(regions) {
+		return Table{}, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return table, nil
+}
+
+func listResources(ctx context.Context, reg *registry.Registry, s Section) ([]dao.Resource, error) {
+	for k, v := range s.Filters {
+		ctx = dao.WithFilter(ctx, k, v)
+	}
+	if s.Parent == nil {
+		return listDAO(ctx, reg, s.Service, s.Resource)
+	}
+
+	parents, err := listDAO(ctx, reg, s.Parent.Service, s.Parent.Resource)
+	if err != nil {
+		return nil, err
+	}
+	var all []dao.Resource
+	for _, p := range parents {
+		children, err := listDAO(dao.WithFilter(ctx, s.Parent.Filter, s.Parent.id(dao.UnwrapResource(p))), reg, s.Service, s.Resource)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, children...)
+	}
+	return all, nil
+}
+
+func listDAO(ctx context.Context, reg *registry.Registry, service, resource string) ([]dao.Resource, error) {
+	d, err := reg.GetDAO(ctx, service, resource)
+	if err != nil {
+		return nil, err
+	}
+	return d.List(ctx)
+}
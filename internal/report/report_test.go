@@ -0,0 +1,285 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+type mockResource struct {
+	dao.BaseResource
+	state string
+}
+
+type mockDAO struct {
+	dao.BaseDAO
+	resources func(ctx context.Context) []dao.Resource
+	listErr   error
+}
+
+func (d *mockDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if d.listErr != nil {
+		return nil, d.listErr
+	}
+	return d.resources(ctx), nil
+}
+
+func (d *mockDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (d *mockDAO) Delete(ctx context.Context, id string) error {
+	return errors.New("not implemented")
+}
+
+func newTestRegistry() *registry.Registry {
+	reg := registry.New()
+	reg.RegisterCustom("test", "items", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockDAO{
+				BaseDAO: dao.NewBaseDAO("test", "items"),
+				resources: func(ctx context.Context) []dao.Resource {
+					parent := dao.GetFilterFromContext(ctx, "ParentId")
+					return []dao.Resource{
+						&mockResource{BaseResource: dao.BaseResource{ID: parent + "a", Name: "alpha|one"}, state: "idle"},
+						&mockResource{BaseResource: dao.BaseResource{ID: parent + "b", Name: "beta"}, state: "busy"},
+					}
+				},
+			}, nil
+		},
+		RendererFactory: func() render.Renderer {
+			return &render.BaseRenderer{
+				Service:  "test",
+				Resource: "items",
+				Cols: []render.Column{
+					{Name: "ID", Getter: func(r dao.Resource) string { return r.GetID() }},
+					{Name: "NAME", Getter: func(r dao.Resource) string { return r.GetName() }},
+					{Name: "STATE", Getter: func(r dao.Resource) string { return r.(*mockResource).state }},
+				},
+			}
+		},
+	})
+	reg.RegisterCustom("test", "parents", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockDAO{
+				BaseDAO: dao.NewBaseDAO("test", "parents"),
+				resources: func(ctx context.Context) []dao.Resource {
+					return []dao.Resource{
+						&mockResource{BaseResource: dao.BaseResource{ID: "p1-"}},
+						&mockResource{BaseResource: dao.BaseResource{ID: "p2-"}},
+					}
+				},
+			}, nil
+		},
+	})
+	reg.RegisterCustom("test", "broken", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockDAO{BaseDAO: dao.NewBaseDAO("test", "broken"), listErr: errors.New("access denied")}, nil
+		},
+		RendererFactory: func() render.Renderer {
+			return &render.BaseRenderer{Service: "test", Resource: "broken"}
+		},
+	})
+	return reg
+}
+
+func setRegions(t *testing.T, regions ...string) {
+	t.Helper()
+	cfg := config.Global()
+	prev := cfg.Regions()
+	cfg.SetRegions(regions)
+	t.Cleanup(func() { cfg.SetRegions(prev) })
+}
+
+func TestGenerate(t *testing.T) {
+	setRegions(t, "us-east-1")
+	reg := newTestRegistry()
+
+	tmpl := &Template{
+		Name:  "test",
+		Title: "Test",
+		Sections: []Section{
+			{Title: "Idle", Service: "test", Resource: "items", Columns: []string{"ID", "STATE"},
+				Keep: func(r dao.Resource) bool { return r.(*mockResource).state == "idle" }},
+			{Title: "Children", Service: "test", Resource: "items", Columns: []string{"ID"},
+				Parent: &Parent{Service: "test", Resource: "parents", Filter: "ParentId"}, Limit: 3},
+			{Title: "Broken", Service: "test", Resource: "broken"},
+		},
+	}
+
+	rep := Generate(context.Background(), reg, tmpl)
+	if rep.Failed() {
+		t.Fatal("Failed() = true, want false when only one section failed")
+	}
+	if len(rep.Sections) != 3 {
+		t.Fatalf("got %d sections, want 3", len(rep.Sections))
+	}
+
+	idle := rep.Sections[0]
+	if idle.Err != nil {
+		t.Fatalf("idle section error: %v", idle.Err)
+	}
+	if got := strings.Join(idle.Table.Headers, ","); got != "ID,STATE" {
+		t.Errorf("headers = %q, want ID,STATE", got)
+	}
+	if len(idle.Table.Rows) != 1 || idle.Table.Rows[0][0] != "a" {
+		t.Errorf("rows = %v, want only the idle item", idle.Table.Rows)
+	}
+
+	children := rep.Sections[1]
+	if children.Table.Total != 4 || len(children.Table.Rows) != 3 {
+		t.Errorf("total=%d rows=%d, want 4 total limited to 3", children.Table.Total, len(children.Table.Rows))
+	}
+	if children.Table.Rows[0][0] != "p1-a" || children.Table.Rows[2][0] != "p2-a" {
+		t.Errorf("rows = %v, want children of each parent", children.Table.Rows)
+	}
+	if note := children.Table.Note(); note != "1 more not shown" {
+		t.Errorf("Note() = %q", note)
+	}
+
+	if rep.Sections[2].Err == nil {
+		t.Error("broken section should carry its error")
+	}
+}
+
+func TestGenerate_MultiRegionAddsRegionColumn(t *testing.T) {
+	setRegions(t, "us-east-1", "eu-west-1")
+	reg := newTestRegistry()
+
+	tmpl := &Template{Name: "test", Sections: []Section{
+		{Title: "All", Service: "test", Resource: "items", Columns: []string{"ID"}},
+		{Title: "Global", Service: "test", Resource: "items", Columns: []string{"ID"}, Global: true},
+	}}
+	rep := Generate(context.Background(), reg, tmpl)
+
+	all := rep.Sections[0].Table
+	if got := strings.Join(all.Headers, ","); got != "ID,REGION" {
+		t.Errorf("headers = %q, want ID,REGION", got)
+	}
+	if len(all.Rows) != 4 || all.Rows[0][1] != "us-east-1" || all.Rows[2][1] != "eu-west-1" {
+		t.Errorf("rows = %v, want two rows per region", all.Rows)
+	}
+
+	global := rep.Sections[1].Table
+	if len(global.Headers) != 1 || len(global.Rows) != 2 {
+		t.Errorf("global section = %v/%v, want a single listing without REGION", global.Headers, global.Rows)
+	}
+}
+
+func TestGenerate_AllSectionsFailed(t *testing.T) {
+	setRegions(t, "us-east-1")
+	rep := Generate(context.Background(), newTestRegistry(), &Template{Sections: []Section{
+		{Title: "Broken", Service: "test", Resource: "broken"},
+		{Title: "Fetch", Fetch: func(ctx context.Context) (Table, error) { return Table{}, errors.New("boom") }},
+	}})
+	if !rep.Failed() {
+		t.Error("Failed() = false, want true when every section failed")
+	}
+}
+
+func testReport() *Report {
+	return &Report{
+		Title:   "Test <Report>",
+		Regions: []string{"us-east-1"},
+		Sections: []SectionResult{
+			{Title: "Items", Table: Table{Headers: []string{"ID", "NAME"}, Rows: [][]string{{"a", "x|y"}}, Total: 3}},
+			{Title: "Empty"},
+			{Title: "Broken", Err: errors.New("access denied")},
+		},
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Markdown(&buf, testReport()); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"# Test <Report>",
+		"- Regions: us-east-1",
+		"| ID | NAME |\n| --- | --- |\n| a | x\\|y |",
+		"_2 more not shown_",
+		"## Empty\n\nNone.",
+		"> Error: access denied",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := HTML(&buf, testReport()); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"<h1>Test &lt;Report&gt;</h1>",
+		"<tr><th>ID</th><th>NAME</th></tr>",
+		"<tr><td>a</td><td>x|y</td></tr>",
+		`<p class="note">2 more not shown</p>`,
+		"<p>None.</p>",
+		`<p class="error">Error: access denied</p>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("html missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatForPath(t *testing.T) {
+	tests := map[string]Format{
+		"report.md":   FormatMarkdown,
+		"report.HTML": FormatHTML,
+		"report.htm":  FormatHTML,
+		"":            FormatMarkdown,
+	}
+	for path, want := range tests {
+		if got := FormatForPath(path); got != want {
+			t.Errorf("FormatForPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestWeeklyCostTable(t *testing.T) {
+	table := weeklyCostTable(
+		map[string]float64{"Amazon EC2": 70, "AWS Lambda": 5, "Tax": 0},
+		map[string]float64{"Amazon EC2": 50, "Amazon S3": 10},
+		"USD",
+	)
+	want := [][]string{
+		{"Total", "75.00 USD", "60.00 USD", "+25.0%"},
+		{"Amazon EC2", "70.00 USD", "50.00 USD", "+40.0%"},
+		{"AWS Lambda", "5.00 USD", "0.00 USD", "new"},
+		{"Amazon S3", "0.00 USD", "10.00 USD", "-100.0%"},
+	}
+	if len(table.Rows) != len(want) {
+		t.Fatalf("rows = %v, want %v", table.Rows, want)
+	}
+	for i := range want {
+		if strings.Join(table.Rows[i], ",") != strings.Join(want[i], ",") {
+			t.Errorf("row %d = %v, want %v", i, table.Rows[i], want[i])
+		}
+	}
+}
+
+func TestTemplatesResolve(t *testing.T) {
+	for _, tmpl := range Templates() {
+		got, ok := Lookup(tmpl.Name)
+		if !ok || got != tmpl {
+			t.Errorf("Lookup(%q) failed", tmpl.Name)
+		}
+	}
+	if _, ok := Lookup("nope"); ok {
+		t.Error("Lookup of unknown template succeeded")
+	}
+}
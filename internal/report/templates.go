@@ -0,0 +1,198 @@
+package report
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	cetypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elasticips "github.com/clawscli/claws/custom/ec2/elastic-ips"
+	"github.com/clawscli/claws/custom/ec2/instances"
+	"github.com/clawscli/claws/custom/ec2/volumes"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+var templates = []*Template{
+	{
+		Name:        "cost-weekly",
+		Title:       "Weekly Cost Summary",
+		Description: "Cost by service for the last 7 days vs the week before, month to date and anomalies",
+		Sections: []Section{
+			{Title: "Last 7 days by service", Fetch: fetchWeeklyCosts, Limit: 25},
+			{Title: "Month to date by service", Service: "ce", Resource: "costs", Columns: []string{"SERVICE", "COST", "UNIT"}, Global: true, Limit: 25},
+			{Title: "Cost anomalies", Service: "ce", Resource: "anomalies", Global: true},
+		},
+	},
+	{
+		Name:        "security-findings",
+		Title:       "Security Findings",
+		Description: "Active findings from Security Hub, GuardDuty, Inspector and IAM Access Analyzer",
+		Sections: []Section{
+			{Title: "Security Hub", Service: "securityhub", Resource: "findings"},
+			{
+				Title: "GuardDuty", Service: "guardduty", Resource: "findings",
+				Parent: &Parent{Service: "guardduty", Resource: "detectors", Filter: "DetectorId"},
+			},
+			{Title: "Inspector", Service: "inspector2", Resource: "findings"},
+			{
+				Title: "IAM Access Analyzer", Service: "accessanalyzer", Resource: "findings",
+				Parent: &Parent{
+					Service: "accessanalyzer", Resource: "analyzers", Filter: "AnalyzerArn",
+					ID: func(r dao.Resource) string { return r.GetARN() },
+				},
+			},
+		},
+	},
+	{
+		Name:        "idle-resources",
+		Title:       "Idle Resources",
+		Description: "Unattached EBS volumes, unassociated Elastic IPs and stopped EC2 instances",
+		Sections: []Section{
+			{Title: "Unattached EBS volumes", Service: "ec2", Resource: "volumes", Keep: isUnattachedVolume, Columns: []string{"NAME", "ID", "SIZE", "TYPE", "AZ"}},
+			{Title: "Unassociated Elastic IPs", Service: "ec2", Resource: "elastic-ips", Keep: isUnassociatedAddress, Columns: []string{"NAME", "ALLOCATION ID", "PUBLIC IP", "DOMAIN"}},
+			{Title: "Stopped EC2 instances", Service: "ec2", Resource: "instances", Keep: isStoppedInstance, Columns: []string{"NAME", "ID", "TYPE", "AZ", "AGE"}},
+		},
+	},
+}
+
+// Templates returns the predefined report templates
+func Templates() []*Template {
+	return templates
+}
+
+// Lookup returns the template with the given name
+func Lookup(name string) (*Template, bool) {
+	i := slices.IndexFunc(templates, func(t *Template) bool { return t.Name == name })
+	if i < 0 {
+		return nil, false
+	}
+	return templates[i], true
+}
+
+func isUnattachedVolume(r dao.Resource) bool {
+	v, ok := r.(*volumes.VolumeResource)
+	return ok && v.Item.State == ec2types.VolumeStateAvailable
+}
+
+func isUnassociatedAddress(r dao.Resource) bool {
+	a, ok := r.(*elasticips.ElasticIPResource)
+	return ok && a.Item.AssociationId == nil
+}
+
+func isStoppedInstance(r dao.Resource) bool {
+	i, ok := r.(*instances.InstanceResource)
+	return ok && i.Item.State != nil && i.Item.State.Name == ec2types.InstanceStateNameStopped
+}
+
+// fetchWeeklyCosts compares unblended cost per service over the last 7 full
+// days with the 7 days before
+func fetchWeeklyCosts(ctx context.Context) (Table, error) {
+	cfg, err := appaws.NewConfigWithRegion(ctx, appaws.CostExplorerRegion)
+	if err != nil {
+		return Table{}, apperrors.Wrap(err, "new cost explorer client")
+	}
+	client := costexplorer.NewFromConfig(cfg)
+
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	current, unit, err := costsByService(ctx, client, end.AddDate(0, 0, -7), end)
+	if err != nil {
+		return Table{}, err
+	}
+	previous, _, err := costsByService(ctx, client, end.AddDate(0, 0, -14), end.AddDate(0, 0, -7))
+	if err != nil {
+		return Table{}, err
+	}
+	return weeklyCostTable(current, previous, unit), nil
+}
+
+func costsByService(ctx context.Context, client *costexplorer.Client, start, end time.Time) (map[string]float64, string, error) {
+	out, err := client.GetCostAndUsage(ctx, &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &cetypes.DateInterval{
+			Start: appaws.StringPtr(start.Format("2006-01-02")),
+			End:   appaws.StringPtr(end.Format("2006-01-02")),
+		},
+		Granularity: cetypes.GranularityDaily,
+		Metrics:     []string{"UnblendedCost"},
+		GroupBy: []cetypes.GroupDefinition{
+			{Type: cetypes.GroupDefinitionTypeDimension, Key: appaws.StringPtr("SERVICE")},
+		},
+	})
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "get cost and usage")
+	}
+
+	costs := make(map[string]float64)
+	unit := "USD"
+	for _, result := range out.ResultsByTime {
+		for _, group := range result.Groups {
+			m, ok := group.Metrics["UnblendedCost"]
+			if !ok || len(group.Keys) == 0 {
+				continue
+			}
+			amount, err := strconv.ParseFloat(appaws.Str(m.Amount), 64)
+			if err != nil {
+				continue
+			}
+			costs[group.Keys[0]] += amount
+			if m.Unit != nil {
+				unit = *m.Unit
+			}
+		}
+	}
+	return costs, unit, nil
+}
+
+// weeklyCostTable sorts services by current cost, highest first
+func weeklyCostTable(current, previous map[string]float64, unit string) Table {
+	services := make([]string, 0, len(current))
+	for svc := range current {
+		services = append(services, svc)
+	}
+	for svc := range previous {
+		if _, ok := current[svc]; !ok {
+			services = append(services, svc)
+		}
+	}
+	slices.SortFunc(services, func(a, b string) int {
+		if c := cmp.Compare(current[b], current[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+
+	table := Table{Headers: []string{"SERVICE", "LAST 7 DAYS", "PREVIOUS 7 DAYS", "CHANGE"}}
+	var totalCur, totalPrev float64
+	for _, svc := range services {
+		cur, prev := current[svc], previous[svc]
+		if cur < 0.005 && prev < 0.005 {
+			continue
+		}
+		totalCur += cur
+		totalPrev += prev
+		table.Rows = append(table.Rows, []string{svc, formatCost(cur, unit), formatCost(prev, unit), formatChange(cur, prev)})
+	}
+	table.Rows = append([][]string{{"Total", formatCost(totalCur, unit), formatCost(totalPrev, unit), formatChange(totalCur, totalPrev)}}, table.Rows...)
+	return table
+}
+
+func formatCost(amount float64, unit string) string {
+	return fmt.Sprintf("%.2f %s", amount, unit)
+}
+
+func formatChange(cur, prev float64) string {
+	if prev < 0.005 {
+		if cur < 0.005 {
+			return "-"
+		}
+		return "new"
+	}
+	return fmt.Sprintf("%+.1f%%", (cur-prev)/prev*100)
+}
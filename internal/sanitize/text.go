@@ -15,6 +15,8 @@ var basicCredentialPattern = regexp.MustCompile(`\b[Bb]asic\s+[A-Za-z0-9+/=]*[A-
 var jwtPattern = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\b`)
 var awsAccessKeyPattern = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
 var pemBlockPattern = regexp.MustCompile(`(?s)-----BEGIN [A-Z0-9 ]+-----.*?-----END [A-Z0-9 ]+-----`)
+var accountIDPattern = regexp.MustCompile(`\b\d{12}\b`)
+var ipv4Pattern = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
 var ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07]*(\x07|\x1b\\)|\x1b[@-Z\\-_]`)
 
 // TerminalText removes ANSI escape sequences and control characters that can alter terminal state.
@@ -47,3 +49,21 @@ func SensitiveText(s string) string {
 func LogText(s string) string {
 	return SensitiveText(TerminalText(s))
 }
+
+// DemoText prepares terminal output for sharing: escapes and secrets are
+// removed and account IDs and IPv4 addresses are masked digit by digit, so
+// column alignment of captured tables is preserved.
+func DemoText(s string) string {
+	s = SensitiveText(TerminalText(s))
+	s = accountIDPattern.ReplaceAllStringFunc(s, maskDigits)
+	return ipv4Pattern.ReplaceAllStringFunc(s, maskDigits)
+}
+
+func maskDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return 'x'
+		}
+		return r
+	}, s)
+}
@@ -109,3 +109,19 @@ func TestSensitiveTextPreservesBasicDocumentationPhrase(t *testing.T) {
 		t.Fatalf("SensitiveText(%q) = %q, want unchanged documentation phrase", input, got)
 	}
 }
+
+func TestDemoTextMasksIdentifiers(t *testing.T) {
+	input := "\x1b[1marn:aws:iam::123456789012:role/app\x1b[0m  10.0.12.5  token=abc  i-0abc1234"
+	got := DemoText(input)
+
+	for _, leaked := range []string{"123456789012", "10.0.12.5", "abc  ", "\x1b"} {
+		if strings.Contains(got, leaked) {
+			t.Errorf("DemoText(%q) leaked %q in %q", input, leaked, got)
+		}
+	}
+	for _, want := range []string{"arn:aws:iam::xxxxxxxxxxxx:role/app", "xx.x.xx.x", "i-0abc1234"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("DemoText(%q) = %q, want it to contain %q", input, got, want)
+		}
+	}
+}
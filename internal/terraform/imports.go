@@ -0,0 +1,216 @@
+// Package terraform generates Terraform import blocks for listed resources,
+// to help bring existing infrastructure under Terraform.
+package terraform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// mapping maps a claws resource type to a Terraform AWS provider resource
+// type and the identifier its import expects
+type mapping struct {
+	Type string
+	ID   func(dao.Resource) string // nil uses GetID
+}
+
+func byName(r dao.Resource) string { return r.GetName() }
+func byARN(r dao.Resource) string  { return r.GetARN() }
+
+// mappings is best-effort: resource types whose import ID cannot be derived
+// from the listed resource are left out
+var mappings = map[string]mapping{
+	"acm/certificates":             {Type: "aws_acm_certificate", ID: byARN},
+	"apigateway/http-apis":         {Type: "aws_apigatewayv2_api"},
+	"apigateway/rest-apis":         {Type: "aws_api_gateway_rest_api"},
+	"athena/workgroups":            {Type: "aws_athena_workgroup"},
+	"autoscaling/groups":           {Type: "aws_autoscaling_group"},
+	"backup/plans":                 {Type: "aws_backup_plan"},
+	"backup/vaults":                {Type: "aws_backup_vault"},
+	"cloudformation/stacks":        {Type: "aws_cloudformation_stack", ID: byName},
+	"cloudfront/distributions":     {Type: "aws_cloudfront_distribution"},
+	"cloudtrail/trails":            {Type: "aws_cloudtrail", ID: byARN},
+	"cloudwatch/alarms":            {Type: "aws_cloudwatch_metric_alarm"},
+	"cloudwatch/log-groups":        {Type: "aws_cloudwatch_log_group", ID: logGroupName},
+	"codebuild/projects":           {Type: "aws_codebuild_project"},
+	"codepipeline/pipelines":       {Type: "aws_codepipeline"},
+	"cognito-idp/user-pools":       {Type: "aws_cognito_user_pool"},
+	"dynamodb/tables":              {Type: "aws_dynamodb_table"},
+	"ec2/elastic-ips":              {Type: "aws_eip"},
+	"ec2/images":                   {Type: "aws_ami"},
+	"ec2/instances":                {Type: "aws_instance"},
+	"ec2/key-pairs":                {Type: "aws_key_pair", ID: byName},
+	"ec2/launch-templates":         {Type: "aws_launch_template"},
+	"ec2/prefix-lists":             {Type: "aws_ec2_managed_prefix_list"},
+	"ec2/security-groups":          {Type: "aws_security_group"},
+	"ec2/snapshots":                {Type: "aws_ebs_snapshot"},
+	"ec2/volumes":                  {Type: "aws_ebs_volume"},
+	"ecr/repositories":             {Type: "aws_ecr_repository"},
+	"ecs/clusters":                 {Type: "aws_ecs_cluster"},
+	"ecs/services":                 {Type: "aws_ecs_service", ID: ecsServiceID},
+	"eks/clusters":                 {Type: "aws_eks_cluster"},
+	"elasticache/clusters":         {Type: "aws_elasticache_cluster"},
+	"elbv2/load-balancers":         {Type: "aws_lb", ID: byARN},
+	"elbv2/target-groups":          {Type: "aws_lb_target_group", ID: byARN},
+	"events/buses":                 {Type: "aws_cloudwatch_event_bus"},
+	"events/rules":                 {Type: "aws_cloudwatch_event_rule"},
+	"glue/crawlers":                {Type: "aws_glue_crawler"},
+	"glue/jobs":                    {Type: "aws_glue_job"},
+	"iam/groups":                   {Type: "aws_iam_group"},
+	"iam/instance-profiles":        {Type: "aws_iam_instance_profile", ID: byName},
+	"iam/policies":                 {Type: "aws_iam_policy", ID: byARN},
+	"iam/roles":                    {Type: "aws_iam_role"},
+	"iam/users":                    {Type: "aws_iam_user"},
+	"kinesis/streams":              {Type: "aws_kinesis_stream"},
+	"kms/keys":                     {Type: "aws_kms_key"},
+	"lambda/functions":             {Type: "aws_lambda_function"},
+	"opensearch/domains":           {Type: "aws_opensearch_domain"},
+	"rds/clusters":                 {Type: "aws_rds_cluster"},
+	"rds/instances":                {Type: "aws_db_instance"},
+	"rds/parameter-groups":         {Type: "aws_db_parameter_group"},
+	"redshift/clusters":            {Type: "aws_redshift_cluster"},
+	"route53/health-checks":        {Type: "aws_route53_health_check"},
+	"route53/hosted-zones":         {Type: "aws_route53_zone"},
+	"s3/buckets":                   {Type: "aws_s3_bucket"},
+	"secretsmanager/secrets":       {Type: "aws_secretsmanager_secret", ID: byARN},
+	"sns/subscriptions":            {Type: "aws_sns_topic_subscription", ID: byARN},
+	"sns/topics":                   {Type: "aws_sns_topic", ID: byARN},
+	"sqs/queues":                   {Type: "aws_sqs_queue", ID: queueURL},
+	"ssm/parameters":               {Type: "aws_ssm_parameter"},
+	"stepfunctions/state-machines": {Type: "aws_sfn_state_machine", ID: byARN},
+	"vpc/endpoints":                {Type: "aws_vpc_endpoint"},
+	"vpc/internet-gateways":        {Type: "aws_internet_gateway"},
+	"vpc/nat-gateways":             {Type: "aws_nat_gateway"},
+	"vpc/route-tables":             {Type: "aws_route_table"},
+	"vpc/subnets":                  {Type: "aws_subnet"},
+	"vpc/transit-gateways":         {Type: "aws_ec2_transit_gateway"},
+	"vpc/vpcs":                     {Type: "aws_vpc"},
+}
+
+// ResourceType returns the Terraform resource type for a claws resource
+// type, or "" if it has no mapping
+func ResourceType(service, resourceType string) string {
+	return mappings[service+"/"+resourceType].Type
+}
+
+// ImportBlocks renders an import block for each resource. Resources without
+// an import ID are listed as comments. ok is false if the resource type has
+// no Terraform mapping.
+func ImportBlocks(service, resourceType string, resources []dao.Resource) (string, bool) {
+	m, ok := mappings[service+"/"+resourceType]
+	if !ok {
+		return "", false
+	}
+
+	// Multi-region and multi-profile lists need a provider alias per block,
+	// which is left to the user; the region and profile are noted instead
+	scoped := multiScope(resources)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s/%s: %d resource(s)\n", service, resourceType, len(resources))
+	names := make(map[string]int)
+	for _, res := range resources {
+		unwrapped := dao.UnwrapResource(res)
+		id := unwrapped.GetID()
+		if m.ID != nil {
+			id = m.ID(unwrapped)
+		}
+		label := unwrapped.GetName()
+		if label == "" {
+			label = unwrapped.GetID()
+		}
+
+		b.WriteString("\n")
+		if id == "" {
+			fmt.Fprintf(&b, "# %s: no import ID\n", label)
+			continue
+		}
+		if scoped {
+			fmt.Fprintf(&b, "# %s\n", scope(res))
+		}
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %q\n}\n", m.Type, uniqueName(names, Identifier(label)), id)
+	}
+	return b.String(), true
+}
+
+// Identifier converts s to a valid Terraform resource name
+func Identifier(s string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-':
+			b.WriteRune(c)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	name := strings.Trim(b.String(), "_-")
+	if name == "" {
+		return "resource"
+	}
+	if c := name[0]; c >= '0' && c <= '9' {
+		name = "r_" + name
+	}
+	return name
+}
+
+func uniqueName(names map[string]int, name string) string {
+	names[name]++
+	if n := names[name]; n > 1 {
+		return fmt.Sprintf("%s_%d", name, n)
+	}
+	return name
+}
+
+func scope(res dao.Resource) string {
+	parts := []string{dao.GetResourceProfile(res), dao.GetResourceRegion(res)}
+	if parts[0] == "" {
+		parts = parts[1:]
+	}
+	return strings.Join(parts, " ")
+}
+
+func multiScope(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if scope(res) != scope(resources[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+// logGroupName returns the log group name; the listed ID is the ARN for
+// log groups shared from other accounts
+func logGroupName(r dao.Resource) string {
+	if lg, ok := r.(interface{ LogGroupName() string }); ok {
+		return lg.LogGroupName()
+	}
+	return r.GetID()
+}
+
+// ecsServiceID returns the cluster-name/service-name import ID
+func ecsServiceID(r dao.Resource) string {
+	cluster := dao.GetResourceClusterArn(r)
+	if cluster == "" {
+		return ""
+	}
+	return cluster[strings.LastIndex(cluster, "/")+1:] + "/" + r.GetID()
+}
+
+// queueURL builds the queue URL, which is the aws_sqs_queue import ID,
+// from the queue ARN
+func queueURL(r dao.Resource) string {
+	parsed, err := arn.Parse(r.GetARN())
+	if err != nil {
+		return ""
+	}
+	domain := "amazonaws.com"
+	if parsed.Partition == "aws-cn" {
+		domain = "amazonaws.com.cn"
+	}
+	return fmt.Sprintf("https://sqs.%s.%s/%s/%s", parsed.Region, domain, parsed.AccountID, parsed.Resource)
+}
@@ -0,0 +1,118 @@
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+type logGroup struct {
+	dao.BaseResource
+	name string
+}
+
+func (r *logGroup) LogGroupName() string { return r.name }
+
+type ecsService struct {
+	dao.BaseResource
+	cluster string
+}
+
+func (r *ecsService) ClusterArn() string { return r.cluster }
+
+func TestImportBlocks(t *testing.T) {
+	resources := []dao.Resource{
+		&dao.BaseResource{ID: "i-0123", Name: "web server"},
+		&dao.BaseResource{ID: "i-0456", Name: "Web-Server"},
+		&dao.BaseResource{ID: "i-0789"},
+	}
+
+	got, ok := ImportBlocks("ec2", "instances", resources)
+	if !ok {
+		t.Fatal("ec2/instances should be supported")
+	}
+	for _, want := range []string{
+		"# ec2/instances: 3 resource(s)",
+		"import {\n  to = aws_instance.web_server\n  id = \"i-0123\"\n}",
+		"to = aws_instance.web-server\n",
+		"to = aws_instance.i-0789\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in\n%s", want, got)
+		}
+	}
+
+	if _, ok := ImportBlocks("ce", "costs", resources); ok {
+		t.Error("ce/costs should not be supported")
+	}
+}
+
+func TestImportBlocksDuplicateNames(t *testing.T) {
+	got, _ := ImportBlocks("iam", "roles", []dao.Resource{
+		&dao.BaseResource{ID: "app", Name: "app"},
+		&dao.BaseResource{ID: "App", Name: "App"},
+	})
+	if !strings.Contains(got, "aws_iam_role.app\n") || !strings.Contains(got, "aws_iam_role.app_2\n") {
+		t.Errorf("expected unique names, got\n%s", got)
+	}
+}
+
+func TestImportBlocksIDs(t *testing.T) {
+	tests := []struct {
+		service, resource string
+		res               dao.Resource
+		want              string
+	}{
+		{"sns", "topics", &dao.BaseResource{ID: "arn:aws:sns:us-east-1:123456789012:alerts", ARN: "arn:aws:sns:us-east-1:123456789012:alerts"}, "arn:aws:sns:us-east-1:123456789012:alerts"},
+		{"sqs", "queues", &dao.BaseResource{ID: "jobs", ARN: "arn:aws:sqs:eu-west-1:123456789012:jobs"}, "https://sqs.eu-west-1.amazonaws.com/123456789012/jobs"},
+		{"cloudwatch", "log-groups", &logGroup{BaseResource: dao.BaseResource{ID: "arn:aws:logs:us-east-1:210987654321:log-group:/app", Name: "app"}, name: "/app"}, "/app"},
+		{"ecs", "services", &ecsService{BaseResource: dao.BaseResource{ID: "api", Name: "api"}, cluster: "arn:aws:ecs:us-east-1:123456789012:cluster/prod"}, "prod/api"},
+		{"cloudformation", "stacks", &dao.BaseResource{ID: "arn:aws:cloudformation:us-east-1:123456789012:stack/net/abc", Name: "net"}, "net"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.service+"/"+tt.resource, func(t *testing.T) {
+			got, ok := ImportBlocks(tt.service, tt.resource, []dao.Resource{tt.res})
+			if !ok {
+				t.Fatalf("%s/%s should be supported", tt.service, tt.resource)
+			}
+			if want := "id = \"" + tt.want + "\""; !strings.Contains(got, want) {
+				t.Errorf("expected %q in\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestImportBlocksMissingID(t *testing.T) {
+	got, _ := ImportBlocks("sqs", "queues", []dao.Resource{&dao.BaseResource{ID: "jobs", Name: "jobs"}})
+	if !strings.Contains(got, "# jobs: no import ID") || strings.Contains(got, "import {") {
+		t.Errorf("expected resource without ARN to be commented out, got\n%s", got)
+	}
+}
+
+func TestImportBlocksMultiRegion(t *testing.T) {
+	got, _ := ImportBlocks("s3", "buckets", []dao.Resource{
+		dao.WrapWithRegion(&dao.BaseResource{ID: "logs", Name: "logs"}, "us-east-1"),
+		dao.WrapWithRegion(&dao.BaseResource{ID: "data", Name: "data"}, "eu-west-1"),
+	})
+	if !strings.Contains(got, "# us-east-1\nimport {") || !strings.Contains(got, "# eu-west-1\nimport {") {
+		t.Errorf("expected region comments, got\n%s", got)
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"web-server":      "web-server",
+		"My App (prod)":   "my_app__prod",
+		"123abc":          "r_123abc",
+		"/aws/lambda/fn":  "aws_lambda_fn",
+		"":                "resource",
+		"日本":              "resource",
+		"arn:aws:sns:x:y": "arn_aws_sns_x_y",
+	}
+	for in, want := range tests {
+		if got := Identifier(in); got != want {
+			t.Errorf("Identifier(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package view
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	tea "charm.land/bubbletea/v2"
@@ -68,6 +69,7 @@ type ActionMenu struct {
 	lastExecAction *action.Action
 	styles         actionMenuStyles
 	dangerous      dangerousState
+	prompt         promptState
 }
 
 // NewActionMenu creates a new ActionMenu
@@ -97,6 +99,19 @@ func NewActionMenu(ctx context.Context, resource dao.Resource, service, resType
 	}
 }
 
+// NewStandaloneActionMenu creates an ActionMenu with the Standalone actions of
+// service/resType, for lists without a selected resource. Returns nil if there
+// are none.
+func NewStandaloneActionMenu(ctx context.Context, service, resType string) *ActionMenu {
+	path := service + "/" + resType
+	menu := NewActionMenu(ctx, &dao.BaseResource{ID: path, Name: path}, service, resType)
+	menu.actions = slices.DeleteFunc(menu.actions, func(act action.Action) bool { return !act.Standalone })
+	if len(menu.actions) == 0 {
+		return nil
+	}
+	return menu
+}
+
 // Init implements tea.Model
 func (m *ActionMenu) Init() tea.Cmd {
 	return nil
@@ -129,8 +144,14 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.styles = newActionMenuStyles()
 		return m, nil
 
+	case promptOptionsMsg:
+		return m.handlePromptOptions(msg)
+
+	case promptsLoadedMsg:
+		return m.handlePromptsLoaded(msg)
+
 	case tea.MouseMotionMsg:
-		if !m.confirming && !m.dangerous.active {
+		if !m.confirming && !m.dangerous.active && !m.prompt.active && !m.prompt.loadingPrompts {
 			if idx := m.getActionAtPosition(msg.Y); idx >= 0 && idx != m.cursor {
 				m.cursor = idx
 			}
@@ -138,7 +159,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.MouseClickMsg:
-		if msg.Button == tea.MouseLeft && !m.confirming && !m.dangerous.active {
+		if msg.Button == tea.MouseLeft && !m.confirming && !m.dangerous.active && !m.prompt.active && !m.prompt.loadingPrompts {
 			if idx := m.getActionAtPosition(msg.Y); idx >= 0 {
 				m.cursor = idx
 				return m.handleActionConfirm(m.actions[idx], idx)
@@ -147,6 +168,16 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyPressMsg:
+		if m.prompt.active {
+			return m.handlePromptKey(msg)
+		}
+		if m.prompt.loadingPrompts {
+			if msg.String() == "esc" {
+				m.prompt = promptState{}
+			}
+			return m, nil
+		}
+
 		if m.dangerous.active {
 			switch msg.String() {
 			case "enter":
@@ -155,7 +186,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.dangerous.input = ""
 					m.dangerous.token = ""
 					if m.confirmIdx < len(m.actions) {
-						return m.executeAction(m.actions[m.confirmIdx])
+						return m.executeAction(m.withInputs(m.actions[m.confirmIdx]))
 					}
 				}
 				return m, nil
@@ -184,7 +215,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.confirming = false
 				if m.confirmIdx < len(m.actions) {
 					act := m.actions[m.confirmIdx]
-					return m.executeAction(act)
+					return m.executeAction(m.withInputs(act))
 				}
 				return m, nil
 			case "n", "N", "esc":
@@ -224,6 +255,23 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *ActionMenu) handleActionConfirm(act action.Action, idx int) (tea.Model, tea.Cmd) {
+	m.prompt = promptState{}
+	if act.PromptsFunc != nil {
+		return m, m.loadPrompts(act, idx)
+	}
+	return m.beginAction(act, idx)
+}
+
+// beginAction starts collecting prompt input for act, or asks for confirmation
+func (m *ActionMenu) beginAction(act action.Action, idx int) (tea.Model, tea.Cmd) {
+	if len(act.Prompts) > 0 {
+		return m.startPrompt(idx)
+	}
+	return m.confirmAction(act, idx)
+}
+
+// confirmAction asks for confirmation according to act.Confirm, or executes directly.
+func (m *ActionMenu) confirmAction(act action.Action, idx int) (tea.Model, tea.Cmd) {
 	switch act.Confirm {
 	case action.ConfirmDangerous:
 		m.dangerous.active = true
@@ -236,7 +284,7 @@ func (m *ActionMenu) handleActionConfirm(act action.Action, idx int) (tea.Model,
 		m.confirmIdx = idx
 		return m, nil
 	default:
-		return m.executeAction(act)
+		return m.executeAction(m.withInputs(act))
 	}
 }
 
@@ -317,7 +365,17 @@ func (m *ActionMenu) ViewString() string {
 		}
 	}
 
-	if m.dangerous.active && m.confirmIdx < len(m.actions) {
+	if m.prompt.active && m.confirmIdx < len(m.actions) {
+		act := m.actions[m.confirmIdx]
+		out += "\n"
+		out += m.renderPrompt(act)
+	} else if m.prompt.loadingPrompts && m.confirmIdx < len(m.actions) {
+		act := m.actions[m.confirmIdx]
+		content := s.bold.Render(act.Name) + "\n\n"
+		content += ui.DimStyle().Render(LoadingMessage) + "\n\n"
+		content += ui.DimStyle().Render("Esc to cancel")
+		out += "\n" + s.box.Render(content)
+	} else if m.dangerous.active && m.confirmIdx < len(m.actions) {
 		act := m.actions[m.confirmIdx]
 		out += "\n"
 		out += m.renderDangerousConfirm(act)
@@ -327,6 +385,7 @@ func (m *ActionMenu) ViewString() string {
 
 		confirmContent := s.bold.Render("Confirm Action") + "\n"
 		confirmContent += fmt.Sprintf("Execute '%s' on %s?\n\n", act.Name, m.resource.GetID())
+		confirmContent += m.renderPromptInputs(act)
 		confirmContent += "Press " + s.yes.Render("[Y]") + " to confirm or " + s.no.Render("[N]") + " to cancel"
 
 		out += s.box.Render(confirmContent)
@@ -341,7 +400,7 @@ func (m *ActionMenu) ViewString() string {
 		}
 	}
 
-	if !m.confirming && !m.dangerous.active {
+	if !m.confirming && !m.dangerous.active && !m.prompt.active && !m.prompt.loadingPrompts {
 		out += "\n\n" + ui.DimStyle().Render("Press shortcut key or Enter to execute, Esc to cancel")
 	}
 
@@ -356,6 +415,7 @@ func (m *ActionMenu) renderDangerousConfirm(act action.Action) string {
 	content := dangerTitle + "\n\n"
 	content += fmt.Sprintf("You are about to %s:\n", s.no.Render(act.Name))
 	content += s.bold.Render(m.dangerous.token) + "\n\n"
+	content += m.renderPromptInputs(act)
 
 	confirmText := action.ConfirmSuffix(m.dangerous.token)
 	content += "Type the full confirmation token:\n"
@@ -392,6 +452,9 @@ func (m *ActionMenu) SetSize(_, _ int) tea.Cmd {
 }
 
 func (m *ActionMenu) StatusLine() string {
+	if m.prompt.active {
+		return "Enter value • Enter to continue • Esc to cancel"
+	}
 	if m.dangerous.active {
 		confirmText := action.ConfirmSuffix(m.dangerous.token)
 		if m.dangerous.input != "" && !strings.HasPrefix(confirmText, m.dangerous.input) {
@@ -406,5 +469,5 @@ func (m *ActionMenu) StatusLine() string {
 }
 
 func (m *ActionMenu) HasActiveInput() bool {
-	return m.dangerous.active
+	return m.dangerous.active || m.prompt.active || m.prompt.loadingPrompts
 }
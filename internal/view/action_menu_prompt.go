@@ -0,0 +1,279 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// maxPromptOptions limits how many picker options are rendered at once
+const maxPromptOptions = 10
+
+// promptState tracks input collection for actions with Prompts
+type promptState struct {
+	active  bool
+	step    int
+	input   string
+	inputs  []string
+	options []string // nil for free-text prompts
+	loading bool     // picker options are being fetched
+	cursor  int
+	err     error
+
+	loadingPrompts bool // the action's PromptsFunc is running
+}
+
+// promptOptionsMsg carries the picker options loaded for a prompt step
+type promptOptionsMsg struct {
+	idx     int
+	step    int
+	options []string
+	err     error
+}
+
+// promptsLoadedMsg carries the prompts built by an action's PromptsFunc
+type promptsLoadedMsg struct {
+	idx     int
+	prompts []action.Prompt
+	err     error
+}
+
+// loadPrompts returns a command running the PromptsFunc of act, so forms
+// that call AWS APIs do not block the UI
+func (m *ActionMenu) loadPrompts(act action.Action, idx int) tea.Cmd {
+	m.confirmIdx = idx
+	m.prompt = promptState{loadingPrompts: true}
+	ctx, resource := m.ctx, m.resource
+	return func() tea.Msg {
+		prompts, err := act.PromptsFunc(ctx, resource)
+		return promptsLoadedMsg{idx: idx, prompts: prompts, err: err}
+	}
+}
+
+// handlePromptsLoaded starts the loaded prompts if their action is still pending
+func (m *ActionMenu) handlePromptsLoaded(msg promptsLoadedMsg) (tea.Model, tea.Cmd) {
+	if !m.prompt.loadingPrompts || msg.idx != m.confirmIdx || msg.idx >= len(m.actions) {
+		return m, nil
+	}
+	m.prompt = promptState{}
+	if msg.err != nil {
+		m.result = &action.ActionResult{Success: false, Error: msg.err}
+		return m, nil
+	}
+	act := m.actions[msg.idx]
+	act.Prompts = msg.prompts
+	m.actions[msg.idx] = act
+	return m.beginAction(act, msg.idx)
+}
+
+// startPrompt begins collecting input for the action at idx
+func (m *ActionMenu) startPrompt(idx int) (tea.Model, tea.Cmd) {
+	m.confirmIdx = idx
+	m.prompt = promptState{active: true}
+	return m, m.loadPromptStep()
+}
+
+// loadPromptStep prepares the current prompt: it fills in the default value,
+// or returns a command loading the picker options
+func (m *ActionMenu) loadPromptStep() tea.Cmd {
+	p := m.currentPrompt()
+	m.prompt.input = ""
+	m.prompt.options = nil
+	m.prompt.loading = false
+	m.prompt.cursor = 0
+	m.prompt.err = nil
+
+	if p.Options != nil {
+		m.prompt.loading = true
+		ctx, resource := m.ctx, m.resource
+		idx, step := m.confirmIdx, m.prompt.step
+		return func() tea.Msg {
+			options, err := p.Options(ctx, resource)
+			return promptOptionsMsg{idx: idx, step: step, options: options, err: err}
+		}
+	}
+	if p.Default != nil {
+		m.prompt.input = p.Default(m.resource)
+	}
+	return nil
+}
+
+// handlePromptOptions shows loaded picker options if their prompt step is still current
+func (m *ActionMenu) handlePromptOptions(msg promptOptionsMsg) (tea.Model, tea.Cmd) {
+	if !m.prompt.active || !m.prompt.loading || msg.idx != m.confirmIdx || msg.step != m.prompt.step {
+		return m, nil
+	}
+	m.prompt.loading = false
+	m.prompt.err = msg.err
+	m.prompt.options = msg.options
+	if m.prompt.options == nil {
+		m.prompt.options = []string{}
+	}
+	return m, nil
+}
+
+func (m *ActionMenu) currentPrompt() action.Prompt {
+	act := m.actions[m.confirmIdx]
+	return act.Prompts[m.prompt.step]
+}
+
+// filteredOptions returns picker options containing the typed text
+func (m *ActionMenu) filteredOptions() []string {
+	if m.prompt.input == "" {
+		return m.prompt.options
+	}
+	needle := strings.ToLower(m.prompt.input)
+	var out []string
+	for _, opt := range m.prompt.options {
+		if strings.Contains(strings.ToLower(opt), needle) {
+			out = append(out, opt)
+		}
+	}
+	return out
+}
+
+func (m *ActionMenu) handlePromptKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	isPicker := m.prompt.options != nil
+
+	switch msg.String() {
+	case "esc":
+		m.prompt = promptState{}
+		return m, nil
+	}
+	if m.prompt.loading {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "enter":
+		return m.submitPromptStep()
+	case "up":
+		if isPicker && m.prompt.cursor > 0 {
+			m.prompt.cursor--
+		}
+		return m, nil
+	case "down":
+		if isPicker && m.prompt.cursor < len(m.filteredOptions())-1 {
+			m.prompt.cursor++
+		}
+		return m, nil
+	}
+
+	if msg.Code == tea.KeyBackspace || msg.String() == "backspace" {
+		if len(m.prompt.input) > 0 {
+			_, size := utf8.DecodeLastRuneInString(m.prompt.input)
+			m.prompt.input = m.prompt.input[:len(m.prompt.input)-size]
+			m.prompt.cursor = 0
+		}
+		return m, nil
+	}
+	if msg.Text != "" {
+		m.prompt.input += msg.Text
+		m.prompt.cursor = 0
+	}
+	return m, nil
+}
+
+func (m *ActionMenu) submitPromptStep() (tea.Model, tea.Cmd) {
+	p := m.currentPrompt()
+
+	value := m.prompt.input
+	if m.prompt.options != nil {
+		options := m.filteredOptions()
+		if m.prompt.cursor >= len(options) {
+			return m, nil
+		}
+		value = options[m.prompt.cursor]
+	} else if p.Validate != nil {
+		if err := p.Validate(value); err != nil {
+			m.prompt.err = err
+			return m, nil
+		}
+	}
+
+	m.prompt.inputs = append(m.prompt.inputs, value)
+	m.prompt.step++
+
+	act := m.actions[m.confirmIdx]
+	if m.prompt.step < len(act.Prompts) {
+		return m, m.loadPromptStep()
+	}
+
+	m.prompt.active = false
+	return m.confirmAction(act, m.confirmIdx)
+}
+
+// withInputs returns a copy of act carrying the collected prompt values
+func (m *ActionMenu) withInputs(act action.Action) action.Action {
+	if len(act.Prompts) > 0 {
+		act.Inputs = append([]string(nil), m.prompt.inputs...)
+	}
+	return act
+}
+
+func (m *ActionMenu) renderPrompt(act action.Action) string {
+	s := m.styles
+	p := act.Prompts[m.prompt.step]
+
+	content := s.bold.Render(act.Name)
+	if len(act.Prompts) > 1 {
+		content += ui.DimStyle().Render(fmt.Sprintf(" (%d/%d)", m.prompt.step+1, len(act.Prompts)))
+	}
+	content += "\n\n" + p.Label + ":\n"
+	content += s.input.Render(m.prompt.input+"▌") + "\n"
+
+	if m.prompt.loading {
+		content += ui.DimStyle().Render(LoadingMessage) + "\n"
+	}
+	if m.prompt.options != nil {
+		options := m.filteredOptions()
+		if len(options) == 0 {
+			content += ui.DimStyle().Render("No matches") + "\n"
+		}
+		start := 0
+		if m.prompt.cursor >= maxPromptOptions {
+			start = m.prompt.cursor - maxPromptOptions + 1
+		}
+		for i := start; i < len(options) && i < start+maxPromptOptions; i++ {
+			if i == m.prompt.cursor {
+				content += s.selected.Render(options[i]) + "\n"
+			} else {
+				content += "  " + s.item.Render(options[i]) + "\n"
+			}
+		}
+	}
+
+	if m.prompt.err != nil {
+		content += ui.DangerStyle().Render(m.prompt.err.Error()) + "\n"
+	}
+
+	hint := "Press Enter to continue, Esc to cancel"
+	if m.prompt.loading {
+		hint = "Esc to cancel"
+	} else if m.prompt.options != nil {
+		hint = "Type to filter, ↑/↓ to select, Enter to choose, Esc to cancel"
+	}
+	content += "\n" + ui.DimStyle().Render(hint)
+
+	return s.box.Render(content)
+}
+
+// renderPromptInputs lists collected prompt values (or the action's Preview) for the confirmation box
+func (m *ActionMenu) renderPromptInputs(act action.Action) string {
+	if len(act.Prompts) == 0 || len(m.prompt.inputs) != len(act.Prompts) {
+		return ""
+	}
+	if act.Preview != nil {
+		return act.Preview(m.resource, m.prompt.inputs) + "\n\n"
+	}
+	var out string
+	for i, p := range act.Prompts {
+		out += fmt.Sprintf("%s: %s\n", p.Label, m.styles.bold.Render(m.prompt.inputs[i]))
+	}
+	return out + "\n"
+}
@@ -2,11 +2,14 @@ package view
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
 )
 
 func TestActionMenuMouseHover(t *testing.T) {
@@ -251,3 +254,207 @@ func TestActionMenuDangerousStatusLineFullToken(t *testing.T) {
 		t.Errorf("StatusLine() = %q, want %q", got, want)
 	}
 }
+
+func TestActionMenuPromptCollectsInputs(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "q-1", name: "queue"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:     "Edit",
+		Shortcut: "e",
+		Type:     action.ActionTypeAPI,
+		Confirm:  action.ConfirmSimple,
+		Prompts: []action.Prompt{
+			{Label: "Timeout", Default: func(dao.Resource) string { return "3" }},
+			{Label: "Target", Options: func(context.Context, dao.Resource) ([]string, error) {
+				return []string{"alpha", "beta", "gamma"}, nil
+			}},
+		},
+		Preview: func(_ dao.Resource, inputs []string) string {
+			return "Timeout: 3 → " + inputs[0]
+		},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "e", Code: 'e'})
+	if !menu.prompt.active || !menu.HasActiveInput() {
+		t.Fatal("expected prompt to be active after selecting action")
+	}
+	if menu.prompt.input != "3" {
+		t.Errorf("prompt input = %q, want default %q", menu.prompt.input, "3")
+	}
+
+	// Append to the default value and submit
+	menu.Update(tea.KeyPressMsg{Text: "0", Code: '0'})
+	_, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	// Picker options load asynchronously; keys other than Esc wait for them
+	if !menu.prompt.loading || cmd == nil {
+		t.Fatal("expected picker options to be loading")
+	}
+	menu.Update(tea.KeyPressMsg{Text: "x", Code: 'x'})
+	if menu.prompt.input != "" {
+		t.Errorf("prompt input while loading = %q, want empty", menu.prompt.input)
+	}
+	menu.Update(cmd())
+	if menu.prompt.loading || len(menu.prompt.options) != 3 {
+		t.Fatalf("options = %v, loading = %v; want 3 loaded options", menu.prompt.options, menu.prompt.loading)
+	}
+
+	// Picker: filter to "ga" and choose
+	menu.Update(tea.KeyPressMsg{Text: "g", Code: 'g'})
+	menu.Update(tea.KeyPressMsg{Text: "a", Code: 'a'})
+	if got := menu.filteredOptions(); len(got) != 1 || got[0] != "gamma" {
+		t.Errorf("filteredOptions() = %v, want [gamma]", got)
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if menu.prompt.active {
+		t.Error("expected prompt to be inactive after last step")
+	}
+	if !menu.confirming {
+		t.Error("expected simple confirmation after prompts")
+	}
+	if view := menu.ViewString(); !strings.Contains(view, "Timeout: 3 → 30") {
+		t.Errorf("confirmation should render Preview, got:\n%s", view)
+	}
+	act := menu.withInputs(menu.actions[0])
+	if act.Input(0) != "30" || act.Input(1) != "gamma" {
+		t.Errorf("inputs = %v, want [30 gamma]", act.Inputs)
+	}
+}
+
+func TestActionMenuPromptBackspaceTrimsRune(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "q-1", name: "queue"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:     "Edit",
+		Shortcut: "e",
+		Type:     action.ActionTypeAPI,
+		Confirm:  action.ConfirmSimple,
+		Prompts:  []action.Prompt{{Label: "Name"}},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "e", Code: 'e'})
+	menu.Update(tea.KeyPressMsg{Text: "a", Code: 'a'})
+	menu.Update(tea.KeyPressMsg{Text: "é", Code: 'é'})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+
+	if menu.prompt.input != "a" {
+		t.Errorf("prompt input after backspace = %q, want %q", menu.prompt.input, "a")
+	}
+}
+
+func TestActionMenuPromptValidateAndEsc(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "q-1", name: "queue"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:     "Edit",
+		Shortcut: "e",
+		Type:     action.ActionTypeAPI,
+		Confirm:  action.ConfirmSimple,
+		Prompts: []action.Prompt{{
+			Label: "Email",
+			Validate: func(v string) error {
+				if v == "" {
+					return errors.New("required")
+				}
+				return nil
+			},
+		}},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "e", Code: 'e'})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !menu.prompt.active || menu.prompt.err == nil {
+		t.Fatal("expected validation error to keep prompt active")
+	}
+
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if menu.prompt.active || menu.confirming {
+		t.Error("expected esc to cancel prompt without confirming")
+	}
+}
+
+func TestActionMenuPromptsFunc(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "doc-1", name: "doc"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{
+		{
+			Name:     "Start",
+			Shortcut: "s",
+			Type:     action.ActionTypeAPI,
+			Confirm:  action.ConfirmSimple,
+			PromptsFunc: func(_ context.Context, r dao.Resource) ([]action.Prompt, error) {
+				return []action.Prompt{{Label: "Target for " + r.GetName()}}, nil
+			},
+		},
+		{
+			Name:     "Broken",
+			Shortcut: "b",
+			Type:     action.ActionTypeAPI,
+			Confirm:  action.ConfirmSimple,
+			PromptsFunc: func(context.Context, dao.Resource) ([]action.Prompt, error) {
+				return nil, errors.New("describe failed")
+			},
+		},
+	}
+
+	_, cmd := menu.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	if cmd == nil || !menu.prompt.loadingPrompts {
+		t.Fatal("expected PromptsFunc to run in a command")
+	}
+	if !strings.Contains(menu.ViewString(), LoadingMessage) {
+		t.Error("expected loading message while PromptsFunc runs")
+	}
+	menu.Update(cmd())
+	if !menu.prompt.active {
+		t.Fatal("expected prompt built by PromptsFunc to be active")
+	}
+	if got := menu.currentPrompt().Label; got != "Target for doc" {
+		t.Errorf("prompt label = %q, want %q", got, "Target for doc")
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	// Prompts loaded after cancelling are dropped
+	_, cmd = menu.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	menu.Update(cmd())
+	if menu.prompt.active {
+		t.Error("prompts loaded after Esc should not start")
+	}
+
+	_, cmd = menu.Update(tea.KeyPressMsg{Text: "b", Code: 'b'})
+	menu.Update(cmd())
+	if menu.prompt.active || menu.confirming {
+		t.Error("PromptsFunc error should not start prompts or confirmation")
+	}
+	if menu.result == nil || menu.result.Error == nil {
+		t.Error("PromptsFunc error should be shown as a result")
+	}
+}
+
+func TestNewStandaloneActionMenu(t *testing.T) {
+	action.Global.Register("test-standalone", "items", []action.Action{
+		{Name: "Create", Shortcut: "c", Type: action.ActionTypeAPI, Operation: "CreateItem", Standalone: true},
+		{Name: "Delete", Shortcut: "D", Type: action.ActionTypeAPI, Operation: "DeleteItem"},
+	})
+
+	menu := NewStandaloneActionMenu(context.Background(), "test-standalone", "items")
+	if menu == nil {
+		t.Fatal("expected a menu with the standalone action")
+	}
+	if len(menu.actions) != 1 || menu.actions[0].Name != "Create" {
+		t.Errorf("actions = %v, want only Create", menu.actions)
+	}
+
+	if NewStandaloneActionMenu(context.Background(), "ec2", "no-such-type") != nil {
+		t.Error("expected nil without standalone actions")
+	}
+}
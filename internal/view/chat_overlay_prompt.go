@@ -11,8 +11,8 @@ import (
 // formatProfileName converts internal profile ID to display name
 func formatProfileName(profileID string) string {
 	sel := config.ProfileSelectionFromID(profileID)
-	if sel.Mode == config.ModeNamedProfile {
-		return sel.ProfileName
+	if sel.Mode == config.ModeNamedProfile || sel.Mode == config.ModeSSORole {
+		return sel.DisplayName()
 	}
 	return sel.Mode.String()
 }
@@ -334,7 +334,7 @@ func (c *CommandInput) resolveDestination(input string) string {
 	if strings.HasPrefix(input, "tag ") || strings.HasPrefix(input, "tags ") ||
 		strings.HasPrefix(input, "diff ") || strings.HasPrefix(input, "sort ") ||
 		strings.HasPrefix(input, "theme ") || strings.HasPrefix(input, "autosave ") ||
-		strings.HasPrefix(input, "login ") {
+		strings.HasPrefix(input, "login ") || strings.HasPrefix(input, "sso ") {
 		return ""
 	}
 
@@ -470,6 +470,17 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		return nil, &NavigateMsg{View: dashboard, ClearStack: false}
 	}
 
+	// Handle posture command - open the account security posture summary
+	if input == "posture" {
+		posture := NewSecurityPostureView(c.ctx, c.registry)
+		return nil, &NavigateMsg{View: posture}
+	}
+
+	// Handle debug-log command - tail claws' own log
+	if input == "debug-log" {
+		return nil, &NavigateMsg{View: NewDebugLogView()}
+	}
+
 	// Handle services/browse command - go to service browser
 	if input == "services" || input == "browse" {
 		browser := NewServiceBrowser(c.ctx, c.registry)
@@ -515,6 +526,20 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		return c.executeLogin(profileName), nil
 	}
 
+	// Handle sso command: :sso (current SSO session) or :sso <profile>
+	if input == "sso" {
+		return c.showSSOBrowser(""), nil
+	}
+	if suffix, ok := strings.CutPrefix(input, "sso "); ok {
+		profileName := strings.TrimSpace(suffix)
+		if profileName != "" && !config.IsValidProfileName(profileName) {
+			return func() tea.Msg {
+				return ErrorMsg{Err: fmt.Errorf("invalid profile name: %q", profileName)}
+			}, nil
+		}
+		return c.showSSOBrowser(profileName), nil
+	}
+
 	// Handle tag command: :tag (clear) or :tag <filter> (filter by tag)
 	if input == "tag" {
 		return func() tea.Msg {
@@ -594,6 +619,18 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 	}, nil
 }
 
+// showSSOBrowser opens the SSO account and role browser as a modal. The
+// modal is shown before loading starts so the result reaches it.
+func (c *CommandInput) showSSOBrowser(profile string) tea.Cmd {
+	browser := NewSSOBrowser(c.ctx, profile)
+	return tea.Sequence(
+		func() tea.Msg {
+			return ShowModalMsg{Modal: &Modal{Content: browser, Width: ModalWidthSSO}}
+		},
+		browser.Init(),
+	)
+}
+
 func (c *CommandInput) parseSortArgs(args string) tea.Cmd {
 	ascending := true
 	column := args
@@ -684,9 +721,18 @@ func (c *CommandInput) GetSuggestions() []string {
 		if strings.HasPrefix("dashboard", input) {
 			suggestions = append(suggestions, "dashboard")
 		}
+		if strings.HasPrefix("posture", input) {
+			suggestions = append(suggestions, "posture")
+		}
+		if strings.HasPrefix("debug-log", input) {
+			suggestions = append(suggestions, "debug-log")
+		}
 		if strings.HasPrefix("login", input) {
 			suggestions = append(suggestions, "login")
 		}
+		if strings.HasPrefix("sso", input) {
+			suggestions = append(suggestions, "sso")
+		}
 		if strings.HasPrefix("clear-history", input) {
 			suggestions = append(suggestions, "clear-history")
 		}
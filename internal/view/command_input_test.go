@@ -547,6 +547,38 @@ func TestCommandInput_ServicesCommand(t *testing.T) {
 	}
 }
 
+func TestCommandInput_SSOCommand(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	for _, input := range []string{"sso", "sso admin"} {
+		t.Run(input, func(t *testing.T) {
+			ci := NewCommandInput(ctx, reg)
+			ci.Activate()
+			ci.textInput.SetValue(input)
+
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if nav != nil {
+				t.Errorf("%q: expected modal, got NavigateMsg", input)
+			}
+			if cmd == nil {
+				t.Errorf("%q: expected command", input)
+			}
+		})
+	}
+
+	ci := NewCommandInput(ctx, reg)
+	ci.Activate()
+	ci.textInput.SetValue("sso bad/name")
+	cmd, _ := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected error command for invalid profile name")
+	}
+	if _, ok := cmd().(ErrorMsg); !ok {
+		t.Errorf("expected ErrorMsg for invalid profile name")
+	}
+}
+
 func TestCommandInput_CtrlCExit(t *testing.T) {
 	ctx := context.Background()
 	reg := registry.New()
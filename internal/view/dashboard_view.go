@@ -74,6 +74,10 @@ type DashboardView struct {
 	healthLoading bool
 	healthErr     error
 
+	hcUnhealthy int
+	hcLoading   bool
+	hcErr       error
+
 	secItems   []securityItem
 	secLoading bool
 	secErr     error
@@ -98,6 +102,7 @@ func NewDashboardView(ctx context.Context, reg *registry.Registry) *DashboardVie
 		costLoading:    true,
 		anomalyLoading: true,
 		healthLoading:  true,
+		hcLoading:      true,
 		secLoading:     true,
 		taLoading:      true,
 		hoverIdx:       -1,
@@ -113,6 +118,7 @@ func (d *DashboardView) Init() tea.Cmd {
 		d.loadCosts,
 		d.loadAnomalies,
 		d.loadHealth,
+		d.loadHealthChecks,
 		d.loadSecurity,
 		d.loadTrustedAdvisor,
 	)
@@ -156,6 +162,14 @@ func (d *DashboardView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		d.healthLoading = false
 		d.healthErr = msg.err
 		return d, nil
+	case healthCheckLoadedMsg:
+		d.hcLoading = false
+		d.hcUnhealthy = msg.unhealthy
+		return d, nil
+	case healthCheckErrorMsg:
+		d.hcLoading = false
+		d.hcErr = msg.err
+		return d, nil
 
 	case securityLoadedMsg:
 		d.secLoading = false
@@ -473,7 +487,7 @@ func (d *DashboardView) activateCurrentRow() (tea.Model, tea.Cmd) {
 
 func (d *DashboardView) isLoading() bool {
 	return d.alarmLoading || d.costLoading || d.anomalyLoading ||
-		d.healthLoading || d.secLoading || d.taLoading
+		d.healthLoading || d.hcLoading || d.secLoading || d.taLoading
 }
 
 func (d *DashboardView) ViewString() string {
@@ -10,6 +10,7 @@ import (
 	"github.com/clawscli/claws/custom/ce/costs"
 	"github.com/clawscli/claws/custom/cloudwatch/alarms"
 	"github.com/clawscli/claws/custom/health/events"
+	healthchecks "github.com/clawscli/claws/custom/route53/health-checks"
 	"github.com/clawscli/claws/custom/securityhub/findings"
 	"github.com/clawscli/claws/custom/trustedadvisor/recommendations"
 	"github.com/clawscli/claws/internal/dao"
@@ -60,6 +61,9 @@ type anomalyErrorMsg struct{ err error }
 type healthLoadedMsg struct{ items []healthItem }
 type healthErrorMsg struct{ err error }
 
+type healthCheckLoadedMsg struct{ unhealthy int }
+type healthCheckErrorMsg struct{ err error }
+
 type securityLoadedMsg struct{ items []securityItem }
 type securityErrorMsg struct{ err error }
 
@@ -179,6 +183,30 @@ func (d *DashboardView) loadHealth() tea.Msg {
 	return healthLoadedMsg{items: items}
 }
 
+func (d *DashboardView) loadHealthChecks() tea.Msg {
+	if d.ctx.Err() != nil {
+		return healthCheckErrorMsg{err: d.ctx.Err()}
+	}
+
+	hcDAO, err := healthchecks.NewHealthCheckDAO(d.ctx)
+	if err != nil {
+		return healthCheckErrorMsg{err: err}
+	}
+
+	resources, err := hcDAO.List(d.ctx)
+	if err != nil {
+		return healthCheckErrorMsg{err: err}
+	}
+
+	var unhealthy int
+	for _, r := range resources {
+		if hc, ok := r.(*healthchecks.HealthCheckResource); ok && hc.IsUnhealthy() {
+			unhealthy++
+		}
+	}
+	return healthCheckLoadedMsg{unhealthy: unhealthy}
+}
+
 func (d *DashboardView) loadSecurity() tea.Msg {
 	if d.ctx.Err() != nil {
 		return securityErrorMsg{err: d.ctx.Err()}
@@ -63,12 +63,14 @@ func (d *DashboardView) handleRefresh() (tea.Model, tea.Cmd) {
 	d.costLoading = true
 	d.anomalyLoading = true
 	d.healthLoading = true
+	d.hcLoading = true
 	d.secLoading = true
 	d.taLoading = true
 	d.alarmErr = nil
 	d.costErr = nil
 	d.anomalyErr = nil
 	d.healthErr = nil
+	d.hcErr = nil
 	d.secErr = nil
 	d.taErr = nil
 	return d, d.Init()
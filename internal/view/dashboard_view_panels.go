@@ -131,7 +131,7 @@ func (d *DashboardView) renderOpsContent(contentWidth, contentHeight int, focusR
 		lines = append(lines, s.dim.Render("Alarms: N/A"))
 	} else if alarmCount > 0 {
 		lines = append(lines, s.danger.Render(fmt.Sprintf("Alarms: %d in ALARM", alarmCount)))
-		maxShow := min(alarmCount, contentHeight-3)
+		maxShow := min(alarmCount, contentHeight-4)
 		for i := range maxShow {
 			line := "  " + s.danger.Render("• ") + s.text.Render(TruncateString(d.alarms[i].name, contentWidth-bulletIndentWidth))
 			if i == focusRow {
@@ -149,7 +149,7 @@ func (d *DashboardView) renderOpsContent(contentWidth, contentHeight int, focusR
 		lines = append(lines, s.dim.Render("Health: N/A"))
 	} else if len(d.healthItems) > 0 {
 		lines = append(lines, s.warning.Render(fmt.Sprintf("Health: %d open", len(d.healthItems))))
-		remaining := contentHeight - len(lines) - 1
+		remaining := contentHeight - len(lines) - 2
 		maxShow := min(len(d.healthItems), remaining)
 		for i := range maxShow {
 			h := d.healthItems[i]
@@ -163,6 +163,16 @@ func (d *DashboardView) renderOpsContent(contentWidth, contentHeight int, focusR
 		lines = append(lines, s.text.Render("Health: ")+s.success.Render("0 open ✓"))
 	}
 
+	if d.hcLoading {
+		lines = append(lines, s.text.Render("Health checks: "+d.spinner.View()))
+	} else if d.hcErr != nil {
+		lines = append(lines, s.text.Render("Health checks: ")+s.dim.Render("N/A"))
+	} else if d.hcUnhealthy > 0 {
+		lines = append(lines, s.text.Render("Health checks: ")+s.danger.Render(fmt.Sprintf("%d unhealthy", d.hcUnhealthy)))
+	} else {
+		lines = append(lines, s.text.Render("Health checks: ")+s.success.Render("0 unhealthy ✓"))
+	}
+
 	return strings.Join(lines, "\n")
 }
 
@@ -2,6 +2,7 @@ package view
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/clawscli/claws/internal/registry"
@@ -243,6 +244,7 @@ func TestDashboardView_IsLoading(t *testing.T) {
 	dv.costLoading = false
 	dv.anomalyLoading = false
 	dv.healthLoading = false
+	dv.hcLoading = false
 	dv.secLoading = false
 	dv.taLoading = false
 
@@ -257,6 +259,28 @@ func TestDashboardView_IsLoading(t *testing.T) {
 	}
 }
 
+func TestDashboardView_RenderOpsContent_HealthChecks(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	dv := NewDashboardView(ctx, reg)
+	dv.alarmLoading = false
+	dv.healthLoading = false
+	dv.hcLoading = false
+	dv.hcUnhealthy = 2
+
+	content := dv.renderOpsContent(40, 10, -1)
+	if !strings.Contains(content, "Health checks: ") || !strings.Contains(content, "2 unhealthy") {
+		t.Errorf("expected unhealthy health check count, got %q", content)
+	}
+
+	dv.hcUnhealthy = 0
+	content = dv.renderOpsContent(40, 10, -1)
+	if !strings.Contains(content, "0 unhealthy") {
+		t.Errorf("expected zero unhealthy health checks, got %q", content)
+	}
+}
+
 func TestDashboardView_CanRefresh(t *testing.T) {
 	ctx := context.Background()
 	reg := registry.New()
@@ -0,0 +1,327 @@
+package view
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/sanitize"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const debugLogPollInterval = time.Second
+
+// debugLogLevels are the minimum levels cycled through with "l"
+var debugLogLevels = []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, slog.LevelError}
+
+type debugLogTickMsg time.Time
+
+type debugLogStyles struct {
+	header    lipgloss.Style
+	timestamp lipgloss.Style
+	message   lipgloss.Style
+	attrs     lipgloss.Style
+	paused    lipgloss.Style
+	dim       lipgloss.Style
+}
+
+func newDebugLogStyles() debugLogStyles {
+	return debugLogStyles{
+		header:    ui.TitleStyle(),
+		timestamp: ui.SecondaryStyle(),
+		message:   ui.TextStyle(),
+		attrs:     ui.DimStyle(),
+		paused:    ui.BoldWarningStyle(),
+		dim:       ui.DimStyle(),
+	}
+}
+
+// DebugLogView tails claws' own log from the in-memory buffer in internal/log,
+// so issues can be debugged without a second terminal tailing --log-file.
+type DebugLogView struct {
+	vp     ViewportState
+	styles debugLogStyles
+
+	entries  []log.Entry
+	seq      uint64
+	levelIdx int
+	paused   bool
+
+	width  int
+	height int
+
+	filterInput  textinput.Model
+	filterActive bool
+	filterText   string
+}
+
+// NewDebugLogView creates a new DebugLogView
+func NewDebugLogView() *DebugLogView {
+	ti := textinput.New()
+	ti.Placeholder = "Filter logs..."
+	ti.Prompt = "/"
+	ti.CharLimit = 200
+
+	v := &DebugLogView{
+		styles:      newDebugLogStyles(),
+		filterInput: ti,
+	}
+	v.entries, v.seq = log.Recent()
+	return v
+}
+
+func (v *DebugLogView) Init() tea.Cmd {
+	return v.tickCmd()
+}
+
+func (v *DebugLogView) tickCmd() tea.Cmd {
+	return tea.Tick(debugLogPollInterval, func(t time.Time) tea.Msg {
+		return debugLogTickMsg(t)
+	})
+}
+
+// poll reloads the buffer if new entries were logged since the last poll
+func (v *DebugLogView) poll() {
+	if log.Seq() == v.seq {
+		return
+	}
+	v.entries, v.seq = log.Recent()
+	if v.vp.Ready {
+		atBottom := v.vp.Model.AtBottom()
+		v.updateViewportContent()
+		if atBottom {
+			v.vp.Model.GotoBottom()
+		}
+	}
+}
+
+func (v *DebugLogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case debugLogTickMsg:
+		if v.paused {
+			return v, nil
+		}
+		v.poll()
+		return v, v.tickCmd()
+
+	case tea.KeyPressMsg:
+		if v.filterActive {
+			return v.handleFilterInput(msg)
+		}
+
+		switch msg.String() {
+		case "/":
+			v.filterActive = true
+			v.filterInput.Focus()
+			v.SetSize(v.width, v.height)
+			return v, textinput.Blink
+		case "l":
+			v.levelIdx = (v.levelIdx + 1) % len(debugLogLevels)
+			v.refreshContent()
+			return v, nil
+		case "space":
+			v.paused = !v.paused
+			if !v.paused {
+				v.poll()
+				return v, v.tickCmd()
+			}
+			return v, nil
+		case "g":
+			if v.vp.Ready {
+				v.vp.Model.GotoTop()
+			}
+			return v, nil
+		case "G":
+			if v.vp.Ready {
+				v.vp.Model.GotoBottom()
+			}
+			return v, nil
+		case "c":
+			// Clear filter if active, otherwise clear buffer
+			if v.filterText != "" {
+				v.filterText = ""
+				v.filterInput.SetValue("")
+				v.SetSize(v.width, v.height)
+				return v, tea.ClearScreen
+			}
+			log.ClearRecent()
+			v.entries, v.seq = log.Recent()
+			v.refreshContent()
+			return v, nil
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newDebugLogStyles()
+		v.refreshContent()
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *DebugLogView) handleFilterInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "enter":
+		v.filterActive = false
+		v.filterInput.Blur()
+		v.filterText = v.filterInput.Value()
+		v.SetSize(v.width, v.height)
+		return v, nil
+	default:
+		var cmd tea.Cmd
+		v.filterInput, cmd = v.filterInput.Update(msg)
+		v.filterText = v.filterInput.Value()
+		v.refreshContent()
+		return v, tea.Batch(cmd, tea.ClearScreen)
+	}
+}
+
+func (v *DebugLogView) minLevel() slog.Level {
+	return debugLogLevels[v.levelIdx]
+}
+
+func (v *DebugLogView) matches(e log.Entry) bool {
+	if e.Level < v.minLevel() {
+		return false
+	}
+	if v.filterText == "" {
+		return true
+	}
+	filter := strings.ToLower(v.filterText)
+	return strings.Contains(strings.ToLower(e.Message), filter) ||
+		strings.Contains(strings.ToLower(e.AttrString()), filter)
+}
+
+// levelStyle colors the level label like the log severity it represents
+func levelStyle(level slog.Level) lipgloss.Style {
+	switch {
+	case level >= slog.LevelError:
+		return ui.DangerStyle()
+	case level >= slog.LevelWarn:
+		return ui.WarningStyle()
+	case level >= slog.LevelInfo:
+		return ui.SuccessStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+func (v *DebugLogView) refreshContent() {
+	if v.vp.Ready {
+		v.updateViewportContent()
+	}
+}
+
+func (v *DebugLogView) updateViewportContent() {
+	var sb strings.Builder
+	for _, e := range v.entries {
+		if !v.matches(e) {
+			continue
+		}
+		sb.WriteString(v.styles.timestamp.Render(e.Time.Format("15:04:05.000")))
+		sb.WriteString(" ")
+		sb.WriteString(levelStyle(e.Level).Render(fmt.Sprintf("%-5s", e.Level.String())))
+		sb.WriteString(" ")
+		sb.WriteString(v.styles.message.Render(sanitize.LogText(e.Message)))
+		if attrs := e.AttrString(); attrs != "" {
+			sb.WriteString(" ")
+			sb.WriteString(v.styles.attrs.Render(sanitize.LogText(attrs)))
+		}
+		sb.WriteString("\n")
+	}
+	v.vp.Model.SetContent(sb.String())
+}
+
+func (v *DebugLogView) displayedCount() int {
+	count := 0
+	for _, e := range v.entries {
+		if v.matches(e) {
+			count++
+		}
+	}
+	return count
+}
+
+func (v *DebugLogView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+
+	var sb strings.Builder
+	sb.WriteString(v.styles.header.Render("🪵 claws log"))
+	sb.WriteString("\n")
+
+	if v.filterActive {
+		sb.WriteString(ui.InputFieldStyle().Render(v.filterInput.View()))
+		sb.WriteString("\n")
+	} else if v.filterText != "" {
+		sb.WriteString(ui.AccentStyle().Render(fmt.Sprintf("🔍 filter: %s", v.filterText)))
+		sb.WriteString("\n")
+	}
+
+	if v.paused {
+		sb.WriteString(v.styles.paused.Render("⏸ PAUSED"))
+		sb.WriteString(" ")
+	}
+	sb.WriteString(v.styles.dim.Render(fmt.Sprintf("level ≥ %s (%d/%d lines)",
+		v.minLevel(), v.displayedCount(), len(v.entries))))
+	if !log.IsEnabled() {
+		sb.WriteString(v.styles.dim.Render(" • in-memory only, use --log-file to persist"))
+	}
+	sb.WriteString("\n\n")
+
+	if len(v.entries) == 0 {
+		sb.WriteString(v.styles.dim.Render("No log entries yet"))
+		return sb.String()
+	}
+
+	sb.WriteString(v.vp.Model.View())
+	return sb.String()
+}
+
+func (v *DebugLogView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *DebugLogView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+
+	headerOffset := viewportHeaderOffset
+	if v.filterActive || v.filterText != "" {
+		headerOffset++ // Extra line for filter UI
+	}
+	v.vp.SetSize(width, max(height-headerOffset, 1))
+	v.filterInput.SetWidth(max(width-filterInputPadding, minFilterWidth))
+
+	v.updateViewportContent()
+	v.vp.Model.GotoBottom()
+	return nil
+}
+
+func (v *DebugLogView) StatusLine() string {
+	if v.filterActive {
+		return "Esc:cancel Enter:done"
+	}
+	status := "l:level Space:pause/resume g/G:top/bottom c:clear /:filter Esc:back"
+	if v.paused {
+		return "⏸ PAUSED • " + status
+	}
+	return "▶ TAILING • " + status
+}
+
+// HasActiveInput reports whether the filter input has focus
+func (v *DebugLogView) HasActiveInput() bool {
+	return v.filterActive
+}
@@ -0,0 +1,81 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/log"
+)
+
+func TestDebugLogViewLevelFilter(t *testing.T) {
+	log.ClearRecent()
+	log.Debug("debug entry")
+	log.Warn("region failed", "region", "ap-south-2")
+
+	v := NewDebugLogView()
+	v.SetSize(120, 20)
+
+	view := v.ViewString()
+	for _, want := range []string{"debug entry", "region failed", "region=ap-south-2", "(2/2 lines)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+
+	// debug → info → warn
+	v.Update(tea.KeyPressMsg{Text: "l", Code: 'l'})
+	v.Update(tea.KeyPressMsg{Text: "l", Code: 'l'})
+	view = v.ViewString()
+	if strings.Contains(view, "debug entry") {
+		t.Error("debug entry should be hidden at warn level")
+	}
+	if !strings.Contains(view, "region failed") || !strings.Contains(view, "(1/2 lines)") {
+		t.Errorf("expected warn entry at warn level:\n%s", view)
+	}
+}
+
+func TestDebugLogViewTail(t *testing.T) {
+	log.ClearRecent()
+	v := NewDebugLogView()
+	v.SetSize(120, 20)
+
+	if !strings.Contains(v.ViewString(), "No log entries yet") {
+		t.Error("expected empty message")
+	}
+
+	log.Info("late entry")
+	_, cmd := v.Update(debugLogTickMsg{})
+	if cmd == nil {
+		t.Error("expected next tick")
+	}
+	if !strings.Contains(v.ViewString(), "late entry") {
+		t.Error("expected tick to pick up new entries")
+	}
+
+	// Paused views stop polling
+	v.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	log.Info("while paused")
+	if _, cmd := v.Update(debugLogTickMsg{}); cmd != nil {
+		t.Error("paused view should not schedule ticks")
+	}
+	if strings.Contains(v.ViewString(), "while paused") {
+		t.Error("paused view should not update")
+	}
+}
+
+func TestDebugLogViewTextFilter(t *testing.T) {
+	log.ClearRecent()
+	log.Info("loaded resources", "service", "ec2")
+	log.Info("loaded resources", "service", "s3")
+
+	v := NewDebugLogView()
+	v.SetSize(120, 20)
+	v.filterText = "s3"
+	v.updateViewportContent()
+
+	if v.displayedCount() != 1 {
+		t.Errorf("displayedCount() = %d, want 1 (attrs should be matched)", v.displayedCount())
+	}
+}
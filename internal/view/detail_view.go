@@ -47,6 +47,7 @@ type DetailView struct {
 	dao         dao.DAO
 	refreshing  bool
 	refreshErr  error
+	errState    errorState // Classification of refreshErr, set with it
 	spinner     spinner.Model
 	styles      detailViewStyles
 	width       int
@@ -108,14 +109,13 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			log.Warn("failed to refresh resource details", "error", msg.err)
 			d.refreshErr = msg.err
+			d.errState = newErrorState(msg.err, "refresh "+d.resource.GetID())
 		} else {
 			d.refreshErr = nil
 			d.resource = mergeResources(d.resource, msg.resource)
-			if d.vp.Ready {
-				content := d.renderContent()
-				d.vp.Model.SetContent(content)
-			}
 		}
+		// Error banner above the details changes the viewport height
+		d.recalcViewport()
 		return d, nil
 
 	case spinner.TickMsg:
@@ -142,12 +142,23 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return d, nil
 		}
 
+		if d.refreshErr != nil {
+			if cmd := d.errState.handleKey(msg.String()); cmd != nil {
+				return d, cmd
+			}
+		}
+
 		// Check navigation shortcuts
 		if model, cmd := d.handleNavigation(msg.String()); model != nil {
 			return model, cmd
 		}
 
 		switch msg.String() {
+		case "ctrl+r":
+			if d.refreshErr != nil && !d.refreshing {
+				d.refreshing = true
+				return d, tea.Batch(d.spinner.Tick, d.refreshResource)
+			}
 		case "a":
 			if actions := action.Global.Get(d.service, d.resType); len(actions) > 0 {
 				actionMenu := NewActionMenu(d.ctx, dao.UnwrapResource(d.resource), d.service, d.resType)
@@ -202,7 +213,15 @@ func (d *DetailView) ViewString() string {
 
 	header := d.headerPanel.Render(d.service, d.resType, summaryFields)
 
-	return header + "\n" + d.vp.Model.View()
+	return header + "\n" + d.errorBanner() + d.vp.Model.View()
+}
+
+// errorBanner explains a failed refresh above the (last known) details
+func (d *DetailView) errorBanner() string {
+	if d.refreshErr == nil {
+		return ""
+	}
+	return d.errState.render(d.refreshErr) + "\n\n"
 }
 
 // View implements tea.Model
@@ -231,7 +250,7 @@ func (d *DetailView) recalcViewport() {
 	headerHeight := d.headerPanel.Height(headerStr)
 
 	// +1 compensates for border overlap
-	viewportHeight := max(d.height-headerHeight+1, minViewportHeight)
+	viewportHeight := max(d.height-headerHeight-lipgloss.Height(d.errorBanner())+2, minViewportHeight)
 
 	d.vp.SetSize(d.width, viewportHeight)
 
@@ -248,7 +267,7 @@ func (d *DetailView) StatusLine() string {
 	if d.refreshing {
 		parts = append(parts, d.spinner.View()+" refreshing...")
 	} else if d.refreshErr != nil {
-		parts = append(parts, "⚠ refresh failed")
+		parts = append(parts, "⚠ refresh failed", "ctrl+r:retry")
 	}
 
 	parts = append(parts, "↑/↓:scroll")
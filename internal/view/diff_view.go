@@ -19,6 +19,9 @@ type DiffView struct {
 	leftUnwrap   dao.Resource // unwrapped for rendering
 	rightUnwrap  dao.Resource // unwrapped for rendering
 	renderer     render.Renderer
+	dao          dao.DAO
+	loading      bool
+	loadErr      error
 	service      string
 	resourceType string
 	vp           ViewportState
@@ -56,14 +59,55 @@ func NewDiffView(ctx context.Context, left, right dao.Resource, renderer render.
 	}
 }
 
+// WithDAO sets the DAO used to refresh both resources for renderers implementing render.DiffRenderer
+func (d *DiffView) WithDAO(dd dao.DAO) *DiffView {
+	d.dao = dd
+	return d
+}
+
+// diffRefreshMsg is sent when both resources have been refreshed
+type diffRefreshMsg struct {
+	left  dao.Resource
+	right dao.Resource
+	err   error
+}
+
 // Init implements tea.Model
 func (d *DiffView) Init() tea.Cmd {
+	if _, ok := d.renderer.(render.DiffRenderer); ok && d.dao != nil && d.dao.Supports(dao.OpGet) {
+		d.loading = true
+		return d.refreshResources
+	}
 	return nil
 }
 
+// refreshResources fetches full details for both resources in background
+func (d *DiffView) refreshResources() tea.Msg {
+	left, err := d.dao.Get(d.ctx, d.leftUnwrap.GetID())
+	if err != nil {
+		return diffRefreshMsg{err: err}
+	}
+	right, err := d.dao.Get(d.ctx, d.rightUnwrap.GetID())
+	if err != nil {
+		return diffRefreshMsg{err: err}
+	}
+	return diffRefreshMsg{left: left, right: right}
+}
+
 // Update implements tea.Model
 func (d *DiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case diffRefreshMsg:
+		d.loading = false
+		d.loadErr = msg.err
+		if msg.err == nil {
+			d.leftUnwrap = msg.left
+			d.rightUnwrap = msg.right
+		}
+		if d.vp.Ready {
+			d.vp.Model.SetContent(d.renderContent())
+		}
+		return d, nil
 	case tea.KeyPressMsg:
 		// Let app handle back navigation (esc/backspace/q handled by app.go)
 		if IsEscKey(msg) {
@@ -72,7 +116,7 @@ func (d *DiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ThemeChangedMsg:
 		d.styles = newDiffViewStyles()
 		if d.vp.Ready {
-			d.vp.Model.SetContent(d.renderSideBySide())
+			d.vp.Model.SetContent(d.renderContent())
 		}
 		return d, nil
 	}
@@ -105,7 +149,7 @@ func (d *DiffView) SetSize(width, height int) tea.Cmd {
 
 	d.vp.SetSize(width, viewportHeight)
 
-	content := d.renderSideBySide()
+	content := d.renderContent()
 	d.vp.Model.SetContent(content)
 
 	return nil
@@ -116,6 +160,24 @@ func (d *DiffView) StatusLine() string {
 	return d.leftUnwrap.GetName() + " vs " + d.rightUnwrap.GetName() + " • ↑/↓:scroll • q/esc:back"
 }
 
+// renderContent uses the renderer's own comparison if available, else side-by-side details
+func (d *DiffView) renderContent() string {
+	dr, ok := d.renderer.(render.DiffRenderer)
+	if !ok {
+		return d.renderSideBySide()
+	}
+
+	header := d.styles.title.Render("Compare: "+d.leftUnwrap.GetName()+" → "+d.rightUnwrap.GetName()) + "\n"
+	header += strings.Repeat("─", d.width) + "\n"
+	switch {
+	case d.loading:
+		return header + LoadingMessage
+	case d.loadErr != nil:
+		return header + ui.DangerStyle().Render("Error: "+d.loadErr.Error())
+	}
+	return header + dr.RenderDiff(d.leftUnwrap, d.rightUnwrap)
+}
+
 // renderSideBySide generates the side-by-side view
 func (d *DiffView) renderSideBySide() string {
 	s := d.styles
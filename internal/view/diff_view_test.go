@@ -2,10 +2,13 @@ package view
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
 )
 
 func TestDiffView_New(t *testing.T) {
@@ -116,3 +119,52 @@ func TestDiffView_View_NotReady(t *testing.T) {
 		t.Errorf("ViewString() = %q, want %q", view, LoadingMessage)
 	}
 }
+
+// mockDiffRenderer implements render.DiffRenderer
+type mockDiffRenderer struct {
+	mockRenderer
+}
+
+func (m *mockDiffRenderer) RenderDiff(left, right dao.Resource) string {
+	return "diff " + left.GetName() + " " + right.GetName()
+}
+
+func TestDiffView_DiffRendererRefreshesResources(t *testing.T) {
+	ctx := context.Background()
+	left := &mockResource{id: "pg-a", name: "group-a"}
+	right := &mockResource{id: "pg-b", name: "group-b"}
+
+	dv := NewDiffView(ctx, left, right, &mockDiffRenderer{}, "rds", "parameter-groups").
+		WithDAO(&mockDAO{supportsGet: true})
+
+	cmd := dv.Init()
+	if cmd == nil {
+		t.Fatal("Init() should refresh resources for a DiffRenderer")
+	}
+	dv.SetSize(100, 30)
+	if !strings.Contains(dv.renderContent(), LoadingMessage) {
+		t.Error("expected loading message before refresh completes")
+	}
+
+	dv.Update(cmd())
+
+	// mockDAO.Get returns resources named "fetched"
+	if got := dv.renderContent(); !strings.Contains(got, "diff fetched fetched") {
+		t.Errorf("renderContent() = %q, want RenderDiff output for refreshed resources", got)
+	}
+}
+
+func TestDiffView_DiffRendererRefreshError(t *testing.T) {
+	ctx := context.Background()
+	left := &mockResource{id: "pg-a", name: "group-a"}
+	right := &mockResource{id: "pg-b", name: "group-b"}
+
+	dv := NewDiffView(ctx, left, right, &mockDiffRenderer{}, "rds", "parameter-groups").
+		WithDAO(&mockDAO{supportsGet: true, getErr: errors.New("access denied")})
+	dv.SetSize(100, 30)
+	dv.Update(dv.Init()())
+
+	if got := dv.renderContent(); !strings.Contains(got, "access denied") {
+		t.Errorf("renderContent() = %q, want error", got)
+	}
+}
@@ -0,0 +1,190 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// errorKey is a key the user can press to act on an error
+type errorKey struct {
+	key   string
+	label string
+}
+
+// errorState is the categorized presentation of a load error: a headline
+// naming the category, a targeted hint, and the keys that act on it.
+type errorState struct {
+	kind       apperrors.Kind
+	headline   string
+	hint       string
+	keys       []errorKey
+	ssoProfile string // Named SSO profile to log in to, for expired credentials
+}
+
+// newErrorState classifies err for the failed operation on target
+// (e.g., "list ec2/instances").
+func newErrorState(err error, target string) errorState {
+	kind := apperrors.Classify(err)
+	retry := errorKey{"ctrl+r", "retry"}
+	profile := errorKey{"P", "switch profile"}
+
+	switch kind {
+	case apperrors.Expired:
+		e := errorState{
+			kind:     kind,
+			headline: "⏳ Credentials expired: cannot " + target,
+			hint:     "Refresh the credentials for the current profile, then retry.",
+			keys:     []errorKey{retry, profile},
+		}
+		if name := currentSSOProfile(); name != "" {
+			e.ssoProfile = name
+			e.hint = fmt.Sprintf("The SSO session for profile %q has expired.", name)
+			e.keys = append([]errorKey{{"ctrl+l", "SSO login"}}, e.keys...)
+		}
+		return e
+	case apperrors.Auth:
+		return errorState{
+			kind:     kind,
+			headline: "🔒 Access denied: the current credentials cannot " + target,
+			hint:     "Other services remain available. See docs/iam-permissions.md for the permissions each resource needs.",
+			keys:     []errorKey{profile},
+		}
+	case apperrors.Throttling:
+		return errorState{
+			kind:     kind,
+			headline: "🐢 Throttled: AWS rate-limited requests to " + target,
+			hint:     "Wait a moment before retrying. Lowering concurrency.max_fetches reduces parallel calls.",
+			keys:     []errorKey{retry},
+		}
+	case apperrors.Network:
+		return errorState{
+			kind:     kind,
+			headline: "🌐 Network error: could not reach AWS to " + target,
+			hint:     "Check your connection, VPN or proxy settings (HTTPS_PROXY), then retry.",
+			keys:     []errorKey{retry},
+		}
+	case apperrors.NotFound:
+		return errorState{
+			kind:     kind,
+			headline: "Not found: cannot " + target,
+			hint:     "It may have been deleted, or live in another region or account.",
+			keys:     []errorKey{retry, {"esc", "back"}},
+		}
+	default:
+		// Unclassified errors are shown as-is
+		return errorState{
+			kind:     kind,
+			headline: fmt.Sprintf("Error: %v", err),
+			keys:     []errorKey{retry},
+		}
+	}
+}
+
+// render returns the error block shown in place of view content
+func (e errorState) render(err error) string {
+	style := ui.DangerStyle()
+	if e.kind != apperrors.Unknown {
+		style = ui.WarningStyle()
+	}
+
+	var b strings.Builder
+	b.WriteString(style.Render(e.headline))
+	b.WriteString("\n")
+	if e.hint != "" || e.kind != apperrors.Unknown {
+		b.WriteString("\n")
+	}
+	if e.hint != "" {
+		b.WriteString(ui.DimStyle().Render(e.hint))
+		b.WriteString("\n")
+	}
+	if keys := e.keyHints(); keys != "" {
+		b.WriteString(ui.DimStyle().Render(keys))
+		b.WriteString("\n")
+	}
+	if e.kind != apperrors.Unknown {
+		b.WriteString(ui.DimStyle().Render(err.Error()))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// keyHints formats the error's keys for a status line (e.g., "ctrl+l:SSO login • ctrl+r:retry")
+func (e errorState) keyHints() string {
+	parts := make([]string, len(e.keys))
+	for i, k := range e.keys {
+		parts[i] = k.key + ":" + k.label
+	}
+	return strings.Join(parts, " • ")
+}
+
+// handleKey runs the error-specific action for key. Only keys not already
+// bound by the views (SSO login) are handled here; they must not collide with
+// view keys such as render.LogSourceKey, whose meaning would otherwise depend
+// on whether the last load failed.
+func (e errorState) handleKey(key string) tea.Cmd {
+	if key == "ctrl+l" && e.ssoProfile != "" {
+		return ssoLoginRefreshCmd(e.ssoProfile)
+	}
+	return nil
+}
+
+// currentSSOProfile returns the selected profile name if it is a single named
+// SSO profile, or the SSO session profile of a single SSO role, or "" otherwise.
+func currentSSOProfile() string {
+	selections := config.Global().Selections()
+	if len(selections) != 1 || (!selections[0].IsNamedProfile() && !selections[0].IsSSORole()) {
+		return ""
+	}
+	name := selections[0].ProfileName
+	profiles, err := aws.LoadProfiles()
+	if err != nil {
+		log.Debug("failed to load profiles", "error", err)
+		return ""
+	}
+	for _, p := range profiles {
+		if p.Name == name && p.IsSSO {
+			return name
+		}
+	}
+	return ""
+}
+
+// ssoLoginExec builds the `aws sso login` command for profileID
+func ssoLoginExec(profileID string) (*action.SimpleExec, error) {
+	if config.Global().ReadOnly() && !action.IsExecAllowedInReadOnly(action.ActionNameSSOLogin) {
+		return nil, fmt.Errorf("SSO login denied: read-only mode")
+	}
+	awsPath, err := action.ResolveExecutable("aws")
+	if err != nil {
+		return nil, fmt.Errorf("aws CLI not found in PATH: %w", err)
+	}
+	return &action.SimpleExec{
+		Args:       []string{awsPath, "sso", "login", "--profile", profileID},
+		ActionName: action.ActionNameSSOLogin,
+		SkipAWSEnv: true,
+	}, nil
+}
+
+// ssoLoginRefreshCmd runs `aws sso login` and, on success, re-applies the
+// current profiles so credentials are re-resolved and the view reloads.
+func ssoLoginRefreshCmd(profileID string) tea.Cmd {
+	execCmd, err := ssoLoginExec(profileID)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	return tea.Exec(execCmd, func(err error) tea.Msg {
+		if err != nil {
+			return ErrorMsg{Err: apperrors.Wrapf(err, "sso login %s", profileID)}
+		}
+		return navmsg.ProfilesChangedMsg{Selections: config.Global().Selections()}
+	})
+}
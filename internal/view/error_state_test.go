@@ -0,0 +1,79 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestNewErrorState(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		kind     apperrors.Kind
+		headline string
+		key      string
+	}{
+		{"expired", errors.New("api error ExpiredToken: The security token included in the request is expired"), apperrors.Expired, "Credentials expired", "ctrl+r:retry"},
+		{"access denied", errors.New("AccessDenied: not authorized"), apperrors.Auth, "Access denied", "P:switch profile"},
+		{"throttled", errors.New("ThrottlingException: Rate exceeded"), apperrors.Throttling, "Throttled", "ctrl+r:retry"},
+		{"network", errors.New("dial tcp: lookup ec2.us-east-1.amazonaws.com: no such host"), apperrors.Network, "Network error", "ctrl+r:retry"},
+		{"not found", errors.New("ResourceNotFoundException: gone"), apperrors.NotFound, "Not found", "esc:back"},
+		{"unknown", errors.New("boom"), apperrors.Unknown, "Error: boom", "ctrl+r:retry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newErrorState(tt.err, "list ec2/instances")
+			if e.kind != tt.kind {
+				t.Errorf("kind = %v, want %v", e.kind, tt.kind)
+			}
+			if !strings.Contains(e.headline, tt.headline) {
+				t.Errorf("headline = %q, want to contain %q", e.headline, tt.headline)
+			}
+			if !strings.Contains(e.keyHints(), tt.key) {
+				t.Errorf("keyHints() = %q, want to contain %q", e.keyHints(), tt.key)
+			}
+			if e.handleKey("ctrl+l") != nil {
+				t.Error("handleKey(ctrl+l) returned a command without an SSO profile")
+			}
+		})
+	}
+}
+
+func TestResourceBrowserCategorizedError(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+	browser := NewResourceBrowserWithType(context.Background(), reg, "ec2", "instances")
+	browser.SetSize(120, 40)
+
+	browser.Update(resourcesErrorMsg{err: errors.New("ThrottlingException: Rate exceeded")})
+	view := browser.ViewString()
+	for _, want := range []string{"Throttled", "ec2/instances", "concurrency.max_fetches", "ctrl+r:retry", "Rate exceeded"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestDetailViewRefreshErrorBannerAndRetry(t *testing.T) {
+	resource := &mockResource{id: "i-123", name: "test"}
+	dv := NewDetailView(context.Background(), resource, nil, "ec2", "instances", nil, nil)
+	dv.SetSize(100, 40)
+
+	dv.Update(detailRefreshMsg{resource: resource, err: errors.New("dial tcp 10.0.0.1:443: connect: connection refused")})
+	if view := dv.ViewString(); !strings.Contains(view, "Network error") {
+		t.Errorf("view missing network error banner:\n%s", view)
+	}
+
+	_, cmd := dv.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if cmd == nil || !dv.refreshing {
+		t.Error("ctrl+r did not retry the refresh")
+	}
+}
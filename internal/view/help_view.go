@@ -88,6 +88,8 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render("a") + s.desc.Render("Show actions menu") + "\n"
 	out += s.key.Render("y") + s.desc.Render("Copy resource ID to clipboard") + "\n"
 	out += s.key.Render("Y") + s.desc.Render("Copy resource ARN to clipboard") + "\n"
+	out += s.key.Render("I") + s.desc.Render("Copy Terraform import blocks for the list") + "\n"
+	out += s.key.Render("E") + s.desc.Render("Generate CloudFormation template") + "\n"
 
 	// Filter Syntax
 	out += "\n" + s.section.Render("Filter Syntax") + "\n"
@@ -101,6 +103,8 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render(":pulse") + s.desc.Render("Go to dashboard") + "\n"
 	out += s.key.Render(":dashboard") + s.desc.Render("Go to dashboard") + "\n"
 	out += s.key.Render(":services") + s.desc.Render("Go to services") + "\n"
+	out += s.key.Render(":posture") + s.desc.Render("Security posture summary") + "\n"
+	out += s.key.Render(":debug-log") + s.desc.Render("Tail claws' own log (:logs opens CloudWatch Logs)") + "\n"
 	out += s.key.Render(":clear-history") + s.desc.Render("Clear navigation history") + "\n"
 	out += s.key.Render("Tab") + s.desc.Render("Cycle through suggestions") + "\n"
 	out += s.key.Render("Shift+Tab") + s.desc.Render("Cycle backward") + "\n"
@@ -108,6 +112,8 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render(":q") + s.desc.Render("Quit") + "\n"
 	out += s.key.Render(":login") + s.desc.Render("AWS Console login (claws-login profile)") + "\n"
 	out += s.key.Render(":login <name>") + s.desc.Render("AWS Console login with profile") + "\n"
+	out += s.key.Render(":sso") + s.desc.Render("Browse SSO accounts and roles") + "\n"
+	out += s.key.Render(":sso <name>") + s.desc.Render("Browse SSO accounts of a profile") + "\n"
 	out += s.key.Render(":theme <name>") + s.desc.Render("Change theme (dark/light/nord/dracula/...)") + "\n"
 	out += s.key.Render(":autosave") + s.desc.Render("Toggle config persistence (on/off)") + "\n"
 	out += s.key.Render(":settings") + s.desc.Render("Show current settings") + "\n"
@@ -144,12 +150,14 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render("Subnet") + s.desc.Render("v:VPC e:Instances") + "\n"
 	out += s.key.Render("Instance") + s.desc.Render("v:VPC u:Subnet g:SecurityGroups") + "\n"
 	out += s.key.Render("SecurityGroup") + s.desc.Render("v:VPC e:Instances") + "\n"
+	out += s.key.Render("L/l") + s.desc.Render("Open the resource's CloudWatch Logs (l too, unless the resource uses l itself)") + "\n"
 
 	// Global
 	out += "\n" + s.section.Render("Global") + "\n"
 	out += s.key.Render("R") + s.desc.Render("Switch AWS region") + "\n"
 	out += s.key.Render("P") + s.desc.Render("Switch AWS profile") + "\n"
 	out += s.key.Render("Ctrl+E") + s.desc.Render("Toggle compact header") + "\n"
+	out += s.key.Render("Ctrl+T") + s.desc.Render("Toggle performance HUD") + "\n"
 	out += s.key.Render("?") + s.desc.Render("Show this help") + "\n"
 
 	// Command examples
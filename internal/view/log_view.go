@@ -178,8 +178,8 @@ func (v *LogView) doFetchLogs(startTime, endTime int64, older bool) tea.Msg {
 	defer cancel()
 
 	input := &cloudwatchlogs.FilterLogEventsInput{
-		LogGroupName: appaws.StringPtr(v.logGroupName),
-		Limit:        appaws.Int32Ptr(logFetchLimit),
+		LogGroupIdentifier: appaws.StringPtr(v.logGroupName), // name, or ARN for source-account log groups
+		Limit:              appaws.Int32Ptr(logFetchLimit),
 	}
 
 	if v.logStreamName != "" {
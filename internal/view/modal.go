@@ -25,6 +25,7 @@ const (
 	ModalWidthActionMenu    = 60
 	ModalWidthSettings      = 75
 	ModalWidthChat          = 80
+	ModalWidthSSO           = 75
 )
 
 type Modal struct {
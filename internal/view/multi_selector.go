@@ -83,6 +83,10 @@ func (m *MultiSelector[T]) SetItems(items []T) {
 	m.updateViewport()
 }
 
+func (m *MultiSelector[T]) SetTitle(title string) {
+	m.title = title
+}
+
 func (m *MultiSelector[T]) ReloadStyles() {
 	m.styles = newSelectorStyles()
 	m.updateViewport()
@@ -0,0 +1,73 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// PerfStats describes the data loading of a view for the performance HUD
+type PerfStats struct {
+	Loading    bool
+	LastFetch  time.Duration // duration of the last completed fetch
+	FetchCalls int64         // AWS API calls made by the last fetch
+	APICalls   int64         // AWS API calls made by the view so far
+	Items      int
+	Pages      int
+	HasMore    bool
+}
+
+// PerfReporter is implemented by views that report fetch statistics
+type PerfReporter interface {
+	PerfStats() PerfStats
+}
+
+// fetchStats is the timing of one fetch, carried on its result message
+type fetchStats struct {
+	duration time.Duration
+	apiCalls int64
+}
+
+// RenderPerfHUD renders the one-line performance HUD for a view. renderTime
+// is how long the view took to render its previous frame.
+func RenderPerfHUD(v View, renderTime time.Duration) string {
+	parts := []string{ui.AccentStyle().Render("PERF")}
+
+	if pr, ok := v.(PerfReporter); ok {
+		s := pr.PerfStats()
+		switch {
+		case s.Loading:
+			parts = append(parts, "fetch: loading...")
+		case s.LastFetch > 0:
+			parts = append(parts, fmt.Sprintf("fetch: %s (%d API calls)", formatPerfDuration(s.LastFetch), s.FetchCalls))
+		}
+		parts = append(parts, fmt.Sprintf("view: %d API calls", s.APICalls))
+		page := fmt.Sprintf("items: %d", s.Items)
+		if s.Pages > 0 {
+			page += fmt.Sprintf(" in %d pages", s.Pages)
+		}
+		if s.HasMore {
+			page += ", more available"
+		}
+		parts = append(parts, page)
+	} else {
+		parts = append(parts, ui.DimStyle().Render("no fetch stats for this view"))
+	}
+
+	parts = append(parts,
+		fmt.Sprintf("session: %d API calls", aws.TotalAPICalls()),
+		fmt.Sprintf("render: %s", formatPerfDuration(renderTime)),
+	)
+	return strings.Join(parts, ui.DimStyle().Render(" │ "))
+}
+
+// formatPerfDuration shows sub-second durations in ms and longer ones in seconds
+func formatPerfDuration(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%.1fms", float64(d.Microseconds())/1000)
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
@@ -0,0 +1,56 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestRenderPerfHUD(t *testing.T) {
+	rb := NewResourceBrowserWithType(context.Background(), registry.New(), "ec2", "instances")
+	rb.Update(resourcesLoadedMsg{
+		renderer:     &mockRenderer{},
+		resources:    perfTestResources("a", "b", "c"),
+		hasMorePages: true,
+		stats:        fetchStats{duration: 1500 * time.Millisecond, apiCalls: 2},
+	})
+	rb.Update(nextPageLoadedMsg{
+		resources: perfTestResources("d", "e"),
+		stats:     fetchStats{duration: 250 * time.Millisecond, apiCalls: 1},
+	})
+
+	hud := RenderPerfHUD(rb, 3*time.Millisecond)
+	for _, want := range []string{"fetch: 250.0ms (1 API calls)", "items: 5 in 2 pages", "render: 3.0ms"} {
+		if !strings.Contains(hud, want) {
+			t.Errorf("HUD missing %q: %s", want, hud)
+		}
+	}
+	if strings.Contains(hud, "more available") {
+		t.Errorf("HUD should not report more pages: %s", hud)
+	}
+
+	if hud := RenderPerfHUD(NewHelpView(), 0); !strings.Contains(hud, "no fetch stats") {
+		t.Errorf("non-reporting view HUD = %s", hud)
+	}
+}
+
+func perfTestResources(ids ...string) []dao.Resource {
+	resources := make([]dao.Resource, len(ids))
+	for i, id := range ids {
+		resources[i] = &mockResource{id: id, name: id}
+	}
+	return resources
+}
+
+func TestFormatPerfDuration(t *testing.T) {
+	if got := formatPerfDuration(1234 * time.Microsecond); got != "1.2ms" {
+		t.Errorf("formatPerfDuration = %q", got)
+	}
+	if got := formatPerfDuration(2500 * time.Millisecond); got != "2.50s" {
+		t.Errorf("formatPerfDuration = %q", got)
+	}
+}
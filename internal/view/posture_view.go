@@ -0,0 +1,273 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// postureSource is one aggregated source in the security posture view
+type postureSource int
+
+const (
+	postureGuardDuty postureSource = iota
+	postureSecurityHub
+	postureAccessAnalyzer
+	postureRootAccount
+	posturePublicExposure
+	postureSourceCount
+)
+
+var postureSourceNames = [postureSourceCount]string{
+	postureGuardDuty:      "GuardDuty",
+	postureSecurityHub:    "Security Hub Standards",
+	postureAccessAnalyzer: "IAM Access Analyzer",
+	postureRootAccount:    "Root Account",
+	posturePublicExposure: "Public Exposure",
+}
+
+type postureLevel int
+
+const (
+	postureUnknown postureLevel = iota
+	postureOK
+	postureWarn
+	postureFail
+)
+
+const postureLabelWidth = 40
+
+// postureNav is the resource list a row drills into
+type postureNav struct {
+	service     string
+	resource    string
+	filterKey   string
+	filterValue string
+}
+
+// postureRow is one line of a source, e.g. a standard and its score
+type postureRow struct {
+	label string
+	value string
+	level postureLevel
+	nav   *postureNav
+}
+
+type postureSection struct {
+	loading bool
+	err     error
+	rows    []postureRow
+}
+
+type postureStyles struct {
+	title    lipgloss.Style
+	section  lipgloss.Style
+	label    lipgloss.Style
+	selected lipgloss.Style
+}
+
+func newPostureStyles() postureStyles {
+	return postureStyles{
+		title:    ui.TitleStyle(),
+		section:  ui.SectionStyle(),
+		label:    ui.TextStyle(),
+		selected: ui.SelectedStyle(),
+	}
+}
+
+// SecurityPostureView summarizes account security on one screen and links
+// each line to the resource list it was derived from.
+type SecurityPostureView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	width    int
+	height   int
+	spinner  spinner.Model
+	styles   postureStyles
+
+	sections [postureSourceCount]postureSection
+	cursor   int
+}
+
+// NewSecurityPostureView creates a new SecurityPostureView
+func NewSecurityPostureView(ctx context.Context, reg *registry.Registry) *SecurityPostureView {
+	v := &SecurityPostureView{
+		ctx:      ctx,
+		registry: reg,
+		spinner:  ui.NewSpinner(),
+		styles:   newPostureStyles(),
+	}
+	v.resetSections()
+	return v
+}
+
+func (v *SecurityPostureView) resetSections() {
+	for i := range v.sections {
+		v.sections[i] = postureSection{loading: true}
+	}
+	v.cursor = 0
+}
+
+func (v *SecurityPostureView) Init() tea.Cmd {
+	return tea.Batch(append([]tea.Cmd{v.spinner.Tick}, v.loadCmds()...)...)
+}
+
+func (v *SecurityPostureView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case postureLoadedMsg:
+		for _, r := range msg.results {
+			v.sections[r.source] = postureSection{rows: r.rows, err: r.err}
+		}
+		v.cursor = min(v.cursor, max(len(v.rows())-1, 0))
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.isLoading() {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+
+	case tea.KeyPressMsg:
+		return v.handleKeyPress(msg)
+
+	case RefreshMsg, navmsg.ProfilesChangedMsg, navmsg.RegionChangedMsg:
+		v.resetSections()
+		return v, v.Init()
+
+	case ThemeChangedMsg:
+		v.styles = newPostureStyles()
+	}
+	return v, nil
+}
+
+func (v *SecurityPostureView) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "j", "down":
+		if v.cursor < len(v.rows())-1 {
+			v.cursor++
+		}
+	case "k", "up":
+		if v.cursor > 0 {
+			v.cursor--
+		}
+	case "ctrl+r":
+		return v.Update(RefreshMsg{})
+	case "enter", "d":
+		return v.navigate()
+	}
+	return v, nil
+}
+
+// rows returns the selectable rows of all loaded sections in display order
+func (v *SecurityPostureView) rows() []postureRow {
+	var rows []postureRow
+	for _, s := range v.sections {
+		if !s.loading && s.err == nil {
+			rows = append(rows, s.rows...)
+		}
+	}
+	return rows
+}
+
+func (v *SecurityPostureView) navigate() (tea.Model, tea.Cmd) {
+	rows := v.rows()
+	if v.cursor >= len(rows) || rows[v.cursor].nav == nil {
+		return v, nil
+	}
+	nav := rows[v.cursor].nav
+
+	var browser *ResourceBrowser
+	if nav.filterKey != "" {
+		browser = NewResourceBrowserWithFilter(v.ctx, v.registry, nav.service, nav.resource, nav.filterKey, nav.filterValue)
+	} else {
+		browser = NewResourceBrowserWithType(v.ctx, v.registry, nav.service, nav.resource)
+	}
+	return v, func() tea.Msg {
+		return NavigateMsg{View: browser}
+	}
+}
+
+func (v *SecurityPostureView) isLoading() bool {
+	for _, s := range v.sections {
+		if s.loading {
+			return true
+		}
+	}
+	return false
+}
+
+// postureIndicator renders the status marker for a level
+func postureIndicator(level postureLevel) string {
+	switch level {
+	case postureOK:
+		return ui.SuccessStyle().Render("●")
+	case postureWarn:
+		return ui.WarningStyle().Render("●")
+	case postureFail:
+		return ui.DangerStyle().Render("●")
+	default:
+		return ui.DimStyle().Render("○")
+	}
+}
+
+func (v *SecurityPostureView) ViewString() string {
+	s := v.styles
+	var b strings.Builder
+
+	b.WriteString(s.title.Render("Security Posture"))
+	b.WriteString("\n")
+
+	idx := 0
+	for i, section := range v.sections {
+		b.WriteString("\n" + s.section.Render(postureSourceNames[i]) + "\n")
+
+		switch {
+		case section.loading:
+			b.WriteString("  " + v.spinner.View() + " loading...\n")
+		case section.err != nil:
+			b.WriteString("  " + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", section.err)) + "\n")
+		default:
+			for _, row := range section.rows {
+				label := TruncateOrPadString(row.label, postureLabelWidth)
+				line := label + "  " + row.value
+				if idx == v.cursor {
+					line = s.selected.Render(line)
+				} else {
+					line = s.label.Render(label) + "  " + row.value
+				}
+				b.WriteString("  " + postureIndicator(row.level) + " " + line + "\n")
+				idx++
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func (v *SecurityPostureView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *SecurityPostureView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	return nil
+}
+
+func (v *SecurityPostureView) StatusLine() string {
+	return "Security Posture • j/k:row • enter:open source • Ctrl+r:refresh • esc:back"
+}
+
+// CanRefresh implements Refreshable interface
+func (v *SecurityPostureView) CanRefresh() bool {
+	return true
+}
@@ -0,0 +1,495 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	aatypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	gdtypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	shtypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/clawscli/claws/internal/aws"
+)
+
+// postureMaxPages caps paginated finding scans per source
+const postureMaxPages = 10
+
+// postureResult holds the rows loaded for one source
+type postureResult struct {
+	source postureSource
+	rows   []postureRow
+	err    error
+}
+
+// postureLoadedMsg carries results; one API scan may feed several sources
+type postureLoadedMsg struct {
+	results []postureResult
+}
+
+func postureError(err error, sources ...postureSource) postureLoadedMsg {
+	msg := postureLoadedMsg{}
+	for _, s := range sources {
+		msg.results = append(msg.results, postureResult{source: s, err: err})
+	}
+	return msg
+}
+
+func (v *SecurityPostureView) loadCmds() []tea.Cmd {
+	return []tea.Cmd{
+		v.loadGuardDuty,
+		v.loadSecurityHub,
+		v.loadAccessAnalyzer,
+		v.loadRootAccount,
+	}
+}
+
+func (v *SecurityPostureView) loadGuardDuty() tea.Msg {
+	cfg, err := aws.NewConfig(v.ctx)
+	if err != nil {
+		return postureError(err, postureGuardDuty)
+	}
+	client := guardduty.NewFromConfig(cfg)
+
+	detectors, err := client.ListDetectors(v.ctx, &guardduty.ListDetectorsInput{})
+	if err != nil {
+		return postureError(fmt.Errorf("list detectors: %w", err), postureGuardDuty)
+	}
+	if len(detectors.DetectorIds) == 0 {
+		return postureLoadedMsg{results: []postureResult{{source: postureGuardDuty, rows: guardDutyRows("", nil)}}}
+	}
+
+	detectorID := detectors.DetectorIds[0]
+	stats, err := client.GetFindingsStatistics(v.ctx, &guardduty.GetFindingsStatisticsInput{
+		DetectorId: &detectorID,
+		GroupBy:    gdtypes.GroupByTypeSeverity,
+		FindingCriteria: &gdtypes.FindingCriteria{
+			Criterion: map[string]gdtypes.Condition{
+				"service.archived": {Equals: []string{"false"}},
+			},
+		},
+	})
+	if err != nil {
+		return postureError(fmt.Errorf("get findings statistics: %w", err), postureGuardDuty)
+	}
+
+	counts := make(map[string]int)
+	if stats.FindingStatistics != nil {
+		for _, s := range stats.FindingStatistics.GroupedBySeverity {
+			if s.Severity != nil && s.TotalFindings != nil {
+				counts[guardDutySeverity(*s.Severity)] += int(*s.TotalFindings)
+			}
+		}
+	}
+	return postureLoadedMsg{results: []postureResult{{source: postureGuardDuty, rows: guardDutyRows(detectorID, counts)}}}
+}
+
+// guardDutySeverity buckets numeric severities the way the console labels them
+func guardDutySeverity(sev float64) string {
+	switch {
+	case sev >= 9:
+		return "critical"
+	case sev >= 7:
+		return "high"
+	case sev >= 4:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+var severityOrder = []string{"critical", "high", "medium", "low"}
+
+func guardDutyRows(detectorID string, counts map[string]int) []postureRow {
+	if detectorID == "" {
+		return []postureRow{{
+			label: "Detector",
+			value: "not enabled in this region",
+			level: postureWarn,
+			nav:   &postureNav{service: "guardduty", resource: "detectors"},
+		}}
+	}
+
+	var parts []string
+	for _, sev := range severityOrder {
+		if n := counts[sev]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, sev))
+		}
+	}
+
+	row := postureRow{
+		label: "Active findings",
+		value: "none",
+		level: postureOK,
+		nav:   &postureNav{service: "guardduty", resource: "findings", filterKey: "DetectorId", filterValue: detectorID},
+	}
+	switch {
+	case counts["critical"] > 0 || counts["high"] > 0:
+		row.level = postureFail
+	case counts["medium"] > 0 || counts["low"] > 0:
+		row.level = postureWarn
+	}
+	if len(parts) > 0 {
+		row.value = strings.Join(parts, " · ")
+	}
+	return []postureRow{row}
+}
+
+func (v *SecurityPostureView) loadSecurityHub() tea.Msg {
+	cfg, err := aws.NewConfig(v.ctx)
+	if err != nil {
+		return postureError(err, postureSecurityHub)
+	}
+	client := securityhub.NewFromConfig(cfg)
+
+	enabled, err := aws.Paginate(v.ctx, func(token *string) ([]shtypes.StandardsSubscription, *string, error) {
+		out, err := client.GetEnabledStandards(v.ctx, &securityhub.GetEnabledStandardsInput{NextToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("get enabled standards: %w", err)
+		}
+		return out.StandardsSubscriptions, out.NextToken, nil
+	})
+	if err != nil {
+		return postureError(err, postureSecurityHub)
+	}
+
+	names := make(map[string]string)
+	standards, err := aws.Paginate(v.ctx, func(token *string) ([]shtypes.Standard, *string, error) {
+		out, err := client.DescribeStandards(v.ctx, &securityhub.DescribeStandardsInput{NextToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("describe standards: %w", err)
+		}
+		return out.Standards, out.NextToken, nil
+	})
+	if err == nil {
+		for _, s := range standards {
+			names[aws.Str(s.StandardsArn)] = aws.Str(s.Name)
+		}
+	}
+
+	var scores []standardScore
+	for _, sub := range enabled {
+		if sub.StandardsStatus != shtypes.StandardsStatusReady {
+			continue
+		}
+		arn := aws.Str(sub.StandardsArn)
+		findings, truncated, err := v.standardFindings(client, standardsID(arn))
+		if err != nil {
+			return postureError(err, postureSecurityHub)
+		}
+		score := controlScore(findings)
+		score.name = names[arn]
+		if score.name == "" {
+			score.name = standardsID(arn)
+		}
+		score.truncated = truncated
+		scores = append(scores, score)
+	}
+
+	return postureLoadedMsg{results: []postureResult{{source: postureSecurityHub, rows: securityHubRows(scores)}}}
+}
+
+// standardsID returns the resource part of a standards ARN, which is the
+// value findings carry in Compliance.AssociatedStandards
+func standardsID(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) == 6 {
+		return parts[5]
+	}
+	return arn
+}
+
+// standardFindings returns active control findings for a standard, up to postureMaxPages
+func (v *SecurityPostureView) standardFindings(client *securityhub.Client, standardID string) ([]shtypes.AwsSecurityFinding, bool, error) {
+	filters := &shtypes.AwsSecurityFindingFilters{
+		ComplianceAssociatedStandardsId: []shtypes.StringFilter{
+			{Comparison: shtypes.StringFilterComparisonEquals, Value: &standardID},
+		},
+		RecordState: []shtypes.StringFilter{
+			{Comparison: shtypes.StringFilterComparisonEquals, Value: aws.StringPtr("ACTIVE")},
+		},
+	}
+
+	var findings []shtypes.AwsSecurityFinding
+	var token *string
+	for range postureMaxPages {
+		out, err := client.GetFindings(v.ctx, &securityhub.GetFindingsInput{
+			Filters:    filters,
+			MaxResults: aws.Int32Ptr(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("get findings for %s: %w", standardID, err)
+		}
+		findings = append(findings, out.Findings...)
+		if out.NextToken == nil || *out.NextToken == "" {
+			return findings, false, nil
+		}
+		token = out.NextToken
+	}
+	return findings, true, nil
+}
+
+// standardScore is the share of passed controls for one standard
+type standardScore struct {
+	name      string
+	passed    int
+	total     int
+	truncated bool
+}
+
+// controlScore counts a control as failed if any of its findings failed, and
+// as passed if all evaluated findings passed; controls without data are skipped
+func controlScore(findings []shtypes.AwsSecurityFinding) standardScore {
+	status := make(map[string]bool) // control ID -> passed
+	for _, f := range findings {
+		if f.Compliance == nil {
+			continue
+		}
+		id := aws.Str(f.Compliance.SecurityControlId)
+		if id == "" {
+			id = aws.Str(f.GeneratorId)
+		}
+		switch f.Compliance.Status {
+		case shtypes.ComplianceStatusFailed:
+			status[id] = false
+		case shtypes.ComplianceStatusPassed:
+			if _, seen := status[id]; !seen {
+				status[id] = true
+			}
+		}
+	}
+
+	score := standardScore{total: len(status)}
+	for _, passed := range status {
+		if passed {
+			score.passed++
+		}
+	}
+	return score
+}
+
+func securityHubRows(scores []standardScore) []postureRow {
+	if len(scores) == 0 {
+		return []postureRow{{
+			label: "Standards",
+			value: "no standards enabled",
+			level: postureWarn,
+			nav:   &postureNav{service: "securityhub", resource: "findings"},
+		}}
+	}
+
+	sort.Slice(scores, func(i, j int) bool { return scores[i].name < scores[j].name })
+
+	rows := make([]postureRow, 0, len(scores))
+	for _, s := range scores {
+		row := postureRow{
+			label: s.name,
+			value: "no control data yet",
+			level: postureUnknown,
+			nav:   &postureNav{service: "securityhub", resource: "findings"},
+		}
+		if s.total > 0 {
+			pct := s.passed * 100 / s.total
+			approx := ""
+			if s.truncated {
+				approx = "~"
+			}
+			row.value = fmt.Sprintf("%s%d%% (%d/%d controls passed)", approx, pct, s.passed, s.total)
+			switch {
+			case pct >= 90:
+				row.level = postureOK
+			case pct >= 70:
+				row.level = postureWarn
+			default:
+				row.level = postureFail
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (v *SecurityPostureView) loadAccessAnalyzer() tea.Msg {
+	cfg, err := aws.NewConfig(v.ctx)
+	if err != nil {
+		return postureError(err, postureAccessAnalyzer, posturePublicExposure)
+	}
+	client := accessanalyzer.NewFromConfig(cfg)
+
+	analyzers, err := aws.Paginate(v.ctx, func(token *string) ([]aatypes.AnalyzerSummary, *string, error) {
+		out, err := client.ListAnalyzers(v.ctx, &accessanalyzer.ListAnalyzersInput{NextToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("list analyzers: %w", err)
+		}
+		return out.Analyzers, out.NextToken, nil
+	})
+	if err != nil {
+		return postureError(err, postureAccessAnalyzer, posturePublicExposure)
+	}
+
+	var scans []analyzerScan
+	for _, a := range analyzers {
+		if a.Status != aatypes.AnalyzerStatusActive || !isExternalAccessAnalyzer(a.Type) {
+			continue
+		}
+		scan, err := v.scanAnalyzer(client, a)
+		if err != nil {
+			return postureError(err, postureAccessAnalyzer, posturePublicExposure)
+		}
+		scans = append(scans, scan)
+	}
+
+	return postureLoadedMsg{results: []postureResult{
+		{source: postureAccessAnalyzer, rows: accessAnalyzerRows(scans)},
+		{source: posturePublicExposure, rows: publicExposureRows(scans)},
+	}}
+}
+
+func isExternalAccessAnalyzer(t aatypes.Type) bool {
+	return t == aatypes.TypeAccount || t == aatypes.TypeOrganization
+}
+
+// analyzerScan summarizes the active findings of one external access analyzer
+type analyzerScan struct {
+	name      string
+	arn       string
+	active    int
+	public    map[string]int // resource type -> count
+	truncated bool
+}
+
+func (v *SecurityPostureView) scanAnalyzer(client *accessanalyzer.Client, a aatypes.AnalyzerSummary) (analyzerScan, error) {
+	scan := analyzerScan{name: aws.Str(a.Name), arn: aws.Str(a.Arn), public: make(map[string]int)}
+
+	var token *string
+	for range postureMaxPages {
+		out, err := client.ListFindings(v.ctx, &accessanalyzer.ListFindingsInput{
+			AnalyzerArn: a.Arn,
+			Filter: map[string]aatypes.Criterion{
+				"status": {Eq: []string{string(aatypes.FindingStatusActive)}},
+			},
+			NextToken: token,
+		})
+		if err != nil {
+			return scan, fmt.Errorf("list findings for %s: %w", scan.name, err)
+		}
+		scan.active += len(out.Findings)
+		for _, f := range out.Findings {
+			if f.IsPublic != nil && *f.IsPublic {
+				scan.public[string(f.ResourceType)]++
+			}
+		}
+		if out.NextToken == nil || *out.NextToken == "" {
+			return scan, nil
+		}
+		token = out.NextToken
+	}
+	scan.truncated = true
+	return scan, nil
+}
+
+func findingsNav(arn string) *postureNav {
+	return &postureNav{service: "accessanalyzer", resource: "findings", filterKey: "AnalyzerArn", filterValue: arn}
+}
+
+func accessAnalyzerRows(scans []analyzerScan) []postureRow {
+	if len(scans) == 0 {
+		return []postureRow{{
+			label: "Analyzer",
+			value: "no active external access analyzer",
+			level: postureWarn,
+			nav:   &postureNav{service: "accessanalyzer", resource: "analyzers"},
+		}}
+	}
+
+	rows := make([]postureRow, 0, len(scans))
+	for _, s := range scans {
+		more := ""
+		if s.truncated {
+			more = "+"
+		}
+		row := postureRow{
+			label: s.name,
+			value: fmt.Sprintf("%d%s active findings", s.active, more),
+			level: postureOK,
+			nav:   findingsNav(s.arn),
+		}
+		if s.active > 0 {
+			row.level = postureWarn
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func publicExposureRows(scans []analyzerScan) []postureRow {
+	if len(scans) == 0 {
+		return []postureRow{{
+			label: "Public resources",
+			value: "unknown (requires an Access Analyzer)",
+			level: postureUnknown,
+			nav:   &postureNav{service: "accessanalyzer", resource: "analyzers"},
+		}}
+	}
+
+	var rows []postureRow
+	for _, s := range scans {
+		resourceTypes := make([]string, 0, len(s.public))
+		for t := range s.public {
+			resourceTypes = append(resourceTypes, t)
+		}
+		sort.Strings(resourceTypes)
+		for _, t := range resourceTypes {
+			rows = append(rows, postureRow{
+				label: t,
+				value: fmt.Sprintf("%d public", s.public[t]),
+				level: postureFail,
+				nav:   findingsNav(s.arn),
+			})
+		}
+	}
+	if len(rows) == 0 {
+		return []postureRow{{
+			label: "Public resources",
+			value: "none found",
+			level: postureOK,
+			nav:   findingsNav(scans[0].arn),
+		}}
+	}
+	return rows
+}
+
+func (v *SecurityPostureView) loadRootAccount() tea.Msg {
+	cfg, err := aws.NewConfig(v.ctx)
+	if err != nil {
+		return postureError(err, postureRootAccount)
+	}
+
+	out, err := iam.NewFromConfig(cfg).GetAccountSummary(v.ctx, &iam.GetAccountSummaryInput{})
+	if err != nil {
+		return postureError(fmt.Errorf("get account summary: %w", err), postureRootAccount)
+	}
+	return postureLoadedMsg{results: []postureResult{{source: postureRootAccount, rows: rootAccountRows(out.SummaryMap)}}}
+}
+
+func rootAccountRows(summary map[string]int32) []postureRow {
+	mfa := postureRow{label: "MFA", value: "enabled", level: postureOK}
+	if summary["AccountMFAEnabled"] != 1 {
+		mfa.value = "not enabled"
+		mfa.level = postureFail
+	}
+
+	keys := postureRow{label: "Access keys", value: "none", level: postureOK}
+	if n := summary["AccountAccessKeysPresent"]; n > 0 {
+		keys.value = "present"
+		keys.level = postureFail
+	}
+
+	return []postureRow{mfa, keys}
+}
@@ -0,0 +1,133 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	shtypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestGuardDutyRows(t *testing.T) {
+	rows := guardDutyRows("", nil)
+	if len(rows) != 1 || rows[0].level != postureWarn || rows[0].nav.resource != "detectors" {
+		t.Errorf("no detector rows = %+v", rows)
+	}
+
+	rows = guardDutyRows("det-1", map[string]int{guardDutySeverity(8.0): 2, guardDutySeverity(5.0): 3})
+	if rows[0].value != "2 high · 3 medium" || rows[0].level != postureFail {
+		t.Errorf("row = %+v", rows[0])
+	}
+	if nav := rows[0].nav; nav.filterKey != "DetectorId" || nav.filterValue != "det-1" {
+		t.Errorf("nav = %+v", nav)
+	}
+
+	rows = guardDutyRows("det-1", map[string]int{})
+	if rows[0].value != "none" || rows[0].level != postureOK {
+		t.Errorf("clean row = %+v", rows[0])
+	}
+}
+
+func TestControlScore(t *testing.T) {
+	finding := func(control string, status shtypes.ComplianceStatus) shtypes.AwsSecurityFinding {
+		return shtypes.AwsSecurityFinding{Compliance: &shtypes.Compliance{SecurityControlId: aws.String(control), Status: status}}
+	}
+
+	score := controlScore([]shtypes.AwsSecurityFinding{
+		finding("S3.1", shtypes.ComplianceStatusPassed),
+		finding("S3.1", shtypes.ComplianceStatusFailed), // any failure fails the control
+		finding("IAM.1", shtypes.ComplianceStatusPassed),
+		finding("IAM.1", shtypes.ComplianceStatusPassed),
+		finding("EC2.2", shtypes.ComplianceStatusNotAvailable), // no data
+	})
+	if score.passed != 1 || score.total != 2 {
+		t.Errorf("controlScore = %+v, want 1/2", score)
+	}
+
+	rows := securityHubRows([]standardScore{{name: "CIS", passed: 45, total: 50, truncated: true}})
+	if rows[0].value != "~90% (45/50 controls passed)" || rows[0].level != postureOK {
+		t.Errorf("row = %+v", rows[0])
+	}
+}
+
+func TestStandardsID(t *testing.T) {
+	got := standardsID("arn:aws:securityhub:us-east-1::standards/aws-foundational-security-best-practices/v/1.0.0")
+	if got != "standards/aws-foundational-security-best-practices/v/1.0.0" {
+		t.Errorf("standardsID = %q", got)
+	}
+}
+
+func TestAccessAnalyzerAndPublicExposureRows(t *testing.T) {
+	if rows := publicExposureRows(nil); rows[0].level != postureUnknown {
+		t.Errorf("no analyzer exposure row = %+v", rows[0])
+	}
+
+	scans := []analyzerScan{{
+		name:   "account",
+		arn:    "arn:aws:access-analyzer:us-east-1:123456789012:analyzer/account",
+		active: 3,
+		public: map[string]int{"AWS::S3::Bucket": 2, "AWS::IAM::Role": 1},
+	}}
+
+	rows := accessAnalyzerRows(scans)
+	if rows[0].value != "3 active findings" || rows[0].level != postureWarn {
+		t.Errorf("analyzer row = %+v", rows[0])
+	}
+
+	rows = publicExposureRows(scans)
+	if len(rows) != 2 || rows[0].label != "AWS::IAM::Role" || rows[1].value != "2 public" {
+		t.Errorf("exposure rows = %+v", rows)
+	}
+	if rows[0].nav.filterValue != scans[0].arn {
+		t.Errorf("exposure nav = %+v", rows[0].nav)
+	}
+}
+
+func TestRootAccountRows(t *testing.T) {
+	rows := rootAccountRows(map[string]int32{"AccountMFAEnabled": 1})
+	if rows[0].level != postureOK || rows[1].level != postureOK {
+		t.Errorf("secure root rows = %+v", rows)
+	}
+
+	rows = rootAccountRows(map[string]int32{"AccountMFAEnabled": 0, "AccountAccessKeysPresent": 1})
+	if rows[0].level != postureFail || rows[1].value != "present" {
+		t.Errorf("insecure root rows = %+v", rows)
+	}
+}
+
+func TestSecurityPostureViewNavigation(t *testing.T) {
+	v := NewSecurityPostureView(context.Background(), registry.New())
+
+	v.Update(postureLoadedMsg{results: []postureResult{
+		{source: postureRootAccount, rows: rootAccountRows(map[string]int32{"AccountMFAEnabled": 1})},
+		{source: postureGuardDuty, rows: guardDutyRows("det-1", map[string]int{"high": 1})},
+		{source: postureSecurityHub, err: errors.New("not subscribed")},
+	}})
+
+	view := v.ViewString()
+	for _, want := range []string{"Security Posture", "1 high", "Error: not subscribed", "loading..."} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+
+	// GuardDuty is listed before the root account, so the first row navigates
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected navigation from GuardDuty row")
+	}
+	if _, ok := cmd().(NavigateMsg); !ok {
+		t.Error("expected NavigateMsg")
+	}
+
+	// Root account rows have no source list
+	v.Update(tea.KeyPressMsg{Text: "j", Code: 'j'})
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); cmd != nil {
+		t.Error("root account row should not navigate")
+	}
+}
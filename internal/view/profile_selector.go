@@ -208,33 +208,18 @@ func (p *ProfileSelector) ssoLoginCurrentProfile() (tea.Model, tea.Cmd) {
 		return p, nil
 	}
 
-	if config.Global().ReadOnly() && !action.IsExecAllowedInReadOnly(action.ActionNameSSOLogin) {
-		p.loginResult = &loginResultMsg{
-			profileID: profile.id,
-			success:   false,
-			err:       fmt.Errorf("SSO login denied: read-only mode"),
-		}
-		p.updateExtraHeight()
-		return p, nil
-	}
-
-	awsPath, err := action.ResolveExecutable("aws")
+	execCmd, err := ssoLoginExec(profile.id)
 	if err != nil {
 		p.loginResult = &loginResultMsg{
 			profileID: profile.id,
 			success:   false,
-			err:       fmt.Errorf("aws CLI not found in PATH: %w", err),
+			err:       err,
 		}
 		p.updateExtraHeight()
 		return p, nil
 	}
 
 	profileID := profile.id
-	execCmd := &action.SimpleExec{
-		Args:       []string{awsPath, "sso", "login", "--profile", profileID},
-		ActionName: action.ActionNameSSOLogin,
-		SkipAWSEnv: true,
-	}
 	return p, tea.Exec(execCmd, func(err error) tea.Msg {
 		if err != nil {
 			return loginResultMsg{profileID: profileID, success: false, err: err}
@@ -31,17 +31,27 @@ type regionItem string
 func (r regionItem) GetID() string    { return string(r) }
 func (r regionItem) GetLabel() string { return string(r) }
 
+const regionSelectorTitle = "Select Regions"
+
 type RegionSelector struct {
-	ctx      context.Context
-	selector *MultiSelector[regionItem]
-	regions  []regionItem
+	ctx       context.Context
+	selector  *MultiSelector[regionItem]
+	regions   []regionItem
+	available []string // All regions, sorted
+	favorites []string // Configured favorite regions, in order
+	showAll   bool     // Show every region rather than only favorites
 }
 
 func NewRegionSelector(ctx context.Context) *RegionSelector {
-	return &RegionSelector{
-		ctx:      ctx,
-		selector: NewMultiSelector[regionItem]("Select Regions", config.Global().Regions()),
+	favorites := config.File().FavoriteRegions()
+	r := &RegionSelector{
+		ctx:       ctx,
+		selector:  NewMultiSelector[regionItem](regionSelectorTitle, config.Global().Regions()),
+		favorites: favorites,
+		showAll:   len(favorites) == 0,
 	}
+	r.updateTitle()
+	return r
 }
 
 func (r *RegionSelector) Init() tea.Cmd {
@@ -85,12 +95,16 @@ func (r *RegionSelector) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case regionsLoadedMsg:
 		sortRegions(msg.regions)
-		r.regions = make([]regionItem, len(msg.regions))
-		for i, region := range msg.regions {
-			r.regions[i] = regionItem(region)
-		}
-		r.selector.SetItems(r.regions)
+		r.available = msg.regions
+		r.setItems()
 		return r, nil
+	case tea.KeyPressMsg:
+		if msg.String() == "f" && len(r.favorites) > 0 && !r.selector.FilterActive() {
+			r.showAll = !r.showAll
+			r.updateTitle()
+			r.setItems()
+			return r, nil
+		}
 	case ThemeChangedMsg:
 		r.selector.ReloadStyles()
 		return r, nil
@@ -103,6 +117,55 @@ func (r *RegionSelector) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return r, cmd
 }
 
+// setItems lists favorites first, in configured order. Unless showAll is set,
+// only favorites and already-selected regions are listed, so a selection is
+// never dropped by hiding it.
+func (r *RegionSelector) setItems() {
+	regions := orderRegions(r.available, r.favorites, r.selector.Selected(), r.showAll)
+	r.regions = make([]regionItem, len(regions))
+	for i, region := range regions {
+		r.regions[i] = regionItem(region)
+	}
+	r.selector.SetItems(r.regions)
+}
+
+func (r *RegionSelector) updateTitle() {
+	switch {
+	case len(r.favorites) == 0:
+		r.selector.SetTitle(regionSelectorTitle)
+	case r.showAll:
+		r.selector.SetTitle(regionSelectorTitle + " (all)")
+	default:
+		r.selector.SetTitle(regionSelectorTitle + " (favorites)")
+	}
+}
+
+// orderRegions returns favorites followed by the remaining available regions.
+// Without all, the remainder is limited to selected regions. Favorites not in
+// available are kept only when available is empty (region listing failed).
+func orderRegions(available, favorites []string, selected map[string]bool, all bool) []string {
+	known := make(map[string]bool, len(available))
+	for _, region := range available {
+		known[region] = true
+	}
+
+	result := make([]string, 0, len(available))
+	seen := make(map[string]bool, len(favorites))
+	for _, region := range favorites {
+		if seen[region] || (len(available) > 0 && !known[region]) {
+			continue
+		}
+		seen[region] = true
+		result = append(result, region)
+	}
+	for _, region := range available {
+		if !seen[region] && (all || selected[region]) {
+			result = append(result, region)
+		}
+	}
+	return result
+}
+
 func (r *RegionSelector) applySelection() (tea.Model, tea.Cmd) {
 	selected := r.selector.SelectedItems()
 	if len(selected) == 0 {
@@ -138,7 +201,15 @@ func (r *RegionSelector) StatusLine() string {
 	if r.selector.FilterActive() {
 		return "Type to filter • Enter confirm • Esc cancel"
 	}
-	return "Space:toggle • a:all • n:none • Enter:apply • " + strings.Repeat("●", count) + " selected"
+	toggle := ""
+	if len(r.favorites) > 0 {
+		if r.showAll {
+			toggle = "f:favorites • "
+		} else {
+			toggle = "f:all regions • "
+		}
+	}
+	return "Space:toggle • a:all • n:none • " + toggle + "Enter:apply • " + strings.Repeat("●", count) + " selected"
 }
 
 func (r *RegionSelector) HasActiveInput() bool {
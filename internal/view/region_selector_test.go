@@ -2,6 +2,8 @@ package view
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
@@ -67,3 +69,69 @@ func TestRegionSelectorEmptyFilter(t *testing.T) {
 		t.Errorf("Expected cursor >= 0 after clear, got %d", selector.selector.Cursor())
 	}
 }
+
+func TestOrderRegions(t *testing.T) {
+	available := []string{"us-east-1", "us-west-2", "eu-west-1", "ap-northeast-1"}
+	favorites := []string{"ap-northeast-1", "us-east-1", "xx-nowhere-1"}
+
+	tests := []struct {
+		name      string
+		available []string
+		selected  map[string]bool
+		all       bool
+		want      []string
+	}{
+		{
+			name:      "favorites only",
+			available: available,
+			want:      []string{"ap-northeast-1", "us-east-1"},
+		},
+		{
+			name:      "favorites keep selected regions",
+			available: available,
+			selected:  map[string]bool{"eu-west-1": true},
+			want:      []string{"ap-northeast-1", "us-east-1", "eu-west-1"},
+		},
+		{
+			name:      "all with favorites first",
+			available: available,
+			all:       true,
+			want:      []string{"ap-northeast-1", "us-east-1", "us-west-2", "eu-west-1"},
+		},
+		{
+			name: "listing failed",
+			want: favorites,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := orderRegions(tt.available, favorites, tt.selected, tt.all)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("orderRegions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionSelectorFavoritesToggle(t *testing.T) {
+	selector := NewRegionSelector(context.Background())
+	selector.favorites = []string{"eu-west-1"}
+	selector.showAll = false
+	selector.SetSize(100, 50)
+
+	selector.Update(regionsLoadedMsg{regions: []string{"us-east-1", "us-west-2", "eu-west-1"}})
+	if !slices.Contains(selector.regions, "eu-west-1") || slices.Contains(selector.regions, "us-west-2") {
+		t.Fatalf("favorites view regions = %v", selector.regions)
+	}
+
+	selector.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	if !selector.showAll || len(selector.regions) != 3 || selector.regions[0] != "eu-west-1" {
+		t.Fatalf("all view regions = %v, want 3 with eu-west-1 first", selector.regions)
+	}
+
+	selector.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	if selector.showAll {
+		t.Error("second f should return to favorites")
+	}
+}
@@ -0,0 +1,122 @@
+package view
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/recording"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// replayViewStyles holds cached lipgloss styles for performance
+type replayViewStyles struct {
+	title lipgloss.Style
+	meta  lipgloss.Style
+}
+
+func newReplayViewStyles() replayViewStyles {
+	return replayViewStyles{
+		title: ui.TitleStyle(),
+		meta:  ui.DimStyle(),
+	}
+}
+
+// ReplayView steps through a recorded session read-only. Screens are shown
+// as captured; nothing is fetched from AWS.
+type ReplayView struct {
+	rec    *recording.Recording
+	step   int
+	styles replayViewStyles
+	vp     ViewportState
+}
+
+// NewReplayView creates a ReplayView positioned at the first step
+func NewReplayView(rec *recording.Recording) *ReplayView {
+	return &ReplayView{
+		rec:    rec,
+		styles: newReplayViewStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *ReplayView) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (v *ReplayView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ThemeChangedMsg:
+		v.styles = newReplayViewStyles()
+		return v, nil
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "n", "right", "l", "space", "enter":
+			v.goTo(v.step + 1)
+			return v, nil
+		case "p", "left", "h", "backspace":
+			v.goTo(v.step - 1)
+			return v, nil
+		case "home":
+			v.goTo(0)
+			return v, nil
+		case "end":
+			v.goTo(len(v.rec.Steps) - 1)
+			return v, nil
+		}
+	}
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *ReplayView) goTo(step int) {
+	step = max(0, min(step, len(v.rec.Steps)-1))
+	if step == v.step {
+		return
+	}
+	v.step = step
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.rec.Steps[v.step].Screen)
+		v.vp.Model.GotoTop()
+	}
+}
+
+// Step returns the index of the current step
+func (v *ReplayView) Step() int {
+	return v.step
+}
+
+// ViewString returns the view content as a string
+func (v *ReplayView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	s := v.rec.Steps[v.step]
+	header := v.styles.title.Render(fmt.Sprintf("Replay %d/%d", v.step+1, len(v.rec.Steps)))
+	meta := s.Title
+	if path := s.Path(); path != "" {
+		meta = path + " • " + meta
+	}
+	meta += " • " + s.Time.Format("2006-01-02 15:04:05")
+	return header + " " + v.styles.meta.Render(meta) + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *ReplayView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ReplayView) SetSize(width, height int) tea.Cmd {
+	v.vp.SetSize(width, height-1)
+	v.vp.Model.SetContent(v.rec.Steps[v.step].Screen)
+	return nil
+}
+
+// StatusLine implements View
+func (v *ReplayView) StatusLine() string {
+	return "REPLAY (read-only) • n/→:next p/←:prev j/k:scroll q:quit"
+}
@@ -0,0 +1,57 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/recording"
+)
+
+func newTestRecording() *recording.Recording {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &recording.Recording{
+		Version: recording.FormatVersion,
+		Steps: []recording.Step{
+			{Time: ts, Title: "Services", Screen: "service list"},
+			{Time: ts, Service: "ec2", ResourceType: "instances", Title: "12 instances", Screen: "instance table"},
+			{Time: ts, Service: "ec2", ResourceType: "instances", ResourceID: "i-1", Title: "i-1", Screen: "instance detail"},
+		},
+	}
+}
+
+func TestReplayViewStepsThroughRecording(t *testing.T) {
+	v := NewReplayView(newTestRecording())
+	v.SetSize(80, 20)
+
+	if out := v.ViewString(); !strings.Contains(out, "Replay 1/3") || !strings.Contains(out, "service list") {
+		t.Errorf("first step not shown: %q", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	out := v.ViewString()
+	if !strings.Contains(out, "Replay 2/3") || !strings.Contains(out, "ec2/instances • 12 instances") || !strings.Contains(out, "instance table") {
+		t.Errorf("second step not shown: %q", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnd})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	if v.Step() != 2 {
+		t.Errorf("Step() = %d, want to stay on the last step", v.Step())
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyHome})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if v.Step() != 0 {
+		t.Errorf("Step() = %d, want to stay on the first step", v.Step())
+	}
+}
+
+func TestReplayViewStatusLine(t *testing.T) {
+	v := NewReplayView(newTestRecording())
+	if !strings.Contains(v.StatusLine(), "read-only") {
+		t.Errorf("StatusLine() = %q, want read-only marker", v.StatusLine())
+	}
+}
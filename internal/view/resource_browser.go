@@ -17,6 +17,7 @@ import (
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/metrics"
+	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
@@ -72,6 +73,7 @@ type ResourceBrowser struct {
 	filtered  []dao.Resource
 	loading   bool
 	err       error
+	errState  errorState // Classification of err, set with it
 	width     int
 	height    int
 
@@ -93,6 +95,7 @@ type ResourceBrowser struct {
 	// Auto-reload
 	autoReload         bool
 	autoReloadInterval time.Duration
+	autoReloadDone     func([]dao.Resource) bool // Stops auto-reload once true, if set
 
 	// Pagination (for PaginatedDAO)
 	nextPageToken       string
@@ -100,7 +103,16 @@ type ResourceBrowser struct {
 	nextMultiPageTokens map[profileRegionKey]string
 	hasMorePages        bool
 	isLoadingMore       bool
-	pageSize            int
+	capped              bool // Pagination stopped at the configured max_items
+
+	// Remaining pages of a StreamingDAO listing
+	stream *resourceStream
+
+	// In-flight fetches: scopeCtx is cancelled when the view is left,
+	// loadCancel when a load is replaced by another
+	scopeCtx    context.Context
+	scopeCancel context.CancelFunc
+	loadCancel  context.CancelFunc
 
 	// Sorting
 	sortColumn    int  // column index to sort by (-1 = no sort)
@@ -125,6 +137,11 @@ type ResourceBrowser struct {
 
 	// List-level toggles (e.g., show resolved findings)
 	toggleStates map[string]bool
+
+	// Performance HUD
+	apiCalls    *aws.APICallCounter
+	lastFetch   fetchStats
+	pagesLoaded int
 }
 
 // NewResourceBrowser creates a new ResourceBrowser
@@ -157,6 +174,14 @@ func NewResourceBrowserWithAutoReload(ctx context.Context, reg *registry.Registr
 	return rb
 }
 
+// NewResourceBrowserFollowing creates an auto-reloading ResourceBrowser for msg,
+// which stops reloading once msg.Done reports true for the loaded resources
+func NewResourceBrowserFollowing(ctx context.Context, reg *registry.Registry, msg navmsg.FollowResourcesMsg) *ResourceBrowser {
+	rb := NewResourceBrowserWithAutoReload(ctx, reg, msg.Service, msg.ResourceType, msg.FilterField, msg.FilterValue, DefaultAutoReloadInterval)
+	rb.autoReloadDone = msg.Done
+	return rb
+}
+
 func newResourceBrowser(ctx context.Context, reg *registry.Registry, service, resourceType string) *ResourceBrowser {
 	ti := textinput.New()
 	ti.Placeholder = FilterPlaceholder
@@ -166,8 +191,10 @@ func newResourceBrowser(ctx context.Context, reg *registry.Registry, service, re
 	hp := NewHeaderPanel()
 	hp.SetWidth(120) // Default width until SetSize is called
 
+	apiCalls := &aws.APICallCounter{}
+
 	return &ResourceBrowser{
-		ctx:           ctx,
+		ctx:           aws.WithAPICallCounter(ctx, apiCalls),
 		registry:      reg,
 		service:       service,
 		resourceType:  resourceType,
@@ -177,16 +204,16 @@ func newResourceBrowser(ctx context.Context, reg *registry.Registry, service, re
 		headerPanel:   hp,
 		spinner:       ui.NewSpinner(),
 		styles:        newResourceBrowserStyles(),
-		pageSize:      100,
 		sortColumn:    -1,
 		sortAscending: true,
 		toggleStates:  make(map[string]bool),
+		apiCalls:      apiCalls,
 	}
 }
 
 // Init implements tea.Model
 func (r *ResourceBrowser) Init() tea.Cmd {
-	cmds := []tea.Cmd{r.loadResources, r.spinner.Tick}
+	cmds := []tea.Cmd{r.loadResourcesCmd(), r.spinner.Tick}
 	if r.autoReload {
 		cmds = append(cmds, r.tickCmd())
 	}
@@ -211,6 +238,8 @@ func (r *ResourceBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return r.handleResourcesLoaded(msg)
 	case nextPageLoadedMsg:
 		return r.handleNextPageLoaded(msg)
+	case streamPageMsg:
+		return r.handleStreamPage(msg)
 	case resourcesErrorMsg:
 		return r.handleResourcesError(msg)
 	case metricsLoadedMsg:
@@ -263,13 +292,18 @@ func (r *ResourceBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Check if we should load more pages (infinite scroll)
 	if r.shouldLoadNextPage() {
-		r.isLoadingMore = true
-		return r, r.loadNextPage
+		return r, r.loadNextPageCmd()
 	}
 
 	return r, nil
 }
 
+// renderError explains the load error by category, with a targeted hint
+// and the keys that act on it, rather than only showing the raw SDK error
+func (r *ResourceBrowser) renderError() string {
+	return r.errState.render(r.err)
+}
+
 // ViewString returns the view content as a string
 func (r *ResourceBrowser) ViewString() string {
 	if r.loading {
@@ -279,13 +313,12 @@ func (r *ResourceBrowser) ViewString() string {
 
 	if r.err != nil {
 		header := r.headerPanel.Render(r.service, r.resourceType, nil)
-		return header + "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", r.err))
+		return header + "\n" + r.renderError()
 	}
 
 	var summaryFields []render.SummaryField
 	if len(r.filtered) > 0 && r.tc.Cursor() < len(r.filtered) && r.renderer != nil {
-		selectedResource := dao.UnwrapResource(r.filtered[r.tc.Cursor()])
-		summaryFields = r.renderer.RenderSummary(selectedResource)
+		summaryFields = r.summaryFields(r.filtered[r.tc.Cursor()])
 	}
 
 	// Render header panel
@@ -297,7 +330,11 @@ func (r *ResourceBrowser) ViewString() string {
 		countText = fmt.Sprintf(" [%d/%d]", len(r.filtered), len(r.resources))
 	}
 	// Show pagination status
-	if r.isLoadingMore {
+	if r.stream != nil {
+		countText += " (streaming...)"
+	} else if r.capped {
+		countText += fmt.Sprintf(" (capped at %d)", r.maxItems())
+	} else if r.isLoadingMore {
 		countText += " (loading more...)"
 	} else if r.hasMorePages {
 		countText += " (more available)"
@@ -12,6 +12,7 @@ import (
 	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -22,30 +23,47 @@ type listResourcesResult struct {
 	err       error
 }
 
-func (r *ResourceBrowser) listResourcesWithContext(ctx context.Context, d dao.DAO) listResourcesResult {
-	listCtx := ctx
+// listContext adds the navigation filter and list toggles to ctx
+func (r *ResourceBrowser) listContext(ctx context.Context) context.Context {
 	if r.fieldFilter != "" && r.fieldFilterValue != "" {
-		listCtx = dao.WithFilter(listCtx, r.fieldFilter, r.fieldFilterValue)
+		ctx = dao.WithFilter(ctx, r.fieldFilter, r.fieldFilterValue)
 	}
 	for key, val := range r.toggleStates {
 		if val {
-			listCtx = dao.WithFilter(listCtx, key, "true")
+			ctx = dao.WithFilter(ctx, key, "true")
 		}
 	}
+	return ctx
+}
+
+func (r *ResourceBrowser) listResourcesWithContext(ctx context.Context, d dao.DAO) listResourcesResult {
+	listCtx := r.listContext(ctx)
 
 	var resources []dao.Resource
 	var nextToken string
 	var err error
 	if pagDAO, ok := d.(dao.PaginatedDAO); ok {
-		resources, nextToken, err = pagDAO.ListPage(listCtx, r.pageSize, "")
+		resources, nextToken, err = pagDAO.ListPage(listCtx, r.pageSize(), "")
 	} else {
 		resources, err = d.List(listCtx)
 	}
 	return listResourcesResult{resources: resources, nextToken: nextToken, err: err}
 }
 
-func (r *ResourceBrowser) listResources(d dao.DAO) listResourcesResult {
-	return r.listResourcesWithContext(r.ctx, d)
+func (r *ResourceBrowser) listResources(ctx context.Context, d dao.DAO) listResourcesResult {
+	result := r.listResourcesWithContext(ctx, d)
+	r.recordAccess(config.Global().Selection().ID(), config.Global().Region(), result.err)
+	return result
+}
+
+// recordAccess notes whether the list was denied so the service browser can
+// flag services the principal cannot use. Other errors say nothing about
+// permissions and are not recorded.
+func (r *ResourceBrowser) recordAccess(profileID, region string, err error) {
+	if err != nil && !apperrors.IsAccessDenied(err) {
+		return
+	}
+	config.Global().RecordAccess(profileID, region, r.service, r.resourceType, err != nil)
 }
 
 type profileRegionKey struct {
@@ -121,7 +139,7 @@ func fetchParallel[K comparable](
 	return parallelFetchResult[K]{resources: allResources, errors: errors, pageTokens: pageTokens}
 }
 
-func (r *ResourceBrowser) fetchMultiProfileResources(profiles []config.ProfileSelection, regions []string, existingTokens map[profileRegionKey]string) parallelFetchResult[profileRegionKey] {
+func (r *ResourceBrowser) fetchMultiProfileResources(ctx context.Context, profiles []config.ProfileSelection, regions []string, existingTokens map[profileRegionKey]string) parallelFetchResult[profileRegionKey] {
 	profileMap := make(map[string]config.ProfileSelection, len(profiles))
 	for _, sel := range profiles {
 		profileMap[sel.ID()] = sel
@@ -157,6 +175,7 @@ func (r *ResourceBrowser) fetchMultiProfileResources(profiles []config.ProfileSe
 		}
 
 		listResult := r.fetchWithDAO(fetchCtx, d, existingTokens[key])
+		r.recordAccess(key.Profile, key.Region, listResult.err)
 		if listResult.err != nil {
 			return nil, "", listResult.err
 		}
@@ -173,7 +192,7 @@ func (r *ResourceBrowser) fetchMultiProfileResources(profiles []config.ProfileSe
 		return fmt.Sprintf("%s/%s: %v", key.Profile, key.Region, err)
 	}
 
-	return fetchParallel(r.ctx, keys, fetch, formatError)
+	return fetchParallel(ctx, keys, fetch, formatError)
 }
 
 func hasProfileRegionToken(tokens map[profileRegionKey]string, key profileRegionKey) bool {
@@ -181,7 +200,7 @@ func hasProfileRegionToken(tokens map[profileRegionKey]string, key profileRegion
 	return ok
 }
 
-func (r *ResourceBrowser) fetchMultiRegionResources(regions []string, existingTokens map[string]string) parallelFetchResult[string] {
+func (r *ResourceBrowser) fetchMultiRegionResources(ctx context.Context, regions []string, existingTokens map[string]string) parallelFetchResult[string] {
 	fetch := func(ctx context.Context, region string) ([]dao.Resource, string, error) {
 		regionCtx := aws.WithRegionOverride(ctx, region)
 		d, err := r.registry.GetDAO(regionCtx, r.service, r.resourceType)
@@ -194,6 +213,7 @@ func (r *ResourceBrowser) fetchMultiRegionResources(regions []string, existingTo
 			token = existingTokens[region]
 		}
 		listResult := r.fetchWithDAO(regionCtx, d, token)
+		r.recordAccess(config.Global().Selection().ID(), region, listResult.err)
 		if listResult.err != nil {
 			return nil, "", listResult.err
 		}
@@ -210,28 +230,107 @@ func (r *ResourceBrowser) fetchMultiRegionResources(regions []string, existingTo
 		return fmt.Sprintf("%s: %v", region, err)
 	}
 
-	return fetchParallel(r.ctx, regions, fetch, formatError)
+	return fetchParallel(ctx, regions, fetch, formatError)
+}
+
+// pageSize returns the configured page size for the current resource type,
+// never requesting more items than the max_items cap allows.
+func (r *ResourceBrowser) pageSize() int {
+	size := config.File().PageSize(r.service, r.resourceType)
+	if limit := r.maxItems(); limit > 0 {
+		size = min(size, limit)
+	}
+	return size
+}
+
+// maxItems returns the configured item cap for the current resource type (0 = no cap)
+func (r *ResourceBrowser) maxItems() int {
+	return config.File().MaxItems(r.service, r.resourceType)
+}
+
+// applyMaxItems truncates the listing to the max_items cap and stops further
+// pagination and streaming once it is reached.
+func (r *ResourceBrowser) applyMaxItems() {
+	r.capped = false
+	limit := r.maxItems()
+	if limit <= 0 || len(r.resources) < limit {
+		return
+	}
+	truncated := len(r.resources) > limit
+	r.resources = r.resources[:limit]
+	if !truncated && !r.hasMorePages && r.stream == nil {
+		return
+	}
+	log.Debug("pagination stopped at max_items", "service", r.service, "resourceType", r.resourceType, "maxItems", limit)
+	r.stopStream()
+	r.hasMorePages = false
+	r.nextPageToken = ""
+	r.nextPageTokens = nil
+	r.nextMultiPageTokens = nil
+	r.capped = true
 }
 
 func (r *ResourceBrowser) fetchWithDAO(ctx context.Context, d dao.DAO, token string) listResourcesResult {
 	if pagDAO, ok := d.(dao.PaginatedDAO); ok {
-		listCtx := ctx
-		if r.fieldFilter != "" && r.fieldFilterValue != "" {
-			listCtx = dao.WithFilter(listCtx, r.fieldFilter, r.fieldFilterValue)
-		}
-		for key, val := range r.toggleStates {
-			if val {
-				listCtx = dao.WithFilter(listCtx, key, "true")
-			}
-		}
-		resources, nextToken, err := pagDAO.ListPage(listCtx, r.pageSize, token)
+		resources, nextToken, err := pagDAO.ListPage(r.listContext(ctx), r.pageSize(), token)
 		return listResourcesResult{resources: resources, nextToken: nextToken, err: err}
 	}
 	return r.listResourcesWithContext(ctx, d)
 }
 
-func (r *ResourceBrowser) loadResources() tea.Msg {
-	start := time.Now()
+// scope returns the parent context of fetches started by this view. It is
+// cancelled by CancelLoads when the user navigates away.
+func (r *ResourceBrowser) scope() context.Context {
+	if r.scopeCtx == nil {
+		r.scopeCtx, r.scopeCancel = context.WithCancel(r.ctx)
+	}
+	return r.scopeCtx
+}
+
+// beginLoad cancels a load still running for the previous resource type,
+// filter or refresh and returns the context for its replacement
+func (r *ResourceBrowser) beginLoad() context.Context {
+	r.stopStream()
+	if r.loadCancel != nil {
+		r.loadCancel()
+	}
+	r.isLoadingMore = false
+	var ctx context.Context
+	ctx, r.loadCancel = context.WithCancel(r.scope())
+	return ctx
+}
+
+// CancelLoads implements LoadCanceler. Abandoned fetches, including
+// multi-region fan-outs, stop instead of running to completion.
+func (r *ResourceBrowser) CancelLoads() {
+	r.stopStream()
+	if r.scopeCancel != nil {
+		r.scopeCancel()
+	}
+	r.scopeCtx, r.scopeCancel, r.loadCancel = nil, nil, nil
+	r.isLoadingMore = false
+}
+
+func (r *ResourceBrowser) loadResourcesCmd() tea.Cmd {
+	ctx := r.beginLoad()
+	return func() tea.Msg { return r.loadResources(ctx) }
+}
+
+func (r *ResourceBrowser) reloadResourcesCmd() tea.Cmd {
+	ctx := r.beginLoad()
+	return func() tea.Msg { return r.reloadResources(ctx) }
+}
+
+func (r *ResourceBrowser) loadNextPageCmd() tea.Cmd {
+	ctx := r.beginLoad()
+	r.isLoadingMore = true
+	return func() tea.Msg { return r.loadNextPage(ctx) }
+}
+
+func (r *ResourceBrowser) loadResources(ctx context.Context) (msg tea.Msg) {
+	start, calls := time.Now(), r.apiCalls.Count()
+	defer func() { msg = r.finishFetch(ctx, msg, start, calls) }()
+
 	profiles := config.Global().Selections()
 	regions := config.Global().Regions()
 	isMultiProfile := len(profiles) > 1
@@ -247,7 +346,7 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 	}
 
 	if isMultiProfile {
-		fetchResult := r.fetchMultiProfileResources(profiles, regions, nil)
+		fetchResult := r.fetchMultiProfileResources(ctx, profiles, regions, nil)
 		if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
 			return resourcesErrorMsg{err: fmt.Errorf("all profile/region pairs failed: %s", strings.Join(fetchResult.errors, "; "))}
 		}
@@ -266,13 +365,17 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 	}
 
 	if !isMultiRegion {
-		d, err := r.registry.GetDAO(r.ctx, r.service, r.resourceType)
+		d, err := r.registry.GetDAO(ctx, r.service, r.resourceType)
 		if err != nil {
 			log.Error("failed to get DAO", "service", r.service, "resourceType", r.resourceType, "error", err)
 			return resourcesErrorMsg{err: err}
 		}
 
-		result := r.listResources(d)
+		if sd, ok := d.(dao.StreamingDAO); ok {
+			return r.startStream(ctx, sd, renderer)
+		}
+
+		result := r.listResources(ctx, d)
 		if result.err != nil {
 			log.Error("failed to list resources", "error", result.err, "duration", time.Since(start))
 			return resourcesErrorMsg{err: result.err}
@@ -288,7 +391,7 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 		}
 	}
 
-	fetchResult := r.fetchMultiRegionResources(regions, nil)
+	fetchResult := r.fetchMultiRegionResources(ctx, regions, nil)
 	if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
 		return resourcesErrorMsg{err: fmt.Errorf("all regions failed: %s", strings.Join(fetchResult.errors, "; "))}
 	}
@@ -306,14 +409,17 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 	}
 }
 
-func (r *ResourceBrowser) reloadResources() tea.Msg {
+func (r *ResourceBrowser) reloadResources(ctx context.Context) (msg tea.Msg) {
+	start, calls := time.Now(), r.apiCalls.Count()
+	defer func() { msg = r.finishFetch(ctx, msg, start, calls) }()
+
 	profiles := config.Global().Selections()
 	regions := config.Global().Regions()
 	isMultiProfile := len(profiles) > 1
 	isMultiRegion := len(regions) > 1
 
 	if isMultiProfile {
-		fetchResult := r.fetchMultiProfileResources(profiles, regions, nil)
+		fetchResult := r.fetchMultiProfileResources(ctx, profiles, regions, nil)
 		if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
 			return resourcesErrorMsg{err: fmt.Errorf("all profile/region pairs failed: %s", strings.Join(fetchResult.errors, "; "))}
 		}
@@ -332,13 +438,13 @@ func (r *ResourceBrowser) reloadResources() tea.Msg {
 		d := r.dao
 		if d == nil {
 			var err error
-			d, err = r.registry.GetDAO(r.ctx, r.service, r.resourceType)
+			d, err = r.registry.GetDAO(ctx, r.service, r.resourceType)
 			if err != nil {
 				return resourcesErrorMsg{err: err}
 			}
 		}
 
-		result := r.listResources(d)
+		result := r.listResources(ctx, d)
 		if result.err != nil {
 			return resourcesErrorMsg{err: result.err}
 		}
@@ -352,7 +458,7 @@ func (r *ResourceBrowser) reloadResources() tea.Msg {
 		}
 	}
 
-	fetchResult := r.fetchMultiRegionResources(regions, nil)
+	fetchResult := r.fetchMultiRegionResources(ctx, regions, nil)
 	if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
 		return resourcesErrorMsg{err: fmt.Errorf("all regions failed: %s", strings.Join(fetchResult.errors, "; "))}
 	}
@@ -376,6 +482,8 @@ type resourcesLoadedMsg struct {
 	nextMultiPageTokens map[profileRegionKey]string
 	hasMorePages        bool
 	partialErrors       []string
+	stats               fetchStats
+	stream              *resourceStream // remaining pages of a StreamingDAO listing
 }
 
 type nextPageLoadedMsg struct {
@@ -384,10 +492,48 @@ type nextPageLoadedMsg struct {
 	nextPageTokens      map[string]string
 	nextMultiPageTokens map[profileRegionKey]string
 	hasMorePages        bool
+	stats               fetchStats
 }
 
 type resourcesErrorMsg struct {
-	err error
+	err   error
+	stats fetchStats
+}
+
+// finishFetch records on a fetch result how long the fetch took and how
+// many API calls the view made meanwhile. Results of cancelled fetches are
+// dropped: the load that replaced them, or Init on return, reloads the list.
+func (r *ResourceBrowser) finishFetch(ctx context.Context, msg tea.Msg, start time.Time, calls int64) tea.Msg {
+	if ctx.Err() != nil {
+		log.Debug("dropping cancelled fetch", "service", r.service, "resourceType", r.resourceType)
+		return nil
+	}
+	stats := fetchStats{duration: time.Since(start), apiCalls: r.apiCalls.Count() - calls}
+	switch m := msg.(type) {
+	case resourcesLoadedMsg:
+		m.stats = stats
+		return m
+	case nextPageLoadedMsg:
+		m.stats = stats
+		return m
+	case resourcesErrorMsg:
+		m.stats = stats
+		return m
+	}
+	return msg
+}
+
+// PerfStats implements PerfReporter
+func (r *ResourceBrowser) PerfStats() PerfStats {
+	return PerfStats{
+		Loading:    r.loading || r.isLoadingMore || r.stream != nil,
+		LastFetch:  r.lastFetch.duration,
+		FetchCalls: r.lastFetch.apiCalls,
+		APICalls:   r.apiCalls.Count(),
+		Items:      len(r.resources),
+		Pages:      r.pagesLoaded,
+		HasMore:    r.hasMorePages,
+	}
 }
 
 func (r *ResourceBrowser) shouldLoadNextPage() bool {
@@ -411,13 +557,16 @@ func (r *ResourceBrowser) hasLoadableNextPage() bool {
 	return r.nextPageToken != "" || len(r.nextPageTokens) > 0 || len(r.nextMultiPageTokens) > 0
 }
 
-func (r *ResourceBrowser) loadNextPage() tea.Msg {
+func (r *ResourceBrowser) loadNextPage(ctx context.Context) (msg tea.Msg) {
+	start, calls := time.Now(), r.apiCalls.Count()
+	defer func() { msg = r.finishFetch(ctx, msg, start, calls) }()
+
 	if len(r.nextMultiPageTokens) > 0 {
-		return r.loadNextPageMultiProfile()
+		return r.loadNextPageMultiProfile(ctx)
 	}
 
 	if len(r.nextPageTokens) > 0 {
-		return r.loadNextPageMultiRegion()
+		return r.loadNextPageMultiRegion(ctx)
 	}
 
 	if r.nextPageToken == "" {
@@ -429,20 +578,9 @@ func (r *ResourceBrowser) loadNextPage() tea.Msg {
 		return nil
 	}
 
-	start := time.Now()
 	log.Debug("loading next page", "service", r.service, "resourceType", r.resourceType, "token", r.nextPageToken[:min(logTokenMaxLen, len(r.nextPageToken))])
 
-	listCtx := r.ctx
-	if r.fieldFilter != "" && r.fieldFilterValue != "" {
-		listCtx = dao.WithFilter(listCtx, r.fieldFilter, r.fieldFilterValue)
-	}
-	for key, val := range r.toggleStates {
-		if val {
-			listCtx = dao.WithFilter(listCtx, key, "true")
-		}
-	}
-
-	resources, nextToken, err := pagDAO.ListPage(listCtx, r.pageSize, r.nextPageToken)
+	resources, nextToken, err := pagDAO.ListPage(r.listContext(ctx), r.pageSize(), r.nextPageToken)
 	if err != nil {
 		log.Error("failed to load next page", "error", err, "duration", time.Since(start))
 		return resourcesErrorMsg{err: err}
@@ -457,7 +595,7 @@ func (r *ResourceBrowser) loadNextPage() tea.Msg {
 	}
 }
 
-func (r *ResourceBrowser) loadNextPageMultiRegion() tea.Msg {
+func (r *ResourceBrowser) loadNextPageMultiRegion(ctx context.Context) tea.Msg {
 	configRegions := config.Global().Regions()
 	regions := make([]string, 0, len(r.nextPageTokens))
 	for _, region := range configRegions {
@@ -469,7 +607,7 @@ func (r *ResourceBrowser) loadNextPageMultiRegion() tea.Msg {
 	start := time.Now()
 	log.Debug("loading next page multi-region", "service", r.service, "resourceType", r.resourceType, "regions", len(regions))
 
-	fetchResult := r.fetchMultiRegionResources(regions, r.nextPageTokens)
+	fetchResult := r.fetchMultiRegionResources(ctx, regions, r.nextPageTokens)
 
 	log.Debug("next page multi-region loaded", "count", len(fetchResult.resources), "hasMore", len(fetchResult.pageTokens) > 0, "duration", time.Since(start))
 
@@ -480,7 +618,7 @@ func (r *ResourceBrowser) loadNextPageMultiRegion() tea.Msg {
 	}
 }
 
-func (r *ResourceBrowser) loadNextPageMultiProfile() tea.Msg {
+func (r *ResourceBrowser) loadNextPageMultiProfile(ctx context.Context) tea.Msg {
 	profiles := config.Global().Selections()
 	regions := config.Global().Regions()
 
@@ -492,7 +630,7 @@ func (r *ResourceBrowser) loadNextPageMultiProfile() tea.Msg {
 	start := time.Now()
 	log.Debug("loading next page multi-profile", "service", r.service, "resourceType", r.resourceType, "pairs", len(tokensToFetch))
 
-	fetchResult := r.fetchMultiProfileResources(profiles, regions, tokensToFetch)
+	fetchResult := r.fetchMultiProfileResources(ctx, profiles, regions, tokensToFetch)
 
 	log.Debug("next page multi-profile loaded", "count", len(fetchResult.resources), "hasMore", len(fetchResult.pageTokens) > 0, "duration", time.Since(start))
 
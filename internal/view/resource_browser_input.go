@@ -5,9 +5,12 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/cfntemplate"
 	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/terraform"
 )
 
 func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
@@ -15,6 +18,12 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleFilterInput(msg)
 	}
 
+	if r.err != nil {
+		if cmd := r.errState.handleKey(msg.String()); cmd != nil {
+			return r, cmd
+		}
+	}
+
 	if len(r.filtered) > 0 && r.tc.Cursor() < len(r.filtered) {
 		if nav, cmd := r.handleNavigation(msg.String()); cmd != nil {
 			return nav, cmd
@@ -46,10 +55,10 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleAction()
 	case "tab":
 		r.cycleResourceType(1)
-		return r, tea.Batch(r.loadResources, r.spinner.Tick)
+		return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 	case "shift+tab":
 		r.cycleResourceType(-1)
-		return r, tea.Batch(r.loadResources, r.spinner.Tick)
+		return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
 		return r.handleNumberKey(msg.String())
 	case "N":
@@ -58,6 +67,10 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleCopyID()
 	case "Y":
 		return r.handleCopyARN()
+	case "I":
+		return r.handleCopyTerraformImports()
+	case "E":
+		return r.handleGenerateTemplate()
 	case "j", "down":
 		r.tc.SetCursor(r.tc.Cursor()+1, len(r.filtered))
 		r.tc.UpdateScrollOffset(len(r.filtered))
@@ -124,7 +137,7 @@ func (r *ResourceBrowser) handleRefresh() (tea.Model, tea.Cmd) {
 		r.metricsLoading = true
 		r.metricsData = nil
 	}
-	return r, tea.Batch(r.loadResources, r.spinner.Tick)
+	return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 }
 
 func (r *ResourceBrowser) handleClearFilter() (tea.Model, tea.Cmd) {
@@ -136,7 +149,7 @@ func (r *ResourceBrowser) handleClearFilter() (tea.Model, tea.Cmd) {
 	r.markedResource = nil
 	r.loading = true
 	r.err = nil
-	return r, tea.Batch(r.loadResources, r.spinner.Tick)
+	return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 }
 
 func (r *ResourceBrowser) handleEsc() (tea.Model, tea.Cmd) {
@@ -179,7 +192,7 @@ func (r *ResourceBrowser) handleEnter() (tea.Model, tea.Cmd) {
 	if len(r.filtered) > 0 && cursor >= 0 && cursor < len(r.filtered) {
 		ctx, resource := r.contextForResource(r.filtered[cursor])
 		if r.markedResource != nil && r.markedResource.GetID() != resource.GetID() {
-			diffView := NewDiffView(ctx, r.markedResource, resource, r.renderer, r.service, r.resourceType)
+			diffView := NewDiffView(ctx, r.markedResource, resource, r.renderer, r.service, r.resourceType).WithDAO(r.dao)
 			return r, func() tea.Msg {
 				return NavigateMsg{View: diffView}
 			}
@@ -202,6 +215,13 @@ func (r *ResourceBrowser) handleAction() (tea.Model, tea.Cmd) {
 				return ShowModalMsg{Modal: &Modal{Content: actionMenu, Width: ModalWidthActionMenu}}
 			}
 		}
+		return r, nil
+	}
+	// Nothing selected: offer actions that need no resource (e.g., create)
+	if actionMenu := NewStandaloneActionMenu(r.ctx, r.service, r.resourceType); actionMenu != nil {
+		return r, func() tea.Msg {
+			return ShowModalMsg{Modal: &Modal{Content: actionMenu, Width: ModalWidthActionMenu}}
+		}
 	}
 	return r, nil
 }
@@ -216,15 +236,14 @@ func (r *ResourceBrowser) handleNumberKey(key string) (tea.Model, tea.Cmd) {
 		r.markedResource = nil
 		r.metricsEnabled = false
 		r.metricsData = nil
-		return r, tea.Batch(r.loadResources, r.spinner.Tick)
+		return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 	}
 	return r, nil
 }
 
 func (r *ResourceBrowser) handleLoadNextPage() (tea.Model, tea.Cmd) {
 	if r.hasLoadableNextPage() {
-		r.isLoadingMore = true
-		return r, r.loadNextPage
+		return r, r.loadNextPageCmd()
 	}
 	return r, nil
 }
@@ -314,7 +333,7 @@ func (r *ResourceBrowser) switchToTab(idx int) (tea.Model, tea.Cmd) {
 	r.markedResource = nil
 	r.metricsEnabled = false
 	r.metricsData = nil
-	return r, r.loadResources
+	return r, r.loadResourcesCmd()
 }
 
 func (r *ResourceBrowser) openDetailView() (tea.Model, tea.Cmd) {
@@ -350,6 +369,41 @@ func (r *ResourceBrowser) handleCopyARN() (tea.Model, tea.Cmd) {
 	return r, nil
 }
 
+func (r *ResourceBrowser) handleCopyTerraformImports() (tea.Model, tea.Cmd) {
+	if len(r.filtered) == 0 {
+		return r, nil
+	}
+	blocks, ok := terraform.ImportBlocks(r.service, r.resourceType, r.filtered)
+	if !ok {
+		return r, clipboard.Unsupported("No Terraform mapping for " + r.service + "/" + r.resourceType)
+	}
+	return r, clipboard.Copy("Terraform import blocks", blocks)
+}
+
+func (r *ResourceBrowser) handleGenerateTemplate() (tea.Model, tea.Cmd) {
+	cursor := r.tc.Cursor()
+	if len(r.filtered) == 0 || cursor < 0 || cursor >= len(r.filtered) {
+		return r, nil
+	}
+	// The IaC generator creates a generated template in the account
+	if config.Global().ReadOnly() {
+		return r, clipboard.Unsupported("Template generation is disabled in read-only mode")
+	}
+	ctx, resource := r.contextForResource(r.filtered[cursor])
+	definition, ok := cfntemplate.ResourceDefinition(r.service, r.resourceType, resource)
+	if !ok {
+		return r, clipboard.Unsupported("No CloudFormation mapping for " + r.service + "/" + r.resourceType)
+	}
+	name := resource.GetName()
+	if name == "" {
+		name = resource.GetID()
+	}
+	templateView := NewTemplateView(ctx, name, definition)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: templateView}
+	}
+}
+
 func (r *ResourceBrowser) handleToggleKey(key string) (tea.Model, tea.Cmd) {
 	if r.renderer == nil {
 		return nil, nil
@@ -362,7 +416,7 @@ func (r *ResourceBrowser) handleToggleKey(key string) (tea.Model, tea.Cmd) {
 		if toggle.Key == key {
 			r.toggleStates[toggle.ContextKey] = !r.toggleStates[toggle.ContextKey]
 			r.loading = true
-			return r, tea.Batch(r.loadResources, r.spinner.Tick)
+			return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 		}
 	}
 	return nil, nil
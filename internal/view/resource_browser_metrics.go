@@ -2,14 +2,17 @@ package view
 
 import (
 	"context"
+	"fmt"
 
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/metrics"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 type metricsLoadedMsg struct {
@@ -38,7 +41,7 @@ func (r *ResourceBrowser) loadMetricsCmd() tea.Cmd {
 		}
 	}
 	resourceType := r.resourceType
-	baseCtx := r.ctx
+	baseCtx := r.scope()
 
 	return func() tea.Msg {
 		if baseCtx.Err() != nil {
@@ -75,6 +78,12 @@ func (r *ResourceBrowser) loadMetricsCmd() tea.Cmd {
 			if err != nil {
 				continue
 			}
+			if config.File().MetricsAnomalyBands() {
+				// Bands are an overlay: metrics still show without them
+				if err := fetcher.FetchBands(regionCtx, regionData); err != nil {
+					log.Warn("failed to fetch anomaly bands", "region", region, "error", err)
+				}
+			}
 
 			for i, info := range regionInfos {
 				if result := regionData.Get(unwrappedIDs[i]); result != nil {
@@ -97,3 +106,28 @@ func (r *ResourceBrowser) getMetricSpec() *render.MetricSpec {
 	}
 	return nil
 }
+
+// summaryFields returns the header summary for a resource, followed by the
+// expected range of its metric when an anomaly band was fetched
+func (r *ResourceBrowser) summaryFields(res dao.Resource) []render.SummaryField {
+	fields := r.renderer.RenderSummary(dao.UnwrapResource(res))
+	if !r.metricsEnabled || r.metricsData == nil || r.metricsData.Spec == nil {
+		return fields
+	}
+
+	result := r.metricsData.Get(res.GetID())
+	lower, upper, ok := result.LatestRange()
+	if !ok {
+		return fields
+	}
+	unit := r.metricsData.Spec.Unit
+	field := render.SummaryField{
+		Label: "Expected " + r.metricsData.Spec.ColumnHeader,
+		Value: fmt.Sprintf("%.0f–%.0f%s (now %.0f%s)", lower, upper, unit, result.Latest, unit),
+		Style: ui.SuccessStyle(),
+	}
+	if result.Deviation(len(result.Values)-1) != 0 {
+		field.Style = ui.WarningStyle()
+	}
+	return append(fields, field)
+}
@@ -0,0 +1,153 @@
+package view
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// resourceStream buffers pages emitted by a StreamingDAO until the view
+// picks them up. The listing goroutine never blocks on the view, so it
+// finishes even if the view is left before the stream completes.
+type resourceStream struct {
+	mu      sync.Mutex
+	pending []dao.Resource
+	done    bool
+	err     error
+
+	notify chan struct{}
+	cancel context.CancelFunc
+
+	start time.Time
+	calls int64
+}
+
+// streamPageMsg carries the pages buffered since the previous message
+type streamPageMsg struct {
+	stream    *resourceStream
+	resources []dao.Resource
+	done      bool
+	err       error
+}
+
+func (s *resourceStream) push(page []dao.Resource) {
+	s.mu.Lock()
+	s.pending = append(s.pending, page...)
+	s.mu.Unlock()
+	s.signal()
+}
+
+func (s *resourceStream) finish(err error) {
+	s.mu.Lock()
+	s.done = true
+	s.err = err
+	s.mu.Unlock()
+	s.signal()
+}
+
+func (s *resourceStream) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// next waits for pages or the end of the stream
+func (s *resourceStream) next() tea.Msg {
+	<-s.notify
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg := streamPageMsg{stream: s, resources: s.pending, done: s.done, err: s.err}
+	s.pending = nil
+	return msg
+}
+
+// startStream lists with a StreamingDAO and returns once the first page
+// arrives; the remaining pages are delivered as streamPageMsg.
+func (r *ResourceBrowser) startStream(ctx context.Context, d dao.StreamingDAO, renderer render.Renderer) tea.Msg {
+	ctx, cancel := context.WithCancel(r.listContext(ctx))
+	s := &resourceStream{
+		notify: make(chan struct{}, 1),
+		cancel: cancel,
+		start:  time.Now(),
+		calls:  r.apiCalls.Count(),
+	}
+	profileID, region := config.Global().Selection().ID(), config.Global().Region()
+
+	go func() {
+		defer cancel()
+		err := d.ListStream(ctx, func(page []dao.Resource) bool {
+			s.push(page)
+			return ctx.Err() == nil
+		})
+		r.recordAccess(profileID, region, err)
+		s.finish(err)
+	}()
+
+	first := s.next().(streamPageMsg)
+	if first.err != nil && len(first.resources) == 0 {
+		log.Error("failed to stream resources", "error", first.err)
+		return resourcesErrorMsg{err: first.err}
+	}
+
+	msg := resourcesLoadedMsg{
+		dao:       d,
+		renderer:  renderer,
+		resources: first.resources,
+	}
+	if first.err != nil {
+		msg.partialErrors = []string{first.err.Error()}
+	}
+	if !first.done {
+		msg.stream = s
+	}
+	return msg
+}
+
+func (r *ResourceBrowser) handleStreamPage(msg streamPageMsg) (tea.Model, tea.Cmd) {
+	// Ignore pages from a stream replaced by a refresh or resource switch
+	if msg.stream != r.stream {
+		return r, nil
+	}
+
+	if len(msg.resources) > 0 {
+		r.resources = append(r.resources, msg.resources...)
+		r.pagesLoaded++
+		r.applyMaxItems()
+		r.applyFilter()
+		r.buildTable()
+		if r.capped {
+			// applyMaxItems stopped the stream
+			return r, nil
+		}
+	}
+	if !msg.done {
+		return r, r.stream.next
+	}
+
+	r.lastFetch = fetchStats{
+		duration: time.Since(r.stream.start),
+		apiCalls: r.apiCalls.Count() - r.stream.calls,
+	}
+	r.stream = nil
+	if msg.err != nil {
+		log.Warn("resource stream stopped", "service", r.service, "resourceType", r.resourceType, "error", msg.err)
+		r.partialErrors = append(r.partialErrors, msg.err.Error())
+	}
+	return r, nil
+}
+
+// stopStream cancels the in-progress stream, if any
+func (r *ResourceBrowser) stopStream() {
+	if r.stream != nil {
+		r.stream.cancel()
+		r.stream = nil
+	}
+}
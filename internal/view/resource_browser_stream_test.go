@@ -0,0 +1,159 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// gatedStreamingDAO emits its first page immediately and each later page
+// once the test sends on release
+type gatedStreamingDAO struct {
+	dao.BaseDAO
+	pages   [][]dao.Resource
+	err     error
+	release chan struct{}
+}
+
+func (d *gatedStreamingDAO) List(context.Context) ([]dao.Resource, error) { return nil, nil }
+func (d *gatedStreamingDAO) Get(context.Context, string) (dao.Resource, error) {
+	return nil, nil
+}
+func (d *gatedStreamingDAO) Delete(context.Context, string) error { return nil }
+
+func (d *gatedStreamingDAO) ListStream(ctx context.Context, emit func([]dao.Resource) bool) error {
+	for i, page := range d.pages {
+		if i > 0 {
+			select {
+			case <-d.release:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if !emit(page) {
+			return nil
+		}
+	}
+	return d.err
+}
+
+func newStreamingBrowser(t *testing.T, d *gatedStreamingDAO) *ResourceBrowser {
+	t.Helper()
+	config.Global().SetRegions([]string{"us-east-1"})
+	t.Cleanup(func() { config.Global().SetRegions(nil) })
+
+	reg := registry.New()
+	reg.RegisterCustom("svc", "items", registry.Entry{
+		DAOFactory:      func(context.Context) (dao.DAO, error) { return d, nil },
+		RendererFactory: func() render.Renderer { return &mockRenderer{} },
+	})
+	browser := NewResourceBrowserWithType(context.Background(), reg, "svc", "items")
+	browser.SetSize(100, 40)
+	return browser
+}
+
+func TestResourceBrowserStreamsPages(t *testing.T) {
+	d := &gatedStreamingDAO{
+		BaseDAO: dao.NewBaseDAO("svc", "items"),
+		pages: [][]dao.Resource{
+			{&mockResource{id: "a", name: "a"}, &mockResource{id: "b", name: "b"}},
+			{&mockResource{id: "c", name: "c"}},
+		},
+		release: make(chan struct{}),
+	}
+	browser := newStreamingBrowser(t, d)
+
+	msg, ok := browser.loadResources(browser.beginLoad()).(resourcesLoadedMsg)
+	if !ok || msg.stream == nil {
+		t.Fatalf("expected first page with an open stream, got %#v", msg)
+	}
+	browser.Update(msg)
+	if len(browser.resources) != 2 || browser.stream == nil {
+		t.Fatalf("after first page: %d resources, stream=%v", len(browser.resources), browser.stream)
+	}
+	if !strings.Contains(browser.ViewString(), "streaming") {
+		t.Error("expected streaming indicator while pages arrive")
+	}
+
+	close(d.release)
+	for browser.stream != nil {
+		browser.Update(browser.stream.next())
+	}
+	if len(browser.resources) != 3 {
+		t.Errorf("expected 3 resources after stream completes, got %d", len(browser.resources))
+	}
+	if browser.pagesLoaded != 2 {
+		t.Errorf("pagesLoaded = %d, want 2", browser.pagesLoaded)
+	}
+}
+
+func TestResourceBrowserStreamErrorKeepsPages(t *testing.T) {
+	d := &gatedStreamingDAO{
+		BaseDAO: dao.NewBaseDAO("svc", "items"),
+		pages:   [][]dao.Resource{{&mockResource{id: "a", name: "a"}}, {}},
+		err:     errors.New("throttled"),
+		release: make(chan struct{}),
+	}
+	browser := newStreamingBrowser(t, d)
+	browser.Update(browser.loadResources(browser.beginLoad()))
+
+	close(d.release)
+	for browser.stream != nil {
+		browser.Update(browser.stream.next())
+	}
+	if len(browser.resources) != 1 || len(browser.partialErrors) != 1 {
+		t.Errorf("resources = %d, partialErrors = %v", len(browser.resources), browser.partialErrors)
+	}
+}
+
+func TestResourceBrowserIgnoresStaleStream(t *testing.T) {
+	d := &gatedStreamingDAO{
+		BaseDAO: dao.NewBaseDAO("svc", "items"),
+		pages:   [][]dao.Resource{{&mockResource{id: "a", name: "a"}}, {&mockResource{id: "b", name: "b"}}},
+		release: make(chan struct{}),
+	}
+	browser := newStreamingBrowser(t, d)
+	browser.Update(browser.loadResources(browser.beginLoad()))
+
+	old := browser.stream
+	browser.stopStream()
+	if msg := old.next().(streamPageMsg); !msg.done {
+		t.Fatal("expected cancelled stream to finish")
+	}
+	browser.Update(streamPageMsg{stream: old, resources: []dao.Resource{&mockResource{id: "late"}}, done: true})
+	if len(browser.resources) != 1 {
+		t.Errorf("stale stream pages should be ignored, got %d resources", len(browser.resources))
+	}
+}
+
+func TestResourceBrowserCancelLoadsDropsResults(t *testing.T) {
+	d := &gatedStreamingDAO{
+		BaseDAO: dao.NewBaseDAO("svc", "items"),
+		pages:   [][]dao.Resource{{&mockResource{id: "a", name: "a"}}, {&mockResource{id: "b", name: "b"}}},
+		release: make(chan struct{}),
+	}
+	browser := newStreamingBrowser(t, d)
+	browser.Update(browser.loadResources(browser.beginLoad()))
+
+	s := browser.stream
+	ctx := browser.beginLoad()
+	if s == nil || browser.stream != nil {
+		t.Fatal("expected a new load to stop the previous stream")
+	}
+	browser.CancelLoads()
+	if msg := browser.loadResources(ctx); msg != nil {
+		t.Errorf("expected cancelled load to be dropped, got %#v", msg)
+	}
+
+	// A new load after navigating back is not affected by the cancellation
+	if _, ok := browser.loadResources(browser.beginLoad()).(resourcesLoadedMsg); !ok {
+		t.Error("expected load after CancelLoads to succeed")
+	}
+	browser.CancelLoads()
+}
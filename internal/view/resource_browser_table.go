@@ -84,7 +84,7 @@ func (r *ResourceBrowser) buildTable() {
 	var summaryFields []render.SummaryField
 	cursor := r.tc.Cursor()
 	if len(r.filtered) > 0 && cursor >= 0 && cursor < len(r.filtered) {
-		summaryFields = r.renderer.RenderSummary(dao.UnwrapResource(r.filtered[cursor]))
+		summaryFields = r.summaryFields(r.filtered[cursor])
 	}
 	headerStr := r.headerPanel.Render(r.service, r.resourceType, summaryFields)
 	headerHeight := r.headerPanel.Height(headerStr)
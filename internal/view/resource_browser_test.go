@@ -2,6 +2,7 @@ package view
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"sync"
 	"testing"
@@ -10,7 +11,9 @@ import (
 
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/metrics"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
 )
 
 func TestResourceBrowserFilterEsc(t *testing.T) {
@@ -849,10 +852,9 @@ func TestFetchMultiProfileResourcesSkipsPairsWithoutNextToken(t *testing.T) {
 		registry:     reg,
 		service:      "svc",
 		resourceType: "items",
-		pageSize:     10,
 	}
 
-	result := browser.fetchMultiProfileResources(profiles, regions, map[profileRegionKey]string{
+	result := browser.fetchMultiProfileResources(context.Background(), profiles, regions, map[profileRegionKey]string{
 		{Profile: "p1", Region: "us-east-1"}: "next-p1-r1",
 	})
 
@@ -986,3 +988,49 @@ func TestResourceBrowserCopyEmptyList(t *testing.T) {
 		t.Error("Expected nil cmd for 'Y' on empty list")
 	}
 }
+
+func TestResourceBrowserAccessDeniedError(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("iam", "roles", registry.Entry{})
+
+	browser := NewResourceBrowserWithType(context.Background(), reg, "iam", "roles")
+	browser.SetSize(100, 50)
+
+	browser.Update(resourcesErrorMsg{err: errors.New("operation error IAM: ListRoles, AccessDenied: not authorized")})
+	view := browser.ViewString()
+	if !strings.Contains(view, "Access denied") || !strings.Contains(view, "iam/roles") {
+		t.Errorf("expected access denied explanation:\n%s", view)
+	}
+
+	browser.Update(resourcesErrorMsg{err: errors.New("connection reset")})
+	if view := browser.ViewString(); !strings.Contains(view, "Error: connection reset") {
+		t.Errorf("expected raw error for other failures:\n%s", view)
+	}
+}
+
+func TestResourceBrowserAnomalyBandSummary(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+	browser := NewResourceBrowserWithType(context.Background(), reg, "ec2", "instances")
+	browser.renderer = &mockRenderer{detail: "test"}
+	res := &mockResource{id: "i-1", name: "instance-1"}
+
+	browser.metricsEnabled = true
+	browser.metricsData = metrics.NewMetricData(&render.MetricSpec{ColumnHeader: "CPU", Unit: "%"})
+	if fields := browser.summaryFields(res); len(fields) != 0 {
+		t.Errorf("expected no expected-range field without a band, got %v", fields)
+	}
+
+	browser.metricsData.Results["i-1"] = &metrics.MetricResult{
+		ResourceID: "i-1",
+		Values:     []float64{40, 95},
+		Latest:     95,
+		HasData:    true,
+		Lower:      []float64{30, 30},
+		Upper:      []float64{60, 60},
+	}
+	fields := browser.summaryFields(res)
+	if len(fields) != 1 || fields[0].Label != "Expected CPU" || fields[0].Value != "30–60% (now 95%)" {
+		t.Errorf("unexpected summary fields %+v", fields)
+	}
+}
@@ -1,6 +1,8 @@
 package view
 
 import (
+	"fmt"
+
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/dao"
@@ -8,6 +10,7 @@ import (
 )
 
 func (r *ResourceBrowser) handleResourcesLoaded(msg resourcesLoadedMsg) (tea.Model, tea.Cmd) {
+	r.stream = msg.stream
 	r.loading = false
 	r.dao = msg.dao
 	r.renderer = msg.renderer
@@ -17,10 +20,19 @@ func (r *ResourceBrowser) handleResourcesLoaded(msg resourcesLoadedMsg) (tea.Mod
 	r.nextMultiPageTokens = msg.nextMultiPageTokens
 	r.hasMorePages = msg.hasMorePages
 	r.partialErrors = msg.partialErrors
+	r.lastFetch = msg.stats
+	r.pagesLoaded = 1
+	r.applyMaxItems()
 	r.applyFilter()
 	r.buildTable()
+	if r.autoReload && r.autoReloadDone != nil && r.autoReloadDone(r.resources) {
+		r.autoReload = false
+	}
 
 	var cmds []tea.Cmd
+	if r.stream != nil {
+		cmds = append(cmds, r.stream.next)
+	}
 	if r.autoReload {
 		cmds = append(cmds, r.tickCmd())
 	}
@@ -40,6 +52,9 @@ func (r *ResourceBrowser) handleNextPageLoaded(msg nextPageLoadedMsg) (tea.Model
 	r.nextPageTokens = msg.nextPageTokens
 	r.nextMultiPageTokens = msg.nextMultiPageTokens
 	r.hasMorePages = msg.hasMorePages
+	r.lastFetch = msg.stats
+	r.pagesLoaded++
+	r.applyMaxItems()
 	r.applyFilter()
 	r.buildTable()
 	return r, nil
@@ -48,6 +63,7 @@ func (r *ResourceBrowser) handleNextPageLoaded(msg nextPageLoadedMsg) (tea.Model
 func (r *ResourceBrowser) handleResourcesError(msg resourcesErrorMsg) (tea.Model, tea.Cmd) {
 	r.loading = false
 	r.isLoadingMore = false
+	r.lastFetch = msg.stats
 	if r.hasMorePages && len(r.resources) > 0 {
 		r.hasMorePages = false
 		r.nextPageToken = ""
@@ -57,6 +73,7 @@ func (r *ResourceBrowser) handleResourcesError(msg resourcesErrorMsg) (tea.Model
 		return r, nil
 	}
 	r.err = msg.err
+	r.errState = newErrorState(msg.err, fmt.Sprintf("list %s/%s", r.service, r.resourceType))
 	if r.autoReload {
 		return r, r.tickCmd()
 	}
@@ -79,15 +96,15 @@ func (r *ResourceBrowser) handleMetricsLoaded(msg metricsLoadedMsg) (tea.Model,
 
 func (r *ResourceBrowser) handleAutoReloadTick() (tea.Model, tea.Cmd) {
 	if r.metricsEnabled && r.getMetricSpec() != nil {
-		return r, tea.Batch(r.reloadResources, r.loadMetricsCmd())
+		return r, tea.Batch(r.reloadResourcesCmd(), r.loadMetricsCmd())
 	}
-	return r, r.reloadResources
+	return r, r.reloadResourcesCmd()
 }
 
 func (r *ResourceBrowser) handleRefreshMsg() (tea.Model, tea.Cmd) {
 	r.loading = true
 	r.err = nil
-	return r, tea.Batch(r.loadResources, r.spinner.Tick)
+	return r, tea.Batch(r.loadResourcesCmd(), r.spinner.Tick)
 }
 
 func (r *ResourceBrowser) handleSortMsg(msg SortMsg) (tea.Model, tea.Cmd) {
@@ -146,7 +163,7 @@ func (r *ResourceBrowser) handleDiffMsg(msg DiffMsg) (tea.Model, tea.Cmd) {
 		return r, nil
 	}
 
-	diffView := NewDiffView(r.ctx, dao.UnwrapResource(leftRes), dao.UnwrapResource(rightRes), r.renderer, r.service, r.resourceType)
+	diffView := NewDiffView(r.ctx, dao.UnwrapResource(leftRes), dao.UnwrapResource(rightRes), r.renderer, r.service, r.resourceType).WithDAO(r.dao)
 	return r, func() tea.Msg {
 		return NavigateMsg{View: diffView}
 	}
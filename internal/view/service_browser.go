@@ -9,6 +9,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
 )
@@ -82,6 +83,7 @@ type serviceBrowserStyles struct {
 	serviceNameSe lipgloss.Style // Selected service name
 	aliases       lipgloss.Style
 	aliasesSel    lipgloss.Style // Selected aliases
+	denied        lipgloss.Style // Services the current credentials cannot list
 	filterPrompt  lipgloss.Style
 }
 
@@ -97,6 +99,7 @@ func newServiceBrowserStyles() serviceBrowserStyles {
 		serviceNameSe: ui.TitleStyle(),
 		aliases:       ui.DimStyle(),
 		aliasesSel:    ui.DimStyle(),
+		denied:        ui.WarningStyle(),
 		filterPrompt:  ui.PrimaryStyle(),
 	}
 }
@@ -105,6 +108,7 @@ type serviceItem struct {
 	name        string   // internal service name (e.g., "ssm")
 	displayName string   // display name (e.g., "Systems Manager")
 	aliases     []string // command aliases
+	denied      bool     // every list tried with the current credentials was denied
 }
 
 // filterValue returns searchable text for filtering
@@ -149,6 +153,7 @@ func (s *ServiceBrowser) loadServices() tea.Msg {
 				name:        svc,
 				displayName: s.registry.GetDisplayName(svc),
 				aliases:     aliases,
+				denied:      config.Global().ServiceAccessDenied(svc),
 			})
 		}
 		groups = append(groups, categoryGroup{
@@ -572,6 +577,15 @@ func (s *ServiceBrowser) renderCell(item serviceItem, selected bool) string {
 		}
 	}
 
+	// Services the principal was denied stay selectable but are greyed out
+	if item.denied {
+		if !selected {
+			nameStyle = s.styles.aliases
+		}
+		aliasLine = "🔒 no access"
+		aliasStyle = s.styles.denied
+	}
+
 	content := nameStyle.Render(name) + "\n" + aliasStyle.Render(aliasLine)
 	return cellStyle.Render(content)
 }
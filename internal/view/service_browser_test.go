@@ -2,10 +2,12 @@ package view
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/registry"
 )
 
@@ -199,3 +201,34 @@ func TestServiceBrowserMouseWheel(t *testing.T) {
 
 	// Should not panic
 }
+
+func TestServiceBrowserAccessDenied(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("iam", "roles", registry.Entry{})
+	reg.RegisterCustom("s3", "buckets", registry.Entry{})
+
+	cfg := config.Global()
+	cfg.SetSelection(config.NamedProfile("restricted"))
+	cfg.SetRegions([]string{"us-east-1"})
+	cfg.RecordAccess("restricted", "us-east-1", "iam", "roles", true)
+	t.Cleanup(func() {
+		cfg.RecordAccess("restricted", "us-east-1", "iam", "roles", false)
+		cfg.SetSelections(nil)
+		cfg.SetRegions(nil)
+	})
+
+	browser := NewServiceBrowser(context.Background(), reg)
+	browser.Update(browser.Init()())
+	browser.SetSize(120, 40)
+
+	denied := map[string]bool{}
+	for _, item := range browser.flatItems {
+		denied[item.service.name] = item.service.denied
+	}
+	if !denied["iam"] || denied["s3"] {
+		t.Errorf("denied = %v, want only iam", denied)
+	}
+	if !strings.Contains(browser.ViewString(), "no access") {
+		t.Error("expected denied service to be annotated")
+	}
+}
@@ -169,6 +169,15 @@ func (v *SettingsView) buildContent() string {
 	}
 	sb.WriteString(fmt.Sprintf("  Read-only     %s\n", readOnly))
 
+	sandbox := "no"
+	if globalCfg.Sandbox() {
+		sandbox = "ReadOnlyAccess session policy"
+		if globalCfg.SandboxPolicy() != "" {
+			sandbox = "custom session policy"
+		}
+	}
+	sb.WriteString(fmt.Sprintf("  Sandbox       %s\n", sandbox))
+
 	compactHeader := "no"
 	if globalCfg.CompactHeader() {
 		compactHeader = "yes"
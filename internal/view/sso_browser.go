@@ -0,0 +1,232 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type ssoRoleItem struct {
+	sel   config.ProfileSelection
+	label string
+	email string
+}
+
+func (s ssoRoleItem) GetID() string    { return s.sel.ID() }
+func (s ssoRoleItem) GetLabel() string { return s.label }
+
+type ssoRolesLoadedMsg struct {
+	profile string
+	roles   []aws.SSOAccountRole
+	err     error
+}
+
+type ssoLoginDoneMsg struct {
+	err error
+}
+
+// SSOBrowser lists the accounts and roles available to an SSO session and
+// switches to the selected roles without profiles for them in ~/.aws/config.
+type SSOBrowser struct {
+	ctx      context.Context
+	profile  string // SSO session profile; resolved on load if empty
+	selector *MultiSelector[ssoRoleItem]
+	loading  bool
+	err      error
+}
+
+// NewSSOBrowser creates an SSOBrowser for the SSO session of profile. An
+// empty profile uses the current SSO selection or the first SSO profile.
+func NewSSOBrowser(ctx context.Context, profile string) *SSOBrowser {
+	initialSelected := make([]string, 0)
+	for _, sel := range config.Global().Selections() {
+		if sel.IsSSORole() {
+			initialSelected = append(initialSelected, sel.ID())
+		}
+	}
+
+	b := &SSOBrowser{
+		ctx:      ctx,
+		profile:  profile,
+		selector: NewMultiSelector[ssoRoleItem]("SSO Accounts & Roles", initialSelected),
+		loading:  true,
+	}
+	b.selector.SetRenderExtra(func(item ssoRoleItem) string {
+		if item.email == "" {
+			return ""
+		}
+		return ui.DimStyle().Render(item.email)
+	})
+	return b
+}
+
+func (b *SSOBrowser) Init() tea.Cmd {
+	return b.loadRoles
+}
+
+func (b *SSOBrowser) loadRoles() tea.Msg {
+	return b.fetchRoles(aws.ListSSOAccountRoles)
+}
+
+// reloadRoles is loadRoles, bypassing roles cached by `claws daemon`
+func (b *SSOBrowser) reloadRoles() tea.Msg {
+	return b.fetchRoles(aws.ReloadSSOAccountRoles)
+}
+
+func (b *SSOBrowser) fetchRoles(list func(context.Context, string) ([]aws.SSOAccountRole, error)) tea.Msg {
+	profile := b.profile
+	if profile == "" {
+		var err error
+		if profile, err = defaultSSOProfile(); err != nil {
+			return ssoRolesLoadedMsg{err: err}
+		}
+	}
+	roles, err := list(b.ctx, profile)
+	return ssoRolesLoadedMsg{profile: profile, roles: roles, err: err}
+}
+
+// defaultSSOProfile returns the SSO profile of the current selection, or the
+// first SSO profile in ~/.aws/config
+func defaultSSOProfile() (string, error) {
+	if profile := currentSSOProfile(); profile != "" {
+		return profile, nil
+	}
+	profiles, err := aws.LoadProfiles()
+	if err != nil {
+		return "", fmt.Errorf("load profiles: %w", err)
+	}
+	for _, p := range profiles {
+		if p.IsSSO {
+			return p.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no SSO profile in ~/.aws/config")
+}
+
+func (b *SSOBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ssoRolesLoadedMsg:
+		b.loading = false
+		b.err = msg.err
+		if msg.profile != "" {
+			b.profile = msg.profile
+			b.selector.SetTitle("SSO Accounts & Roles (" + msg.profile + ")")
+		}
+		items := make([]ssoRoleItem, len(msg.roles))
+		for i, r := range msg.roles {
+			items[i] = ssoRoleItem{
+				sel:   r.Selection(msg.profile),
+				label: fmt.Sprintf("%s (%s) %s", r.AccountName, r.AccountID, r.RoleName),
+				email: r.Email,
+			}
+		}
+		b.selector.SetItems(items)
+		return b, nil
+
+	case ssoLoginDoneMsg:
+		if msg.err != nil {
+			b.loading = false
+			b.err = apperrors.Wrapf(msg.err, "sso login %s", b.profile)
+			return b, nil
+		}
+		return b, b.reloadRoles
+
+	case ThemeChangedMsg:
+		b.selector.ReloadStyles()
+		return b, nil
+
+	case tea.KeyPressMsg:
+		if msg.String() == "ctrl+r" && !b.selector.FilterActive() && !b.loading {
+			b.loading = true
+			b.err = nil
+			return b, b.reloadRoles
+		}
+		if msg.String() == "l" && b.err != nil && b.profile != "" {
+			return b, b.ssoLogin()
+		}
+	}
+
+	cmd, result := b.selector.HandleUpdate(msg)
+	if result == KeyApply {
+		return b.applySelection()
+	}
+	return b, cmd
+}
+
+// ssoLogin runs `aws sso login` for the session profile and reloads
+func (b *SSOBrowser) ssoLogin() tea.Cmd {
+	execCmd, err := ssoLoginExec(b.profile)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	b.loading = true
+	b.err = nil
+	return tea.Exec(execCmd, func(err error) tea.Msg {
+		return ssoLoginDoneMsg{err: err}
+	})
+}
+
+func (b *SSOBrowser) applySelection() (tea.Model, tea.Cmd) {
+	selected := b.selector.SelectedItems()
+	if len(selected) == 0 {
+		return b, nil
+	}
+
+	selections := make([]config.ProfileSelection, len(selected))
+	for i, item := range selected {
+		selections[i] = item.sel
+	}
+
+	config.Global().SetSelections(selections)
+	return b, func() tea.Msg {
+		return navmsg.ProfilesChangedMsg{Selections: selections}
+	}
+}
+
+func (b *SSOBrowser) ViewString() string {
+	switch {
+	case b.loading:
+		return ui.TitleStyle().Render("SSO Accounts & Roles") + "\n" + LoadingMessage
+	case b.err != nil:
+		content := ui.TitleStyle().Render("SSO Accounts & Roles") + "\n" +
+			ui.DangerStyle().Render("Error: "+b.err.Error())
+		if b.profile != "" {
+			content += "\n" + ui.DimStyle().Render("Press l to run aws sso login --profile "+b.profile)
+		}
+		return content
+	}
+	return b.selector.ViewString()
+}
+
+func (b *SSOBrowser) View() tea.View {
+	return tea.NewView(b.ViewString())
+}
+
+func (b *SSOBrowser) SetSize(width, height int) tea.Cmd {
+	b.selector.SetSize(width, height)
+	return nil
+}
+
+func (b *SSOBrowser) StatusLine() string {
+	if b.selector.FilterActive() {
+		return "Type to filter • Enter confirm • Esc cancel"
+	}
+	if b.err != nil {
+		return "l:SSO login • Ctrl+r:retry • Esc:close"
+	}
+	count := b.selector.SelectedCount()
+	return "Space:toggle • /:filter • Enter:apply • Ctrl+r:refresh • " + strings.Repeat("●", count) + " selected"
+}
+
+func (b *SSOBrowser) HasActiveInput() bool {
+	return b.selector.FilterActive()
+}
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	navmsg "github.com/clawscli/claws/internal/msg"
+)
+
+func TestSSOBrowserApply(t *testing.T) {
+	original := config.Global().Selections()
+	t.Cleanup(func() { config.Global().SetSelections(original) })
+
+	browser := NewSSOBrowser(context.Background(), "sso-admin")
+	browser.SetSize(100, 30)
+	browser.Update(ssoRolesLoadedMsg{profile: "sso-admin", roles: []aws.SSOAccountRole{
+		{AccountID: "111111111111", AccountName: "dev", Email: "dev@example.com", RoleName: "Admin"},
+		{AccountID: "222222222222", AccountName: "prod", RoleName: "ReadOnly"},
+	}})
+
+	out := browser.ViewString()
+	for _, want := range []string{"sso-admin", "dev (111111111111) Admin", "dev@example.com"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in view:\n%s", want, out)
+		}
+	}
+
+	browser.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	browser.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	_, cmd := browser.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected command on apply")
+	}
+	msg, ok := cmd().(navmsg.ProfilesChangedMsg)
+	if !ok {
+		t.Fatalf("expected ProfilesChangedMsg, got %T", cmd())
+	}
+	want := config.SSORole("sso-admin", "222222222222", "ReadOnly")
+	if len(msg.Selections) != 1 || msg.Selections[0] != want {
+		t.Errorf("selections = %v, want [%v]", msg.Selections, want)
+	}
+}
+
+func TestSSOBrowserError(t *testing.T) {
+	browser := NewSSOBrowser(context.Background(), "sso-admin")
+	browser.SetSize(100, 30)
+	browser.Update(ssoRolesLoadedMsg{profile: "sso-admin", err: errors.New("token expired")})
+
+	if out := browser.ViewString(); !strings.Contains(out, "token expired") || !strings.Contains(out, "aws sso login --profile sso-admin") {
+		t.Errorf("expected error and login hint, got:\n%s", out)
+	}
+	if !strings.Contains(browser.StatusLine(), "l:SSO login") {
+		t.Errorf("expected login hint in status line, got %q", browser.StatusLine())
+	}
+}
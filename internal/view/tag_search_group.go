@@ -0,0 +1,102 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// groupTagBatchSize is the GetResources limit for ResourceARNList
+const groupTagBatchSize = 100
+
+// NewResourceGroupView lists the members of a resource group across services,
+// reusing the tag search listing and its navigation to each resource.
+func NewResourceGroupView(ctx context.Context, reg *registry.Registry, groupName string) *TagSearchView {
+	v := NewTagSearchView(ctx, reg, "")
+	v.groupName = groupName
+	return v
+}
+
+func (v *TagSearchView) loadGroupMembers() tea.Msg {
+	ctx, cancel := context.WithTimeout(v.ctx, config.File().TagSearchTimeout())
+	defer cancel()
+
+	cfg, err := aws.NewConfig(ctx)
+	if err != nil {
+		return tagSearchErrorMsg{err: err}
+	}
+
+	client := resourcegroups.NewFromConfig(cfg)
+	var arns []string
+	var token *string
+	for {
+		output, err := client.ListGroupResources(ctx, &resourcegroups.ListGroupResourcesInput{
+			Group:     &v.groupName,
+			NextToken: token,
+		})
+		if err != nil {
+			return tagSearchErrorMsg{err: fmt.Errorf("list group resources: %w", err)}
+		}
+		for _, item := range output.Resources {
+			if item.Identifier != nil && item.Identifier.ResourceArn != nil {
+				arns = append(arns, *item.Identifier.ResourceArn)
+			}
+		}
+		if output.NextToken == nil || *output.NextToken == "" {
+			break
+		}
+		token = output.NextToken
+	}
+
+	tags := v.fetchTagsForARNs(ctx, resourcegroupstaggingapi.NewFromConfig(cfg), arns)
+
+	region := cfg.Region
+	resources := make([]taggedARN, 0, len(arns))
+	for _, raw := range arns {
+		parsed := aws.ParseARN(raw)
+		memberRegion := region
+		if parsed != nil && parsed.Region != "" {
+			memberRegion = parsed.Region
+		}
+		resources = append(resources, taggedARN{
+			ARN:    parsed,
+			Region: memberRegion,
+			Tags:   tags[raw],
+			RawARN: raw,
+		})
+	}
+
+	return tagSearchLoadedMsg{resources: resources}
+}
+
+// fetchTagsForARNs looks up tags for group members; members without tags or
+// in failed batches are listed without them.
+func (v *TagSearchView) fetchTagsForARNs(ctx context.Context, client *resourcegroupstaggingapi.Client, arns []string) map[string]map[string]string {
+	tags := make(map[string]map[string]string, len(arns))
+	for start := 0; start < len(arns); start += groupTagBatchSize {
+		end := min(start+groupTagBatchSize, len(arns))
+		output, err := client.GetResources(ctx, &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: arns[start:end],
+		})
+		if err != nil {
+			log.Warn("failed to fetch tags for group members", "group", v.groupName, "error", err)
+			continue
+		}
+		for _, mapping := range output.ResourceTagMappingList {
+			m := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				m[aws.Str(tag.Key)] = aws.Str(tag.Value)
+			}
+			tags[aws.Str(mapping.ResourceARN)] = m
+		}
+	}
+	return tags
+}
@@ -53,6 +53,7 @@ type TagSearchView struct {
 	ctx       context.Context
 	registry  *registry.Registry
 	tagFilter string
+	groupName string // lists members of a resource group instead of searching tags
 	styles    tagSearchViewStyles
 
 	tc           TableCursor
@@ -116,6 +117,10 @@ type tagSearchNextPageMsg struct {
 }
 
 func (v *TagSearchView) loadResources() tea.Msg {
+	if v.groupName != "" {
+		return v.loadGroupMembers()
+	}
+
 	regions := config.Global().Regions()
 	if len(regions) == 0 {
 		regions = []string{config.Global().Region()}
@@ -636,11 +641,7 @@ func (v *TagSearchView) buildTable() {
 func (v *TagSearchView) ViewString() string {
 	s := v.styles
 
-	title := "Tag Search"
-	if v.tagFilter != "" {
-		title = fmt.Sprintf("Tag Search: %s", v.tagFilter)
-	}
-	header := s.header.Width(v.width).Render(title)
+	header := s.header.Width(v.width).Render(v.title())
 
 	if v.loading {
 		return header + "\n" + v.spinner.View() + " Searching..."
@@ -681,7 +682,9 @@ func (v *TagSearchView) ViewString() string {
 
 	if len(v.resources) == 0 {
 		msg := "No tagged resources found"
-		if v.tagFilter != "" {
+		if v.groupName != "" {
+			msg = fmt.Sprintf("No resources in group '%s'", v.groupName)
+		} else if v.tagFilter != "" {
 			msg = fmt.Sprintf("No resources with tag '%s' found", v.tagFilter)
 		}
 		return header + "\n" + status + "\n" + ui.DimStyle().Render(msg)
@@ -712,20 +715,25 @@ func (v *TagSearchView) StatusLine() string {
 	count := len(v.filtered)
 	regions := config.Global().Regions()
 	regionInfo := ""
-	if len(regions) > 1 {
+	if len(regions) > 1 && v.groupName == "" {
 		regionInfo = fmt.Sprintf(" (%d regions)", len(regions))
 	}
 
-	if v.tagFilter != "" {
-		if v.filterText != "" {
-			return fmt.Sprintf("Tag Search: %s • %d/%d%s (/%s)", v.tagFilter, count, len(v.resources), regionInfo, v.filterText)
-		}
-		return fmt.Sprintf("Tag Search: %s • %d resources%s", v.tagFilter, count, regionInfo)
-	}
 	if v.filterText != "" {
-		return fmt.Sprintf("Tag Search • %d/%d%s (/%s)", count, len(v.resources), regionInfo, v.filterText)
+		return fmt.Sprintf("%s • %d/%d%s (/%s)", v.title(), count, len(v.resources), regionInfo, v.filterText)
+	}
+	return fmt.Sprintf("%s • %d resources%s", v.title(), count, regionInfo)
+}
+
+func (v *TagSearchView) title() string {
+	switch {
+	case v.groupName != "":
+		return fmt.Sprintf("Resource Group: %s", v.groupName)
+	case v.tagFilter != "":
+		return fmt.Sprintf("Tag Search: %s", v.tagFilter)
+	default:
+		return "Tag Search"
 	}
-	return fmt.Sprintf("Tag Search • %d resources%s", count, regionInfo)
 }
 
 func (v *TagSearchView) HasActiveInput() bool {
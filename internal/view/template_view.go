@@ -0,0 +1,310 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/clawscli/claws/internal/cfntemplate"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// templateTimeout bounds template generation, which the IaC generator
+// usually completes within a minute
+const templateTimeout = 10 * time.Minute
+
+type templateGeneratedMsg struct {
+	result *cfntemplate.Result
+	err    error
+}
+
+type templateStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	warning lipgloss.Style
+	success lipgloss.Style
+	danger  lipgloss.Style
+}
+
+func newTemplateStyles() templateStyles {
+	return templateStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		warning: ui.WarningStyle(),
+		success: ui.SuccessStyle(),
+		danger:  ui.DangerStyle(),
+	}
+}
+
+// TemplateView generates a CloudFormation template for a resource with the
+// IaC generator and shows it, with copy and save-to-file support.
+type TemplateView struct {
+	ctx        context.Context
+	resource   string
+	definition types.ResourceDefinition
+
+	vp      ViewportState
+	spinner spinner.Model
+	styles  templateStyles
+	width   int
+	height  int
+
+	loading bool
+	err     error
+	result  *cfntemplate.Result
+
+	saveInput  textinput.Model
+	saveActive bool
+	saveStatus string
+	saveErr    error
+}
+
+// NewTemplateView creates a new TemplateView for the resource identified by
+// definition. name is used for display and the default file name.
+func NewTemplateView(ctx context.Context, name string, definition types.ResourceDefinition) *TemplateView {
+	ti := textinput.New()
+	ti.Prompt = "Save to: "
+	ti.CharLimit = 512
+	ti.SetValue(templateFileName(name))
+
+	return &TemplateView{
+		ctx:        ctx,
+		resource:   name,
+		definition: definition,
+		spinner:    ui.NewSpinner(),
+		styles:     newTemplateStyles(),
+		loading:    true,
+		saveInput:  ti,
+	}
+}
+
+func (v *TemplateView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.generateCmd())
+}
+
+func (v *TemplateView) generateCmd() tea.Cmd {
+	ctx := v.ctx
+	definition := v.definition
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(ctx, templateTimeout)
+		defer cancel()
+		result, err := cfntemplate.Generate(ctx, []types.ResourceDefinition{definition})
+		return templateGeneratedMsg{result: result, err: err}
+	}
+}
+
+func (v *TemplateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case templateGeneratedMsg:
+		v.loading = false
+		v.result = msg.result
+		v.err = msg.err
+		v.refreshContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.saveActive {
+			return v.handleSaveInput(msg)
+		}
+		switch msg.String() {
+		case "ctrl+r":
+			if v.loading {
+				return v, nil
+			}
+			v.loading = true
+			v.err = nil
+			v.result = nil
+			v.saveStatus = ""
+			v.saveErr = nil
+			return v, tea.Batch(v.spinner.Tick, v.generateCmd())
+		case "s":
+			if v.result != nil {
+				v.saveActive = true
+				v.saveInput.Focus()
+				return v, textinput.Blink
+			}
+			return v, nil
+		case "y":
+			if v.result != nil {
+				return v, clipboard.Copy("template", v.result.Template)
+			}
+			return v, nil
+		case "g":
+			if v.vp.Ready {
+				v.vp.Model.GotoTop()
+			}
+			return v, nil
+		case "G":
+			if v.vp.Ready {
+				v.vp.Model.GotoBottom()
+			}
+			return v, nil
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newTemplateStyles()
+		v.refreshContent()
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *TemplateView) handleSaveInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.saveActive = false
+		v.saveInput.Blur()
+		return v, nil
+	case "enter":
+		v.saveActive = false
+		v.saveInput.Blur()
+		v.save(strings.TrimSpace(v.saveInput.Value()))
+		return v, nil
+	default:
+		var cmd tea.Cmd
+		v.saveInput, cmd = v.saveInput.Update(msg)
+		return v, cmd
+	}
+}
+
+// templateFileName returns the default file name for the template of the
+// named resource
+func templateFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', ' ':
+			return '-'
+		}
+		return r
+	}, name) + ".yaml"
+}
+
+// save writes the template to path, refusing to overwrite existing files
+func (v *TemplateView) save(path string) {
+	v.saveStatus = ""
+	v.saveErr = nil
+	if path == "" {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		v.saveErr = err
+		return
+	}
+	_, err = f.WriteString(v.result.Template)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		v.saveErr = err
+		return
+	}
+	v.saveStatus = "Saved to " + path
+}
+
+func (v *TemplateView) refreshContent() {
+	if !v.vp.Ready {
+		return
+	}
+	if v.result == nil {
+		v.vp.Model.SetContent("")
+		return
+	}
+
+	var sb strings.Builder
+	if len(v.result.Warnings) > 0 {
+		sb.WriteString(v.styles.warning.Render(fmt.Sprintf("⚠ %d warning(s), review before deploying:", len(v.result.Warnings))))
+		sb.WriteString("\n")
+		for _, w := range v.result.Warnings {
+			sb.WriteString(v.styles.dim.Render("  " + w))
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString(v.result.Template)
+	v.vp.Model.SetContent(sb.String())
+}
+
+func (v *TemplateView) ViewString() string {
+	var sb strings.Builder
+	sb.WriteString(v.styles.title.Render("CloudFormation template: " + v.resource))
+	sb.WriteString("\n")
+
+	switch {
+	case v.saveActive:
+		sb.WriteString(ui.InputFieldStyle().Render(v.saveInput.View()))
+	case v.saveErr != nil:
+		sb.WriteString(v.styles.danger.Render("Save failed: " + v.saveErr.Error()))
+	case v.saveStatus != "":
+		sb.WriteString(v.styles.success.Render("✓ " + v.saveStatus))
+	default:
+		sb.WriteString(v.styles.dim.Render("Generated with the CloudFormation IaC generator; DeletionPolicy: Retain"))
+	}
+	sb.WriteString("\n\n")
+
+	switch {
+	case v.loading:
+		sb.WriteString(v.spinner.View() + " Generating template, this can take a minute...")
+	case v.err != nil:
+		sb.WriteString(v.styles.danger.Render("Error: " + v.err.Error()))
+	case !v.vp.Ready:
+		sb.WriteString(LoadingMessage)
+	default:
+		sb.WriteString(v.vp.Model.View())
+	}
+	return sb.String()
+}
+
+func (v *TemplateView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *TemplateView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.vp.SetSize(width, max(height-viewportHeaderOffset, 1))
+	v.saveInput.SetWidth(max(width-filterInputPadding, minFilterWidth))
+	v.refreshContent()
+	return nil
+}
+
+func (v *TemplateView) StatusLine() string {
+	if v.saveActive {
+		return "Esc:cancel Enter:save"
+	}
+	if v.loading {
+		return "Esc:back"
+	}
+	if v.result == nil {
+		return "Ctrl+r:retry Esc:back"
+	}
+	return "s:save y:copy g/G:top/bottom Ctrl+r:regenerate Esc:back"
+}
+
+// HasActiveInput reports whether the save input has focus
+func (v *TemplateView) HasActiveInput() bool {
+	return v.saveActive
+}
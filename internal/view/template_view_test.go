@@ -0,0 +1,71 @@
+package view
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/clawscli/claws/internal/cfntemplate"
+)
+
+func TestTemplateViewShowsTemplate(t *testing.T) {
+	v := NewTemplateView(context.Background(), "web server", types.ResourceDefinition{})
+	v.SetSize(120, 30)
+
+	if !strings.Contains(v.ViewString(), "Generating template") {
+		t.Errorf("expected loading message:\n%s", v.ViewString())
+	}
+
+	v.Update(templateGeneratedMsg{result: &cfntemplate.Result{
+		Template: "Resources:\n  EC2Instance:\n    Type: AWS::EC2::Instance\n",
+		Warnings: []string{"EC2Instance /UserData: write-only"},
+	}})
+	view := v.ViewString()
+	for _, want := range []string{"CloudFormation template: web server", "AWS::EC2::Instance", "1 warning(s)", "/UserData"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestTemplateViewSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "template.yaml")
+	v := NewTemplateView(context.Background(), "db", types.ResourceDefinition{})
+	v.SetSize(120, 30)
+	v.Update(templateGeneratedMsg{result: &cfntemplate.Result{Template: "Resources: {}\n"}})
+
+	v.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	if !v.HasActiveInput() {
+		t.Fatal("s should open the save prompt")
+	}
+	v.saveInput.SetValue(path)
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("template not saved: %v", err)
+	}
+	if string(data) != "Resources: {}\n" {
+		t.Errorf("saved %q", data)
+	}
+	if !strings.Contains(v.ViewString(), "Saved to "+path) {
+		t.Errorf("expected saved message:\n%s", v.ViewString())
+	}
+
+	// Existing files are not overwritten
+	v.save(path)
+	if v.saveErr == nil {
+		t.Error("expected error saving over an existing file")
+	}
+}
+
+func TestTemplateFileName(t *testing.T) {
+	if got, want := templateFileName("/aws/lambda/my fn"), "-aws-lambda-my-fn.yaml"; got != want {
+		t.Errorf("templateFileName() = %q, want %q", got, want)
+	}
+}
@@ -99,6 +99,12 @@ type DiffMsg struct {
 // ClearHistoryMsg tells the app to clear the navigation stack
 type ClearHistoryMsg struct{}
 
+// LoadCanceler is an optional interface for views that fetch in the background.
+// CancelLoads is called when the view is left so abandoned fetches stop early.
+type LoadCanceler interface {
+	CancelLoads()
+}
+
 // Refreshable is an interface for views that can refresh their data
 // Views like ResourceBrowser implement this, while DetailView does not
 type Refreshable interface {
@@ -127,21 +133,38 @@ func (h *NavigationHelper) FormatShortcuts(resource dao.Resource) string {
 		return ""
 	}
 
-	navigator, ok := h.Renderer.(render.Navigator)
-	if !ok {
-		return ""
+	var parts []string
+	if navigator, ok := h.Renderer.(render.Navigator); ok {
+		for _, nav := range navigator.Navigations(resource) {
+			parts = append(parts, fmt.Sprintf("%s:%s", nav.Key, nav.Label))
+		}
+	}
+	if len(h.logTargets(resource)) > 0 {
+		parts = append(parts, render.LogSourceKey+":Logs")
 	}
+	return strings.Join(parts, " ")
+}
 
-	navigations := navigator.Navigations(resource)
-	if len(navigations) == 0 {
-		return ""
+// logTargets returns the resource's log targets if the renderer is a LogSource
+func (h *NavigationHelper) logTargets(resource dao.Resource) []render.LogTarget {
+	if src, ok := h.Renderer.(render.LogSource); ok && resource != nil {
+		return src.LogTargets(resource)
 	}
+	return nil
+}
 
-	var parts []string
-	for _, nav := range navigations {
-		parts = append(parts, fmt.Sprintf("%s:%s", nav.Key, nav.Label))
+// bindsKey reports whether one of the renderer's navigations uses key
+func (h *NavigationHelper) bindsKey(resource dao.Resource, key string) bool {
+	navigator, ok := h.Renderer.(render.Navigator)
+	if !ok {
+		return false
 	}
-	return strings.Join(parts, " ")
+	for _, nav := range navigator.Navigations(resource) {
+		if nav.Key == key {
+			return true
+		}
+	}
+	return false
 }
 
 // HandleKey handles navigation key press and returns a command if navigation occurred
@@ -150,6 +173,12 @@ func (h *NavigationHelper) HandleKey(key string, resource dao.Resource) tea.Cmd
 		return nil
 	}
 
+	if key == render.LogSourceKey || (key == render.LogSourceAliasKey && !h.bindsKey(resource, key)) {
+		if targets := h.logTargets(resource); len(targets) > 0 {
+			return h.openLogTargets(targets)
+		}
+	}
+
 	navigator, ok := h.Renderer.(render.Navigator)
 	if !ok {
 		return nil
@@ -200,6 +229,11 @@ func (h *NavigationHelper) createCustomView(nav render.Navigation, resource dao.
 	switch nav.ViewType {
 	case render.ViewTypeLogView:
 		return h.createLogView(resource)
+	case render.ViewTypeResourceGroup:
+		groupView := NewResourceGroupView(h.Ctx, h.Registry, dao.UnwrapResource(resource).GetName())
+		return func() tea.Msg {
+			return NavigateMsg{View: groupView}
+		}
 	default:
 		return nil
 	}
@@ -209,6 +243,7 @@ func (h *NavigationHelper) createLogView(resource dao.Resource) tea.Cmd {
 	var logView *LogView
 
 	type logGroupProvider interface{ LogGroupName() string }
+	type logGroupIdentifierProvider interface{ LogGroupIdentifier() string }
 	type logStreamProvider interface{ LogStreamName() string }
 	type lastEventProvider interface{ LastEventTimestamp() int64 }
 
@@ -216,6 +251,9 @@ func (h *NavigationHelper) createLogView(resource dao.Resource) tea.Cmd {
 
 	if p, ok := unwrapped.(logGroupProvider); ok {
 		logGroupName := p.LogGroupName()
+		if ip, ok := unwrapped.(logGroupIdentifierProvider); ok {
+			logGroupName = ip.LogGroupIdentifier() // ARN for source-account log groups
+		}
 		if sp, ok := unwrapped.(logStreamProvider); ok {
 			var lastEvent int64
 			if lp, ok := unwrapped.(lastEventProvider); ok {
@@ -234,6 +272,65 @@ func (h *NavigationHelper) createLogView(resource dao.Resource) tea.Cmd {
 	}
 }
 
+// openLogTargets tails a single log target in LogView. Several targets open
+// the log group list filtered by their common prefix, or the first target
+// when they share none.
+func (h *NavigationHelper) openLogTargets(targets []render.LogTarget) tea.Cmd {
+	var next View
+	if prefix := commonLogGroupPrefix(targets); len(targets) > 1 && prefix != "" {
+		next = NewResourceBrowserWithFilter(h.Ctx, h.Registry, "cloudwatch", "log-groups", "LogGroupPrefix", prefix)
+	} else if t := targets[0]; t.Stream != "" {
+		next = NewLogViewWithStream(h.Ctx, t.Group, t.Stream, 0)
+	} else {
+		next = NewLogView(h.Ctx, t.Group)
+	}
+	return func() tea.Msg {
+		return NavigateMsg{View: next}
+	}
+}
+
+func commonLogGroupPrefix(targets []render.LogTarget) string {
+	if len(targets) == 0 {
+		return ""
+	}
+	prefix := targets[0].Group
+	for _, t := range targets[1:] {
+		i := 0
+		for i < len(prefix) && i < len(t.Group) && prefix[i] == t.Group[i] {
+			i++
+		}
+		prefix = prefix[:i]
+	}
+	// A prefix no longer than the service path (e.g., "/aws/lambda/" or
+	// "/ecs/") matches every log group of the service, not the related ones
+	if len(prefix) <= len(logGroupServicePath(targets[0].Group)) {
+		return ""
+	}
+	return prefix
+}
+
+// logGroupServicePath returns the leading service path of a log group name:
+// "/aws/<service>/" for AWS vended groups, "/<first segment>/" for other
+// slash-separated groups, or "" for names not starting with "/"
+func logGroupServicePath(group string) string {
+	if !strings.HasPrefix(group, "/") {
+		return ""
+	}
+	segments := 1
+	if strings.HasPrefix(group, "/aws/") {
+		segments = 2
+	}
+	end := 0
+	for range segments {
+		i := strings.Index(group[end+1:], "/")
+		if i < 0 {
+			return group
+		}
+		end += i + 1
+	}
+	return group[:end+1]
+}
+
 // mergeResources merges the refreshed resource with the original to preserve
 // fields that are only available from List() but not from Get().
 func mergeResources(original, refreshed dao.Resource) dao.Resource {
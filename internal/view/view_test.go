@@ -1,12 +1,14 @@
 package view
 
 import (
+	"context"
 	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
 )
 
@@ -137,3 +139,162 @@ func TestTruncateOrPad(t *testing.T) {
 		})
 	}
 }
+
+// LogSource tests
+
+type mockLogSourceRenderer struct {
+	mockRenderer
+	targets []render.LogTarget
+}
+
+func (m *mockLogSourceRenderer) LogTargets(dao.Resource) []render.LogTarget { return m.targets }
+
+func TestNavigationHelperLogSource(t *testing.T) {
+	resource := &mockResource{id: "fn", name: "fn"}
+
+	tests := []struct {
+		name    string
+		targets []render.LogTarget
+		check   func(t *testing.T, v View)
+	}{
+		{
+			name:    "single group opens LogView",
+			targets: []render.LogTarget{{Group: "/aws/lambda/fn"}},
+			check: func(t *testing.T, v View) {
+				lv, ok := v.(*LogView)
+				if !ok || lv.LogGroupName() != "/aws/lambda/fn" {
+					t.Errorf("expected LogView for /aws/lambda/fn, got %T", v)
+				}
+			},
+		},
+		{
+			name:    "stream target tails the stream",
+			targets: []render.LogTarget{{Group: "/aws/codebuild/p", Stream: "build-1"}},
+			check: func(t *testing.T, v View) {
+				lv, ok := v.(*LogView)
+				if !ok || lv.logStreamName != "build-1" {
+					t.Errorf("expected LogView on stream build-1, got %T", v)
+				}
+			},
+		},
+		{
+			name:    "several groups list by common prefix",
+			targets: []render.LogTarget{{Group: "/aws/rds/instance/db/error"}, {Group: "/aws/rds/instance/db/slowquery"}},
+			check: func(t *testing.T, v View) {
+				rb, ok := v.(*ResourceBrowser)
+				if !ok || rb.fieldFilterValue != "/aws/rds/instance/db/" {
+					t.Errorf("expected log-groups browser filtered by prefix, got %T", v)
+				}
+			},
+		},
+		{
+			name:    "groups sharing only the service path open the first",
+			targets: []render.LogTarget{{Group: "/aws/lambda/fn-a"}, {Group: "/aws/lambda/other"}},
+			check: func(t *testing.T, v View) {
+				lv, ok := v.(*LogView)
+				if !ok || lv.LogGroupName() != "/aws/lambda/fn-a" {
+					t.Errorf("expected LogView for first group, got %T", v)
+				}
+			},
+		},
+		{
+			name:    "unrelated groups open the first",
+			targets: []render.LogTarget{{Group: "/aws/lambda/fn"}, {Group: "/aws/vendedlogs/x"}},
+			check: func(t *testing.T, v View) {
+				lv, ok := v.(*LogView)
+				if !ok || lv.LogGroupName() != "/aws/lambda/fn" {
+					t.Errorf("expected LogView for first group, got %T", v)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NavigationHelper{
+				Ctx:      context.Background(),
+				Registry: registry.New(),
+				Renderer: &mockLogSourceRenderer{targets: tt.targets},
+			}
+			if !strings.Contains(h.FormatShortcuts(resource), "L:Logs") {
+				t.Error("expected L:Logs shortcut")
+			}
+			cmd := h.HandleKey(render.LogSourceKey, resource)
+			if cmd == nil {
+				t.Fatal("expected L to navigate")
+			}
+			tt.check(t, cmd().(NavigateMsg).View)
+		})
+	}
+}
+
+// mockLogSourceNavigator is a LogSource that also has navigations
+type mockLogSourceNavigator struct {
+	mockLogSourceRenderer
+	navs []render.Navigation
+}
+
+func (m *mockLogSourceNavigator) Navigations(dao.Resource) []render.Navigation { return m.navs }
+
+func TestNavigationHelperLogSourceAlias(t *testing.T) {
+	resource := &mockResource{id: "fn", name: "fn"}
+	targets := []render.LogTarget{{Group: "/aws/lambda/fn"}}
+
+	h := &NavigationHelper{
+		Ctx:      context.Background(),
+		Registry: registry.New(),
+		Renderer: &mockLogSourceRenderer{targets: targets},
+	}
+	cmd := h.HandleKey(render.LogSourceAliasKey, resource)
+	if cmd == nil {
+		t.Fatal("expected l to open logs")
+	}
+	v := cmd().(NavigateMsg).View
+	if _, ok := v.(*LogView); !ok {
+		t.Errorf("expected l to open LogView, got %T", v)
+	}
+
+	// A navigation bound to l takes precedence over the alias
+	h.Renderer = &mockLogSourceNavigator{
+		mockLogSourceRenderer: mockLogSourceRenderer{targets: targets},
+		navs:                  []render.Navigation{{Key: "l", Label: "Log Groups", Service: "cloudwatch", Resource: "log-groups"}},
+	}
+	cmd = h.HandleKey(render.LogSourceAliasKey, resource)
+	if cmd == nil {
+		t.Fatal("expected l to navigate")
+	}
+	v = cmd().(NavigateMsg).View
+	if _, ok := v.(*ResourceBrowser); !ok {
+		t.Errorf("expected l navigation to open ResourceBrowser, got %T", v)
+	}
+}
+
+func TestLogGroupServicePath(t *testing.T) {
+	tests := map[string]string{
+		"/aws/lambda/fn":                         "/aws/lambda/",
+		"/aws/rds/instance/db/error":             "/aws/rds/",
+		"/ecs/app":                               "/ecs/",
+		"/aws/lambda":                            "/aws/lambda",
+		"API-Gateway-Execution-Logs_abc123/prod": "",
+	}
+	for group, want := range tests {
+		if got := logGroupServicePath(group); got != want {
+			t.Errorf("logGroupServicePath(%q) = %q, want %q", group, got, want)
+		}
+	}
+}
+
+func TestNavigationHelperLogSourceWithoutTargets(t *testing.T) {
+	h := &NavigationHelper{
+		Ctx:      context.Background(),
+		Registry: registry.New(),
+		Renderer: &mockLogSourceRenderer{},
+	}
+	resource := &mockResource{id: "fn", name: "fn"}
+	if got := h.FormatShortcuts(resource); got != "" {
+		t.Errorf("expected no shortcuts, got %q", got)
+	}
+	if h.HandleKey(render.LogSourceKey, resource) != nil {
+		t.Error("expected L to do nothing without log targets")
+	}
+}